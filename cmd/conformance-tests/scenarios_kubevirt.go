@@ -98,7 +98,6 @@ func (s *kubevirtScenario) NodeDeployments(num int, _ secrets) ([]apimodels.Node
 					Cloud: &apimodels.NodeCloudSpec{
 						Kubevirt: &apimodels.KubevirtNodeSpec{
 							Memory:           utilpointer.StringPtr("1024M"),
-							Namespace:        utilpointer.StringPtr("kube-system"),
 							SourceURL:        utilpointer.StringPtr(sourceURL),
 							StorageClassName: utilpointer.StringPtr("kubermatic-fast"),
 							PVCSize:          utilpointer.StringPtr("10Gi"),
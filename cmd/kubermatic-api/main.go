@@ -62,6 +62,7 @@ import (
 	v2 "k8c.io/kubermatic/v2/pkg/handler/v2"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	metricspkg "k8c.io/kubermatic/v2/pkg/metrics"
+	"k8c.io/kubermatic/v2/pkg/notification"
 	"k8c.io/kubermatic/v2/pkg/pprof"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
@@ -201,6 +202,21 @@ func createInitProviders(options serverRunOptions) (providers, error) {
 		return providers{}, err
 	}
 	admissionPluginProvider := kubernetesprovider.NewAdmissionPluginsProvider(ctx, client)
+	projectRoleProvider := kubernetesprovider.NewProjectRoleProvider(ctx, client)
+	groupProjectBindingProvider := kubernetesprovider.NewGroupProjectBindingProvider(defaultImpersonationClient.CreateImpersonatedClient, client)
+	projectInvitationProvider := kubernetesprovider.NewProjectInvitationProvider(defaultImpersonationClient.CreateImpersonatedClient, client)
+	priceListProvider := kubernetesprovider.NewPriceListProvider(ctx, client)
+	usageReportProvider := kubernetesprovider.NewUsageReportProvider(ctx, client)
+	operationProvider := kubernetesprovider.NewOperationProvider(ctx, client, mgr.GetAPIReader())
+	orphanedCloudResourceProvider := kubernetesprovider.NewOrphanedCloudResourceProvider(ctx, client)
+	credentialRotationProvider := kubernetesprovider.NewCredentialRotationProvider(ctx, client, seedsGetter, seedClientGetter)
+	var invitationNotifier provider.InvitationNotifier
+	if options.smtpOptions.Address != "" {
+		invitationNotifier, err = notification.NewSMTPInvitationNotifier(options.smtpOptions)
+		if err != nil {
+			return providers{}, fmt.Errorf("failed to create the invitation notifier: %v", err)
+		}
+	}
 	// Warm up the restMapper cache. Log but ignore errors encountered here, maybe there are stale seeds
 	go func() {
 		seeds, err := seedsGetter()
@@ -276,6 +292,8 @@ func createInitProviders(options serverRunOptions) (providers, error) {
 		return providers{}, fmt.Errorf("failed to create user watcher due to %v", err)
 	}
 
+	clusterWatcher := kuberneteswatcher.NewClusterWatcher(seedsGetter, clusterProviderGetter)
+
 	return providers{
 		sshKey:                                sshKeyProvider,
 		privilegedSSHKeyProvider:              privilegedSSHKeyProvider,
@@ -302,9 +320,21 @@ func createInitProviders(options serverRunOptions) (providers, error) {
 		admissionPluginProvider:               admissionPluginProvider,
 		settingsWatcher:                       settingsWatcher,
 		userWatcher:                           userWatcher,
+		clusterWatcher:                        clusterWatcher,
 		externalClusterProvider:               externalClusterProvider,
 		privilegedExternalClusterProvider:     externalClusterProvider,
 		constraintTemplateProvider:            constraintTemplateProvider,
+		projectRoleProvider:                   projectRoleProvider,
+		groupProjectBindingProvider:           groupProjectBindingProvider,
+		privilegedGroupProjectBindingProvider: groupProjectBindingProvider,
+		projectInvitationProvider:             projectInvitationProvider,
+		privilegedProjectInvitationProvider:   projectInvitationProvider,
+		invitationNotifier:                    invitationNotifier,
+		priceListProvider:                     priceListProvider,
+		usageReportProvider:                   usageReportProvider,
+		operationProvider:                     operationProvider,
+		orphanedCloudResourceProvider:         orphanedCloudResourceProvider,
+		credentialRotationProvider:            credentialRotationProvider,
 	}, nil
 }
 
@@ -400,15 +430,28 @@ func createAPIHandler(options serverRunOptions, prov providers, oidcIssuerVerifi
 		EventRecorderProvider:                 prov.eventRecorderProvider,
 		ExposeStrategy:                        options.exposeStrategy,
 		AccessibleAddons:                      options.accessibleAddons,
+		WebTerminalImage:                      options.webTerminalImage,
 		UserInfoGetter:                        prov.userInfoGetter,
 		SettingsProvider:                      prov.settingsProvider,
 		AdminProvider:                         prov.adminProvider,
 		AdmissionPluginProvider:               prov.admissionPluginProvider,
 		SettingsWatcher:                       prov.settingsWatcher,
 		UserWatcher:                           prov.userWatcher,
+		ClusterWatcher:                        prov.clusterWatcher,
 		ExternalClusterProvider:               prov.externalClusterProvider,
 		PrivilegedExternalClusterProvider:     prov.privilegedExternalClusterProvider,
 		ConstraintTemplateProvider:            prov.constraintTemplateProvider,
+		ProjectRoleProvider:                   prov.projectRoleProvider,
+		GroupProjectBindingProvider:           prov.groupProjectBindingProvider,
+		PrivilegedGroupProjectBindingProvider: prov.privilegedGroupProjectBindingProvider,
+		ProjectInvitationProvider:             prov.projectInvitationProvider,
+		PrivilegedProjectInvitationProvider:   prov.privilegedProjectInvitationProvider,
+		InvitationNotifier:                    prov.invitationNotifier,
+		PriceListProvider:                     prov.priceListProvider,
+		UsageReportProvider:                   prov.usageReportProvider,
+		OperationProvider:                     prov.operationProvider,
+		OrphanedCloudResourceProvider:         prov.orphanedCloudResourceProvider,
+		CredentialRotationProvider:            prov.credentialRotationProvider,
 	}
 
 	r := handler.NewRouting(routingParams)
@@ -25,6 +25,7 @@ import (
 
 	"k8c.io/kubermatic/v2/pkg/features"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/notification"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/serviceaccount"
 	"k8c.io/kubermatic/v2/pkg/watcher"
@@ -49,6 +50,7 @@ type serverRunOptions struct {
 	namespace        string
 	log              kubermaticlog.Options
 	accessibleAddons sets.String
+	webTerminalImage string
 
 	// OIDC configuration
 	oidcURL                        string
@@ -65,6 +67,9 @@ type serverRunOptions struct {
 	//service account configuration
 	serviceAccountSigningKey string
 
+	// SMTP server the project invitations are sent through, the notifications are disabled if no address is given
+	smtpOptions notification.SMTPOptions
+
 	featureGates features.FeatureGate
 }
 
@@ -90,6 +95,7 @@ func newServerRunOptions() (serverRunOptions, error) {
 	flag.StringVar(&s.presetsFile, "presets", "", "The optional file path for a file containing presets")
 	flag.StringVar(&s.swaggerFile, "swagger", "./cmd/kubermatic-api/swagger.json", "The swagger.json file path")
 	flag.StringVar(&rawAccessibleAddons, "accessible-addons", "", "Comma-separated list of user cluster addons to expose via the API")
+	flag.StringVar(&s.webTerminalImage, "web-terminal-image", "docker.io/alpine/k8s:1.19.2", "The image with kubectl and helm used for the web terminal pods")
 	flag.StringVar(&s.oidcURL, "oidc-url", "", "URL of the OpenID token issuer. Example: http://auth.int.kubermatic.io")
 	flag.BoolVar(&s.oidcSkipTLSVerify, "oidc-skip-tls-verify", false, "Skip TLS verification for the token issuer")
 	flag.StringVar(&oidcCAFile, "oidc-ca-file", "", "The path to the certificate for the CA that signed your identity provider’s web certificate.")
@@ -106,6 +112,10 @@ func newServerRunOptions() (serverRunOptions, error) {
 	flag.StringVar(&rawExposeStrategy, "expose-strategy", "NodePort", "The strategy to expose the controlplane with, either \"NodePort\" which creates NodePorts with a \"nodeport-proxy.k8s.io/expose: true\" annotation or \"LoadBalancer\", which creates a LoadBalancer")
 	flag.BoolVar(&s.dynamicPresets, "dynamic-presets", false, "Whether to enable dynamic presets")
 	flag.StringVar(&s.namespace, "namespace", "kubermatic", "The namespace kubermatic runs in, uses to determine where to look for datacenter custom resources")
+	flag.StringVar(&s.smtpOptions.Address, "smtp-address", "", "The host:port of the SMTP server used to notify the users about project invitations. The notifications are disabled if empty")
+	flag.StringVar(&s.smtpOptions.Username, "smtp-username", "", "The username for the PLAIN authentication against the SMTP server")
+	flag.StringVar(&s.smtpOptions.Password, "smtp-password", "", "The password for the PLAIN authentication against the SMTP server")
+	flag.StringVar(&s.smtpOptions.From, "smtp-from", "", "The sender address of the project invitations")
 	addFlags(flag.CommandLine)
 	flag.Parse()
 
@@ -124,6 +134,8 @@ func newServerRunOptions() (serverRunOptions, error) {
 		return s, fmt.Errorf("--expose-strategy must be either `NodePort` or `LoadBalancer`, got %q", rawExposeStrategy)
 	}
 
+	s.smtpOptions.DashboardURL = fmt.Sprintf("https://%s", s.domain)
+
 	s.accessibleAddons = sets.NewString(strings.Split(rawAccessibleAddons, ",")...)
 	s.accessibleAddons.Delete("")
 
@@ -197,7 +209,19 @@ type providers struct {
 	admissionPluginProvider               provider.AdmissionPluginsProvider
 	settingsWatcher                       watcher.SettingsWatcher
 	userWatcher                           watcher.UserWatcher
+	clusterWatcher                        watcher.ClusterWatcher
 	externalClusterProvider               provider.ExternalClusterProvider
 	privilegedExternalClusterProvider     provider.PrivilegedExternalClusterProvider
 	constraintTemplateProvider            provider.ConstraintTemplateProvider
+	projectRoleProvider                   provider.ProjectRoleProvider
+	groupProjectBindingProvider           provider.GroupProjectBindingProvider
+	privilegedGroupProjectBindingProvider provider.PrivilegedGroupProjectBindingProvider
+	projectInvitationProvider             provider.ProjectInvitationProvider
+	privilegedProjectInvitationProvider   provider.PrivilegedProjectInvitationProvider
+	invitationNotifier                    provider.InvitationNotifier
+	priceListProvider                     provider.PriceListProvider
+	usageReportProvider                   provider.UsageReportProvider
+	operationProvider                     provider.OperationProvider
+	orphanedCloudResourceProvider         provider.OrphanedCloudResourceProvider
+	credentialRotationProvider            provider.CredentialRotationProvider
 }
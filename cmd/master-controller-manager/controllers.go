@@ -21,12 +21,19 @@ import (
 	"fmt"
 
 	"github.com/prometheus/client_golang/prometheus"
+	clustermigration "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/cluster-migration"
 	externalcluster "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/external-cluster"
+	invitationcleanup "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/invitation-cleanup"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/operation"
+	orphanedcloudresource "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/orphaned-cloud-resource"
+	ownerbackfill "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/owner-backfill"
 	projectlabelsynchronizer "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/project-label-synchronizer"
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 	seedproxy "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/seed-proxy"
 	seedsync "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/seed-sync"
 	serviceaccount "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/service-account"
+	serviceaccounttokencleanup "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/service-account-token-cleanup"
+	usagereport "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/usage-report"
 	userprojectbinding "k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/user-project-binding"
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/usersshkeyssynchronizer"
 	seedcontrollerlifecycle "k8c.io/kubermatic/v2/pkg/controller/shared/seed-controller-lifecycle"
@@ -50,6 +57,10 @@ func createAllControllers(ctrlCtx *controllerContext) error {
 	)
 	projectLabelSynchronizerFactory := projectLabelSynchronizerFactoryCreator(ctrlCtx)
 	userSSHKeysSynchronizerFactory := userSSHKeysSynchronizerFactoryCreator(ctrlCtx)
+	clusterMigrationFactory := clusterMigrationFactoryCreator(ctrlCtx)
+	usageReportFactory := usageReportFactoryCreator(ctrlCtx)
+	operationFactory := operationFactoryCreator(ctrlCtx)
+	orphanedCloudResourceFactory := orphanedCloudResourceFactoryCreator(ctrlCtx)
 
 	if err := seedcontrollerlifecycle.Add(ctrlCtx.ctx,
 		kubermaticlog.Logger,
@@ -59,7 +70,11 @@ func createAllControllers(ctrlCtx *controllerContext) error {
 		ctrlCtx.seedKubeconfigGetter,
 		rbacControllerFactory,
 		projectLabelSynchronizerFactory,
-		userSSHKeysSynchronizerFactory); err != nil {
+		userSSHKeysSynchronizerFactory,
+		clusterMigrationFactory,
+		usageReportFactory,
+		operationFactory,
+		orphanedCloudResourceFactory); err != nil {
 		//TODO: Find a better name
 		return fmt.Errorf("failed to create seedcontrollerlifecycle: %v", err)
 	}
@@ -69,6 +84,15 @@ func createAllControllers(ctrlCtx *controllerContext) error {
 	if err := serviceaccount.Add(ctrlCtx.mgr); err != nil {
 		return fmt.Errorf("failed to create serviceaccount controller: %v", err)
 	}
+	if err := serviceaccounttokencleanup.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log); err != nil {
+		return fmt.Errorf("failed to create service account token cleanup controller: %v", err)
+	}
+	if err := invitationcleanup.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log); err != nil {
+		return fmt.Errorf("failed to create invitation cleanup controller: %v", err)
+	}
+	if err := ownerbackfill.Add(ctrlCtx.ctx, ctrlCtx.mgr, ctrlCtx.log); err != nil {
+		return fmt.Errorf("failed to create owner backfill controller: %v", err)
+	}
 	if err := seedsync.Add(ctrlCtx.ctx, ctrlCtx.mgr, 1, ctrlCtx.log, ctrlCtx.namespace, ctrlCtx.seedKubeconfigGetter); err != nil {
 		return fmt.Errorf("failed to create seedsync controller: %v", err)
 	}
@@ -127,3 +151,56 @@ func userSSHKeysSynchronizerFactoryCreator(ctrlCtx *controllerContext) seedcontr
 		)
 	}
 }
+
+func clusterMigrationFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
+	return func(ctx context.Context, mgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+		return clustermigration.ControllerName, clustermigration.Add(
+			ctx,
+			mgr,
+			seedManagerMap,
+			ctrlCtx.log,
+			ctrlCtx.seedsGetter,
+			ctrlCtx.workerName,
+		)
+	}
+}
+
+func usageReportFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
+	return func(ctx context.Context, mgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+		return usagereport.ControllerName, usagereport.Add(
+			ctx,
+			mgr,
+			seedManagerMap,
+			ctrlCtx.log,
+			ctrlCtx.workerName,
+			ctrlCtx.workerCount,
+		)
+	}
+}
+
+func operationFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
+	return func(ctx context.Context, mgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+		return operation.ControllerName, operation.Add(
+			ctx,
+			mgr,
+			seedManagerMap,
+			ctrlCtx.log,
+			ctrlCtx.workerName,
+			ctrlCtx.workerCount,
+		)
+	}
+}
+
+func orphanedCloudResourceFactoryCreator(ctrlCtx *controllerContext) seedcontrollerlifecycle.ControllerFactory {
+	return func(ctx context.Context, mgr manager.Manager, seedManagerMap map[string]manager.Manager) (string, error) {
+		return orphanedcloudresource.ControllerName, orphanedcloudresource.Add(
+			ctx,
+			mgr,
+			seedManagerMap,
+			ctrlCtx.log,
+			ctrlCtx.seedsGetter,
+			ctrlCtx.workerName,
+			ctrlCtx.orphanedCloudResourcesDeletionGracePeriod,
+		)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
@@ -68,6 +69,8 @@ type controllerContext struct {
 	seedKubeconfigGetter    provider.SeedKubeconfigGetter
 	labelSelectorFunc       func(*metav1.ListOptions)
 	namespace               string
+	// orphanedCloudResourcesDeletionGracePeriod is the time after which orphaned cloud resources get deleted
+	orphanedCloudResourcesDeletionGracePeriod time.Duration
 }
 
 func main() {
@@ -86,6 +89,7 @@ func main() {
 	flag.BoolVar(&runOpts.enableLeaderElection, "enable-leader-election", true, "Enable leader election for controller manager. "+
 		"Enabling this will ensure there is only one active controller manager.")
 	flag.StringVar(&runOpts.leaderElectionNamespace, "leader-election-namespace", "", "Leader election namespace. In-cluster discovery will be attempted in such case.")
+	flag.DurationVar(&ctrlCtx.orphanedCloudResourcesDeletionGracePeriod, "orphaned-cloud-resources-deletion-grace-period", 0, "The time after which resources at the cloud providers whose cluster does not exist anymore get deleted. They are only reported if it is zero.")
 	addFlags(flag.CommandLine)
 	flag.Parse()
 
@@ -44,6 +44,7 @@ import (
 	usercluster "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources"
 	machinecontrolerresources "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/machine-controller"
 	rolecloner "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/role-cloner"
+	webterminalcleanup "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/web-terminal-cleanup"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/pprof"
@@ -307,6 +308,11 @@ func main() {
 	}
 	log.Info("Registered ownerbindingcreator controller")
 
+	if err := webterminalcleanup.Add(ctx, log, mgr); err != nil {
+		log.Fatalw("Failed to register webterminalcleanup controller", zap.Error(err))
+	}
+	log.Info("Registered webterminalcleanup controller")
+
 	// This group is forever waiting in a goroutine for signals to stop
 	{
 		g.Add(func() error {
@@ -60,6 +60,11 @@ func main() {
 				ImportAlias:  "corev1",
 				// Don't specify ResourceImportPath so this block does not create a new import line in the generated code
 			},
+			{
+				ResourceName: "ResourceQuota",
+				ImportAlias:  "corev1",
+				// Don't specify ResourceImportPath so this block does not create a new import line in the generated code
+			},
 			{
 				ResourceName:       "StatefulSet",
 				ImportAlias:        "appsv1",
@@ -141,6 +146,12 @@ func main() {
 				ImportAlias:        "extensionsv1beta1",
 				ResourceImportPath: "k8s.io/api/extensions/v1beta1",
 			},
+			{
+				ResourceName:       "NetworkPolicy",
+				ResourceNamePlural: "NetworkPolicies",
+				ImportAlias:        "networkingv1",
+				ResourceImportPath: "k8s.io/api/networking/v1",
+			},
 			{
 				ResourceName:       "Seed",
 				ImportAlias:        "kubermaticv1",
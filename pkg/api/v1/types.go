@@ -450,6 +450,30 @@ type Admin struct {
 	IsAdmin bool `json:"isAdmin"`
 }
 
+// UserSuspension represents the suspension state of a user
+// swagger:model UserSuspension
+type UserSuspension struct {
+	// Email address of the user
+	Email string `json:"email"`
+	// Suspended users are locked out of the API
+	Suspended bool `json:"suspended"`
+}
+
+// UserOffboarding represents the offboarding of a user
+// swagger:model UserOffboarding
+type UserOffboarding struct {
+	// Email address of the offboarded user
+	Email string `json:"email"`
+	// NewOwner is the email address of the admin taking over the projects the user exclusively owns
+	NewOwner string `json:"newOwner"`
+	// TransferredProjects are the IDs of the projects transferred to the new owner
+	TransferredProjects []string `json:"transferredProjects,omitempty"`
+	// RemovedBindings are the IDs of the removed bindings of the user to the projects
+	RemovedBindings []string `json:"removedBindings,omitempty"`
+	// RevokedTokens are the IDs of the revoked tokens of the service accounts the user created
+	RevokedTokens []string `json:"revokedTokens,omitempty"`
+}
+
 // ProjectGroup is a helper data structure that
 // stores the information about a project and a group prefix that a user belongs to
 type ProjectGroup struct {
@@ -486,6 +510,9 @@ type PublicServiceAccountToken struct {
 	// Expiry is a timestamp representing the time when this token will expire.
 	// swagger:strfmt date-time
 	Expiry Time `json:"expiry,omitempty"`
+	// LastUsed is a timestamp representing the time when this token was last used.
+	// swagger:strfmt date-time
+	LastUsed *Time `json:"lastUsed,omitempty"`
 }
 
 // ServiceAccountToken represent an API service account token
@@ -598,6 +625,42 @@ type VSphereFolder struct {
 	Path string `json:"path"`
 }
 
+// VSphereDatastore is the object representing a vsphere datastore.
+// swagger:model VSphereDatastore
+type VSphereDatastore struct {
+	// Name is the name of the datastore
+	Name string `json:"name"`
+	// Path is the absolute path inside vCenter
+	Path string `json:"path"`
+}
+
+// VSphereDatastoreCluster is the object representing a vsphere datastore cluster.
+// swagger:model VSphereDatastoreCluster
+type VSphereDatastoreCluster struct {
+	// Name is the name of the datastore cluster
+	Name string `json:"name"`
+	// Path is the absolute path inside vCenter
+	Path string `json:"path"`
+}
+
+// VSphereResourcePool is the object representing a vsphere resource pool.
+// swagger:model VSphereResourcePool
+type VSphereResourcePool struct {
+	// Name is the name of the resource pool
+	Name string `json:"name"`
+	// Path is the absolute path inside vCenter
+	Path string `json:"path"`
+}
+
+// VSphereStoragePolicy is the object representing a vsphere storage policy.
+// swagger:model VSphereStoragePolicy
+type VSphereStoragePolicy struct {
+	// ID is the unique ID of the storage policy
+	ID string `json:"id"`
+	// Name is the name of the storage policy
+	Name string `json:"name"`
+}
+
 // AlibabaInstanceTypeList represents an array of Alibaba instance types.
 // swagger:model AlibabaInstanceTypeList
 type AlibabaInstanceTypeList []AlibabaInstanceType
@@ -620,6 +683,36 @@ type AlibabaZone struct {
 	ID string `json:"id"`
 }
 
+// AlibabaVPCList represents an array of Alibaba VPCs.
+// swagger:model AlibabaVPCList
+type AlibabaVPCList []AlibabaVPC
+
+// AlibabaVPC represents a object of Alibaba VPC.
+// swagger:model AlibabaVPC
+type AlibabaVPC struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CidrBlock string `json:"cidrBlock"`
+	Status    string `json:"status"`
+	IsDefault bool   `json:"isDefault"`
+}
+
+// AlibabaVSwitchList represents an array of Alibaba vSwitches.
+// swagger:model AlibabaVSwitchList
+type AlibabaVSwitchList []AlibabaVSwitch
+
+// AlibabaVSwitch represents a object of Alibaba vSwitch.
+// swagger:model AlibabaVSwitch
+type AlibabaVSwitch struct {
+	ID                      string `json:"id"`
+	Name                    string `json:"name"`
+	VPCID                   string `json:"vpcID"`
+	ZoneID                  string `json:"zoneID"`
+	CidrBlock               string `json:"cidrBlock"`
+	AvailableIPAddressCount int64  `json:"availableIPAddressCount"`
+	IsDefault               bool   `json:"isDefault"`
+}
+
 // MasterVersion describes a version of the master components
 // swagger:model MasterVersion
 type MasterVersion struct {
@@ -660,6 +753,8 @@ type Cluster struct {
 	Credential      string            `json:"credential,omitempty"`
 	Spec            ClusterSpec       `json:"spec"`
 	Status          ClusterStatus     `json:"status"`
+	// PreflightWarnings are the warnings of the cloud provider checks, they are only set in the response to the creation
+	PreflightWarnings []string `json:"preflightWarnings,omitempty"`
 }
 
 // ClusterSpec defines the cluster specification
@@ -698,6 +793,9 @@ type ClusterSpec struct {
 	// Enabling it causes gatekeeper and its resources to be deployed on the user cluster.
 	// By default it is disabled.
 	OPAIntegration *kubermaticv1.OPAIntegrationSettings `json:"opaIntegration,omitempty"`
+
+	// DeletionProtection prevents the cluster from being deleted until it is disabled.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
 }
 
 // MarshalJSON marshals ClusterSpec object into JSON. It is overwritten to control data
@@ -713,6 +811,7 @@ func (cs *ClusterSpec) MarshalJSON() ([]byte, error) {
 		UsePodNodeSelectorAdmissionPlugin   bool                                   `json:"usePodNodeSelectorAdmissionPlugin,omitempty"`
 		AuditLogging                        *kubermaticv1.AuditLoggingSettings     `json:"auditLogging,omitempty"`
 		AdmissionPlugins                    []string                               `json:"admissionPlugins,omitempty"`
+		DeletionProtection                  bool                                   `json:"deletionProtection,omitempty"`
 	}{
 		Cloud: PublicCloudSpec{
 			DatacenterName: cs.Cloud.DatacenterName,
@@ -737,6 +836,7 @@ func (cs *ClusterSpec) MarshalJSON() ([]byte, error) {
 		UsePodNodeSelectorAdmissionPlugin:   cs.UsePodNodeSelectorAdmissionPlugin,
 		AuditLogging:                        cs.AuditLogging,
 		AdmissionPlugins:                    cs.AdmissionPlugins,
+		DeletionProtection:                  cs.DeletionProtection,
 	})
 
 	return ret, err
@@ -783,14 +883,18 @@ func newPublicDigitaloceanCloudSpec(internal *kubermaticv1.DigitaloceanCloudSpec
 }
 
 // PublicHetznerCloudSpec is a public counterpart of apiv1.HetznerCloudSpec.
-type PublicHetznerCloudSpec struct{}
+type PublicHetznerCloudSpec struct {
+	Network string `json:"network,omitempty"`
+}
 
 func newPublicHetznerCloudSpec(internal *kubermaticv1.HetznerCloudSpec) (public *PublicHetznerCloudSpec) {
 	if internal == nil {
 		return nil
 	}
 
-	return &PublicHetznerCloudSpec{}
+	return &PublicHetznerCloudSpec{
+		Network: internal.Network,
+	}
 }
 
 // PublicAzureCloudSpec is a public counterpart of apiv1.AzureCloudSpec.
@@ -889,25 +993,37 @@ func newPublicGCPCloudSpec(internal *kubermaticv1.GCPCloudSpec) (public *PublicG
 }
 
 // PublicKubevirtCloudSpec is a public counterpart of apiv1.KubevirtCloudSpec.
-type PublicKubevirtCloudSpec struct{}
+type PublicKubevirtCloudSpec struct {
+	Namespace string `json:"namespace,omitempty"`
+}
 
 func newPublicKubevirtCloudSpec(internal *kubermaticv1.KubevirtCloudSpec) (public *PublicKubevirtCloudSpec) {
 	if internal == nil {
 		return nil
 	}
 
-	return &PublicKubevirtCloudSpec{}
+	return &PublicKubevirtCloudSpec{
+		Namespace: internal.Namespace,
+	}
 }
 
 // PublicAlibabaCloudSpec is a public counterpart of apiv1.AlibabaCloudSpec.
-type PublicAlibabaCloudSpec struct{}
+type PublicAlibabaCloudSpec struct {
+	VPCID     string `json:"vpcID,omitempty"`
+	VSwitchID string `json:"vSwitchID,omitempty"`
+	ZoneID    string `json:"zoneID,omitempty"`
+}
 
 func newPublicAlibabaCloudSpec(internal *kubermaticv1.AlibabaCloudSpec) (public *PublicAlibabaCloudSpec) {
 	if internal == nil {
 		return nil
 	}
 
-	return &PublicAlibabaCloudSpec{}
+	return &PublicAlibabaCloudSpec{
+		VPCID:     internal.VPCID,
+		VSwitchID: internal.VSwitchID,
+		ZoneID:    internal.ZoneID,
+	}
 }
 
 // ClusterStatus defines the cluster status
@@ -917,6 +1033,13 @@ type ClusterStatus struct {
 
 	// URL specifies the address at which the cluster is available
 	URL string `json:"url"`
+
+	// Phase is the hibernation phase of the cluster, it is empty for running clusters
+	Phase kubermaticv1.ClusterPhase `json:"phase,omitempty"`
+
+	// DeleteAt is set for deleted clusters which are kept for a grace period, they can be undeleted until then
+	// swagger:strfmt date-time
+	DeleteAt *Time `json:"deleteAt,omitempty"`
 }
 
 // ClusterHealth stores health information about the cluster's components.
@@ -931,6 +1054,58 @@ type ClusterHealth struct {
 	UserClusterControllerManager kubermaticv1.HealthStatus `json:"userClusterControllerManager"`
 }
 
+// ClusterHealthStatus stores the health of the cluster's components together with the conditions of the cluster,
+// it is sent by the cluster health websocket stream
+type ClusterHealthStatus struct {
+	ClusterHealth `json:",inline"`
+	Conditions    []ClusterCondition `json:"conditions"`
+}
+
+// ClusterCondition represents a condition of a cluster, the heartbeat time is left out
+// as it changes without the condition changing
+type ClusterCondition struct {
+	Type               string `json:"type"`
+	Status             string `json:"status"`
+	LastTransitionTime Time   `json:"lastTransitionTime"`
+	Reason             string `json:"reason,omitempty"`
+	Message            string `json:"message,omitempty"`
+}
+
+// ClusterMove defines the project a cluster is moved to
+// swagger:model ClusterMove
+type ClusterMove struct {
+	// ProjectID is the ID of the project the cluster is moved to
+	ProjectID string `json:"projectID"`
+	// SSHKeys are the IDs of the SSH keys of the target project which get assigned to the cluster.
+	// The keys of the current project are unassigned.
+	SSHKeys []string `json:"sshKeys,omitempty"`
+}
+
+// ClusterDeletionPreview lists the resources which are removed when the cluster gets deleted.
+// LoadBalancers and volumes are only removed if requested on deletion, the ones listed here can
+// also be retained using the RetainLoadBalancers and RetainVolumes headers. The instances of the
+// machines can be retained using the RetainMachines header.
+// swagger:model ClusterDeletionPreview
+type ClusterDeletionPreview struct {
+	LoadBalancers          []DeletionPreviewResource `json:"loadBalancers"`
+	PersistentVolumes      []DeletionPreviewResource `json:"persistentVolumes"`
+	PersistentVolumeClaims []DeletionPreviewResource `json:"persistentVolumeClaims"`
+	Machines               []DeletionPreviewResource `json:"machines"`
+	CloudResources         []DeletionPreviewResource `json:"cloudResources"`
+	Addons                 []DeletionPreviewResource `json:"addons"`
+	// InClusterResourcesUnavailable is set if the control plane of the cluster is hibernated,
+	// the load balancers, volumes and machines can't be listed then
+	InClusterResourcesUnavailable bool `json:"inClusterResourcesUnavailable,omitempty"`
+}
+
+// DeletionPreviewResource is a single resource of the cluster deletion preview
+// swagger:model DeletionPreviewResource
+type DeletionPreviewResource struct {
+	Kind      string `json:"kind,omitempty"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
 // AccessibleAddons represents an array of addons that can be configured in the user clusters.
 // swagger:model AccessibleAddons
 type AccessibleAddons []string
@@ -1216,7 +1391,8 @@ type KubevirtNodeSpec struct {
 	// required: true
 	Memory string `json:"memory"`
 	// Namespace states in which namespace kubevirt node will be provisioned.
-	// required: true
+	// Nodes are always provisioned in the dedicated namespace of the cluster in the KubeVirt infra cluster,
+	// if set it has to match it.
 	Namespace string `json:"namespace"`
 	// SourceURL states the url from which the imported image will be downloaded.
 	// required: true
@@ -1229,6 +1405,33 @@ type KubevirtNodeSpec struct {
 	PVCSize string `json:"pvcSize"`
 }
 
+// KubevirtStorageClass represents a storage class of the KubeVirt infra cluster.
+// swagger:model KubevirtStorageClass
+type KubevirtStorageClass struct {
+	Name        string `json:"name"`
+	Provisioner string `json:"provisioner"`
+	Default     bool   `json:"default"`
+}
+
+// KubevirtStorageClassList represents an array of KubeVirt storage classes.
+// swagger:model KubevirtStorageClassList
+type KubevirtStorageClassList []KubevirtStorageClass
+
+// KubevirtVMPreset represents a VirtualMachineInstancePreset of the KubeVirt infra cluster.
+// swagger:model KubevirtVMPreset
+type KubevirtVMPreset struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// CPUs is the number of CPU cores the preset configures
+	CPUs string `json:"cpus,omitempty"`
+	// Memory is the amount of memory the preset requests
+	Memory string `json:"memory,omitempty"`
+}
+
+// KubevirtVMPresetList represents an array of KubeVirt VM presets.
+// swagger:model KubevirtVMPresetList
+type KubevirtVMPresetList []KubevirtVMPreset
+
 // AlibabaNodeSpec alibaba specific node settings
 // swagger:model AlibabaNodeSpec
 type AlibabaNodeSpec struct {
@@ -1345,6 +1548,11 @@ type NodeDeployment struct {
 
 	Spec   NodeDeploymentSpec               `json:"spec"`
 	Status v1alpha1.MachineDeploymentStatus `json:"status"`
+	// PreflightWarnings are the warnings of the cloud provider checks, they are only set in the response to the creation
+	PreflightWarnings []string `json:"preflightWarnings,omitempty"`
+	// ZoneNodeDeployments are all node deployments created if the replicas were spread across
+	// availability zones, they are only set in the response to the creation
+	ZoneNodeDeployments []NodeDeployment `json:"zoneNodeDeployments,omitempty"`
 }
 
 // NodeDeploymentSpec node deployment specification
@@ -1522,6 +1730,186 @@ type AdmissionPlugin struct {
 	FromVersion *ksemver.Semver `json:"fromVersion,omitempty"`
 }
 
+// ProjectRole represents a custom project role that can be assigned to the members of a project
+// swagger:model ProjectRole
+type ProjectRole struct {
+	// Name is used as the group of the project members the role is assigned to
+	Name string `json:"name"`
+	// HumanReadableName is the name of the role displayed in the UI
+	HumanReadableName string `json:"humanReadableName,omitempty"`
+	// Rules holds the allowed verbs per resource
+	Rules []kubermaticv1.ProjectRoleRule `json:"rules"`
+}
+
+// GroupProjectBinding represents a binding between a group of the identity provider and a project
+// swagger:model GroupProjectBinding
+type GroupProjectBinding struct {
+	ObjectMeta `json:",inline"`
+
+	Spec kubermaticv1.GroupProjectBindingSpec `json:"spec"`
+}
+
+// ProjectInvitation represents a pending invitation of a user to a project
+// swagger:model ProjectInvitation
+type ProjectInvitation struct {
+	ObjectMeta `json:",inline"`
+
+	// ProjectID is the ID of the project the user is invited to
+	ProjectID string `json:"projectID"`
+	// ProjectName is the human readable name of the project
+	ProjectName string `json:"projectName,omitempty"`
+	// Email is the email address of the invited user
+	Email string `json:"email"`
+	// Group is the group prefix (e.g. editors) the user is assigned to once the invitation is accepted
+	Group string `json:"group"`
+	// InvitedBy is the email address of the user who sent the invitation
+	InvitedBy string `json:"invitedBy,omitempty"`
+	// ExpiresAt is the time after which the invitation can no longer be accepted, defaults to 7 days after the creation
+	// swagger:strfmt date-time
+	ExpiresAt Time `json:"expiresAt,omitempty"`
+}
+
+// PriceList represents the prices of a datacenter
+// swagger:model PriceList
+type PriceList struct {
+	// Datacenter is the name of the datacenter the prices apply to
+	Datacenter string `json:"datacenter"`
+
+	Spec kubermaticv1.PriceListSpec `json:"spec"`
+}
+
+// CostEstimate represents the estimated cost of a cluster or node deployment
+// swagger:model CostEstimate
+type CostEstimate struct {
+	Datacenter string `json:"datacenter"`
+	Currency   string `json:"currency"`
+	// ControlPlane is the monthly cost of the control plane, it is only set for clusters
+	ControlPlane    float64                      `json:"controlPlane,omitempty"`
+	NodeDeployments []NodeDeploymentCostEstimate `json:"nodeDeployments"`
+	// Hourly is the total hourly cost
+	Hourly float64 `json:"hourly"`
+	// Monthly is the total monthly cost
+	Monthly float64 `json:"monthly"`
+	// MissingPrices lists the instance sizes the price list has no price for, they are not included in the costs
+	MissingPrices []string `json:"missingPrices,omitempty"`
+}
+
+// NodeDeploymentCostEstimate represents the estimated cost of all replicas of a node deployment
+// swagger:model NodeDeploymentCostEstimate
+type NodeDeploymentCostEstimate struct {
+	Name     string  `json:"name,omitempty"`
+	Size     string  `json:"size"`
+	Replicas int32   `json:"replicas"`
+	Hourly   float64 `json:"hourly"`
+	Monthly  float64 `json:"monthly"`
+}
+
+// UsageReport represents the usage of the clusters of a project within a month
+// swagger:model UsageReport
+type UsageReport struct {
+	ProjectID string `json:"projectID"`
+	// Period is the month the report covers, for example "2020-10"
+	Period   string         `json:"period"`
+	Clusters []ClusterUsage `json:"clusters"`
+}
+
+// ClusterUsage represents the usage of a single cluster
+// swagger:model ClusterUsage
+type ClusterUsage struct {
+	ClusterID   string `json:"clusterID"`
+	ClusterName string `json:"clusterName"`
+	Datacenter  string `json:"datacenter"`
+	// Currency of the costs, it is empty if the datacenter has no price list
+	Currency          string      `json:"currency,omitempty"`
+	ControlPlaneHours float64     `json:"controlPlaneHours"`
+	ControlPlaneCost  float64     `json:"controlPlaneCost"`
+	Nodes             []NodeUsage `json:"nodes"`
+}
+
+// NodeUsage represents the usage of all nodes of an instance size within a cluster
+// swagger:model NodeUsage
+type NodeUsage struct {
+	Size      string  `json:"size"`
+	NodeHours float64 `json:"nodeHours"`
+	Cost      float64 `json:"cost"`
+}
+
+// OrphanedCloudResource represents a resource at a cloud provider whose cluster does not exist anymore
+// swagger:model OrphanedCloudResource
+type OrphanedCloudResource struct {
+	Name       string `json:"name"`
+	Provider   string `json:"provider"`
+	Preset     string `json:"preset"`
+	Datacenter string `json:"datacenter"`
+	Kind       string `json:"kind"`
+	// ID identifies the resource at the cloud provider
+	ID           string `json:"id"`
+	ResourceName string `json:"resourceName"`
+	// ClusterName is the name of the deleted cluster the resource was created for
+	ClusterName string `json:"clusterName,omitempty"`
+	// swagger:strfmt date-time
+	FirstSeen Time `json:"firstSeen"`
+	// swagger:strfmt date-time
+	LastSeen Time `json:"lastSeen"`
+	// DeletionError is the error of the last deletion attempt
+	DeletionError string `json:"deletionError,omitempty"`
+}
+
+// CredentialRotation replaces the credentials of a cloud provider account in a preset
+// and in all clusters using them
+// swagger:model CredentialRotation
+type CredentialRotation struct {
+	Provider string `json:"provider"`
+	// Preset whose credentials are rotated, the clusters are matched by the current credentials of the preset.
+	// The preset is only updated once all its clusters were rotated, so a failed rotation can be retried.
+	Preset string `json:"preset,omitempty"`
+	// Credentials match the clusters to rotate if no preset is given
+	Credentials map[string]string `json:"credentials,omitempty"`
+	// NewCredentials replace the matched credentials, omitted keys are kept
+	NewCredentials map[string]string `json:"newCredentials"`
+}
+
+// CredentialRotationResult reports the rotation of the credentials of a cluster
+// swagger:model CredentialRotationResult
+type CredentialRotationResult struct {
+	Seed        string `json:"seed"`
+	ClusterID   string `json:"clusterID,omitempty"`
+	ClusterName string `json:"clusterName,omitempty"`
+	ProjectID   string `json:"projectID,omitempty"`
+	// Error is set if the credentials of the cluster could not be rotated
+	Error string `json:"error,omitempty"`
+}
+
+// Operation represents an asynchronous action on a cluster, e.g. its creation
+// swagger:model Operation
+type Operation struct {
+	ObjectMeta `json:",inline"`
+
+	// Type is one of ClusterCreation, ClusterUpgrade or ClusterDeletion
+	Type      string `json:"type"`
+	ClusterID string `json:"clusterID"`
+	// Version is the target version of cluster upgrades
+	Version string `json:"version,omitempty"`
+	// Phase is one of Running, Succeeded or Failed
+	Phase string          `json:"phase"`
+	Steps []OperationStep `json:"steps"`
+	// Percentage is the share of finished steps
+	Percentage int `json:"percentage"`
+	// CurrentCondition is the cluster condition which changed last
+	CurrentCondition string   `json:"currentCondition,omitempty"`
+	Errors           []string `json:"errors,omitempty"`
+	// CompletionTime is the time at which the operation succeeded or failed
+	// swagger:strfmt date-time
+	CompletionTime *Time `json:"completionTime,omitempty"`
+}
+
+// OperationStep represents a milestone of an operation
+// swagger:model OperationStep
+type OperationStep struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
 // Seed represents a seed object
 // swagger:model Seed
 type Seed struct {
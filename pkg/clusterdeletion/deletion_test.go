@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes/scheme"
 	controllerruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -202,3 +203,87 @@ func unstructuredWithAPIVersionAndKind(apiVersion, kind string) *unstructured.Un
 	u.SetKind(kind)
 	return u
 }
+
+func TestCleanupVolumesKeepsRetainedVolumes(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec: kubermaticv1.ClusterSpec{
+			DeletionRetention: &kubermaticv1.ClusterDeletionRetention{PersistentVolumes: []string{"retained-pv"}},
+		},
+	}
+	objects := []runtime.Object{
+		&corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "retained-pv"},
+			Spec:       corev1.PersistentVolumeSpec{ClaimRef: &corev1.ObjectReference{Namespace: testNS, Name: "retained-pvc"}},
+		},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: "retained-pvc"}},
+		&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "deleted-pv"}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: testNS, Name: "deleted-pvc"}},
+	}
+	client := fake.NewFakeClientWithScheme(scheme.Scheme, objects...)
+	d := &Deletion{userClusterClientGetter: func() (controllerruntimeclient.Client, error) { return client, nil }}
+	ctx := context.Background()
+
+	deletedSomeResource, err := d.cleanupVolumes(ctx, cluster)
+	if err != nil {
+		t.Fatalf("failed to clean up volumes: %v", err)
+	}
+	if !deletedSomeResource {
+		t.Error("expected some volumes to be deleted")
+	}
+
+	expectedDeleted := map[types.NamespacedName]bool{
+		{Name: "retained-pv"}:                     false,
+		{Namespace: testNS, Name: "retained-pvc"}: false,
+		{Name: "deleted-pv"}:                      true,
+		{Namespace: testNS, Name: "deleted-pvc"}:  true,
+	}
+	for _, object := range objects {
+		metav1Object := object.(metav1.Object)
+		nn := types.NamespacedName{Namespace: metav1Object.GetNamespace(), Name: metav1Object.GetName()}
+		err := client.Get(ctx, nn, object.DeepCopyObject())
+		if kerrors.IsNotFound(err) != expectedDeleted[nn] {
+			t.Errorf("Expected object %q to be deleted=%t", nn.String(), expectedDeleted[nn])
+		}
+	}
+
+	deletedSomeResource, err = d.cleanupVolumes(ctx, cluster)
+	if err != nil {
+		t.Fatalf("failed to clean up volumes: %v", err)
+	}
+	if deletedSomeResource {
+		t.Error("expected the cleanup to be done once only retained volumes are left")
+	}
+}
+
+func TestReleaseRetainedMachines(t *testing.T) {
+	finalizers := []string{machineDeleteInstanceFinalizer, machineDeleteNodeFinalizer}
+	client := fake.NewFakeClientWithScheme(scheme.Scheme,
+		&clusterv1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "retained", Finalizers: finalizers}},
+		&clusterv1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "deleted", Finalizers: finalizers}},
+	)
+	ctx := context.Background()
+
+	if err := releaseRetainedMachines(ctx, client, sets.NewString("retained")); err != nil {
+		t.Fatalf("failed to release retained machines: %v", err)
+	}
+
+	retained := &clusterv1alpha1.Machine{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "retained"}, retained); err != nil {
+		t.Fatalf("failed to get machine: %v", err)
+	}
+	if len(retained.Finalizers) != 0 {
+		t.Errorf("expected the finalizers of the retained machine to be removed, got %v", retained.Finalizers)
+	}
+	if retained.Annotations[annotationMachineUninitialized] != retainedMachineInitializer {
+		t.Errorf("expected the retained machine to be ignored by the machine-controller, got annotations %v", retained.Annotations)
+	}
+
+	deleted := &clusterv1alpha1.Machine{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "deleted"}, deleted); err != nil {
+		t.Fatalf("failed to get machine: %v", err)
+	}
+	if len(deleted.Finalizers) != 2 || deleted.Annotations[annotationMachineUninitialized] != "" {
+		t.Errorf("expected the machine which is not retained to be unchanged, got finalizers %v and annotations %v", deleted.Finalizers, deleted.Annotations)
+	}
+}
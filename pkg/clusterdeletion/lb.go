@@ -52,6 +52,11 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 		return false, fmt.Errorf("failed to list Service's from user cluster: %v", err)
 	}
 
+	var retained sets.String
+	if cluster.Spec.DeletionRetention != nil {
+		retained = sets.NewString(cluster.Spec.DeletionRetention.LoadBalancers...)
+	}
+
 	for _, service := range serviceList.Items {
 		serviceName := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
 		slog := log.With("service", serviceName)
@@ -61,6 +66,10 @@ func (d *Deletion) cleanupLBs(ctx context.Context, log *zap.SugaredLogger, clust
 			slog.Debug("Skipping cleanup of service as it's not a LoadBalancer")
 			continue
 		}
+		if retained.Has(serviceName) {
+			slog.Debug("Skipping cleanup of service as it is retained")
+			continue
+		}
 
 		if err := d.cleanupLB(ctx, slog, userClusterClient, &service, cluster); err != nil {
 			return deletedSomeLBs, fmt.Errorf("failed to delete service %q inside user cluster: %v", serviceName, err)
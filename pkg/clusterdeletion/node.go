@@ -29,9 +29,19 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	controllerruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// The machine-controller only removes the instance of a Machine while these finalizers are set
+	machineDeleteInstanceFinalizer = "machine-delete-finalizer"
+	machineDeleteNodeFinalizer     = "machine-node-delete-finalizer"
+	// The machine-controller ignores all Machines which have this annotation with a non-empty value
+	annotationMachineUninitialized = "machine-controller.kubermatic.io/initializers"
+	retainedMachineInitializer     = "kubermatic-deletion-retention"
+)
+
 func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Cluster) error {
 	if !kuberneteshelper.HasFinalizer(cluster, kubermaticapiv1.NodeDeletionFinalizer) {
 		return nil
@@ -63,8 +73,14 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 		}
 	}
 
-	machineDeploymentList := &clusterv1alpha1.MachineDeploymentList{}
 	listOpts := &controllerruntimeclient.ListOptions{Namespace: metav1.NamespaceSystem}
+	if cluster.Spec.DeletionRetention != nil && len(cluster.Spec.DeletionRetention.Machines) > 0 {
+		if err := releaseRetainedMachines(ctx, userClusterClient, sets.NewString(cluster.Spec.DeletionRetention.Machines...)); err != nil {
+			return err
+		}
+	}
+
+	machineDeploymentList := &clusterv1alpha1.MachineDeploymentList{}
 	if err := userClusterClient.List(ctx, machineDeploymentList, listOpts); err != nil && !meta.IsNoMatchError(err) {
 		return fmt.Errorf("failed to list MachineDeployments: %v", err)
 	}
@@ -113,3 +129,34 @@ func (d *Deletion) cleanupNodes(ctx context.Context, cluster *kubermaticv1.Clust
 	kuberneteshelper.RemoveFinalizer(cluster, kubermaticapiv1.NodeDeletionFinalizer)
 	return d.seedClient.Patch(ctx, cluster, controllerruntimeclient.MergeFrom(oldCluster))
 }
+
+// releaseRetainedMachines makes the machine-controller ignore the retained Machines and removes its finalizers
+// from them, so deleting the Machine objects below keeps their instances at the cloud provider.
+func releaseRetainedMachines(ctx context.Context, userClusterClient controllerruntimeclient.Client, retained sets.String) error {
+	machineList := &clusterv1alpha1.MachineList{}
+	if err := userClusterClient.List(ctx, machineList, controllerruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list Machines: %v", err)
+	}
+
+	for _, machine := range machineList.Items {
+		if !retained.Has(machine.Name) || machine.Annotations[annotationMachineUninitialized] == retainedMachineInitializer {
+			continue
+		}
+
+		oldMachine := machine.DeepCopy()
+		if machine.Annotations == nil {
+			machine.Annotations = map[string]string{}
+		}
+		machine.Annotations[annotationMachineUninitialized] = retainedMachineInitializer
+		kuberneteshelper.RemoveFinalizer(&machine, machineDeleteInstanceFinalizer)
+		kuberneteshelper.RemoveFinalizer(&machine, machineDeleteNodeFinalizer)
+		if err := userClusterClient.Patch(ctx, &machine, controllerruntimeclient.MergeFrom(oldMachine)); err != nil {
+			return fmt.Errorf("failed to release retained Machine %q: %v", machine.Name, err)
+		}
+	}
+
+	return nil
+}
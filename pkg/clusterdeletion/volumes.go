@@ -25,6 +25,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	controllerruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -53,6 +54,11 @@ func (d *Deletion) cleanupVolumes(ctx context.Context, cluster *kubermaticv1.Clu
 		return deletedSomeResource, fmt.Errorf("failed to list PVs from user cluster: %v", err)
 	}
 
+	// Retained PVs and the claims bound to them are kept
+	if cluster.Spec.DeletionRetention != nil && len(cluster.Spec.DeletionRetention.PersistentVolumes) > 0 {
+		pvcList.Items, pvList.Items = withoutRetainedVolumes(pvcList.Items, pvList.Items, sets.NewString(cluster.Spec.DeletionRetention.PersistentVolumes...))
+	}
+
 	// Do not attempt to delete any pods when there are no PVs and PVCs
 	if len(pvcList.Items) == 0 && len(pvList.Items) == 0 {
 		return deletedSomeResource, nil
@@ -118,6 +124,29 @@ func (d *Deletion) cleanupPVCUsingPods(ctx context.Context, userClusterClient co
 	return nil
 }
 
+func withoutRetainedVolumes(pvcs []corev1.PersistentVolumeClaim, pvs []corev1.PersistentVolume, retained sets.String) ([]corev1.PersistentVolumeClaim, []corev1.PersistentVolume) {
+	retainedClaims := sets.NewString()
+	var filteredPVs []corev1.PersistentVolume
+	for _, pv := range pvs {
+		if !retained.Has(pv.Name) {
+			filteredPVs = append(filteredPVs, pv)
+			continue
+		}
+		if pv.Spec.ClaimRef != nil {
+			retainedClaims.Insert(pv.Spec.ClaimRef.Namespace + "/" + pv.Spec.ClaimRef.Name)
+		}
+	}
+
+	var filteredPVCs []corev1.PersistentVolumeClaim
+	for _, pvc := range pvcs {
+		if !retained.Has(pvc.Spec.VolumeName) && !retainedClaims.Has(pvc.Namespace+"/"+pvc.Name) {
+			filteredPVCs = append(filteredPVCs, pvc)
+		}
+	}
+
+	return filteredPVCs, filteredPVs
+}
+
 func podUsesPV(p *corev1.Pod) bool {
 	for _, volume := range p.Spec.Volumes {
 		if volume.VolumeSource.PersistentVolumeClaim != nil {
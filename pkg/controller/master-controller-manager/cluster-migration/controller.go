@@ -0,0 +1,818 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustermigration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
+
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
+	utilpointer "k8s.io/utils/pointer"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "cluster_migration_controller"
+
+	// MigrationAnnotation is set on the cluster object created on the target seed and holds the name of the migration
+	MigrationAnnotation = "kubermatic.io/cluster-migration"
+	// MigratedToSeedAnnotation is set on the pod template of kube-proxy to restart it with the address of the target seed
+	MigratedToSeedAnnotation = "kubermatic.io/migrated-to-seed"
+
+	// rollbackFinalizer makes sure a migration which gets deleted before the nodes
+	// were switched brings the cluster back up on the source seed
+	rollbackFinalizer = "kubermatic.io/cluster-migration-rollback"
+
+	pauseReason     = "The control plane is being migrated to another seed"
+	requeueInterval = 10 * time.Second
+
+	endpointSwitcherName  = "kubermatic-endpoint-switcher"
+	endpointSwitcherImage = "docker.io/library/alpine:3.12"
+	// endpointSwitcherScript runs in the namespaces of the node. It replaces the address of the source seed
+	// in the kubeconfigs of the kubelet and restarts it, nothing happens once the kubelet got switched.
+	endpointSwitcherScript = `set -eu
+restart=""
+for kubeconfig in /var/lib/kubelet/kubeconfig /etc/kubernetes/bootstrap-kubelet.conf; do
+  if [ -f "$kubeconfig" ] && grep -qF "$SOURCE_URL" "$kubeconfig"; then
+    sed -i "s|$SOURCE_URL|$TARGET_URL|g" "$kubeconfig"
+    restart=true
+  fi
+done
+if [ -n "$restart" ]; then
+  systemctl restart kubelet
+fi
+while true; do sleep 3600; done`
+
+	kubeProxyName = "kube-proxy"
+)
+
+// addressBoundSecrets contain the address of the seed and are not copied, the target seed creates them for its own address
+var addressBoundSecrets = sets.NewString(
+	resources.ApiserverTLSSecretName,
+	resources.AdminKubeconfigSecretName,
+	resources.ViewerKubeconfigSecretName,
+)
+
+// errMigrationFailed marks errors which can not be fixed by retrying
+var errMigrationFailed = errors.New("migration failed")
+
+// seedConnection bundles everything needed to work with a seed, exec is needed to stream the etcd snapshot
+type seedConnection struct {
+	client     ctrlruntimeclient.Client
+	config     *rest.Config
+	kubeClient kubernetes.Interface
+}
+
+// Reconciler migrates clusters between seeds
+type Reconciler struct {
+	ctx         context.Context
+	log         *zap.SugaredLogger
+	client      ctrlruntimeclient.Client
+	recorder    record.EventRecorder
+	seedsGetter provider.SeedsGetter
+	seeds       map[string]*seedConnection
+	// userClusterClientGetter returns a client for the user cluster, it is a field to be able to replace it in tests
+	userClusterClientGetter func(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
+}
+
+func Add(
+	ctx context.Context,
+	mgr manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	seedsGetter provider.SeedsGetter,
+	workerName string,
+) error {
+	reconciler := &Reconciler{
+		ctx:                     ctx,
+		log:                     log.Named(ControllerName),
+		client:                  mgr.GetClient(),
+		recorder:                mgr.GetEventRecorderFor(ControllerName),
+		seedsGetter:             seedsGetter,
+		seeds:                   map[string]*seedConnection{},
+		userClusterClientGetter: getUserClusterClient,
+	}
+
+	for seedName, seedManager := range seedManagers {
+		kubeClient, err := kubernetes.NewForConfig(seedManager.GetConfig())
+		if err != nil {
+			return fmt.Errorf("failed to create kubernetes client for seed %s: %v", seedName, err)
+		}
+		reconciler.seeds[seedName] = &seedConnection{
+			client:     seedManager.GetClient(),
+			config:     seedManager.GetConfig(),
+			kubeClient: kubeClient,
+		}
+	}
+
+	// A migration streams the whole etcd snapshot, running them one after another keeps the load on the seeds low
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: 1})
+	if err != nil {
+		return fmt.Errorf("failed to construct controller: %v", err)
+	}
+
+	if err := c.Watch(
+		&source.Kind{Type: &kubermaticv1.ClusterMigration{}},
+		&handler.EnqueueRequestForObject{},
+		workerlabel.Predicates(workerName),
+	); err != nil {
+		return fmt.Errorf("failed to create watch for cluster migrations: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	migration := &kubermaticv1.ClusterMigration{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, migration); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	result, err := r.reconcile(log, migration)
+	if controllerutil.IsCacheNotStarted(err) {
+		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if errors.Is(err, errMigrationFailed) {
+		log.Errorw("Migration failed", zap.Error(err))
+		r.recorder.Event(migration, corev1.EventTypeWarning, "MigrationFailed", err.Error())
+		message := err.Error()
+		// The phase is kept until the rollback succeeded, so that it is retried together with the failed step
+		if migration.Status.StartTime != nil && canRollback(migration) {
+			if err := r.rollbackCluster(log, migration); err != nil {
+				log.Errorw("Rolling back the failed migration failed", zap.Error(err))
+				return reconcile.Result{}, fmt.Errorf("failed to roll back the failed migration: %v", err)
+			}
+			message += ", the cluster was rolled back to the source seed"
+		}
+		return reconcile.Result{}, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseFailed, message)
+	}
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+		r.recorder.Event(migration, corev1.EventTypeWarning, "ReconcilingError", err.Error())
+	}
+	if result == nil {
+		result = &reconcile.Result{}
+	}
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(log *zap.SugaredLogger, migration *kubermaticv1.ClusterMigration) (*reconcile.Result, error) {
+	if migration.DeletionTimestamp != nil {
+		return nil, r.rollback(log, migration)
+	}
+
+	switch migration.Status.Phase {
+	case kubermaticv1.ClusterMigrationPhaseCompleted, kubermaticv1.ClusterMigrationPhaseFailed:
+		return nil, nil
+	}
+
+	// The seed connections are missing for a short time whenever the seeds change and
+	// the controller gets restarted, unknown seeds are caught by the validation
+	sourceSeed, targetSeed := r.seeds[migration.Spec.SourceSeed], r.seeds[migration.Spec.TargetSeed]
+	if migration.Status.Phase != "" && (sourceSeed == nil || targetSeed == nil) {
+		return nil, fmt.Errorf("no connection to seed %q or %q", migration.Spec.SourceSeed, migration.Spec.TargetSeed)
+	}
+
+	if !kuberneteshelper.HasFinalizer(migration, rollbackFinalizer) {
+		oldMigration := migration.DeepCopy()
+		kuberneteshelper.AddFinalizer(migration, rollbackFinalizer)
+		if err := r.client.Patch(r.ctx, migration, ctrlruntimeclient.MergeFrom(oldMigration)); err != nil {
+			return nil, fmt.Errorf("failed to add finalizer: %v", err)
+		}
+	}
+
+	switch migration.Status.Phase {
+	case "":
+		if err := r.validate(migration, sourceSeed, targetSeed); err != nil {
+			return nil, err
+		}
+		log.Info("Starting migration")
+		return nil, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseCreatingTarget, "")
+
+	case kubermaticv1.ClusterMigrationPhaseCreatingTarget:
+		if err := r.createTarget(migration, sourceSeed, targetSeed); err != nil {
+			return nil, err
+		}
+		return nil, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseRestoringEtcd, "")
+
+	case kubermaticv1.ClusterMigrationPhaseRestoringEtcd:
+		restored, err := r.restoreEtcd(log, migration, sourceSeed, targetSeed)
+		if err != nil {
+			return nil, err
+		}
+		if !restored {
+			return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+		}
+		return nil, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseStartingControlPlane, "")
+
+	case kubermaticv1.ClusterMigrationPhaseStartingControlPlane:
+		running, err := r.startControlPlane(migration, targetSeed)
+		if err != nil {
+			return nil, err
+		}
+		if !running {
+			return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+		}
+		return nil, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseSwitchingEndpoints, "")
+
+	case kubermaticv1.ClusterMigrationPhaseSwitchingEndpoints:
+		switched, err := r.switchEndpoints(log, migration, sourceSeed, targetSeed)
+		if err != nil {
+			return nil, err
+		}
+		if !switched {
+			return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+		}
+		return nil, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseCleaningUp, "")
+
+	case kubermaticv1.ClusterMigrationPhaseCleaningUp:
+		removed, err := r.cleanupSource(migration, sourceSeed)
+		if err != nil {
+			return nil, err
+		}
+		if !removed {
+			return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+		}
+		log.Info("Migration completed")
+		return nil, r.setPhase(migration, kubermaticv1.ClusterMigrationPhaseCompleted, "")
+	}
+
+	return nil, nil
+}
+
+func (r *Reconciler) setPhase(migration *kubermaticv1.ClusterMigration, phase kubermaticv1.ClusterMigrationPhase, message string) error {
+	oldMigration := migration.DeepCopy()
+	migration.Status.Phase = phase
+	migration.Status.Message = message
+
+	now := metav1.Now()
+	switch phase {
+	case kubermaticv1.ClusterMigrationPhaseCreatingTarget:
+		migration.Status.StartTime = &now
+	case kubermaticv1.ClusterMigrationPhaseCompleted, kubermaticv1.ClusterMigrationPhaseFailed:
+		migration.Status.CompletionTime = &now
+	}
+
+	if err := r.client.Patch(r.ctx, migration, ctrlruntimeclient.MergeFrom(oldMigration)); err != nil {
+		return fmt.Errorf("failed to set migration phase to %q: %v", phase, err)
+	}
+	r.recorder.Eventf(migration, corev1.EventTypeNormal, string(phase), "Migration of cluster %s is in phase %s", migration.Spec.ClusterID, phase)
+	return nil
+}
+
+// validate checks that the migration can be started, it is only called before anything got changed
+func (r *Reconciler) validate(migration *kubermaticv1.ClusterMigration, sourceSeed, targetSeed *seedConnection) error {
+	if migration.Spec.SourceSeed == migration.Spec.TargetSeed {
+		return fmt.Errorf("%w: source and target seed must be different", errMigrationFailed)
+	}
+	if sourceSeed == nil {
+		return fmt.Errorf("%w: unknown source seed %q", errMigrationFailed, migration.Spec.SourceSeed)
+	}
+	if targetSeed == nil {
+		return fmt.Errorf("%w: unknown target seed %q", errMigrationFailed, migration.Spec.TargetSeed)
+	}
+
+	migrations := &kubermaticv1.ClusterMigrationList{}
+	if err := r.client.List(r.ctx, migrations); err != nil {
+		return fmt.Errorf("failed to list cluster migrations: %v", err)
+	}
+	for _, other := range migrations.Items {
+		if other.Name != migration.Name && other.Spec.ClusterID == migration.Spec.ClusterID && isActive(&other) {
+			return fmt.Errorf("%w: cluster %s is already being migrated by %s", errMigrationFailed, migration.Spec.ClusterID, other.Name)
+		}
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return fmt.Errorf("%w: cluster %s does not exist in seed %s", errMigrationFailed, migration.Spec.ClusterID, migration.Spec.SourceSeed)
+		}
+		return fmt.Errorf("failed to get cluster: %v", err)
+	}
+	switch {
+	case cluster.DeletionTimestamp != nil:
+		return fmt.Errorf("%w: cluster is being deleted", errMigrationFailed)
+	case cluster.Spec.Pause:
+		return fmt.Errorf("%w: cluster is paused", errMigrationFailed)
+	case cluster.Status.Phase != "":
+		return fmt.Errorf("%w: cluster is %s", errMigrationFailed, cluster.Status.Phase)
+	case cluster.Status.ExtendedHealth.Etcd != kubermaticv1.HealthStatusUp:
+		return fmt.Errorf("%w: etcd of the cluster is not healthy", errMigrationFailed)
+	}
+
+	err := targetSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, &kubermaticv1.Cluster{})
+	if err == nil {
+		return fmt.Errorf("%w: cluster %s already exists in seed %s", errMigrationFailed, migration.Spec.ClusterID, migration.Spec.TargetSeed)
+	}
+	if !kerrors.IsNotFound(err) {
+		return fmt.Errorf("failed to check for the cluster in the target seed: %v", err)
+	}
+
+	seeds, err := r.seedsGetter()
+	if err != nil {
+		return fmt.Errorf("failed to get seeds: %v", err)
+	}
+	seed, ok := seeds[migration.Spec.TargetSeed]
+	if !ok {
+		return fmt.Errorf("%w: unknown target seed %q", errMigrationFailed, migration.Spec.TargetSeed)
+	}
+	datacenter, ok := seed.Spec.Datacenters[migration.Spec.TargetDatacenter]
+	if !ok {
+		return fmt.Errorf("%w: seed %s has no datacenter %q", errMigrationFailed, seed.Name, migration.Spec.TargetDatacenter)
+	}
+	clusterProvider, err := provider.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMigrationFailed, err)
+	}
+	datacenterProvider, err := provider.DatacenterCloudProviderName(&datacenter.Spec)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errMigrationFailed, err)
+	}
+	if clusterProvider != datacenterProvider {
+		return fmt.Errorf("%w: datacenter %s uses the cloud provider %q, but the cluster runs on %q", errMigrationFailed, migration.Spec.TargetDatacenter, datacenterProvider, clusterProvider)
+	}
+
+	return nil
+}
+
+// createTarget pauses the cluster on the source seed and prepares everything on the target seed. The control plane
+// on the source seed keeps serving until the nodes got switched, the cluster is kept paused on the target seed
+// until etcd got restored.
+func (r *Reconciler) createTarget(migration *kubermaticv1.ClusterMigration, sourceSeed, targetSeed *seedConnection) error {
+	sourceCluster := &kubermaticv1.Cluster{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, sourceCluster); err != nil {
+		return fmt.Errorf("failed to get source cluster: %v", err)
+	}
+
+	// Pausing keeps the controllers of the source seed from changing the cluster while it is copied
+	if !sourceCluster.Spec.Pause {
+		oldCluster := sourceCluster.DeepCopy()
+		sourceCluster.Spec.Pause = true
+		sourceCluster.Spec.PauseReason = pauseReason
+		if err := sourceSeed.client.Patch(r.ctx, sourceCluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to pause source cluster: %v", err)
+		}
+	}
+
+	targetCluster := &kubermaticv1.Cluster{}
+	if err := targetSeed.client.Get(r.ctx, types.NamespacedName{Name: sourceCluster.Name}, targetCluster); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get target cluster: %v", err)
+		}
+		targetCluster = newTargetCluster(migration, sourceCluster)
+		if err := targetSeed.client.Create(r.ctx, targetCluster); err != nil {
+			return fmt.Errorf("failed to create target cluster: %v", err)
+		}
+	}
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            targetCluster.Status.NamespaceName,
+			OwnerReferences: []metav1.OwnerReference{resources.GetClusterRef(targetCluster)},
+		},
+	}
+	if err := targetSeed.client.Create(r.ctx, namespace); err != nil && !kerrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace: %v", err)
+	}
+
+	if err := r.copySecrets(sourceSeed, targetSeed, sourceCluster, targetCluster); err != nil {
+		return err
+	}
+
+	return r.createEtcdRestore(sourceSeed, targetSeed, sourceCluster, targetCluster)
+}
+
+// copySecrets copies the secrets of the cluster namespace, most importantly the CAs and the service account key,
+// and the cloud credentials of the cluster. All other resources, including the apiserver serving certificate for
+// the address of the target seed, are created by the controllers of the target seed.
+func (r *Reconciler) copySecrets(sourceSeed, targetSeed *seedConnection, sourceCluster, targetCluster *kubermaticv1.Cluster) error {
+	secrets := &corev1.SecretList{}
+	if err := sourceSeed.client.List(r.ctx, secrets, ctrlruntimeclient.InNamespace(sourceCluster.Status.NamespaceName)); err != nil {
+		return fmt.Errorf("failed to list secrets: %v", err)
+	}
+
+	for _, secret := range secrets.Items {
+		if secret.Type == corev1.SecretTypeServiceAccountToken || addressBoundSecrets.Has(secret.Name) {
+			continue
+		}
+		if err := createSecretCopy(r.ctx, targetSeed.client, &secret, []metav1.OwnerReference{resources.GetClusterRef(targetCluster)}); err != nil {
+			return err
+		}
+	}
+
+	credentials := &corev1.Secret{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: sourceCluster.GetSecretName()}, credentials); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get credentials: %v", err)
+	}
+	return createSecretCopy(r.ctx, targetSeed.client, credentials, nil)
+}
+
+func createSecretCopy(ctx context.Context, client ctrlruntimeclient.Client, secret *corev1.Secret, ownerRefs []metav1.OwnerReference) error {
+	secretCopy := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            secret.Name,
+			Namespace:       secret.Namespace,
+			Labels:          secret.Labels,
+			Annotations:     secret.Annotations,
+			OwnerReferences: ownerRefs,
+		},
+		Type: secret.Type,
+		Data: secret.Data,
+	}
+	if err := client.Create(ctx, secretCopy); err != nil && !kerrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to copy secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+	return nil
+}
+
+// newTargetCluster returns the cluster object for the target seed. It is paused, so the
+// control plane does not start before etcd got restored.
+func newTargetCluster(migration *kubermaticv1.ClusterMigration, sourceCluster *kubermaticv1.Cluster) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        sourceCluster.Name,
+			Labels:      sourceCluster.Labels,
+			Annotations: map[string]string{},
+		},
+		Spec:   *sourceCluster.Spec.DeepCopy(),
+		Status: *sourceCluster.Status.DeepCopy(),
+	}
+	for k, v := range sourceCluster.Annotations {
+		cluster.Annotations[k] = v
+	}
+	cluster.Annotations[MigrationAnnotation] = migration.Name
+
+	cluster.Spec.Cloud.DatacenterName = migration.Spec.TargetDatacenter
+	cluster.Spec.Pause = true
+	cluster.Spec.PauseReason = pauseReason
+
+	// The address and the health are determined by the controllers of the target seed, the
+	// admin token is kept so existing kubeconfigs stay valid
+	cluster.Address = kubermaticv1.ClusterAddress{AdminToken: sourceCluster.Address.AdminToken}
+	cluster.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{}
+	cluster.Status.Conditions = nil
+	cluster.Status.ErrorReason = nil
+	cluster.Status.ErrorMessage = nil
+
+	return cluster
+}
+
+// startControlPlane unpauses the cluster on the target seed and returns true once its apiserver is healthy
+func (r *Reconciler) startControlPlane(migration *kubermaticv1.ClusterMigration, targetSeed *seedConnection) (bool, error) {
+	cluster := &kubermaticv1.Cluster{}
+	if err := targetSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, cluster); err != nil {
+		return false, fmt.Errorf("failed to get target cluster: %v", err)
+	}
+
+	if cluster.Spec.Pause {
+		oldCluster := cluster.DeepCopy()
+		cluster.Spec.Pause = false
+		cluster.Spec.PauseReason = ""
+		if err := targetSeed.client.Patch(r.ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return false, fmt.Errorf("failed to unpause target cluster: %v", err)
+		}
+		return false, nil
+	}
+
+	return cluster.Status.ExtendedHealth.Apiserver == kubermaticv1.HealthStatusUp, nil
+}
+
+// switchEndpoints points the kubelets of all nodes to the address of the target seed and returns true once all
+// nodes reached the target seed. The nodes are not recreated, their kubeconfigs get rewritten by a DaemonSet which
+// is created through the source apiserver, as this is the one the kubelets still talk to.
+func (r *Reconciler) switchEndpoints(log *zap.SugaredLogger, migration *kubermaticv1.ClusterMigration, sourceSeed, targetSeed *seedConnection) (bool, error) {
+	sourceCluster := &kubermaticv1.Cluster{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, sourceCluster); err != nil {
+		return false, fmt.Errorf("failed to get source cluster: %v", err)
+	}
+	targetCluster := &kubermaticv1.Cluster{}
+	if err := targetSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, targetCluster); err != nil {
+		return false, fmt.Errorf("failed to get target cluster: %v", err)
+	}
+	if targetCluster.Address.URL == "" {
+		return false, nil
+	}
+
+	sourceClient, err := r.userClusterClientGetter(sourceSeed.client, sourceCluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to get source user cluster client: %v", err)
+	}
+	targetClient, err := r.userClusterClientGetter(targetSeed.client, targetCluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to get target user cluster client: %v", err)
+	}
+
+	switcher := &appsv1.DaemonSet{}
+	if err := sourceClient.Get(r.ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: endpointSwitcherName}, switcher); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get endpoint switcher: %v", err)
+		}
+		log.Infow("Switching nodes to the target seed", "url", targetCluster.Address.URL)
+		if err := sourceClient.Create(r.ctx, endpointSwitcher(sourceCluster.Address.URL, targetCluster.Address.URL)); err != nil && !kerrors.IsAlreadyExists(err) {
+			return false, fmt.Errorf("failed to create endpoint switcher: %v", err)
+		}
+		return false, nil
+	}
+
+	switched, err := nodesSwitched(r.ctx, targetClient, switcher.CreationTimestamp.Time)
+	if err != nil || !switched {
+		return false, err
+	}
+
+	restarted, err := r.restartKubeProxy(migration, targetClient, targetCluster.Address.URL)
+	if err != nil || !restarted {
+		return false, err
+	}
+
+	// The switcher only exists in the source cluster, so the kubelets already stopped its pods
+	if err := sourceClient.Delete(r.ctx, switcher); err != nil && !kerrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete endpoint switcher: %v", err)
+	}
+	return true, nil
+}
+
+// nodesSwitched returns true once the kubelets of all ready nodes renewed their lease in the target cluster after the given time
+func nodesSwitched(ctx context.Context, client ctrlruntimeclient.Client, since time.Time) (bool, error) {
+	nodes := &corev1.NodeList{}
+	if err := client.List(ctx, nodes); err != nil {
+		return false, fmt.Errorf("failed to list nodes: %v", err)
+	}
+
+	for _, node := range nodes.Items {
+		// Nodes which were not ready when the snapshot got taken can not be switched
+		if !isNodeReady(&node) {
+			continue
+		}
+		lease := &coordinationv1.Lease{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: corev1.NamespaceNodeLease, Name: node.Name}, lease); err != nil {
+			if kerrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("failed to get lease of node %s: %v", node.Name, err)
+		}
+		if lease.Spec.RenewTime == nil || lease.Spec.RenewTime.Time.Before(since) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// restartKubeProxy restarts kube-proxy once its addon got updated with the address of the target seed
+// and returns true once this is done. Clusters without kube-proxy are skipped.
+func (r *Reconciler) restartKubeProxy(migration *kubermaticv1.ClusterMigration, client ctrlruntimeclient.Client, url string) (bool, error) {
+	kubeProxy := &appsv1.DaemonSet{}
+	if err := client.Get(r.ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: kubeProxyName}, kubeProxy); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get kube-proxy: %v", err)
+	}
+	if kubeProxy.Spec.Template.Annotations[MigratedToSeedAnnotation] == migration.Spec.TargetSeed {
+		return true, nil
+	}
+
+	config := &corev1.ConfigMap{}
+	if err := client.Get(r.ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: kubeProxyName}, config); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get kube-proxy config: %v", err)
+	}
+	updated := false
+	for _, value := range config.Data {
+		if strings.Contains(value, url) {
+			updated = true
+		}
+	}
+	if !updated {
+		return false, nil
+	}
+
+	oldKubeProxy := kubeProxy.DeepCopy()
+	if kubeProxy.Spec.Template.Annotations == nil {
+		kubeProxy.Spec.Template.Annotations = map[string]string{}
+	}
+	kubeProxy.Spec.Template.Annotations[MigratedToSeedAnnotation] = migration.Spec.TargetSeed
+	if err := client.Patch(r.ctx, kubeProxy, ctrlruntimeclient.MergeFrom(oldKubeProxy)); err != nil {
+		return false, fmt.Errorf("failed to restart kube-proxy: %v", err)
+	}
+	return true, nil
+}
+
+// endpointSwitcher returns the DaemonSet which switches the kubelets of all nodes from the source to the target URL
+func endpointSwitcher(sourceURL, targetURL string) *appsv1.DaemonSet {
+	labels := map[string]string{resources.AppLabelKey: endpointSwitcherName}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      endpointSwitcherName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					HostPID:                      true,
+					AutomountServiceAccountToken: utilpointer.BoolPtr(false),
+					PriorityClassName:            "system-node-critical",
+					Tolerations:                  []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{
+						{
+							Name:    "switcher",
+							Image:   endpointSwitcherImage,
+							Command: []string{"nsenter", "-t", "1", "-m", "-u", "-i", "-n", "-p", "--", "/bin/sh", "-c", endpointSwitcherScript},
+							Env: []corev1.EnvVar{
+								{Name: "SOURCE_URL", Value: sourceURL},
+								{Name: "TARGET_URL", Value: targetURL},
+							},
+							SecurityContext: &corev1.SecurityContext{Privileged: utilpointer.BoolPtr(true)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// cleanupSource removes the cluster from the source seed and returns true once it is gone. The finalizers
+// are removed first, they would clean up the cloud resources and the nodes which are still in use.
+func (r *Reconciler) cleanupSource(migration *kubermaticv1.ClusterMigration, sourceSeed *seedConnection) (bool, error) {
+	cluster := &kubermaticv1.Cluster{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get source cluster: %v", err)
+	}
+
+	// The credentials are still used by the target cluster, so the source cluster must be removed
+	// without running the cloud provider cleanup
+	credentials := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: resources.KubermaticNamespace, Name: cluster.GetSecretName()}}
+	if err := sourceSeed.client.Delete(r.ctx, credentials); err != nil && !kerrors.IsNotFound(err) {
+		return false, fmt.Errorf("failed to delete credentials: %v", err)
+	}
+	return false, deleteWithoutFinalizers(r.ctx, sourceSeed.client, cluster)
+}
+
+// rollback brings the cluster back up on the source seed and removes it from the target seed,
+// as long as the nodes were not switched to the target seed yet
+func (r *Reconciler) rollback(log *zap.SugaredLogger, migration *kubermaticv1.ClusterMigration) error {
+	if !kuberneteshelper.HasFinalizer(migration, rollbackFinalizer) {
+		return nil
+	}
+
+	// Nothing was changed yet if the migration did not start
+	if migration.Status.StartTime != nil && canRollback(migration) {
+		if err := r.rollbackCluster(log, migration); err != nil {
+			return err
+		}
+	}
+
+	oldMigration := migration.DeepCopy()
+	kuberneteshelper.RemoveFinalizer(migration, rollbackFinalizer)
+	if err := r.client.Patch(r.ctx, migration, ctrlruntimeclient.MergeFrom(oldMigration)); err != nil {
+		return fmt.Errorf("failed to remove finalizer: %v", err)
+	}
+	return nil
+}
+
+// rollbackCluster removes the cluster from the target seed and resumes it on the source seed
+func (r *Reconciler) rollbackCluster(log *zap.SugaredLogger, migration *kubermaticv1.ClusterMigration) error {
+	sourceSeed, targetSeed := r.seeds[migration.Spec.SourceSeed], r.seeds[migration.Spec.TargetSeed]
+	if sourceSeed == nil || targetSeed == nil {
+		return fmt.Errorf("no connection to seed %q or %q", migration.Spec.SourceSeed, migration.Spec.TargetSeed)
+	}
+
+	targetCluster := &kubermaticv1.Cluster{}
+	err := targetSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, targetCluster)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get target cluster: %v", err)
+	}
+	// Only remove the cluster in case it was created by this migration
+	if err == nil && targetCluster.Annotations[MigrationAnnotation] == migration.Name {
+		log.Info("Removing cluster from the target seed")
+		if err := deleteWithoutFinalizers(r.ctx, targetSeed.client, targetCluster); err != nil {
+			return err
+		}
+		credentials := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: resources.KubermaticNamespace, Name: targetCluster.GetSecretName()}}
+		if err := targetSeed.client.Delete(r.ctx, credentials); err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete credentials: %v", err)
+		}
+	}
+
+	sourceCluster := &kubermaticv1.Cluster{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, sourceCluster); err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get source cluster: %v", err)
+	}
+	// The control plane kept running on the source seed, unpausing hands the cluster back to its controllers
+	if sourceCluster.Spec.Pause && sourceCluster.Spec.PauseReason == pauseReason {
+		log.Info("Resuming cluster on the source seed")
+		oldCluster := sourceCluster.DeepCopy()
+		sourceCluster.Spec.Pause = false
+		sourceCluster.Spec.PauseReason = ""
+		if err := sourceSeed.client.Patch(r.ctx, sourceCluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to unpause source cluster: %v", err)
+		}
+	}
+	return nil
+}
+
+func deleteWithoutFinalizers(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) error {
+	if len(cluster.Finalizers) > 0 {
+		oldCluster := cluster.DeepCopy()
+		cluster.Finalizers = nil
+		if err := client.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return fmt.Errorf("failed to remove finalizers of cluster %s: %v", cluster.Name, err)
+		}
+	}
+	if cluster.DeletionTimestamp == nil {
+		if err := client.Delete(ctx, cluster); err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete cluster %s: %v", cluster.Name, err)
+		}
+	}
+	return nil
+}
+
+// canRollback returns true if the nodes still belong to the source seed, a migration
+// only fails before the control plane is started on the target seed
+func canRollback(migration *kubermaticv1.ClusterMigration) bool {
+	switch migration.Status.Phase {
+	case kubermaticv1.ClusterMigrationPhaseSwitchingEndpoints, kubermaticv1.ClusterMigrationPhaseCleaningUp, kubermaticv1.ClusterMigrationPhaseCompleted:
+		return false
+	}
+	return true
+}
+
+func isActive(migration *kubermaticv1.ClusterMigration) bool {
+	return migration.Status.Phase != "" &&
+		migration.Status.Phase != kubermaticv1.ClusterMigrationPhaseCompleted &&
+		migration.Status.Phase != kubermaticv1.ClusterMigrationPhaseFailed
+}
+
+func getUserClusterClient(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+	provider, err := clusterclient.NewExternal(seedClient)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetClient(cluster)
+}
@@ -0,0 +1,602 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustermigration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	utilpointer "k8s.io/utils/pointer"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	testCluster   = "test-cluster"
+	testNamespace = "cluster-test-cluster"
+	testMigration = "test-migration"
+)
+
+func init() {
+	if err := clusterv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+}
+
+func genMigration(phase kubermaticv1.ClusterMigrationPhase, sourceSeed, targetSeed, targetDC string) *kubermaticv1.ClusterMigration {
+	migration := &kubermaticv1.ClusterMigration{
+		ObjectMeta: metav1.ObjectMeta{Name: testMigration},
+		Spec: kubermaticv1.ClusterMigrationSpec{
+			ClusterID:        testCluster,
+			SourceSeed:       sourceSeed,
+			TargetSeed:       targetSeed,
+			TargetDatacenter: targetDC,
+		},
+		Status: kubermaticv1.ClusterMigrationStatus{Phase: phase},
+	}
+	if phase != "" {
+		now := metav1.Now()
+		migration.Finalizers = []string{rollbackFinalizer}
+		migration.Status.StartTime = &now
+	}
+	return migration
+}
+
+func genCluster() *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: testCluster},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				DatacenterName: "hetzner-fsn1",
+				Hetzner:        &kubermaticv1.HetznerCloudSpec{},
+			},
+		},
+		Address: kubermaticv1.ClusterAddress{
+			URL:          "https://test-cluster.hetzner-fsn1.source.example.com:30000",
+			ExternalName: "test-cluster.hetzner-fsn1.source.example.com",
+			AdminToken:   "admin-token",
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: testNamespace,
+			ExtendedHealth: kubermaticv1.ExtendedClusterHealth{
+				Apiserver: kubermaticv1.HealthStatusUp,
+				Etcd:      kubermaticv1.HealthStatusUp,
+			},
+		},
+	}
+}
+
+func genSeeds() map[string]*kubermaticv1.Seed {
+	seed := func(name, dc string, spec kubermaticv1.DatacenterSpec) *kubermaticv1.Seed {
+		return &kubermaticv1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: kubermaticv1.SeedSpec{
+				Datacenters: map[string]kubermaticv1.Datacenter{dc: {Spec: spec}},
+			},
+		}
+	}
+	return map[string]*kubermaticv1.Seed{
+		"source": seed("source", "hetzner-fsn1", kubermaticv1.DatacenterSpec{Hetzner: &kubermaticv1.DatacenterSpecHetzner{}}),
+		"target": seed("target", "hetzner-nbg1", kubermaticv1.DatacenterSpec{Hetzner: &kubermaticv1.DatacenterSpecHetzner{}}),
+		"other":  seed("other", "do-fra1", kubermaticv1.DatacenterSpec{Digitalocean: &kubermaticv1.DatacenterSpecDigitalocean{}}),
+	}
+}
+
+func newTestReconciler(masterObjs, sourceObjs, targetObjs []runtime.Object) *Reconciler {
+	return &Reconciler{
+		ctx:      context.Background(),
+		log:      kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+		client:   ctrlruntimefakeclient.NewFakeClient(masterObjs...),
+		recorder: record.NewFakeRecorder(100),
+		seedsGetter: func() (map[string]*kubermaticv1.Seed, error) {
+			return genSeeds(), nil
+		},
+		seeds: map[string]*seedConnection{
+			"source": {client: ctrlruntimefakeclient.NewFakeClient(sourceObjs...)},
+			"target": {client: ctrlruntimefakeclient.NewFakeClient(targetObjs...)},
+			"other":  {client: ctrlruntimefakeclient.NewFakeClient()},
+		},
+	}
+}
+
+func reconcileMigration(t *testing.T, r *Reconciler) *kubermaticv1.ClusterMigration {
+	if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: testMigration}}); err != nil {
+		t.Fatalf("reconciling failed: %v", err)
+	}
+	migration := &kubermaticv1.ClusterMigration{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: testMigration}, migration); err != nil {
+		t.Fatalf("failed to get migration: %v", err)
+	}
+	return migration
+}
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		migration     *kubermaticv1.ClusterMigration
+		sourceCluster *kubermaticv1.Cluster
+		targetObjs    []runtime.Object
+		expectedPhase kubermaticv1.ClusterMigrationPhase
+	}{
+		{
+			name:          "valid migration gets started",
+			migration:     genMigration("", "source", "target", "hetzner-nbg1"),
+			sourceCluster: genCluster(),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseCreatingTarget,
+		},
+		{
+			name:          "same source and target seed",
+			migration:     genMigration("", "source", "source", "hetzner-fsn1"),
+			sourceCluster: genCluster(),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+		},
+		{
+			name:          "unknown target seed",
+			migration:     genMigration("", "source", "missing", "hetzner-nbg1"),
+			sourceCluster: genCluster(),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+		},
+		{
+			name:          "unknown target datacenter",
+			migration:     genMigration("", "source", "target", "hetzner-hel1"),
+			sourceCluster: genCluster(),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+		},
+		{
+			name:          "different cloud provider",
+			migration:     genMigration("", "source", "other", "do-fra1"),
+			sourceCluster: genCluster(),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+		},
+		{
+			name:      "unhealthy etcd",
+			migration: genMigration("", "source", "target", "hetzner-nbg1"),
+			sourceCluster: func() *kubermaticv1.Cluster {
+				cluster := genCluster()
+				cluster.Status.ExtendedHealth.Etcd = kubermaticv1.HealthStatusDown
+				return cluster
+			}(),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+		},
+		{
+			name:          "cluster exists in target seed",
+			migration:     genMigration("", "source", "target", "hetzner-nbg1"),
+			sourceCluster: genCluster(),
+			targetObjs:    []runtime.Object{genCluster()},
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestReconciler([]runtime.Object{tc.migration}, []runtime.Object{tc.sourceCluster}, tc.targetObjs)
+
+			migration := reconcileMigration(t, r)
+			if migration.Status.Phase != tc.expectedPhase {
+				t.Fatalf("expected phase %q, got %q (%s)", tc.expectedPhase, migration.Status.Phase, migration.Status.Message)
+			}
+
+			// A migration which failed validation did not change anything and can be removed right away
+			if tc.expectedPhase == kubermaticv1.ClusterMigrationPhaseFailed {
+				if migration.Status.StartTime != nil {
+					t.Error("expected failed migration to not have a start time")
+				}
+				if err := r.rollback(r.log, migration); err != nil {
+					t.Fatalf("rollback failed: %v", err)
+				}
+				cluster := &kubermaticv1.Cluster{}
+				if err := r.seeds["source"].client.Get(r.ctx, types.NamespacedName{Name: testCluster}, cluster); err != nil {
+					t.Fatalf("failed to get source cluster: %v", err)
+				}
+				if cluster.Spec.Pause != tc.sourceCluster.Spec.Pause {
+					t.Error("expected source cluster to be unchanged")
+				}
+			}
+		})
+	}
+}
+
+func TestCreateTarget(t *testing.T) {
+	sourceObjs := []runtime.Object{
+		genCluster(),
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: resources.ApiserverDeploymentName},
+			Spec:       appsv1.DeploymentSpec{Replicas: utilpointer.Int32Ptr(2)},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: resources.EtcdStatefulSetName},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: utilpointer.Int32Ptr(3),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "etcd", Image: "etcd:v3.4.3"}}},
+				},
+			},
+		},
+		&corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "data-etcd-0"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: utilpointer.StringPtr("kubermatic-fast"),
+				AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: resources.CASecretName},
+			Data:       map[string][]byte{resources.CACertSecretKey: []byte("ca")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: resources.ApiserverTLSSecretName},
+			Data:       map[string][]byte{resources.ApiserverTLSCertSecretKey: []byte("cert")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "default-token-abcde"},
+			Type:       corev1.SecretTypeServiceAccountToken,
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: resources.KubermaticNamespace, Name: genCluster().GetSecretName()},
+			Data:       map[string][]byte{"token": []byte("secret")},
+		},
+	}
+	r := newTestReconciler(
+		[]runtime.Object{genMigration(kubermaticv1.ClusterMigrationPhaseCreatingTarget, "source", "target", "hetzner-nbg1")},
+		sourceObjs,
+		nil,
+	)
+
+	migration := reconcileMigration(t, r)
+	if migration.Status.Phase != kubermaticv1.ClusterMigrationPhaseRestoringEtcd {
+		t.Fatalf("expected phase %q, got %q (%s)", kubermaticv1.ClusterMigrationPhaseRestoringEtcd, migration.Status.Phase, migration.Status.Message)
+	}
+
+	source, target := r.seeds["source"].client, r.seeds["target"].client
+
+	sourceCluster := &kubermaticv1.Cluster{}
+	if err := source.Get(r.ctx, types.NamespacedName{Name: testCluster}, sourceCluster); err != nil {
+		t.Fatalf("failed to get source cluster: %v", err)
+	}
+	if !sourceCluster.Spec.Pause || sourceCluster.Spec.PauseReason != pauseReason {
+		t.Error("expected source cluster to be paused")
+	}
+
+	apiserver := &appsv1.Deployment{}
+	if err := source.Get(r.ctx, types.NamespacedName{Namespace: testNamespace, Name: resources.ApiserverDeploymentName}, apiserver); err != nil {
+		t.Fatalf("failed to get source apiserver: %v", err)
+	}
+	if *apiserver.Spec.Replicas != 2 {
+		t.Errorf("expected source apiserver to keep serving, got %d replicas", *apiserver.Spec.Replicas)
+	}
+
+	targetCluster := &kubermaticv1.Cluster{}
+	if err := target.Get(r.ctx, types.NamespacedName{Name: testCluster}, targetCluster); err != nil {
+		t.Fatalf("failed to get target cluster: %v", err)
+	}
+	if !targetCluster.Spec.Pause {
+		t.Error("expected target cluster to be paused")
+	}
+	if targetCluster.Annotations[MigrationAnnotation] != testMigration {
+		t.Errorf("expected target cluster to be annotated with the migration, got %v", targetCluster.Annotations)
+	}
+	if targetCluster.Spec.Cloud.DatacenterName != "hetzner-nbg1" {
+		t.Errorf("expected target cluster to be in datacenter hetzner-nbg1, got %q", targetCluster.Spec.Cloud.DatacenterName)
+	}
+	if targetCluster.Address.ExternalName != "" || targetCluster.Address.AdminToken != "admin-token" {
+		t.Errorf("expected only the admin token to be kept from the address, got %+v", targetCluster.Address)
+	}
+
+	if err := target.Get(r.ctx, types.NamespacedName{Name: testNamespace}, &corev1.Namespace{}); err != nil {
+		t.Errorf("failed to get target namespace: %v", err)
+	}
+	if err := target.Get(r.ctx, types.NamespacedName{Namespace: testNamespace, Name: resources.CASecretName}, &corev1.Secret{}); err != nil {
+		t.Errorf("failed to get copied CA: %v", err)
+	}
+	if err := target.Get(r.ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: sourceCluster.GetSecretName()}, &corev1.Secret{}); err != nil {
+		t.Errorf("failed to get copied credentials: %v", err)
+	}
+	if err := target.Get(r.ctx, types.NamespacedName{Namespace: testNamespace, Name: "default-token-abcde"}, &corev1.Secret{}); !kerrors.IsNotFound(err) {
+		t.Errorf("expected service account token to not be copied, got %v", err)
+	}
+	if err := target.Get(r.ctx, types.NamespacedName{Namespace: testNamespace, Name: resources.ApiserverTLSSecretName}, &corev1.Secret{}); !kerrors.IsNotFound(err) {
+		t.Errorf("expected apiserver serving certificate of the source seed to not be copied, got %v", err)
+	}
+
+	for _, name := range []string{"data-etcd-0", "data-etcd-1", "data-etcd-2"} {
+		pvc := &corev1.PersistentVolumeClaim{}
+		if err := target.Get(r.ctx, types.NamespacedName{Namespace: testNamespace, Name: name}, pvc); err != nil {
+			t.Fatalf("failed to get etcd volume %s: %v", name, err)
+		}
+		if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != "kubermatic-fast" {
+			t.Errorf("expected etcd volume %s to use the storage class of the source", name)
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err := target.List(r.ctx, pods, ctrlruntimeclient.InNamespace(testNamespace)); err != nil {
+		t.Fatalf("failed to list pods: %v", err)
+	}
+	if len(pods.Items) != 3 {
+		t.Fatalf("expected 3 etcd restore pods, got %d", len(pods.Items))
+	}
+	if image := pods.Items[0].Spec.Containers[0].Image; image != "etcd:v3.4.3" {
+		t.Errorf("expected restore pod to use the etcd image, got %q", image)
+	}
+}
+
+func TestRestoreEtcd(t *testing.T) {
+	restorePods := func(phase corev1.PodPhase) []runtime.Object {
+		var pods []runtime.Object
+		for member := 0; member < 3; member++ {
+			pod := restorePod(genCluster(), "etcd:v3.4.3", member, 3)
+			pod.Status.Phase = phase
+			pods = append(pods, pod)
+		}
+		return pods
+	}
+
+	testCases := []struct {
+		name          string
+		pods          []runtime.Object
+		expectedPhase kubermaticv1.ClusterMigrationPhase
+		expectedPods  int
+	}{
+		{
+			name:          "restore pods are still pending",
+			pods:          restorePods(corev1.PodPending),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseRestoringEtcd,
+			expectedPods:  3,
+		},
+		{
+			name:          "restore pods succeeded",
+			pods:          restorePods(corev1.PodSucceeded),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseStartingControlPlane,
+		},
+		{
+			name:          "restore pod failed",
+			pods:          restorePods(corev1.PodFailed),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+			expectedPods:  3,
+		},
+		{
+			name:          "snapshot only reached some of the members",
+			pods:          append(restorePods(corev1.PodSucceeded)[:1], restorePods(corev1.PodPending)[1:]...),
+			expectedPhase: kubermaticv1.ClusterMigrationPhaseFailed,
+			expectedPods:  3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestReconciler(
+				[]runtime.Object{genMigration(kubermaticv1.ClusterMigrationPhaseRestoringEtcd, "source", "target", "hetzner-nbg1")},
+				[]runtime.Object{genCluster()},
+				tc.pods,
+			)
+
+			migration := reconcileMigration(t, r)
+			if migration.Status.Phase != tc.expectedPhase {
+				t.Fatalf("expected phase %q, got %q (%s)", tc.expectedPhase, migration.Status.Phase, migration.Status.Message)
+			}
+
+			pods := &corev1.PodList{}
+			if err := r.seeds["target"].client.List(r.ctx, pods, ctrlruntimeclient.InNamespace(testNamespace)); err != nil {
+				t.Fatalf("failed to list pods: %v", err)
+			}
+			if len(pods.Items) != tc.expectedPods {
+				t.Errorf("expected %d restore pods, got %d", tc.expectedPods, len(pods.Items))
+			}
+		})
+	}
+}
+
+func TestSwitchEndpoints(t *testing.T) {
+	const targetURL = "https://test-cluster.hetzner-nbg1.target.example.com:30001"
+	switchStart := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+
+	switcher := func() *appsv1.DaemonSet {
+		ds := endpointSwitcher(genCluster().Address.URL, targetURL)
+		ds.CreationTimestamp = switchStart
+		return ds
+	}
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+	lease := func(renewed time.Time) *coordinationv1.Lease {
+		return &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{Namespace: corev1.NamespaceNodeLease, Name: "node-1"},
+			Spec:       coordinationv1.LeaseSpec{RenewTime: &metav1.MicroTime{Time: renewed}},
+		}
+	}
+	kubeProxy := []runtime.Object{
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: kubeProxyName}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: kubeProxyName},
+			Data:       map[string]string{"kubeconfig.conf": "server: " + targetURL},
+		},
+	}
+
+	testCases := []struct {
+		name              string
+		sourceObjs        []runtime.Object
+		targetObjs        []runtime.Object
+		expectedPhase     kubermaticv1.ClusterMigrationPhase
+		expectedSwitcher  bool
+		expectedKubeProxy bool
+	}{
+		{
+			name:             "switcher gets created through the source apiserver",
+			targetObjs:       append([]runtime.Object{node, lease(switchStart.Add(-time.Minute))}, kubeProxy...),
+			expectedPhase:    kubermaticv1.ClusterMigrationPhaseSwitchingEndpoints,
+			expectedSwitcher: true,
+		},
+		{
+			name:             "node did not reach the target seed yet",
+			sourceObjs:       []runtime.Object{switcher()},
+			targetObjs:       append([]runtime.Object{node, lease(switchStart.Add(-time.Minute))}, kubeProxy...),
+			expectedPhase:    kubermaticv1.ClusterMigrationPhaseSwitchingEndpoints,
+			expectedSwitcher: true,
+		},
+		{
+			name:              "all nodes reached the target seed",
+			sourceObjs:        []runtime.Object{switcher()},
+			targetObjs:        append([]runtime.Object{node, lease(switchStart.Add(time.Minute))}, kubeProxy...),
+			expectedPhase:     kubermaticv1.ClusterMigrationPhaseCleaningUp,
+			expectedKubeProxy: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sourceUserClusterClient := ctrlruntimefakeclient.NewFakeClient(tc.sourceObjs...)
+			targetUserClusterClient := ctrlruntimefakeclient.NewFakeClient(tc.targetObjs...)
+
+			targetCluster := genCluster()
+			targetCluster.Address.URL = targetURL
+			r := newTestReconciler(
+				[]runtime.Object{genMigration(kubermaticv1.ClusterMigrationPhaseSwitchingEndpoints, "source", "target", "hetzner-nbg1")},
+				[]runtime.Object{genCluster()},
+				[]runtime.Object{targetCluster},
+			)
+			r.userClusterClientGetter = func(seedClient ctrlruntimeclient.Client, _ *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+				if seedClient == r.seeds["source"].client {
+					return sourceUserClusterClient, nil
+				}
+				return targetUserClusterClient, nil
+			}
+
+			migration := reconcileMigration(t, r)
+			if migration.Status.Phase != tc.expectedPhase {
+				t.Fatalf("expected phase %q, got %q (%s)", tc.expectedPhase, migration.Status.Phase, migration.Status.Message)
+			}
+
+			ds := &appsv1.DaemonSet{}
+			err := sourceUserClusterClient.Get(r.ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: endpointSwitcherName}, ds)
+			if exists := err == nil; exists != tc.expectedSwitcher {
+				t.Fatalf("expected switcher to exist: %t, got %v", tc.expectedSwitcher, err)
+			}
+			if tc.expectedSwitcher && ds.Spec.Template.Spec.Containers[0].Env[1].Value != targetURL {
+				t.Errorf("expected switcher to switch to %s, got %v", targetURL, ds.Spec.Template.Spec.Containers[0].Env)
+			}
+
+			proxy := &appsv1.DaemonSet{}
+			if err := targetUserClusterClient.Get(r.ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: kubeProxyName}, proxy); err != nil {
+				t.Fatalf("failed to get kube-proxy: %v", err)
+			}
+			if restarted := proxy.Spec.Template.Annotations[MigratedToSeedAnnotation] == "target"; restarted != tc.expectedKubeProxy {
+				t.Errorf("expected kube-proxy restart: %t, got annotations %v", tc.expectedKubeProxy, proxy.Spec.Template.Annotations)
+			}
+
+			// The nodes might point to the target seed already, so the migration can not be rolled back anymore
+			if err := r.rollback(r.log, migration); err != nil {
+				t.Fatalf("rollback failed: %v", err)
+			}
+			if err := r.seeds["target"].client.Get(r.ctx, types.NamespacedName{Name: testCluster}, &kubermaticv1.Cluster{}); err != nil {
+				t.Errorf("expected target cluster to be kept: %v", err)
+			}
+		})
+	}
+}
+
+func TestRollback(t *testing.T) {
+	sourceCluster := genCluster()
+	sourceCluster.Spec.Pause = true
+	sourceCluster.Spec.PauseReason = pauseReason
+	targetCluster := newTargetCluster(genMigration("", "source", "target", "hetzner-nbg1"), genCluster())
+
+	r := newTestReconciler(
+		[]runtime.Object{genMigration(kubermaticv1.ClusterMigrationPhaseRestoringEtcd, "source", "target", "hetzner-nbg1")},
+		[]runtime.Object{sourceCluster},
+		[]runtime.Object{targetCluster},
+	)
+
+	migration := &kubermaticv1.ClusterMigration{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: testMigration}, migration); err != nil {
+		t.Fatalf("failed to get migration: %v", err)
+	}
+	if err := r.rollback(r.log, migration); err != nil {
+		t.Fatalf("rollback failed: %v", err)
+	}
+
+	if err := r.seeds["target"].client.Get(r.ctx, types.NamespacedName{Name: testCluster}, &kubermaticv1.Cluster{}); !kerrors.IsNotFound(err) {
+		t.Errorf("expected target cluster to be removed, got %v", err)
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.seeds["source"].client.Get(r.ctx, types.NamespacedName{Name: testCluster}, cluster); err != nil {
+		t.Fatalf("failed to get source cluster: %v", err)
+	}
+	if cluster.Spec.Pause {
+		t.Error("expected source cluster to be unpaused")
+	}
+
+	migration = &kubermaticv1.ClusterMigration{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: testMigration}, migration); err != nil {
+		t.Fatalf("failed to get migration: %v", err)
+	}
+	if len(migration.Finalizers) != 0 {
+		t.Errorf("expected finalizer to be removed, got %v", migration.Finalizers)
+	}
+}
+
+func TestRollbackOnFailure(t *testing.T) {
+	sourceCluster := genCluster()
+	sourceCluster.Spec.Pause = true
+	sourceCluster.Spec.PauseReason = pauseReason
+	targetCluster := newTargetCluster(genMigration("", "source", "target", "hetzner-nbg1"), genCluster())
+	pod := restorePod(genCluster(), "etcd:v3.4.3", 0, 1)
+	pod.Status.Phase = corev1.PodFailed
+
+	r := newTestReconciler(
+		[]runtime.Object{genMigration(kubermaticv1.ClusterMigrationPhaseRestoringEtcd, "source", "target", "hetzner-nbg1")},
+		[]runtime.Object{sourceCluster},
+		[]runtime.Object{targetCluster, pod},
+	)
+
+	migration := reconcileMigration(t, r)
+	if migration.Status.Phase != kubermaticv1.ClusterMigrationPhaseFailed {
+		t.Fatalf("expected phase %q, got %q (%s)", kubermaticv1.ClusterMigrationPhaseFailed, migration.Status.Phase, migration.Status.Message)
+	}
+
+	if err := r.seeds["target"].client.Get(r.ctx, types.NamespacedName{Name: testCluster}, &kubermaticv1.Cluster{}); !kerrors.IsNotFound(err) {
+		t.Errorf("expected target cluster to be removed, got %v", err)
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.seeds["source"].client.Get(r.ctx, types.NamespacedName{Name: testCluster}, cluster); err != nil {
+		t.Fatalf("failed to get source cluster: %v", err)
+	}
+	if cluster.Spec.Pause {
+		t.Error("expected source cluster to be unpaused")
+	}
+}
@@ -0,0 +1,36 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package clustermigration contains a controller that moves the control plane of a user cluster to another seed.
+The progress is tracked in a ClusterMigration resource in the master cluster, the migration runs through these phases:
+  - CreatingTarget: the cluster gets paused on the source seed, then the cluster object, the secrets of the
+    cluster namespace, the cloud credentials and empty etcd volumes are created on the target seed. Secrets
+    which contain the address of the source seed, like the apiserver serving certificate, are not copied.
+  - RestoringEtcd: a single snapshot of the source etcd is streamed into the restore pods on the target seed,
+    which restore it into the etcd volumes
+  - StartingControlPlane: the cluster is unpaused on the target seed, which brings up the control plane
+  - SwitchingEndpoints: a DaemonSet created through the source apiserver rewrites the kubeconfig of the kubelets
+    to the address of the target seed, the nodes keep running. Once all nodes renewed their lease in the target
+    cluster, kube-proxy gets restarted with the new address.
+  - CleaningUp: the cluster object is removed from the source seed without running its cleanup finalizers
+
+The control plane on the source seed keeps serving until the nodes got switched. Changes made to the user cluster
+after the snapshot got taken are lost, workloads should not be changed during a migration.
+
+A migration that fails before the nodes got switched rolls the cluster back to the source seed, so does deleting it.
+*/
+package clustermigration
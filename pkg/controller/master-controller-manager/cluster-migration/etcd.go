@@ -0,0 +1,310 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustermigration
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	etcdDataVolume         = "data"
+	etcdDataDir            = "/var/run/etcd"
+	restoreContainerName   = "restore"
+	restorePodAppLabel     = "etcd-restore"
+	sourceSnapshotFile     = etcdDataDir + "/migration.db"
+	restoreSnapshotFile    = etcdDataDir + "/snapshot.db"
+	restoreSnapshotDoneTag = restoreSnapshotFile + ".done"
+)
+
+// createEtcdRestore creates the etcd volumes on the target seed, named like the statefulset would name them, so
+// the etcd statefulset picks them up later on. Every volume gets a pod which restores the snapshot into it.
+func (r *Reconciler) createEtcdRestore(sourceSeed, targetSeed *seedConnection, sourceCluster, targetCluster *kubermaticv1.Cluster) error {
+	etcd := &appsv1.StatefulSet{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Namespace: sourceCluster.Status.NamespaceName, Name: resources.EtcdStatefulSetName}, etcd); err != nil {
+		return fmt.Errorf("failed to get source etcd: %v", err)
+	}
+	if etcd.Spec.Replicas == nil || len(etcd.Spec.Template.Spec.Containers) == 0 {
+		return fmt.Errorf("source etcd statefulset is incomplete")
+	}
+
+	sourcePVC := &corev1.PersistentVolumeClaim{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Namespace: sourceCluster.Status.NamespaceName, Name: etcdPVCName(0)}, sourcePVC); err != nil {
+		return fmt.Errorf("failed to get source etcd volume: %v", err)
+	}
+
+	members := int(*etcd.Spec.Replicas)
+	image := etcd.Spec.Template.Spec.Containers[0].Image
+	for member := 0; member < members; member++ {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            etcdPVCName(member),
+				Namespace:       targetCluster.Status.NamespaceName,
+				OwnerReferences: []metav1.OwnerReference{resources.GetClusterRef(targetCluster)},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: sourcePVC.Spec.StorageClassName,
+				AccessModes:      sourcePVC.Spec.AccessModes,
+				Resources:        sourcePVC.Spec.Resources,
+			},
+		}
+		if err := targetSeed.client.Create(r.ctx, pvc); err != nil && !kerrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create etcd volume %s: %v", pvc.Name, err)
+		}
+
+		if err := targetSeed.client.Create(r.ctx, restorePod(targetCluster, image, member, members)); err != nil && !kerrors.IsAlreadyExists(err) {
+			return fmt.Errorf("failed to create etcd restore pod: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// restoreEtcd streams a snapshot of the source etcd into all restore pods and returns true once all of them restored it
+func (r *Reconciler) restoreEtcd(log *zap.SugaredLogger, migration *kubermaticv1.ClusterMigration, sourceSeed, targetSeed *seedConnection) (bool, error) {
+	sourceCluster := &kubermaticv1.Cluster{}
+	if err := sourceSeed.client.Get(r.ctx, types.NamespacedName{Name: migration.Spec.ClusterID}, sourceCluster); err != nil {
+		return false, fmt.Errorf("failed to get source cluster: %v", err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := targetSeed.client.List(r.ctx, pods,
+		ctrlruntimeclient.InNamespace(sourceCluster.Status.NamespaceName),
+		ctrlruntimeclient.MatchingLabels{resources.AppLabelKey: restorePodAppLabel},
+	); err != nil {
+		return false, fmt.Errorf("failed to list etcd restore pods: %v", err)
+	}
+	if len(pods.Items) == 0 {
+		return false, fmt.Errorf("%w: no etcd restore pods found", errMigrationFailed)
+	}
+
+	// The source apiserver keeps serving while the snapshot is taken, so all members must restore the
+	// very same snapshot, otherwise they would start with diverging data
+	restored := true
+	received := 0
+	var waiting []corev1.Pod
+	for _, pod := range pods.Items {
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			received++
+			continue
+		case corev1.PodFailed:
+			return false, fmt.Errorf("%w: etcd restore pod %s failed", errMigrationFailed, pod.Name)
+		case corev1.PodRunning:
+		default:
+			restored = false
+			continue
+		}
+		restored = false
+
+		ok, err := snapshotReceived(targetSeed, &pod)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			received++
+			continue
+		}
+		waiting = append(waiting, pod)
+	}
+
+	if received > 0 && received < len(pods.Items) {
+		return false, fmt.Errorf("%w: only %d of %d etcd members received the snapshot", errMigrationFailed, received, len(pods.Items))
+	}
+	// Wait until all restore pods are running, they all get the snapshot at once
+	if received == 0 && len(waiting) == len(pods.Items) {
+		log.Infow("Streaming etcd snapshot", "members", len(waiting))
+		if err := streamSnapshot(sourceSeed, targetSeed, sourceCluster.Status.NamespaceName, waiting); err != nil {
+			return false, fmt.Errorf("failed to stream etcd snapshot: %v", err)
+		}
+	}
+
+	if !restored {
+		return false, nil
+	}
+
+	for _, pod := range pods.Items {
+		if err := targetSeed.client.Delete(r.ctx, &pod); err != nil && !kerrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to delete etcd restore pod %s: %v", pod.Name, err)
+		}
+	}
+	return true, nil
+}
+
+func snapshotReceived(seed *seedConnection, pod *corev1.Pod) (bool, error) {
+	stdout := &bytes.Buffer{}
+	if err := execInPod(seed, pod.Namespace, pod.Name, restoreContainerName,
+		[]string{"/bin/sh", "-c", fmt.Sprintf("test -f %s && echo true || echo false", restoreSnapshotDoneTag)}, nil, stdout); err != nil {
+		return false, fmt.Errorf("failed to check for the etcd snapshot in %s: %v", pod.Name, err)
+	}
+	return strings.TrimSpace(stdout.String()) == "true", nil
+}
+
+// streamSnapshot takes a single snapshot of the source etcd and pipes it into all given restore pods. The data
+// is streamed through the controller and never stored outside of the two seeds.
+func streamSnapshot(sourceSeed, targetSeed *seedConnection, sourceNamespace string, pods []corev1.Pod) error {
+	writers := make([]io.Writer, len(pods))
+	pipeWriters := make([]*io.PipeWriter, len(pods))
+	targetErrs := make(chan error, len(pods))
+	command := fmt.Sprintf("cat > %[1]s.tmp && mv %[1]s.tmp %[1]s && touch %[2]s", restoreSnapshotFile, restoreSnapshotDoneTag)
+	for i := range pods {
+		reader, writer := io.Pipe()
+		writers[i], pipeWriters[i] = writer, writer
+		go func(pod *corev1.Pod) {
+			err := execInPod(targetSeed, pod.Namespace, pod.Name, restoreContainerName, []string{"/bin/sh", "-ec", command}, reader, nil)
+			if err != nil {
+				err = fmt.Errorf("%s: %v", pod.Name, err)
+			}
+			// Unblocks the snapshot in case this pod stopped reading
+			reader.CloseWithError(err)
+			targetErrs <- err
+		}(&pods[i])
+	}
+
+	sourceCommand := fmt.Sprintf("trap 'rm -f %[1]s' EXIT; etcdctl --endpoints https://127.0.0.1:2379 snapshot save %[1]s >/dev/null; cat %[1]s", sourceSnapshotFile)
+	err := execInPod(sourceSeed, sourceNamespace, "etcd-0", resources.EtcdStatefulSetName, []string{"/bin/sh", "-ec", sourceCommand}, nil, io.MultiWriter(writers...))
+	for _, writer := range pipeWriters {
+		writer.CloseWithError(err)
+	}
+	for range pods {
+		if targetErr := <-targetErrs; targetErr != nil && err == nil {
+			err = targetErr
+		}
+	}
+	return err
+}
+
+func execInPod(seed *seedConnection, namespace, pod, container string, command []string, stdin io.Reader, stdout io.Writer) error {
+	req := seed.kubeClient.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(pod).
+		Namespace(namespace).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(seed.config, http.MethodPost, req.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %v", err)
+	}
+
+	stderr := &bytes.Buffer{}
+	if err := executor.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	}); err != nil {
+		return fmt.Errorf("%v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// restorePod returns a pod which waits for the snapshot to be streamed into the volume of the given etcd member
+// and restores it into the data directory the etcd statefulset uses
+func restorePod(cluster *kubermaticv1.Cluster, image string, member, members int) *corev1.Pod {
+	namespace := cluster.Status.NamespaceName
+	name := fmt.Sprintf("etcd-%d", member)
+
+	initialCluster := make([]string, members)
+	for i := range initialCluster {
+		initialCluster[i] = fmt.Sprintf("etcd-%d=%s", i, etcdPeerURL(i, namespace))
+	}
+
+	script := fmt.Sprintf(`until [ -f %[1]s ]; do sleep 1; done
+rm -rf %[2]s/pod_%[3]s
+etcdctl snapshot restore %[4]s \
+  --name %[3]s \
+  --initial-cluster %[5]s \
+  --initial-cluster-token %[6]s \
+  --initial-advertise-peer-urls %[7]s \
+  --data-dir %[2]s/pod_%[3]s
+rm -f %[4]s %[1]s`,
+		restoreSnapshotDoneTag, etcdDataDir, name, restoreSnapshotFile,
+		strings.Join(initialCluster, ","), cluster.Name, etcdPeerURL(member, namespace))
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            fmt.Sprintf("etcd-restore-%d", member),
+			Namespace:       namespace,
+			Labels:          map[string]string{resources.AppLabelKey: restorePodAppLabel},
+			OwnerReferences: []metav1.OwnerReference{resources.GetClusterRef(cluster)},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:    restoreContainerName,
+					Image:   image,
+					Command: []string{"/bin/sh", "-ec", script},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "ETCDCTL_API",
+							Value: "3",
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      etcdDataVolume,
+							MountPath: etcdDataDir,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: etcdDataVolume,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: etcdPVCName(member),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// etcdPVCName returns the name the etcd statefulset uses for the volume of the given member
+func etcdPVCName(member int) string {
+	return fmt.Sprintf("%s-%s-%d", etcdDataVolume, resources.EtcdStatefulSetName, member)
+}
+
+func etcdPeerURL(member int, namespace string) string {
+	return fmt.Sprintf("http://etcd-%d.%s.%s.svc.cluster.local:2380", member, resources.EtcdServiceName, namespace)
+}
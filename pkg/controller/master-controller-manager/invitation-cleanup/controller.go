@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invitationcleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "kubermatic_invitation_cleanup_controller"
+
+	// expiredInvitationRetention is how long expired invitations are kept before they get removed
+	expiredInvitationRetention = 7 * 24 * time.Hour
+)
+
+type reconciler struct {
+	ctx    context.Context
+	log    *zap.SugaredLogger
+	client ctrlruntimeclient.Client
+	now    func() time.Time
+}
+
+func Add(ctx context.Context, mgr manager.Manager, log *zap.SugaredLogger) error {
+	log = log.Named(ControllerName)
+	r := &reconciler{
+		ctx:    ctx,
+		log:    log,
+		client: mgr.GetClient(),
+		now:    time.Now,
+	}
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return fmt.Errorf("failed to construct controller: %v", err)
+	}
+
+	if err := c.Watch(&source.Kind{Type: &kubermaticv1.ProjectInvitation{}}, &handler.EnqueueRequestForObject{}); err != nil {
+		return fmt.Errorf("failed to watch project invitations: %v", err)
+	}
+
+	return nil
+}
+
+func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	invitation := &kubermaticv1.ProjectInvitation{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, invitation); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if invitation.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	removeAt := invitation.Spec.ExpiresAt.Add(expiredInvitationRetention)
+	if now := r.now(); now.Before(removeAt) {
+		return reconcile.Result{RequeueAfter: removeAt.Sub(now)}, nil
+	}
+
+	log.Infow("Removing expired invitation", "expired-at", invitation.Spec.ExpiresAt)
+	if err := r.client.Delete(r.ctx, invitation); err != nil && !kerrors.IsNotFound(err) {
+		err = fmt.Errorf("failed to remove the expired invitation: %v", err)
+		log.Errorw("Reconciling failed", zap.Error(err))
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
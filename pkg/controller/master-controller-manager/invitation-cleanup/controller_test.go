@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invitationcleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                 string
+		expiresAt            time.Time
+		expectRemoved        bool
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:                 "scenario 1: a pending invitation is kept",
+			expiresAt:            now.Add(time.Hour),
+			expectedRequeueAfter: expiredInvitationRetention + time.Hour,
+		},
+		{
+			name:                 "scenario 2: a recently expired invitation is kept",
+			expiresAt:            now.Add(-time.Hour),
+			expectedRequeueAfter: expiredInvitationRetention - time.Hour,
+		},
+		{
+			name:          "scenario 3: an invitation which expired longer than the retention ago is removed",
+			expiresAt:     now.Add(-expiredInvitationRetention),
+			expectRemoved: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			invitation := &kubermaticv1.ProjectInvitation{
+				ObjectMeta: metav1.ObjectMeta{Name: "invitation"},
+				Spec: kubermaticv1.ProjectInvitationSpec{
+					ProjectID: "plan9-ID",
+					UserEmail: "bob@acme.com",
+					Group:     "editors-plan9-ID",
+					ExpiresAt: metav1.NewTime(tc.expiresAt),
+				},
+			}
+			client := fake.NewFakeClientWithScheme(scheme.Scheme, invitation)
+			r := &reconciler{
+				ctx:    context.Background(),
+				log:    kubermaticlog.Logger,
+				client: client,
+				now:    func() time.Time { return now },
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: invitation.Name}}
+			result, err := r.Reconcile(request)
+			if err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+			if result.RequeueAfter != tc.expectedRequeueAfter {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeueAfter, result.RequeueAfter)
+			}
+
+			err = client.Get(context.Background(), request.NamespacedName, &kubermaticv1.ProjectInvitation{})
+			if removed := kerrors.IsNotFound(err); removed != tc.expectRemoved {
+				t.Errorf("expected the invitation to be removed = %v, got %v (%v)", tc.expectRemoved, removed, err)
+			}
+		})
+	}
+}
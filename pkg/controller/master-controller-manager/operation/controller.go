@@ -0,0 +1,441 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "operation_controller"
+
+	// operationTimeout is the time after which a running operation is marked as failed
+	operationTimeout = time.Hour
+	// retentionPeriod is the time for which finished operations are kept
+	retentionPeriod = 7 * 24 * time.Hour
+	// requeueInterval is the interval in which running operations are checked even if the cluster did not change,
+	// e.g. to notice the rollout of the control plane deployments
+	requeueInterval = 30 * time.Second
+)
+
+// Reconciler updates the status of the operations from the clusters of all seeds
+type Reconciler struct {
+	ctx         context.Context
+	log         *zap.SugaredLogger
+	client      ctrlruntimeclient.Client
+	seedClients map[string]ctrlruntimeclient.Client
+	now         func() time.Time
+}
+
+func Add(
+	ctx context.Context,
+	mgr manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	workerName string,
+	numWorkers int,
+) error {
+	reconciler := &Reconciler{
+		ctx:         ctx,
+		log:         log.Named(ControllerName),
+		client:      mgr.GetClient(),
+		seedClients: map[string]ctrlruntimeclient.Client{},
+		now:         time.Now,
+	}
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: numWorkers})
+	if err != nil {
+		return fmt.Errorf("failed to construct controller: %v", err)
+	}
+
+	if err := c.Watch(
+		&source.Kind{Type: &kubermaticv1.Operation{}},
+		&handler.EnqueueRequestForObject{},
+		workerlabel.Predicates(workerName),
+	); err != nil {
+		return fmt.Errorf("failed to create watch for operations: %v", err)
+	}
+
+	for seedName, seedManager := range seedManagers {
+		reconciler.seedClients[seedName] = seedManager.GetClient()
+
+		clusterSource := &source.Kind{Type: &kubermaticv1.Cluster{}}
+		if err := clusterSource.InjectCache(seedManager.GetCache()); err != nil {
+			return fmt.Errorf("failed to inject cache into clusterSource for seed %s: %v", seedName, err)
+		}
+		if err := c.Watch(
+			clusterSource,
+			enqueueOperationsForCluster(ctx, reconciler.client, log),
+			workerlabel.Predicates(workerName),
+		); err != nil {
+			return fmt.Errorf("failed to establish watch for clusters in seed %s: %v", seedName, err)
+		}
+	}
+
+	return nil
+}
+
+// enqueueOperationsForCluster enqueues all operations of a cluster whenever it changes
+func enqueueOperationsForCluster(ctx context.Context, client ctrlruntimeclient.Client, log *zap.SugaredLogger) handler.EventHandler {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+		operations := &kubermaticv1.OperationList{}
+		if err := client.List(ctx, operations, ctrlruntimeclient.MatchingLabels{kubermaticv1.OperationClusterLabelKey: a.Meta.GetName()}); err != nil {
+			log.Errorw("Failed to list operations", "cluster", a.Meta.GetName(), zap.Error(err))
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, operation := range operations.Items {
+			if operation.IsFinished() {
+				continue
+			}
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: operation.Name}})
+		}
+		return requests
+	})}
+}
+
+func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	result, err := r.reconcile(log, request)
+	if controllerutil.IsCacheNotStarted(err) {
+		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if err != nil {
+		log.Errorw("Reconciliation failed", zap.Error(err))
+		return reconcile.Result{}, err
+	}
+	return result, nil
+}
+
+func (r *Reconciler) reconcile(log *zap.SugaredLogger, request reconcile.Request) (reconcile.Result, error) {
+	operation := &kubermaticv1.Operation{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, operation); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get operation: %v", err)
+	}
+
+	now := r.now()
+	if operation.IsFinished() {
+		return r.garbageCollect(log, operation, now)
+	}
+
+	seedClient, cluster, err := r.getCluster(operation.Spec.ClusterID)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	oldOperation := operation.DeepCopy()
+	switch operation.Spec.Type {
+	case kubermaticv1.OperationTypeClusterCreation:
+		operation.Status = creationStatus(cluster)
+	case kubermaticv1.OperationTypeClusterUpgrade:
+		operation.Status, err = r.upgradeStatus(seedClient, cluster, operation.Spec.Version)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	case kubermaticv1.OperationTypeClusterDeletion:
+		operation.Status = deletionStatus(cluster)
+	default:
+		operation.Status = kubermaticv1.OperationStatus{
+			Phase:  kubermaticv1.OperationPhaseFailed,
+			Errors: []string{fmt.Sprintf("unknown operation type %q", operation.Spec.Type)},
+		}
+	}
+
+	if operation.Status.Phase == kubermaticv1.OperationPhaseRunning && now.Sub(operation.CreationTimestamp.Time) > operationTimeout {
+		operation.Status.Phase = kubermaticv1.OperationPhaseFailed
+		operation.Status.Errors = append(operation.Status.Errors, fmt.Sprintf("the operation did not finish within %v", operationTimeout))
+	}
+	if operation.IsFinished() {
+		operation.Status.CompletionTime = &metav1.Time{Time: now}
+	}
+
+	if !equality.Semantic.DeepEqual(oldOperation.Status, operation.Status) {
+		if err := r.client.Patch(r.ctx, operation, ctrlruntimeclient.MergeFrom(oldOperation)); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to update operation status: %v", err)
+		}
+	}
+
+	if operation.IsFinished() {
+		log.Infow("Operation finished", "phase", operation.Status.Phase)
+		return reconcile.Result{RequeueAfter: retentionPeriod}, nil
+	}
+	return reconcile.Result{RequeueAfter: requeueInterval}, nil
+}
+
+// garbageCollect deletes the operation once the retention period is over
+func (r *Reconciler) garbageCollect(log *zap.SugaredLogger, operation *kubermaticv1.Operation, now time.Time) (reconcile.Result, error) {
+	finishedAt := operation.CreationTimestamp.Time
+	if operation.Status.CompletionTime != nil {
+		finishedAt = operation.Status.CompletionTime.Time
+	}
+
+	if remaining := finishedAt.Add(retentionPeriod).Sub(now); remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	log.Debug("Deleting finished operation")
+	if err := r.client.Delete(r.ctx, operation); err != nil && !kerrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed to delete operation: %v", err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// getCluster looks up the cluster in all seeds, the cluster is nil if it does not exist
+func (r *Reconciler) getCluster(name string) (ctrlruntimeclient.Client, *kubermaticv1.Cluster, error) {
+	for seedName, seedClient := range r.seedClients {
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(r.ctx, types.NamespacedName{Name: name}, cluster); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to get cluster %s from seed %s: %v", name, seedName, err)
+		}
+		return seedClient, cluster, nil
+	}
+	return nil, nil, nil
+}
+
+// creationStatus follows the control plane components until the cluster is initialized. A cluster that does
+// not exist yet is tolerated, as the cluster might not be in the cache of the seed client yet.
+func creationStatus(cluster *kubermaticv1.Cluster) kubermaticv1.OperationStatus {
+	if cluster == nil {
+		return newStatus(
+			step("EtcdReady", false),
+			step("APIServerReady", false),
+			step("ControllersReady", false),
+			step("CloudProviderInfrastructureReady", false),
+			step("MachineControllerReady", false),
+			step("UserClusterControllerManagerReady", false),
+			step("ClusterInitialized", false),
+		)
+	}
+
+	health := cluster.Status.ExtendedHealth
+	status := newStatus(
+		step("EtcdReady", health.Etcd == kubermaticv1.HealthStatusUp),
+		step("APIServerReady", health.Apiserver == kubermaticv1.HealthStatusUp),
+		step("ControllersReady", health.Controller == kubermaticv1.HealthStatusUp && health.Scheduler == kubermaticv1.HealthStatusUp),
+		step("CloudProviderInfrastructureReady", health.CloudProviderInfrastructure == kubermaticv1.HealthStatusUp),
+		step("MachineControllerReady", health.MachineController == kubermaticv1.HealthStatusUp),
+		step("UserClusterControllerManagerReady", health.UserClusterControllerManager == kubermaticv1.HealthStatusUp),
+		step("ClusterInitialized", hasCondition(cluster, kubermaticv1.ClusterConditionClusterInitialized)),
+	)
+	setClusterDetails(&status, cluster)
+
+	if cluster.DeletionTimestamp != nil || cluster.IsSoftDeleted() {
+		status.Phase = kubermaticv1.OperationPhaseFailed
+		status.Errors = append(status.Errors, "the cluster got deleted before it was ready")
+	}
+	return status
+}
+
+// upgradeStatus follows the rollout of the control plane deployments to the target version
+func (r *Reconciler) upgradeStatus(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, version string) (kubermaticv1.OperationStatus, error) {
+	if cluster == nil {
+		status := newStatus(
+			step("APIServerUpdated", false),
+			step("ControllerManagerUpdated", false),
+			step("SchedulerUpdated", false),
+			step("ControlPlaneHealthy", false),
+		)
+		status.Phase = kubermaticv1.OperationPhaseFailed
+		status.Errors = []string{"the cluster does not exist anymore"}
+		return status, nil
+	}
+
+	var steps []kubermaticv1.OperationStep
+	for _, deployment := range controlPlaneDeployments {
+		updated, err := r.deploymentUpdated(seedClient, cluster, deployment.name, version)
+		if err != nil {
+			return kubermaticv1.OperationStatus{}, err
+		}
+		steps = append(steps, step(deployment.step, updated))
+	}
+	steps = append(steps, step("ControlPlaneHealthy",
+		cluster.Spec.Version.String() == version &&
+			cluster.Status.ExtendedHealth.AllHealthy() &&
+			hasCondition(cluster, kubermaticv1.ClusterConditionSeedResourcesUpToDate)))
+
+	status := newStatus(steps...)
+	setClusterDetails(&status, cluster)
+	return status, nil
+}
+
+// controlPlaneDeployments are the deployments which get updated during an upgrade, in the order of the rollout
+var controlPlaneDeployments = []struct {
+	step string
+	name string
+}{
+	{step: "APIServerUpdated", name: resources.ApiserverDeploymentName},
+	{step: "ControllerManagerUpdated", name: resources.ControllerManagerDeploymentName},
+	{step: "SchedulerUpdated", name: resources.SchedulerDeploymentName},
+}
+
+// deploymentUpdated returns true if the deployment is completely rolled out with the image of the given version.
+// The images of OpenShift clusters are not tagged with the Kubernetes version, only the rollout is checked for them.
+func (r *Reconciler) deploymentUpdated(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, name, version string) (bool, error) {
+	if cluster.Status.NamespaceName == "" {
+		return false, nil
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := seedClient.Get(r.ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: name}, deployment); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get deployment %s/%s: %v", cluster.Status.NamespaceName, name, err)
+	}
+
+	if !deploymentRolledOut(deployment) {
+		return false, nil
+	}
+	if cluster.IsOpenshift() {
+		return true, nil
+	}
+	for _, container := range deployment.Spec.Template.Spec.Containers {
+		if strings.HasSuffix(container.Image, ":v"+version) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.Replicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
+
+// deletionStatus follows the deletion of the cluster until it is gone. Clusters which are soft-deleted are
+// kept hibernated for the grace period, for them the deletion is done once the cluster is hibernated.
+func deletionStatus(cluster *kubermaticv1.Cluster) kubermaticv1.OperationStatus {
+	if cluster == nil {
+		return newStatus(
+			step("DeletionStarted", true),
+			step("ResourcesCleanedUp", true),
+			step("ClusterRemoved", true),
+		)
+	}
+
+	if cluster.IsSoftDeleted() && cluster.DeletionTimestamp == nil {
+		status := newStatus(
+			step("DeletionStarted", true),
+			step("ClusterHibernated", cluster.Spec.Hibernated),
+		)
+		status.CurrentCondition = fmt.Sprintf("the cluster is kept hibernated until %s", cluster.Spec.SoftDeletion.DeleteAt.UTC().Format(time.RFC3339))
+		return status
+	}
+
+	deleting := cluster.DeletionTimestamp != nil
+	status := newStatus(
+		step("DeletionStarted", deleting),
+		step("ResourcesCleanedUp", deleting && len(cluster.Finalizers) == 0),
+		step("ClusterRemoved", false),
+	)
+	setClusterDetails(&status, cluster)
+	return status
+}
+
+func step(name string, done bool) kubermaticv1.OperationStep {
+	return kubermaticv1.OperationStep{Name: name, Done: done}
+}
+
+// newStatus calculates the percentage and the phase from the steps, the operation succeeded once all steps are done
+func newStatus(steps ...kubermaticv1.OperationStep) kubermaticv1.OperationStatus {
+	done := 0
+	for _, s := range steps {
+		if s.Done {
+			done++
+		}
+	}
+
+	status := kubermaticv1.OperationStatus{
+		Phase:      kubermaticv1.OperationPhaseRunning,
+		Steps:      steps,
+		Percentage: 100,
+	}
+	if len(steps) > 0 {
+		status.Percentage = done * 100 / len(steps)
+	}
+	if done == len(steps) {
+		status.Phase = kubermaticv1.OperationPhaseSucceeded
+	}
+	return status
+}
+
+// setClusterDetails sets the condition which changed last and the errors of the cluster
+func setClusterDetails(status *kubermaticv1.OperationStatus, cluster *kubermaticv1.Cluster) {
+	var latest *kubermaticv1.ClusterCondition
+	for i, condition := range cluster.Status.Conditions {
+		if latest == nil || condition.LastTransitionTime.After(latest.LastTransitionTime.Time) {
+			latest = &cluster.Status.Conditions[i]
+		}
+		if condition.Status == corev1.ConditionFalse && condition.Message != "" {
+			status.Errors = append(status.Errors, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+		}
+	}
+	if latest != nil {
+		status.CurrentCondition = strings.TrimSpace(fmt.Sprintf("%s: %s %s", latest.Type, latest.Reason, latest.Message))
+	}
+
+	if cluster.Status.ErrorMessage != nil && *cluster.Status.ErrorMessage != "" {
+		status.Errors = append([]string{*cluster.Status.ErrorMessage}, status.Errors...)
+	}
+}
+
+func hasCondition(cluster *kubermaticv1.Cluster, conditionType kubermaticv1.ClusterConditionType) bool {
+	for _, condition := range cluster.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
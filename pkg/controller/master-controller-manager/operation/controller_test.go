@@ -0,0 +1,346 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/semver"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/diff"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	testSeed      = "test-seed"
+	testCluster   = "test-cluster"
+	testOperation = "test-operation"
+	testNamespace = "cluster-test-cluster"
+)
+
+func genOperation(created time.Time, operationType kubermaticv1.OperationType, version string) *kubermaticv1.Operation {
+	return &kubermaticv1.Operation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              testOperation,
+			Labels:            map[string]string{kubermaticv1.OperationClusterLabelKey: testCluster},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: kubermaticv1.OperationSpec{
+			Type:      operationType,
+			ProjectID: "test-project",
+			ClusterID: testCluster,
+			Version:   version,
+			UserEmail: "bob@acme.com",
+		},
+		Status: kubermaticv1.OperationStatus{Phase: kubermaticv1.OperationPhaseRunning},
+	}
+}
+
+func genCluster(version string, modify func(*kubermaticv1.Cluster)) *kubermaticv1.Cluster {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: testCluster},
+		Spec:       kubermaticv1.ClusterSpec{Version: *semver.NewSemverOrDie(version)},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: testNamespace,
+			ExtendedHealth: kubermaticv1.ExtendedClusterHealth{
+				Apiserver:                    kubermaticv1.HealthStatusUp,
+				Scheduler:                    kubermaticv1.HealthStatusUp,
+				Controller:                   kubermaticv1.HealthStatusUp,
+				MachineController:            kubermaticv1.HealthStatusUp,
+				Etcd:                         kubermaticv1.HealthStatusUp,
+				CloudProviderInfrastructure:  kubermaticv1.HealthStatusUp,
+				UserClusterControllerManager: kubermaticv1.HealthStatusUp,
+			},
+			Conditions: []kubermaticv1.ClusterCondition{
+				{Type: kubermaticv1.ClusterConditionSeedResourcesUpToDate, Status: corev1.ConditionTrue, Reason: "ReconcilingSuccess", LastTransitionTime: metav1.NewTime(time.Date(2020, time.October, 10, 11, 0, 0, 0, time.UTC))},
+				{Type: kubermaticv1.ClusterConditionClusterInitialized, Status: corev1.ConditionTrue, Reason: "ClusterInitialized", LastTransitionTime: metav1.NewTime(time.Date(2020, time.October, 10, 10, 0, 0, 0, time.UTC))},
+			},
+		},
+	}
+	if modify != nil {
+		modify(cluster)
+	}
+	return cluster
+}
+
+func genDeployment(name, image string, updatedReplicas int32) *appsv1.Deployment {
+	replicas := int32(2)
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: name, Image: image}}}},
+		},
+		Status: appsv1.DeploymentStatus{Replicas: replicas, UpdatedReplicas: updatedReplicas, AvailableReplicas: replicas},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, time.October, 10, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		operation       *kubermaticv1.Operation
+		seedObjects     []runtime.Object
+		expectedStatus  kubermaticv1.OperationStatus
+		expectedRequeue time.Duration
+	}{
+		{
+			name:      "creation waits for a cluster which is not cached yet",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterCreation, ""),
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase: kubermaticv1.OperationPhaseRunning,
+				Steps: []kubermaticv1.OperationStep{
+					{Name: "EtcdReady"}, {Name: "APIServerReady"}, {Name: "ControllersReady"}, {Name: "CloudProviderInfrastructureReady"},
+					{Name: "MachineControllerReady"}, {Name: "UserClusterControllerManagerReady"}, {Name: "ClusterInitialized"},
+				},
+			},
+			expectedRequeue: requeueInterval,
+		},
+		{
+			name:      "creation reports the progress and the errors of the cluster",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterCreation, ""),
+			seedObjects: []runtime.Object{genCluster("1.18.8", func(c *kubermaticv1.Cluster) {
+				c.Status.ExtendedHealth.MachineController = kubermaticv1.HealthStatusProvisioning
+				c.Status.ExtendedHealth.UserClusterControllerManager = kubermaticv1.HealthStatusDown
+				c.Status.Conditions = []kubermaticv1.ClusterCondition{{
+					Type:               kubermaticv1.ClusterConditionCloudControllerReconcilingSuccess,
+					Status:             corev1.ConditionFalse,
+					Reason:             "ReconcilingError",
+					Message:            "failed to create security group",
+					LastTransitionTime: metav1.NewTime(now.Add(-time.Minute)),
+				}}
+				errorMessage := "failed to reconcile cloud provider"
+				c.Status.ErrorMessage = &errorMessage
+			})},
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase: kubermaticv1.OperationPhaseRunning,
+				Steps: []kubermaticv1.OperationStep{
+					{Name: "EtcdReady", Done: true}, {Name: "APIServerReady", Done: true}, {Name: "ControllersReady", Done: true}, {Name: "CloudProviderInfrastructureReady", Done: true},
+					{Name: "MachineControllerReady"}, {Name: "UserClusterControllerManagerReady"}, {Name: "ClusterInitialized"},
+				},
+				Percentage:       57,
+				CurrentCondition: "CloudControllerReconcilledSuccessfully: ReconcilingError failed to create security group",
+				Errors:           []string{"failed to reconcile cloud provider", "CloudControllerReconcilledSuccessfully: failed to create security group"},
+			},
+			expectedRequeue: requeueInterval,
+		},
+		{
+			name:        "creation succeeds once the cluster is initialized",
+			operation:   genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterCreation, ""),
+			seedObjects: []runtime.Object{genCluster("1.18.8", nil)},
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase: kubermaticv1.OperationPhaseSucceeded,
+				Steps: []kubermaticv1.OperationStep{
+					{Name: "EtcdReady", Done: true}, {Name: "APIServerReady", Done: true}, {Name: "ControllersReady", Done: true}, {Name: "CloudProviderInfrastructureReady", Done: true},
+					{Name: "MachineControllerReady", Done: true}, {Name: "UserClusterControllerManagerReady", Done: true}, {Name: "ClusterInitialized", Done: true},
+				},
+				Percentage:       100,
+				CurrentCondition: "SeedResourcesUpToDate: ReconcilingSuccess",
+				CompletionTime:   &metav1.Time{Time: now},
+			},
+			expectedRequeue: retentionPeriod,
+		},
+		{
+			name:      "upgrade waits for the rollout of the control plane",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterUpgrade, "1.18.8"),
+			seedObjects: []runtime.Object{
+				genCluster("1.18.8", nil),
+				genDeployment(resources.ApiserverDeploymentName, "k8s.gcr.io/kube-apiserver:v1.18.8", 2),
+				genDeployment(resources.ControllerManagerDeploymentName, "k8s.gcr.io/kube-controller-manager:v1.18.8", 1),
+				genDeployment(resources.SchedulerDeploymentName, "k8s.gcr.io/kube-scheduler:v1.17.11", 2),
+			},
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase: kubermaticv1.OperationPhaseRunning,
+				Steps: []kubermaticv1.OperationStep{
+					{Name: "APIServerUpdated", Done: true}, {Name: "ControllerManagerUpdated"}, {Name: "SchedulerUpdated"}, {Name: "ControlPlaneHealthy", Done: true},
+				},
+				Percentage:       50,
+				CurrentCondition: "SeedResourcesUpToDate: ReconcilingSuccess",
+			},
+			expectedRequeue: requeueInterval,
+		},
+		{
+			name:      "upgrade fails if the cluster is gone",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterUpgrade, "1.18.8"),
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase: kubermaticv1.OperationPhaseFailed,
+				Steps: []kubermaticv1.OperationStep{
+					{Name: "APIServerUpdated"}, {Name: "ControllerManagerUpdated"}, {Name: "SchedulerUpdated"}, {Name: "ControlPlaneHealthy"},
+				},
+				Errors:         []string{"the cluster does not exist anymore"},
+				CompletionTime: &metav1.Time{Time: now},
+			},
+			expectedRequeue: retentionPeriod,
+		},
+		{
+			name:      "deletion waits for the cleanup",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterDeletion, ""),
+			seedObjects: []runtime.Object{genCluster("1.18.8", func(c *kubermaticv1.Cluster) {
+				deletionTimestamp := metav1.NewTime(now.Add(-time.Minute))
+				c.DeletionTimestamp = &deletionTimestamp
+				c.Finalizers = []string{"kubermatic.io/cleanup-in-cluster-lb"}
+			})},
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase:            kubermaticv1.OperationPhaseRunning,
+				Steps:            []kubermaticv1.OperationStep{{Name: "DeletionStarted", Done: true}, {Name: "ResourcesCleanedUp"}, {Name: "ClusterRemoved"}},
+				Percentage:       33,
+				CurrentCondition: "SeedResourcesUpToDate: ReconcilingSuccess",
+			},
+			expectedRequeue: requeueInterval,
+		},
+		{
+			name:      "deletion succeeds once the cluster is gone",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterDeletion, ""),
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase:          kubermaticv1.OperationPhaseSucceeded,
+				Steps:          []kubermaticv1.OperationStep{{Name: "DeletionStarted", Done: true}, {Name: "ResourcesCleanedUp", Done: true}, {Name: "ClusterRemoved", Done: true}},
+				Percentage:     100,
+				CompletionTime: &metav1.Time{Time: now},
+			},
+			expectedRequeue: retentionPeriod,
+		},
+		{
+			name:      "soft deletion succeeds once the cluster is hibernated",
+			operation: genOperation(now.Add(-time.Minute), kubermaticv1.OperationTypeClusterDeletion, ""),
+			seedObjects: []runtime.Object{genCluster("1.18.8", func(c *kubermaticv1.Cluster) {
+				c.Spec.Hibernated = true
+				c.Spec.SoftDeletion = &kubermaticv1.ClusterSoftDeletion{DeleteAt: metav1.NewTime(now.Add(24 * time.Hour))}
+			})},
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase:            kubermaticv1.OperationPhaseSucceeded,
+				Steps:            []kubermaticv1.OperationStep{{Name: "DeletionStarted", Done: true}, {Name: "ClusterHibernated", Done: true}},
+				Percentage:       100,
+				CurrentCondition: "the cluster is kept hibernated until 2020-10-11T12:00:00Z",
+				CompletionTime:   &metav1.Time{Time: now},
+			},
+			expectedRequeue: retentionPeriod,
+		},
+		{
+			name:      "operation times out",
+			operation: genOperation(now.Add(-2*time.Hour), kubermaticv1.OperationTypeClusterCreation, ""),
+			seedObjects: []runtime.Object{genCluster("1.18.8", func(c *kubermaticv1.Cluster) {
+				c.Status.Conditions = nil
+			})},
+			expectedStatus: kubermaticv1.OperationStatus{
+				Phase: kubermaticv1.OperationPhaseFailed,
+				Steps: []kubermaticv1.OperationStep{
+					{Name: "EtcdReady", Done: true}, {Name: "APIServerReady", Done: true}, {Name: "ControllersReady", Done: true}, {Name: "CloudProviderInfrastructureReady", Done: true},
+					{Name: "MachineControllerReady", Done: true}, {Name: "UserClusterControllerManagerReady", Done: true}, {Name: "ClusterInitialized"},
+				},
+				Percentage:     85,
+				Errors:         []string{"the operation did not finish within 1h0m0s"},
+				CompletionTime: &metav1.Time{Time: now},
+			},
+			expectedRequeue: retentionPeriod,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			masterClient := ctrlruntimefakeclient.NewFakeClient(tc.operation)
+			r := &Reconciler{
+				ctx:         context.Background(),
+				log:         kubermaticlog.Logger,
+				client:      masterClient,
+				seedClients: map[string]ctrlruntimeclient.Client{testSeed: ctrlruntimefakeclient.NewFakeClient(tc.seedObjects...)},
+				now:         func() time.Time { return now },
+			}
+
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: testOperation}})
+			if err != nil {
+				t.Fatalf("failed to reconcile: %v", err)
+			}
+			if result.RequeueAfter != tc.expectedRequeue {
+				t.Errorf("expected the operation to be requeued after %v, got %v", tc.expectedRequeue, result.RequeueAfter)
+			}
+
+			operation := &kubermaticv1.Operation{}
+			if err := masterClient.Get(context.Background(), types.NamespacedName{Name: testOperation}, operation); err != nil {
+				t.Fatalf("failed to get operation: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(tc.expectedStatus, operation.Status) {
+				t.Errorf("unexpected status: %v", diff.ObjectDiff(tc.expectedStatus, operation.Status))
+			}
+		})
+	}
+}
+
+func TestGarbageCollection(t *testing.T) {
+	now := time.Date(2020, time.October, 10, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name            string
+		completed       time.Time
+		expectDeleted   bool
+		expectedRequeue time.Duration
+	}{
+		{
+			name:            "recently finished operation is kept",
+			completed:       now.Add(-24 * time.Hour),
+			expectedRequeue: retentionPeriod - 24*time.Hour,
+		},
+		{
+			name:          "operation is deleted after the retention period",
+			completed:     now.Add(-retentionPeriod - time.Minute),
+			expectDeleted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			operation := genOperation(tc.completed.Add(-time.Hour), kubermaticv1.OperationTypeClusterDeletion, "")
+			operation.Status.Phase = kubermaticv1.OperationPhaseSucceeded
+			operation.Status.CompletionTime = &metav1.Time{Time: tc.completed}
+			masterClient := ctrlruntimefakeclient.NewFakeClient(operation)
+			r := &Reconciler{
+				ctx:         context.Background(),
+				log:         kubermaticlog.Logger,
+				client:      masterClient,
+				seedClients: map[string]ctrlruntimeclient.Client{},
+				now:         func() time.Time { return now },
+			}
+
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: testOperation}})
+			if err != nil {
+				t.Fatalf("failed to reconcile: %v", err)
+			}
+			if result.RequeueAfter != tc.expectedRequeue {
+				t.Errorf("expected the operation to be requeued after %v, got %v", tc.expectedRequeue, result.RequeueAfter)
+			}
+
+			err = masterClient.Get(context.Background(), types.NamespacedName{Name: testOperation}, &kubermaticv1.Operation{})
+			if deleted := kerrors.IsNotFound(err); deleted != tc.expectDeleted {
+				t.Errorf("expected the operation to be deleted: %v, got error %v", tc.expectDeleted, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package operation contains a controller that tracks the progress of asynchronous cluster actions.
+The API creates an Operation for every cluster creation, upgrade and deletion. The controller looks
+up the cluster in all seeds and derives the finished steps, the current condition and the errors of
+the operation from the cluster status until the operation succeeds, fails or times out.
+Finished operations are garbage collected after a retention period.
+*/
+package operation
@@ -0,0 +1,473 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedcloudresource
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	providerconfig "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "orphaned_cloud_resource_controller"
+
+	// sweepInterval is the time between two searches with the credentials of a preset
+	sweepInterval = time.Hour
+)
+
+var (
+	orphanedResourcesMetric = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "kubermatic",
+		Subsystem: "master_controller_manager",
+		Name:      "orphaned_cloud_resources",
+		Help:      "The number of resources at the cloud providers whose cluster does not exist anymore",
+	}, []string{"preset", "provider", "kind"})
+	deletedResourcesMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "kubermatic",
+		Subsystem: "master_controller_manager",
+		Name:      "orphaned_cloud_resources_deleted_total",
+		Help:      "The number of orphaned resources which were deleted at the cloud providers",
+	}, []string{"provider", "kind"})
+)
+
+func init() {
+	prometheus.MustRegister(orphanedResourcesMetric, deletedResourcesMetric)
+}
+
+// sweeperGetter returns the sweeper for the given datacenter, nil is returned if the provider of the datacenter does not support sweeping
+type sweeperGetter func(datacenter *kubermaticv1.Datacenter) (provider.CloudResourceSweeper, error)
+
+// Reconciler looks for orphaned cloud resources with the credentials of a preset
+type Reconciler struct {
+	ctx         context.Context
+	log         *zap.SugaredLogger
+	client      ctrlruntimeclient.Client
+	seedClients map[string]ctrlruntimeclient.Client
+	seedsGetter provider.SeedsGetter
+	// deletionGracePeriod is the time after which orphaned resources get deleted, they are only reported if it is zero
+	deletionGracePeriod time.Duration
+	sweeperGetter       sweeperGetter
+	now                 func() time.Time
+}
+
+func Add(
+	ctx context.Context,
+	mgr manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	seedsGetter provider.SeedsGetter,
+	workerName string,
+	deletionGracePeriod time.Duration,
+) error {
+	reconciler := &Reconciler{
+		ctx:                 ctx,
+		log:                 log.Named(ControllerName),
+		client:              mgr.GetClient(),
+		seedClients:         map[string]ctrlruntimeclient.Client{},
+		seedsGetter:         seedsGetter,
+		deletionGracePeriod: deletionGracePeriod,
+		sweeperGetter:       getSweeper,
+		now:                 time.Now,
+	}
+	for seedName, seedManager := range seedManagers {
+		reconciler.seedClients[seedName] = seedManager.GetClient()
+	}
+
+	// The cloud provider APIs are called sequentially, the presets are processed one at a time to not hit any rate limits
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: 1})
+	if err != nil {
+		return fmt.Errorf("failed to construct controller: %v", err)
+	}
+
+	if err := c.Watch(&source.Kind{Type: &kubermaticv1.Preset{}}, &handler.EnqueueRequestForObject{}, workerlabel.Predicates(workerName)); err != nil {
+		return fmt.Errorf("failed to establish watch for presets: %v", err)
+	}
+
+	return nil
+}
+
+func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("preset", request.Name)
+	log.Debug("Processing")
+
+	err := r.reconcile(log, request.Name)
+	if controllerutil.IsCacheNotStarted(err) {
+		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if err != nil {
+		log.Errorw("Reconciliation failed", zap.Error(err))
+		return reconcile.Result{}, err
+	}
+	// Resources get orphaned without the preset changing, so the search is repeated periodically
+	return reconcile.Result{RequeueAfter: sweepInterval}, nil
+}
+
+// orphan is a resource found with the credentials of the preset whose cluster does not exist
+type orphan struct {
+	name     string
+	spec     kubermaticv1.OrphanedCloudResourceSpec
+	cloud    kubermaticv1.CloudSpec
+	sweeper  provider.CloudResourceSweeper
+	resource provider.ClusterCloudResource
+}
+
+func (r *Reconciler) reconcile(log *zap.SugaredLogger, presetName string) error {
+	records, err := r.listRecords(presetName)
+	if err != nil {
+		return err
+	}
+
+	preset := &kubermaticv1.Preset{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: presetName}, preset); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get preset: %v", err)
+		}
+		// Without the credentials the resources can neither be checked nor deleted
+		for i := range records {
+			if err := r.client.Delete(r.ctx, &records[i]); err != nil && !kerrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete orphaned cloud resource %s: %v", records[i].Name, err)
+			}
+		}
+		return r.updateMetrics()
+	}
+
+	seeds, err := r.seedsGetter()
+	if err != nil {
+		return fmt.Errorf("failed to get seeds: %v", err)
+	}
+
+	// The list of clusters must be complete, otherwise the resources of existing clusters are taken for orphans.
+	// Unreachable seeds have no client, the sweep is skipped until all of them are back.
+	clusterNames := map[string]bool{}
+	for _, seedName := range sortedKeys(seeds) {
+		seedClient, ok := r.seedClients[seedName]
+		if !ok {
+			return fmt.Errorf("seed %s is not reachable, the clusters of all seeds are required to find orphaned resources", seedName)
+		}
+		clusters := &kubermaticv1.ClusterList{}
+		if err := seedClient.List(r.ctx, clusters); err != nil {
+			return fmt.Errorf("failed to list clusters in seed %s: %v", seedName, err)
+		}
+		for _, cluster := range clusters.Items {
+			clusterNames[cluster.Name] = true
+		}
+	}
+
+	projects := &kubermaticv1.ProjectList{}
+	if err := r.client.List(r.ctx, projects); err != nil {
+		return fmt.Errorf("failed to list projects: %v", err)
+	}
+	projectIDs := map[string]bool{}
+	for _, project := range projects.Items {
+		projectIDs[project.Name] = true
+	}
+
+	orphans, listErr := r.findOrphans(log, preset, seeds, clusterNames, projectIDs)
+
+	found := map[string]bool{}
+	for _, o := range orphans {
+		found[o.name] = true
+		if err := r.processOrphan(log, o); err != nil {
+			return err
+		}
+	}
+
+	// If the resources could not be listed in all datacenters, missing resources might still exist
+	if listErr == nil {
+		for i := range records {
+			if found[records[i].Name] {
+				continue
+			}
+			if err := r.client.Delete(r.ctx, &records[i]); err != nil && !kerrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete orphaned cloud resource %s: %v", records[i].Name, err)
+			}
+		}
+	}
+
+	if err := r.updateMetrics(); err != nil {
+		return err
+	}
+	return listErr
+}
+
+// findOrphans lists the resources of all datacenters the preset has credentials for. The resources of the
+// datacenters which can be listed are returned even if listing others failed. Resources whose owner is unknown
+// are never orphans: they have no cluster name or the project they were created for does not exist in this installation.
+func (r *Reconciler) findOrphans(log *zap.SugaredLogger, preset *kubermaticv1.Preset, seeds map[string]*kubermaticv1.Seed, clusterNames, projectIDs map[string]bool) ([]orphan, error) {
+	var orphans []orphan
+	var errs []string
+	seen := map[string]bool{}
+	for _, seedName := range sortedKeys(seeds) {
+		datacenters := seeds[seedName].Spec.Datacenters
+		dcNames := make([]string, 0, len(datacenters))
+		for dcName := range datacenters {
+			dcNames = append(dcNames, dcName)
+		}
+		sort.Strings(dcNames)
+
+		for _, dcName := range dcNames {
+			datacenter := datacenters[dcName]
+			cloudSpec := presetCloudSpec(preset, dcName, &datacenter)
+			if cloudSpec == nil {
+				continue
+			}
+			sweeper, err := r.sweeperGetter(&datacenter)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to get cloud provider for datacenter %s: %v", dcName, err))
+				continue
+			}
+			if sweeper == nil {
+				continue
+			}
+			providerName, err := provider.DatacenterCloudProviderName(&datacenter.Spec)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to get cloud provider name for datacenter %s: %v", dcName, err))
+				continue
+			}
+
+			resources, err := sweeper.ListClusterResources(*cloudSpec)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("failed to list resources in datacenter %s: %v", dcName, err))
+				continue
+			}
+			for _, resource := range resources {
+				if resource.ClusterName == "" || clusterNames[resource.ClusterName] {
+					continue
+				}
+				if resource.ProjectID != "" && !projectIDs[resource.ProjectID] {
+					continue
+				}
+				name := recordName(preset.Name, providerName, resource)
+				// Resources which are not bound to a region are found in every datacenter
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+				log.Debugw("Found orphaned cloud resource", "datacenter", dcName, "kind", resource.Kind, "id", resource.ID, "cluster", resource.ClusterName)
+				orphans = append(orphans, orphan{
+					name: name,
+					spec: kubermaticv1.OrphanedCloudResourceSpec{
+						Provider:     providerName,
+						Preset:       preset.Name,
+						Datacenter:   dcName,
+						Kind:         resource.Kind,
+						ID:           resource.ID,
+						ResourceName: resource.Name,
+						ClusterName:  resource.ClusterName,
+					},
+					cloud:    *cloudSpec,
+					sweeper:  sweeper,
+					resource: resource,
+				})
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return orphans, errors.New(strings.Join(errs, ", "))
+	}
+	return orphans, nil
+}
+
+// processOrphan records the orphaned resource and deletes it once the grace period is over
+func (r *Reconciler) processOrphan(log *zap.SugaredLogger, o orphan) error {
+	now := metav1.NewTime(r.now())
+
+	record := &kubermaticv1.OrphanedCloudResource{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: o.name}, record); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get orphaned cloud resource %s: %v", o.name, err)
+		}
+		record = &kubermaticv1.OrphanedCloudResource{
+			ObjectMeta: metav1.ObjectMeta{Name: o.name},
+			Spec:       o.spec,
+			Status:     kubermaticv1.OrphanedCloudResourceStatus{FirstSeen: now},
+		}
+		if err := r.client.Create(r.ctx, record); err != nil {
+			return fmt.Errorf("failed to create orphaned cloud resource %s: %v", o.name, err)
+		}
+	}
+
+	oldRecord := record.DeepCopy()
+	record.Status.LastSeen = now
+
+	if r.deletionGracePeriod > 0 && now.Sub(record.Status.FirstSeen.Time) >= r.deletionGracePeriod {
+		log := log.With("kind", o.resource.Kind, "id", o.resource.ID, "cluster", o.resource.ClusterName)
+		log.Info("Deleting orphaned cloud resource")
+		if err := o.sweeper.DeleteClusterResource(o.cloud, o.resource); err != nil {
+			log.Infow("Failed to delete orphaned cloud resource", zap.Error(err))
+			record.Status.DeletionError = err.Error()
+		} else {
+			deletedResourcesMetric.WithLabelValues(o.spec.Provider, o.spec.Kind).Inc()
+			if err := r.client.Delete(r.ctx, record); err != nil && !kerrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete orphaned cloud resource %s: %v", o.name, err)
+			}
+			return nil
+		}
+	}
+
+	if err := r.client.Patch(r.ctx, record, ctrlruntimeclient.MergeFrom(oldRecord)); err != nil {
+		return fmt.Errorf("failed to update orphaned cloud resource %s: %v", o.name, err)
+	}
+	return nil
+}
+
+func (r *Reconciler) listRecords(presetName string) ([]kubermaticv1.OrphanedCloudResource, error) {
+	list := &kubermaticv1.OrphanedCloudResourceList{}
+	if err := r.client.List(r.ctx, list); err != nil {
+		return nil, fmt.Errorf("failed to list orphaned cloud resources: %v", err)
+	}
+	var records []kubermaticv1.OrphanedCloudResource
+	for _, record := range list.Items {
+		if record.Spec.Preset == presetName {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// updateMetrics sets the gauge from all recorded resources, so resources of deleted presets vanish as well
+func (r *Reconciler) updateMetrics() error {
+	list := &kubermaticv1.OrphanedCloudResourceList{}
+	if err := r.client.List(r.ctx, list); err != nil {
+		return fmt.Errorf("failed to list orphaned cloud resources: %v", err)
+	}
+	orphanedResourcesMetric.Reset()
+	for _, record := range list.Items {
+		orphanedResourcesMetric.WithLabelValues(record.Spec.Preset, record.Spec.Provider, record.Spec.Kind).Inc()
+	}
+	return nil
+}
+
+// recordName returns a stable name for the record of a resource
+func recordName(presetName, providerName string, resource provider.ClusterCloudResource) string {
+	hash := sha256.Sum256([]byte(strings.Join([]string{presetName, resource.Kind, resource.ID, resource.Name}, "/")))
+	return fmt.Sprintf("%s-%x", providerName, hash[:16])
+}
+
+// presetCloudSpec returns a cloud spec with the credentials of the preset for the given datacenter,
+// nil is returned if the preset has no credentials for the provider of the datacenter
+func presetCloudSpec(preset *kubermaticv1.Preset, dcName string, datacenter *kubermaticv1.Datacenter) *kubermaticv1.CloudSpec {
+	spec := &kubermaticv1.CloudSpec{DatacenterName: dcName}
+
+	switch {
+	case datacenter.Spec.AWS != nil && preset.Spec.AWS != nil:
+		if !matchesDatacenter(preset.Spec.AWS.Datacenter, dcName) {
+			return nil
+		}
+		spec.AWS = &kubermaticv1.AWSCloudSpec{
+			AccessKeyID:     preset.Spec.AWS.AccessKeyID,
+			SecretAccessKey: preset.Spec.AWS.SecretAccessKey,
+		}
+	case datacenter.Spec.Azure != nil && preset.Spec.Azure != nil:
+		if !matchesDatacenter(preset.Spec.Azure.Datacenter, dcName) {
+			return nil
+		}
+		spec.Azure = &kubermaticv1.AzureCloudSpec{
+			TenantID:       preset.Spec.Azure.TenantID,
+			SubscriptionID: preset.Spec.Azure.SubscriptionID,
+			ClientID:       preset.Spec.Azure.ClientID,
+			ClientSecret:   preset.Spec.Azure.ClientSecret,
+		}
+	case datacenter.Spec.GCP != nil && preset.Spec.GCP != nil:
+		if !matchesDatacenter(preset.Spec.GCP.Datacenter, dcName) {
+			return nil
+		}
+		spec.GCP = &kubermaticv1.GCPCloudSpec{
+			ServiceAccount: preset.Spec.GCP.ServiceAccount,
+		}
+	case datacenter.Spec.Openstack != nil && preset.Spec.Openstack != nil:
+		if !matchesDatacenter(preset.Spec.Openstack.Datacenter, dcName) {
+			return nil
+		}
+		spec.Openstack = &kubermaticv1.OpenstackCloudSpec{
+			Username:                    preset.Spec.Openstack.Username,
+			Password:                    preset.Spec.Openstack.Password,
+			Tenant:                      preset.Spec.Openstack.Tenant,
+			TenantID:                    preset.Spec.Openstack.TenantID,
+			Domain:                      preset.Spec.Openstack.Domain,
+			ApplicationCredentialID:     preset.Spec.Openstack.ApplicationCredentialID,
+			ApplicationCredentialSecret: preset.Spec.Openstack.ApplicationCredentialSecret,
+		}
+	case datacenter.Spec.VSphere != nil && preset.Spec.VSphere != nil:
+		if !matchesDatacenter(preset.Spec.VSphere.Datacenter, dcName) {
+			return nil
+		}
+		spec.VSphere = &kubermaticv1.VSphereCloudSpec{
+			Username: preset.Spec.VSphere.Username,
+			Password: preset.Spec.VSphere.Password,
+		}
+	default:
+		return nil
+	}
+	return spec
+}
+
+// matchesDatacenter returns true if a preset limited to the given datacenter can be used in the datacenter
+func matchesDatacenter(presetDatacenter, dcName string) bool {
+	return presetDatacenter == "" || presetDatacenter == dcName
+}
+
+func getSweeper(datacenter *kubermaticv1.Datacenter) (provider.CloudResourceSweeper, error) {
+	cloudProvider, err := cloud.Provider(datacenter, noSecretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	sweeper, ok := cloudProvider.(provider.CloudResourceSweeper)
+	if !ok {
+		return nil, nil
+	}
+	return sweeper, nil
+}
+
+// noSecretKeySelector is passed to the cloud providers, the credentials of presets are always set inline
+func noSecretKeySelector(_ *providerconfig.GlobalSecretKeySelector, _ string) (string, error) {
+	return "", errors.New("presets do not reference secrets")
+}
+
+func sortedKeys(seeds map[string]*kubermaticv1.Seed) []string {
+	keys := make([]string, 0, len(seeds))
+	for key := range seeds {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
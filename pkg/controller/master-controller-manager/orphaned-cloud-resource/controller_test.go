@@ -0,0 +1,275 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphanedcloudresource
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	testSeed   = "test-seed"
+	testPreset = "test-preset"
+)
+
+type fakeSweeper struct {
+	resources []provider.ClusterCloudResource
+	listErr   error
+	deleted   []string
+}
+
+func (s *fakeSweeper) ListClusterResources(spec kubermaticv1.CloudSpec) ([]provider.ClusterCloudResource, error) {
+	if spec.AWS == nil || spec.AWS.AccessKeyID != "key" {
+		return nil, errors.New("unexpected credentials")
+	}
+	return s.resources, s.listErr
+}
+
+func (s *fakeSweeper) DeleteClusterResource(_ kubermaticv1.CloudSpec, resource provider.ClusterCloudResource) error {
+	s.deleted = append(s.deleted, resource.ID)
+	return nil
+}
+
+var (
+	existingResource = provider.ClusterCloudResource{
+		CloudResource: provider.CloudResource{Kind: "SecurityGroup", Name: "kubernetes-existing"},
+		ID:            "sg-1",
+		ClusterName:   "existing",
+	}
+	orphanedResource = provider.ClusterCloudResource{
+		CloudResource: provider.CloudResource{Kind: "SecurityGroup", Name: "kubernetes-deleted"},
+		ID:            "sg-2",
+		ClusterName:   "deleted",
+	}
+	globalResource = provider.ClusterCloudResource{
+		CloudResource: provider.CloudResource{Kind: "Role", Name: "kubernetes-deleted-worker"},
+		ID:            "kubernetes-deleted-worker",
+		ClusterName:   "deleted",
+		ProjectID:     "project",
+	}
+	// resources whose owner is unknown are never orphans
+	unknownClusterResource = provider.ClusterCloudResource{
+		CloudResource: provider.CloudResource{Kind: "Tag", Name: "kubernetes.io/cluster"},
+		ID:            "subnet-1",
+	}
+	foreignProjectResource = provider.ClusterCloudResource{
+		CloudResource: provider.CloudResource{Kind: "SecurityGroup", Name: "kubernetes-foreign"},
+		ID:            "sg-3",
+		ClusterName:   "foreign",
+		ProjectID:     "other-installation",
+	}
+)
+
+func genPreset() *kubermaticv1.Preset {
+	return &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{Name: testPreset},
+		Spec: kubermaticv1.PresetSpec{
+			AWS: &kubermaticv1.AWS{AccessKeyID: "key", SecretAccessKey: "secret"},
+		},
+	}
+}
+
+func genRecord(resource provider.ClusterCloudResource, firstSeen time.Time) *kubermaticv1.OrphanedCloudResource {
+	return &kubermaticv1.OrphanedCloudResource{
+		ObjectMeta: metav1.ObjectMeta{Name: recordName(testPreset, provider.AWSCloudProvider, resource)},
+		Spec: kubermaticv1.OrphanedCloudResourceSpec{
+			Provider:     provider.AWSCloudProvider,
+			Preset:       testPreset,
+			Datacenter:   "aws-eu-central-1a",
+			Kind:         resource.Kind,
+			ID:           resource.ID,
+			ResourceName: resource.Name,
+			ClusterName:  resource.ClusterName,
+		},
+		Status: kubermaticv1.OrphanedCloudResourceStatus{FirstSeen: metav1.NewTime(firstSeen)},
+	}
+}
+
+func seedsGetter() (map[string]*kubermaticv1.Seed, error) {
+	return map[string]*kubermaticv1.Seed{
+		testSeed: {
+			ObjectMeta: metav1.ObjectMeta{Name: testSeed},
+			Spec: kubermaticv1.SeedSpec{
+				Datacenters: map[string]kubermaticv1.Datacenter{
+					"aws-eu-central-1a": {Spec: kubermaticv1.DatacenterSpec{AWS: &kubermaticv1.DatacenterSpecAWS{Region: "eu-central-1"}}},
+					"aws-eu-west-1a":    {Spec: kubermaticv1.DatacenterSpec{AWS: &kubermaticv1.DatacenterSpecAWS{Region: "eu-west-1"}}},
+					"hetzner-fsn1":      {Spec: kubermaticv1.DatacenterSpec{Hetzner: &kubermaticv1.DatacenterSpecHetzner{Datacenter: "fsn1"}}},
+				},
+			},
+		}}, nil
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, time.October, 10, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                string
+		masterObjects       []runtime.Object
+		deletionGracePeriod time.Duration
+		listErr             error
+		seedUnreachable     bool
+		expectedRecords     map[string]time.Time
+		expectedDeleted     []string
+	}{
+		{
+			name:          "orphaned resources are recorded once",
+			masterObjects: []runtime.Object{genPreset()},
+			expectedRecords: map[string]time.Time{
+				recordName(testPreset, provider.AWSCloudProvider, orphanedResource): now,
+				recordName(testPreset, provider.AWSCloudProvider, globalResource):   now,
+			},
+		},
+		{
+			name: "records of vanished resources are removed and first seen is kept",
+			masterObjects: []runtime.Object{
+				genPreset(),
+				genRecord(orphanedResource, now.Add(-time.Hour)),
+				genRecord(existingResource, now.Add(-time.Hour)),
+			},
+			expectedRecords: map[string]time.Time{
+				recordName(testPreset, provider.AWSCloudProvider, orphanedResource): now.Add(-time.Hour),
+				recordName(testPreset, provider.AWSCloudProvider, globalResource):   now,
+			},
+		},
+		{
+			name: "records are kept if listing the resources failed",
+			masterObjects: []runtime.Object{
+				genPreset(),
+				genRecord(existingResource, now.Add(-time.Hour)),
+			},
+			listErr: errors.New("rate limited"),
+			expectedRecords: map[string]time.Time{
+				recordName(testPreset, provider.AWSCloudProvider, existingResource): now.Add(-time.Hour),
+			},
+		},
+		{
+			name: "resources are deleted after the grace period",
+			masterObjects: []runtime.Object{
+				genPreset(),
+				genRecord(orphanedResource, now.Add(-25*time.Hour)),
+			},
+			deletionGracePeriod: 24 * time.Hour,
+			expectedRecords: map[string]time.Time{
+				recordName(testPreset, provider.AWSCloudProvider, globalResource): now,
+			},
+			expectedDeleted: []string{"sg-2"},
+		},
+		{
+			name: "nothing is swept while a seed is unreachable",
+			masterObjects: []runtime.Object{
+				genPreset(),
+				genRecord(orphanedResource, now.Add(-25*time.Hour)),
+			},
+			deletionGracePeriod: 24 * time.Hour,
+			seedUnreachable:     true,
+			expectedRecords: map[string]time.Time{
+				recordName(testPreset, provider.AWSCloudProvider, orphanedResource): now.Add(-25 * time.Hour),
+			},
+		},
+		{
+			name: "records of deleted presets are removed",
+			masterObjects: []runtime.Object{
+				genRecord(orphanedResource, now.Add(-time.Hour)),
+			},
+			expectedRecords: map[string]time.Time{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			masterObjects := append([]runtime.Object{&kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}}}, tc.masterObjects...)
+			masterClient := ctrlruntimefakeclient.NewFakeClient(masterObjects...)
+			seedClient := ctrlruntimefakeclient.NewFakeClient(&kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "existing"}})
+			sweeper := &fakeSweeper{listErr: tc.listErr}
+			if tc.listErr == nil {
+				sweeper.resources = []provider.ClusterCloudResource{existingResource, orphanedResource, globalResource, unknownClusterResource, foreignProjectResource}
+			}
+			seedClients := map[string]ctrlruntimeclient.Client{testSeed: seedClient}
+			if tc.seedUnreachable {
+				seedClients = map[string]ctrlruntimeclient.Client{}
+			}
+
+			r := &Reconciler{
+				ctx:                 context.Background(),
+				log:                 kubermaticlog.Logger,
+				client:              masterClient,
+				seedClients:         seedClients,
+				seedsGetter:         seedsGetter,
+				deletionGracePeriod: tc.deletionGracePeriod,
+				sweeperGetter: func(datacenter *kubermaticv1.Datacenter) (provider.CloudResourceSweeper, error) {
+					if datacenter.Spec.AWS == nil {
+						return nil, nil
+					}
+					return sweeper, nil
+				},
+				now: func() time.Time { return now },
+			}
+
+			_, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: testPreset}})
+			expectErr := tc.listErr != nil || tc.seedUnreachable
+			if (err != nil) != expectErr {
+				t.Fatalf("unexpected reconcile error: %v", err)
+			}
+
+			records := &kubermaticv1.OrphanedCloudResourceList{}
+			if err := masterClient.List(context.Background(), records); err != nil {
+				t.Fatalf("failed to list records: %v", err)
+			}
+			if len(records.Items) != len(tc.expectedRecords) {
+				t.Fatalf("expected %d records, got %d: %+v", len(tc.expectedRecords), len(records.Items), records.Items)
+			}
+			for _, record := range records.Items {
+				firstSeen, ok := tc.expectedRecords[record.Name]
+				if !ok {
+					t.Errorf("unexpected record %s for %s", record.Name, record.Spec.ResourceName)
+					continue
+				}
+				if !record.Status.FirstSeen.Time.Equal(firstSeen) {
+					t.Errorf("expected record %s to be first seen at %v, got %v", record.Name, firstSeen, record.Status.FirstSeen)
+				}
+				if !expectErr && !record.Status.LastSeen.Time.Equal(now) {
+					t.Errorf("expected record %s to be last seen at %v, got %v", record.Name, now, record.Status.LastSeen)
+				}
+				if record.Spec.Datacenter != "aws-eu-central-1a" {
+					t.Errorf("expected record %s to be reported for the first datacenter, got %s", record.Name, record.Spec.Datacenter)
+				}
+			}
+
+			if len(sweeper.deleted) != len(tc.expectedDeleted) {
+				t.Fatalf("expected %v to be deleted, got %v", tc.expectedDeleted, sweeper.deleted)
+			}
+			for i := range tc.expectedDeleted {
+				if sweeper.deleted[i] != tc.expectedDeleted[i] {
+					t.Errorf("expected %v to be deleted, got %v", tc.expectedDeleted, sweeper.deleted)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package orphanedcloudresource contains a controller that finds resources at the cloud providers which were left
+behind by failed cluster deletions. With the credentials of every preset it lists the resources carrying the
+tag or the name of a cluster in all matching datacenters and records those whose cluster does not exist in any
+seed as OrphanedCloudResource. The records are exposed by the admin API and as metrics. If a deletion grace
+period is configured, the resources get deleted once they have been orphaned for longer than the grace period.
+Resources whose owner is unknown are left alone, like resources without a cluster or of projects which do not
+exist in this installation. Nothing is swept while a seed is unreachable, as its clusters can not be listed.
+Only presets stored as custom resources are considered.
+*/
+package orphanedcloudresource
@@ -0,0 +1,238 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownerbackfill
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	SSHKeyControllerName         = "kubermatic_ssh_key_owner_backfill_controller"
+	ServiceAccountControllerName = "kubermatic_service_account_creator_backfill_controller"
+)
+
+func Add(ctx context.Context, mgr manager.Manager, log *zap.SugaredLogger) error {
+	sshKeyLog := log.Named(SSHKeyControllerName)
+	sshKeyController, err := controller.New(SSHKeyControllerName, mgr, controller.Options{Reconciler: &sshKeyReconciler{
+		ctx:      ctx,
+		log:      sshKeyLog,
+		client:   mgr.GetClient(),
+		recorder: mgr.GetEventRecorderFor(SSHKeyControllerName),
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to construct ssh key controller: %v", err)
+	}
+	withoutOwner := predicateutil.Factory(func(m metav1.Object, _ runtime.Object) bool {
+		key, ok := m.(*kubermaticv1.UserSSHKey)
+		return ok && key.Spec.Owner == ""
+	})
+	if err := sshKeyController.Watch(&source.Kind{Type: &kubermaticv1.UserSSHKey{}}, &handler.EnqueueRequestForObject{}, withoutOwner); err != nil {
+		return fmt.Errorf("failed to watch ssh keys: %v", err)
+	}
+
+	serviceAccountLog := log.Named(ServiceAccountControllerName)
+	serviceAccountController, err := controller.New(ServiceAccountControllerName, mgr, controller.Options{Reconciler: &serviceAccountReconciler{
+		ctx:      ctx,
+		log:      serviceAccountLog,
+		client:   mgr.GetClient(),
+		recorder: mgr.GetEventRecorderFor(ServiceAccountControllerName),
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to construct service account controller: %v", err)
+	}
+	withoutCreator := predicateutil.Factory(func(m metav1.Object, _ runtime.Object) bool {
+		_, hasCreator := m.GetAnnotations()[kubernetesprovider.ServiceAccountAnnotationCreator]
+		return kubernetesprovider.IsServiceAccount(m.GetName()) && !hasCreator
+	})
+	if err := serviceAccountController.Watch(&source.Kind{Type: &kubermaticv1.User{}}, &handler.EnqueueRequestForObject{}, withoutCreator); err != nil {
+		return fmt.Errorf("failed to watch users: %v", err)
+	}
+
+	return nil
+}
+
+// sshKeyReconciler records the owner of ssh keys created before the owner was tracked
+type sshKeyReconciler struct {
+	ctx      context.Context
+	log      *zap.SugaredLogger
+	client   ctrlruntimeclient.Client
+	recorder record.EventRecorder
+}
+
+func (r *sshKeyReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	key := &kubermaticv1.UserSSHKey{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, key); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if key.Spec.Owner != "" || key.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	owner, reason, err := attribute(r.ctx, r.client, key)
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+		return reconcile.Result{}, err
+	}
+	if owner == "" {
+		log.Infow("The owner of the ssh key can not be determined", "reason", reason)
+		r.recorder.Eventf(key, corev1.EventTypeWarning, "OwnerUnknown", "The owner can not be determined because %s, set the %s annotation to the email of the owner", reason, kubernetesprovider.ServiceAccountAnnotationCreator)
+		return reconcile.Result{}, nil
+	}
+
+	oldKey := key.DeepCopy()
+	key.Spec.Owner = owner
+	if err := r.client.Patch(r.ctx, key, ctrlruntimeclient.MergeFrom(oldKey)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to set the owner of the ssh key: %v", err)
+	}
+	log.Infow("Recorded the owner of the ssh key", "owner", owner)
+	return reconcile.Result{}, nil
+}
+
+// serviceAccountReconciler records the creator of service accounts created before the creator was tracked
+type serviceAccountReconciler struct {
+	ctx      context.Context
+	log      *zap.SugaredLogger
+	client   ctrlruntimeclient.Client
+	recorder record.EventRecorder
+}
+
+func (r *serviceAccountReconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	sa := &kubermaticv1.User{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, sa); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if _, ok := sa.Annotations[kubernetesprovider.ServiceAccountAnnotationCreator]; ok || sa.DeletionTimestamp != nil ||
+		!kubernetesprovider.IsServiceAccount(sa.Spec.Email) {
+		return reconcile.Result{}, nil
+	}
+
+	creator, reason, err := attribute(r.ctx, r.client, sa)
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+		return reconcile.Result{}, err
+	}
+	if creator == "" {
+		log.Infow("The creator of the service account can not be determined", "reason", reason)
+		r.recorder.Eventf(sa, corev1.EventTypeWarning, "CreatorUnknown", "The creator can not be determined because %s, set the %s annotation to the email of the creator", reason, kubernetesprovider.ServiceAccountAnnotationCreator)
+		return reconcile.Result{}, nil
+	}
+
+	oldSA := sa.DeepCopy()
+	if sa.Annotations == nil {
+		sa.Annotations = map[string]string{}
+	}
+	sa.Annotations[kubernetesprovider.ServiceAccountAnnotationCreator] = creator
+	if err := r.client.Patch(r.ctx, sa, ctrlruntimeclient.MergeFrom(oldSA)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to set the creator of the service account: %v", err)
+	}
+	log.Infow("Recorded the creator of the service account", "creator", creator)
+	return reconcile.Result{}, nil
+}
+
+// projectOf returns the name of the project the given object belongs to
+func projectOf(obj metav1.Object) string {
+	for _, owner := range obj.GetOwnerReferences() {
+		if owner.APIVersion == kubermaticv1.SchemeGroupVersion.String() && owner.Kind == kubermaticv1.ProjectKindName {
+			return owner.Name
+		}
+	}
+	return obj.GetLabels()[kubermaticv1.ProjectIDLabelKey]
+}
+
+// attribute returns the email of the user who most likely created the given object of the given project.
+// An email set by an admin in the creator annotation takes precedence, otherwise the creator of the project
+// is used as long as they are still a member of it. If the object can't be attributed, an empty email and
+// the reason are returned.
+func attribute(ctx context.Context, client ctrlruntimeclient.Client, obj metav1.Object) (string, string, error) {
+	if creator := obj.GetAnnotations()[kubernetesprovider.ServiceAccountAnnotationCreator]; creator != "" {
+		return creator, "", nil
+	}
+
+	projectName := projectOf(obj)
+	if projectName == "" {
+		return "", "it doesn't belong to a project", nil
+	}
+	project := &kubermaticv1.Project{}
+	if err := client.Get(ctx, types.NamespacedName{Name: projectName}, project); err != nil {
+		if kerrors.IsNotFound(err) {
+			return "", fmt.Sprintf("the project %s doesn't exist", projectName), nil
+		}
+		return "", "", fmt.Errorf("failed to get project: %v", err)
+	}
+
+	creator := &kubermaticv1.User{}
+	found := false
+	for _, owner := range project.OwnerReferences {
+		if owner.APIVersion == kubermaticv1.SchemeGroupVersion.String() && owner.Kind == kubermaticv1.UserKindName {
+			if err := client.Get(ctx, types.NamespacedName{Name: owner.Name}, creator); err != nil {
+				if kerrors.IsNotFound(err) {
+					return "", fmt.Sprintf("the creator of the project %s doesn't exist anymore", projectName), nil
+				}
+				return "", "", fmt.Errorf("failed to get the creator of the project: %v", err)
+			}
+			found = true
+			break
+		}
+	}
+	if !found {
+		return "", fmt.Sprintf("the creator of the project %s is unknown", projectName), nil
+	}
+
+	bindings := &kubermaticv1.UserProjectBindingList{}
+	if err := client.List(ctx, bindings); err != nil {
+		return "", "", fmt.Errorf("failed to list user project bindings: %v", err)
+	}
+	for _, binding := range bindings.Items {
+		if binding.Spec.ProjectID == projectName && strings.EqualFold(binding.Spec.UserEmail, creator.Spec.Email) {
+			return creator.Spec.Email, "", nil
+		}
+	}
+	return "", fmt.Sprintf("the creator of the project %s is no longer a member of it", projectName), nil
+}
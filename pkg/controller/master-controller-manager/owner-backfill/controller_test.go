@@ -0,0 +1,220 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ownerbackfill
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcileSSHKey(t *testing.T) {
+	testCases := []struct {
+		name          string
+		key           *kubermaticv1.UserSSHKey
+		objects       []runtime.Object
+		expectedOwner string
+		expectEvent   bool
+	}{
+		{
+			name:          "scenario 1: the creator of the project becomes the owner",
+			key:           genSSHKey("", nil),
+			objects:       []runtime.Object{genProject("alice"), genUser("alice"), genUser("bob"), genBinding("alice@acme.com"), genBinding("bob@acme.com")},
+			expectedOwner: "alice@acme.com",
+		},
+		{
+			name:        "scenario 2: the owner is not set if the creator of the project left it",
+			key:         genSSHKey("", nil),
+			objects:     []runtime.Object{genProject("alice"), genUser("alice"), genUser("bob"), genBinding("bob@acme.com")},
+			expectEvent: true,
+		},
+		{
+			name:          "scenario 3: the owner set by an admin in the creator annotation is used",
+			key:           genSSHKey("", map[string]string{kubernetesprovider.ServiceAccountAnnotationCreator: "bob@acme.com"}),
+			objects:       []runtime.Object{genProject("alice"), genUser("alice"), genUser("bob"), genBinding("alice@acme.com"), genBinding("bob@acme.com")},
+			expectedOwner: "bob@acme.com",
+		},
+		{
+			name:          "scenario 4: an existing owner is kept",
+			key:           genSSHKey("bob@acme.com", nil),
+			objects:       []runtime.Object{genProject("alice"), genUser("alice"), genBinding("alice@acme.com")},
+			expectedOwner: "bob@acme.com",
+		},
+		{
+			name:        "scenario 5: the owner is not set if the project has no creator",
+			key:         genSSHKey("", nil),
+			objects:     []runtime.Object{genProject(""), genUser("alice"), genBinding("alice@acme.com")},
+			expectEvent: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(scheme.Scheme, append(tc.objects, tc.key)...)
+			recorder := record.NewFakeRecorder(10)
+			r := &sshKeyReconciler{
+				ctx:      context.Background(),
+				log:      kubermaticlog.Logger,
+				client:   client,
+				recorder: recorder,
+			}
+			if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.key.Name}}); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			key := &kubermaticv1.UserSSHKey{}
+			if err := client.Get(context.Background(), types.NamespacedName{Name: tc.key.Name}, key); err != nil {
+				t.Fatalf("failed to get ssh key: %v", err)
+			}
+			if key.Spec.Owner != tc.expectedOwner {
+				t.Errorf("expected owner %q, got %q", tc.expectedOwner, key.Spec.Owner)
+			}
+			if hasEvent := len(recorder.Events) > 0; hasEvent != tc.expectEvent {
+				t.Errorf("expected an event to be recorded = %v, got %v", tc.expectEvent, hasEvent)
+			}
+		})
+	}
+}
+
+func TestReconcileServiceAccount(t *testing.T) {
+	testCases := []struct {
+		name            string
+		sa              *kubermaticv1.User
+		objects         []runtime.Object
+		expectedCreator string
+		expectEvent     bool
+	}{
+		{
+			name:            "scenario 1: the creator of the project becomes the creator",
+			sa:              genServiceAccount(nil),
+			objects:         []runtime.Object{genProject("alice"), genUser("alice"), genUser("bob"), genBinding("alice@acme.com"), genBinding("bob@acme.com"), genBinding("serviceaccount-abcd@localhost")},
+			expectedCreator: "alice@acme.com",
+		},
+		{
+			name:        "scenario 2: the creator is not set if the creator of the project left it",
+			sa:          genServiceAccount(nil),
+			objects:     []runtime.Object{genProject("alice"), genUser("alice"), genUser("bob"), genBinding("bob@acme.com")},
+			expectEvent: true,
+		},
+		{
+			name:            "scenario 3: an existing creator is kept",
+			sa:              genServiceAccount(map[string]string{kubernetesprovider.ServiceAccountAnnotationCreator: "bob@acme.com"}),
+			objects:         []runtime.Object{genProject("alice"), genUser("alice"), genBinding("alice@acme.com")},
+			expectedCreator: "bob@acme.com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewFakeClientWithScheme(scheme.Scheme, append(tc.objects, tc.sa)...)
+			recorder := record.NewFakeRecorder(10)
+			r := &serviceAccountReconciler{
+				ctx:      context.Background(),
+				log:      kubermaticlog.Logger,
+				client:   client,
+				recorder: recorder,
+			}
+			if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.sa.Name}}); err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+
+			sa := &kubermaticv1.User{}
+			if err := client.Get(context.Background(), types.NamespacedName{Name: tc.sa.Name}, sa); err != nil {
+				t.Fatalf("failed to get service account: %v", err)
+			}
+			if creator := sa.Annotations[kubernetesprovider.ServiceAccountAnnotationCreator]; creator != tc.expectedCreator {
+				t.Errorf("expected creator %q, got %q", tc.expectedCreator, creator)
+			}
+			if hasEvent := len(recorder.Events) > 0; hasEvent != tc.expectEvent {
+				t.Errorf("expected an event to be recorded = %v, got %v", tc.expectEvent, hasEvent)
+			}
+		})
+	}
+}
+
+func genSSHKey(owner string, annotations map[string]string) *kubermaticv1.UserSSHKey {
+	return &kubermaticv1.UserSSHKey{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "key-abcd",
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{genProjectOwnerReference()},
+		},
+		Spec: kubermaticv1.SSHKeySpec{Owner: owner},
+	}
+}
+
+func genServiceAccount(annotations map[string]string) *kubermaticv1.User {
+	return &kubermaticv1.User{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "serviceaccount-abcd",
+			Annotations:     annotations,
+			OwnerReferences: []metav1.OwnerReference{genProjectOwnerReference()},
+		},
+		Spec: kubermaticv1.UserSpec{Email: "serviceaccount-abcd@localhost"},
+	}
+}
+
+// genProject returns the project "my-project" created by the given user, it has no creator if the name is empty
+func genProject(creator string) *kubermaticv1.Project {
+	project := &kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "my-project", UID: "my-project-uid"}}
+	if creator != "" {
+		project.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+			Kind:       kubermaticv1.UserKindName,
+			Name:       creator,
+		}}
+	}
+	return project
+}
+
+func genUser(name string) *kubermaticv1.User {
+	return &kubermaticv1.User{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       kubermaticv1.UserSpec{Email: name + "@acme.com"},
+	}
+}
+
+func genBinding(email string) *kubermaticv1.UserProjectBinding {
+	return &kubermaticv1.UserProjectBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: email},
+		Spec: kubermaticv1.UserProjectBindingSpec{
+			ProjectID: "my-project",
+			UserEmail: email,
+			Group:     "owners-my-project",
+		},
+	}
+}
+
+func genProjectOwnerReference() metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+		Kind:       kubermaticv1.ProjectKindName,
+		Name:       "my-project",
+		UID:        "my-project-uid",
+	}
+}
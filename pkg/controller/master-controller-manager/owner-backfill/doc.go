@@ -0,0 +1,25 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package ownerbackfill contains a migration controller that records the owner of ssh keys and the
+creator of service accounts which were created before those were tracked. Both are needed to remove
+the ssh keys and to revoke the service account tokens of suspended users. As the API never recorded
+who created those objects, they are attributed to the creator of their project, as long as that user
+is still a member of it. Admins can attribute an object explicitly by setting the kubermatic.io/creator
+annotation on it. Objects which can't be attributed are left untouched and get a warning event.
+*/
+package ownerbackfill
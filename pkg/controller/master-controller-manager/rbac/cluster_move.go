@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RevokeClusterRBACForProject removes the RBAC which grants the groups of the given project access to the cluster,
+// it has to be called with the seed client after a cluster was moved to another project. The resources controller
+// regenerates the RBAC for the new project, including the bindings which are shared by all projects and got removed here.
+func RevokeClusterRBACForProject(ctx context.Context, cli client.Client, cluster *kubermaticv1.Cluster, projectName string) error {
+	for _, groupPrefix := range AllGroupsPrefixes {
+		name := generateRBACRoleNameForNamedResource(kubermaticv1.ClusterKindName, cluster.Name, GenerateActualGroupNameFor(projectName, groupPrefix))
+		if err := cli.Delete(ctx, &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}}); err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ClusterRoleBinding %s: %v", name, err)
+		}
+		if err := cli.Delete(ctx, &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}}); err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ClusterRole %s: %v", name, err)
+		}
+	}
+
+	// The addon RoleBindings are named after the group prefix only, the ones bound to the groups of the project are removed
+	if cluster.Status.NamespaceName != "" {
+		for _, groupPrefix := range AllGroupsPrefixes {
+			groupName := GenerateActualGroupNameFor(projectName, groupPrefix)
+			binding := &rbacv1.RoleBinding{}
+			key := client.ObjectKey{Namespace: cluster.Status.NamespaceName, Name: generateRBACRoleNameForClusterNamespaceResource(kubermaticv1.AddonKindName, groupName)}
+			if err := cli.Get(ctx, key, binding); err != nil {
+				if kerrors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("failed to get RoleBinding %s: %v", key, err)
+			}
+			if !hasGroupSubject(binding.Subjects, groupName) {
+				continue
+			}
+			if err := cli.Delete(ctx, binding); err != nil && !kerrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete RoleBinding %s: %v", key, err)
+			}
+		}
+	}
+
+	// The etcd launcher binding refers to the viewer ClusterRole of the project, the reference can not be changed
+	name := generateRBACRoleNameForNamedResourceWithServiceAccount(kubermaticv1.ClusterKindName, cluster.Name, EtcdLauncherServiceAccountName)
+	binding := &rbacv1.ClusterRoleBinding{}
+	if err := cli.Get(ctx, client.ObjectKey{Name: name}, binding); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get ClusterRoleBinding %s: %v", name, err)
+	}
+	viewerRoleName := generateRBACRoleNameForNamedResource(kubermaticv1.ClusterKindName, cluster.Name, GenerateActualGroupNameFor(projectName, ViewerGroupNamePrefix))
+	if binding.RoleRef.Name != viewerRoleName {
+		return nil
+	}
+	if err := cli.Delete(ctx, binding); err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete ClusterRoleBinding %s: %v", name, err)
+	}
+	return nil
+}
+
+func hasGroupSubject(subjects []rbacv1.Subject, groupName string) bool {
+	for _, subject := range subjects {
+		if subject.Kind == rbacv1.GroupKind && subject.Name == groupName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRevokeClusterRBACForProject(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abcd"},
+		Status:     kubermaticv1.ClusterStatus{NamespaceName: "cluster-abcd"},
+	}
+	namedRBAC := func(projectName, groupPrefix string) []runtime.Object {
+		groupName := GenerateActualGroupNameFor(projectName, groupPrefix)
+		return []runtime.Object{
+			&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: generateRBACRoleNameForNamedResource(kubermaticv1.ClusterKindName, cluster.Name, groupName)}},
+			generateClusterRBACRoleBindingNamedResource(kubermaticv1.ClusterKindName, cluster.Name, groupName, metav1.OwnerReference{}),
+		}
+	}
+	objects := []runtime.Object{
+		// the addon bindings of the owners already belong to the target project
+		generateRBACRoleBindingForClusterNamespaceResource(cluster, GenerateActualGroupNameFor("target", OwnerGroupNamePrefix), kubermaticv1.AddonKindName),
+		generateRBACRoleBindingForClusterNamespaceResource(cluster, GenerateActualGroupNameFor("source", EditorGroupNamePrefix), kubermaticv1.AddonKindName),
+		generateClusterRBACRoleBindingForResourceWithServiceAccount(cluster.Name, kubermaticv1.ClusterKindName,
+			GenerateActualGroupNameFor("source", ViewerGroupNamePrefix), EtcdLauncherServiceAccountName, cluster.Status.NamespaceName, metav1.OwnerReference{}),
+	}
+	for _, groupPrefix := range AllGroupsPrefixes {
+		objects = append(objects, namedRBAC("source", groupPrefix)...)
+		objects = append(objects, namedRBAC("target", groupPrefix)...)
+	}
+	cli := fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme, objects...)
+
+	if err := RevokeClusterRBACForProject(context.Background(), cli, cluster, "source"); err != nil {
+		t.Fatal(err)
+	}
+
+	exists := func(obj runtime.Object, namespace, name string) bool {
+		err := cli.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, obj)
+		if err != nil && !kerrors.IsNotFound(err) {
+			t.Fatal(err)
+		}
+		return err == nil
+	}
+	for _, groupPrefix := range AllGroupsPrefixes {
+		for projectName, expected := range map[string]bool{"source": false, "target": true} {
+			name := generateRBACRoleNameForNamedResource(kubermaticv1.ClusterKindName, cluster.Name, GenerateActualGroupNameFor(projectName, groupPrefix))
+			if exists(&rbacv1.ClusterRole{}, "", name) != expected {
+				t.Errorf("expected ClusterRole %s to exist: %v", name, expected)
+			}
+			if exists(&rbacv1.ClusterRoleBinding{}, "", name) != expected {
+				t.Errorf("expected ClusterRoleBinding %s to exist: %v", name, expected)
+			}
+		}
+	}
+
+	ownersBinding := generateRBACRoleNameForClusterNamespaceResource(kubermaticv1.AddonKindName, OwnerGroupNamePrefix)
+	if !exists(&rbacv1.RoleBinding{}, cluster.Status.NamespaceName, ownersBinding) {
+		t.Errorf("expected RoleBinding %s of the target project to be kept", ownersBinding)
+	}
+	editorsBinding := generateRBACRoleNameForClusterNamespaceResource(kubermaticv1.AddonKindName, EditorGroupNamePrefix)
+	if exists(&rbacv1.RoleBinding{}, cluster.Status.NamespaceName, editorsBinding) {
+		t.Errorf("expected RoleBinding %s of the source project to be deleted", editorsBinding)
+	}
+	etcdLauncherBinding := generateRBACRoleNameForNamedResourceWithServiceAccount(kubermaticv1.ClusterKindName, cluster.Name, EtcdLauncherServiceAccountName)
+	if exists(&rbacv1.ClusterRoleBinding{}, "", etcdLauncherBinding) {
+		t.Errorf("expected ClusterRoleBinding %s referring to the source project to be deleted", etcdLauncherBinding)
+	}
+}
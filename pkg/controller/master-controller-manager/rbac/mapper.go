@@ -391,8 +391,8 @@ func generateVerbsForNamedResource(groupName, resourceKind string) ([]string, er
 	if strings.HasPrefix(groupName, EditorGroupNamePrefix) && resourceKind == kubermaticv1.ProjectKindName {
 		return []string{"get", "update"}, nil
 	}
-	// special case - editors are not allowed to interact with members of a project (UserProjectBinding)
-	if strings.HasPrefix(groupName, EditorGroupNamePrefix) && resourceKind == kubermaticv1.UserProjectBindingKind {
+	// special case - editors are not allowed to interact with members of a project (UserProjectBinding, GroupProjectBinding, ProjectInvitation)
+	if strings.HasPrefix(groupName, EditorGroupNamePrefix) && isProjectMemberKind(resourceKind) {
 		return nil, nil
 	}
 	// special case - editors are not allowed to interact with service accounts (User)
@@ -408,8 +408,8 @@ func generateVerbsForNamedResource(groupName, resourceKind string) ([]string, er
 	// verbs for editors
 	//
 	// viewers of a named resource
-	// special case - viewers are not allowed to interact with members of a project (UserProjectBinding)
-	if strings.HasPrefix(groupName, ViewerGroupNamePrefix) && resourceKind == kubermaticv1.UserProjectBindingKind {
+	// special case - viewers are not allowed to interact with members of a project (UserProjectBinding, GroupProjectBinding, ProjectInvitation)
+	if strings.HasPrefix(groupName, ViewerGroupNamePrefix) && isProjectMemberKind(resourceKind) {
 		return nil, nil
 	}
 	// special case - viewers are not allowed to interact with service accounts (User)
@@ -429,9 +429,9 @@ func generateVerbsForNamedResource(groupName, resourceKind string) ([]string, er
 func generateVerbsForResource(groupName, resourceKind string) ([]string, error) {
 	// special case - only the owners of a project can manipulate members
 	//
-	if strings.HasPrefix(groupName, OwnerGroupNamePrefix) && resourceKind == kubermaticv1.UserProjectBindingKind {
+	if strings.HasPrefix(groupName, OwnerGroupNamePrefix) && isProjectMemberKind(resourceKind) {
 		return []string{"create"}, nil
-	} else if resourceKind == kubermaticv1.UserProjectBindingKind {
+	} else if isProjectMemberKind(resourceKind) {
 		return nil, nil
 	}
 
@@ -507,3 +507,8 @@ func generateVerbsForClusterNamespaceResource(cluster *kubermaticv1.Cluster, gro
 	// unknown group passed
 	return nil, fmt.Errorf("unable to generate verbs for cluster namespace resource cluster = %s, group = %s, kind = %s", cluster.Name, groupName, kind)
 }
+
+// isProjectMemberKind tells if the given kind defines the members of a project
+func isProjectMemberKind(resourceKind string) bool {
+	return resourceKind == kubermaticv1.UserProjectBindingKind || resourceKind == kubermaticv1.GroupProjectBindingKind || resourceKind == kubermaticv1.ProjectInvitationKind
+}
@@ -89,6 +89,38 @@ func TestGenerateVerbsForNamedResources(t *testing.T) {
 			expectedVerbs: []string{},
 			resourceKind:  "User",
 		},
+		// tests for GroupProjectBinding named resource
+		{
+			name:          "scenario 9: owners of a project can interact with GroupProjectBinding named resource",
+			groupName:     "owners-projectID",
+			expectedVerbs: []string{"get", "update", "delete"},
+			resourceKind:  "GroupProjectBinding",
+		},
+		{
+			name:          "scenario 10: editors of a project cannot interact with GroupProjectBinding named resource",
+			groupName:     "editors-projectID",
+			expectedVerbs: []string{},
+			resourceKind:  "GroupProjectBinding",
+		},
+		{
+			name:          "scenario 11: viewers of a project cannot interact with GroupProjectBinding named resource",
+			groupName:     "viewers-projectID",
+			expectedVerbs: []string{},
+			resourceKind:  "GroupProjectBinding",
+		},
+		// tests for ProjectInvitation named resource
+		{
+			name:          "scenario 12: owners of a project can interact with ProjectInvitation named resource",
+			groupName:     "owners-projectID",
+			expectedVerbs: []string{"get", "update", "delete"},
+			resourceKind:  "ProjectInvitation",
+		},
+		{
+			name:          "scenario 13: editors of a project cannot interact with ProjectInvitation named resource",
+			groupName:     "editors-projectID",
+			expectedVerbs: []string{},
+			resourceKind:  "ProjectInvitation",
+		},
 	}
 
 	for _, test := range tests {
@@ -172,6 +204,30 @@ func TestGenerateVerbsForResources(t *testing.T) {
 			expectedVerbs: []string{},
 			resourceKind:  "User",
 		},
+		{
+			name:          "scenario 11: only the owners can create GroupProjectBinding resource",
+			groupName:     "owners-projectID",
+			expectedVerbs: []string{"create"},
+			resourceKind:  "GroupProjectBinding",
+		},
+		{
+			name:          "scenario 12: editors of a project cannot create GroupProjectBinding resource",
+			groupName:     "editors-projectID",
+			expectedVerbs: []string{},
+			resourceKind:  "GroupProjectBinding",
+		},
+		{
+			name:          "scenario 13: only the owners can create ProjectInvitation resource",
+			groupName:     "owners-projectID",
+			expectedVerbs: []string{"create"},
+			resourceKind:  "ProjectInvitation",
+		},
+		{
+			name:          "scenario 14: viewers of a project cannot create ProjectInvitation resource",
+			groupName:     "viewers-projectID",
+			expectedVerbs: []string{},
+			resourceKind:  "ProjectInvitation",
+		},
 	}
 
 	for _, test := range tests {
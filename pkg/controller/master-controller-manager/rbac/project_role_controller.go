@@ -0,0 +1,120 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+type projectRoleController struct {
+	client        client.Client
+	seedClientMap map[string]client.Client
+	ctx           context.Context
+}
+
+// newProjectRoleRBACController creates a new controller that is responsible for
+// generating RBAC ClusterRoles and ClusterRoleBindings for custom project roles (ProjectRole).
+//
+// For every project and every ProjectRole a single ClusterRole is generated on the master
+// and on every seed cluster. The ClusterRole is bound to the "<role>-<project>" group and grants
+// the verbs from the role's rules on the named resources that belong to the project.
+func newProjectRoleRBACController(ctx context.Context, mgr manager.Manager, seedManagerMap map[string]manager.Manager, workerPredicate predicate.Predicate) error {
+	seedClientMap := make(map[string]client.Client)
+	for k, v := range seedManagerMap {
+		seedClientMap[k] = v.GetClient()
+	}
+
+	c := &projectRoleController{
+		client:        mgr.GetClient(),
+		seedClientMap: seedClientMap,
+		ctx:           ctx,
+	}
+
+	cc, err := controller.New("rbac_generator_for_project_roles", mgr, controller.Options{Reconciler: c})
+	if err != nil {
+		return err
+	}
+
+	// Watch for changes to Project
+	if err := cc.Watch(&source.Kind{Type: &kubermaticv1.Project{}}, &handler.EnqueueRequestForObject{}, workerPredicate); err != nil {
+		return err
+	}
+
+	// Watch for changes to ProjectRole, a change affects all projects
+	if err := cc.Watch(&source.Kind{Type: &kubermaticv1.ProjectRole{}}, &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(c.enqueueAllProjects)}); err != nil {
+		return err
+	}
+
+	// Watch for the project's resources, the generated roles are bound to their names
+	for _, object := range []runtime.Object{&kubermaticv1.UserSSHKey{}, &kubermaticv1.ExternalCluster{}} {
+		if err := cc.Watch(&source.Kind{Type: object}, &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(enqueueOwningProject)}); err != nil {
+			return err
+		}
+	}
+	for _, seedManager := range seedManagerMap {
+		if err := cc.Watch(source.NewKindWithCache(&kubermaticv1.Cluster{}, seedManager.GetCache()), &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(enqueueOwningProject)}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *projectRoleController) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	err := c.sync(req.NamespacedName)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (c *projectRoleController) enqueueAllProjects(a handler.MapObject) []reconcile.Request {
+	var projects kubermaticv1.ProjectList
+	if err := c.client.List(c.ctx, &projects); err != nil {
+		klog.Errorf("failed to list projects: %v", err)
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, project := range projects.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: project.Name}})
+	}
+	return requests
+}
+
+func enqueueOwningProject(a handler.MapObject) []reconcile.Request {
+	projectName := getOwningProjectName(a.Meta)
+	if len(projectName) == 0 {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: projectName}}}
+}
@@ -101,6 +101,24 @@ func New(ctx context.Context, metrics *Metrics, mgr manager.Manager, seedManager
 			},
 		},
 
+		{
+			object: &kubermaticv1.GroupProjectBinding{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.GroupProjectBindingKind,
+				},
+			},
+		},
+
+		{
+			object: &kubermaticv1.ProjectInvitation{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.ProjectInvitationKind,
+				},
+			},
+		},
+
 		{
 			object: &k8scorev1.Secret{
 				TypeMeta: metav1.TypeMeta{
@@ -141,6 +159,10 @@ func New(ctx context.Context, metrics *Metrics, mgr manager.Manager, seedManager
 		return nil, err
 	}
 
+	if err := newProjectRoleRBACController(ctx, mgr, seedManagerMap, workerPredicate); err != nil {
+		return nil, err
+	}
+
 	resourcesRBACCtrl, err := newResourcesControllers(ctx, metrics, mgr, seedManagerMap, projectResources)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,335 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ProjectRoleLabelKey is the label put on the RBAC resources generated for a ProjectRole
+	ProjectRoleLabelKey = "project-role"
+)
+
+// projectRoleResource describes a kind that can be used in the rules of a ProjectRole
+type projectRoleResource struct {
+	resource    string
+	destination string
+
+	// names lists the names of the resources of this kind that belong to the given project
+	names func(ctx context.Context, cli client.Client, projectName string) ([]string, error)
+}
+
+// projectRoleResources holds the kinds that are enforced by RBAC for ProjectRoles.
+//
+// Note that kubermaticv1.NodeDeploymentResourceKind is not listed here,
+// node deployments live in the user clusters and access to them is enforced by the API.
+var projectRoleResources = map[string]projectRoleResource{
+	kubermaticv1.ProjectKindName: {
+		resource: kubermaticv1.ProjectResourceName,
+		names: func(_ context.Context, _ client.Client, projectName string) ([]string, error) {
+			return []string{projectName}, nil
+		},
+	},
+	kubermaticv1.ClusterKindName: {
+		resource:    kubermaticv1.ClusterResourceName,
+		destination: destinationSeed,
+		names: func(ctx context.Context, cli client.Client, projectName string) ([]string, error) {
+			var clusters kubermaticv1.ClusterList
+			if err := cli.List(ctx, &clusters, client.MatchingLabels{kubermaticv1.ProjectIDLabelKey: projectName}); err != nil {
+				return nil, err
+			}
+			names := []string{}
+			for _, cluster := range clusters.Items {
+				names = append(names, cluster.Name)
+			}
+			return names, nil
+		},
+	},
+	kubermaticv1.ExternalClusterKind: {
+		resource: kubermaticv1.ExternalClusterResourceName,
+		names: func(ctx context.Context, cli client.Client, projectName string) ([]string, error) {
+			var clusters kubermaticv1.ExternalClusterList
+			if err := cli.List(ctx, &clusters, client.MatchingLabels{kubermaticv1.ProjectIDLabelKey: projectName}); err != nil {
+				return nil, err
+			}
+			names := []string{}
+			for _, cluster := range clusters.Items {
+				names = append(names, cluster.Name)
+			}
+			return names, nil
+		},
+	},
+	kubermaticv1.SSHKeyKind: {
+		resource: kubermaticv1.SSHKeyResourceName,
+		names: func(ctx context.Context, cli client.Client, projectName string) ([]string, error) {
+			var keys kubermaticv1.UserSSHKeyList
+			if err := cli.List(ctx, &keys); err != nil {
+				return nil, err
+			}
+			names := []string{}
+			for i := range keys.Items {
+				if getOwningProjectName(&keys.Items[i]) == projectName {
+					names = append(names, keys.Items[i].Name)
+				}
+			}
+			return names, nil
+		},
+	},
+}
+
+// IsProjectRoleResource tells whether the given kind can be used in the rules of a ProjectRole
+func IsProjectRoleResource(kind string) bool {
+	_, ok := projectRoleResources[kind]
+	return ok || kind == kubermaticv1.NodeDeploymentResourceKind
+}
+
+// IsBuiltInGroupPrefix tells whether the given group prefix is one of the groups
+// every project has, as opposed to a group of a custom ProjectRole
+func IsBuiltInGroupPrefix(groupPrefix string) bool {
+	for _, prefix := range AllGroupsPrefixes {
+		if prefix == groupPrefix {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *projectRoleController) sync(key client.ObjectKey) error {
+	var project kubermaticv1.Project
+	if err := c.client.Get(c.ctx, key, &project); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		project.Name = key.Name
+	}
+
+	// a project that is gone or being deleted keeps no roles, the generated ones will be removed
+	var roles kubermaticv1.ProjectRoleList
+	if project.DeletionTimestamp == nil && len(project.UID) > 0 {
+		if err := c.client.List(c.ctx, &roles); err != nil {
+			return err
+		}
+	}
+
+	if err := ensureClusterRBACForProjectRoles(c.ctx, c.client, "", &project, roles.Items); err != nil {
+		return fmt.Errorf("failed to ensure RBAC for project roles on the master cluster: %v", err)
+	}
+	for seedName, seedClient := range c.seedClientMap {
+		if err := ensureClusterRBACForProjectRoles(c.ctx, seedClient, destinationSeed, &project, roles.Items); err != nil {
+			return fmt.Errorf("failed to ensure RBAC for project roles on seed %q: %v", seedName, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureClusterRBACForProjectRoles makes sure that for every given role there is a matching ClusterRole and ClusterRoleBinding
+// for the resources that live on the given destination. The RBAC resources of roles that are gone are removed.
+func ensureClusterRBACForProjectRoles(ctx context.Context, cli client.Client, destination string, project *kubermaticv1.Project, roles []kubermaticv1.ProjectRole) error {
+	resourceNames := map[string][]string{}
+	if len(roles) > 0 {
+		for kind, resource := range projectRoleResources {
+			if resource.destination != destination {
+				continue
+			}
+			names, err := resource.names(ctx, cli, project.Name)
+			if err != nil {
+				return err
+			}
+			resourceNames[kind] = names
+		}
+	}
+
+	wanted := sets.NewString()
+	for i := range roles {
+		generatedRole := generateClusterRBACRoleForProjectRole(&roles[i], project, resourceNames)
+		if generatedRole == nil {
+			continue
+		}
+		wanted.Insert(generatedRole.Name)
+
+		var existingRole rbacv1.ClusterRole
+		if err := cli.Get(ctx, client.ObjectKey{Name: generatedRole.Name}, &existingRole); err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+			if err := cli.Create(ctx, generatedRole); err != nil {
+				return err
+			}
+		} else if !equality.Semantic.DeepEqual(existingRole.Rules, generatedRole.Rules) {
+			updatedRole := existingRole.DeepCopy()
+			updatedRole.Rules = generatedRole.Rules
+			if err := cli.Update(ctx, updatedRole); err != nil {
+				return err
+			}
+		}
+
+		generatedRoleBinding := generateClusterRBACRoleBindingForProjectRole(&roles[i], project)
+		var existingRoleBinding rbacv1.ClusterRoleBinding
+		if err := cli.Get(ctx, client.ObjectKey{Name: generatedRoleBinding.Name}, &existingRoleBinding); err != nil {
+			if !kerrors.IsNotFound(err) {
+				return err
+			}
+			if err := cli.Create(ctx, generatedRoleBinding); err != nil {
+				return err
+			}
+		}
+	}
+
+	selector := client.MatchingLabels{kubermaticv1.ProjectIDLabelKey: project.Name}
+	var existingRoleBindings rbacv1.ClusterRoleBindingList
+	if err := cli.List(ctx, &existingRoleBindings, selector); err != nil {
+		return err
+	}
+	for i := range existingRoleBindings.Items {
+		binding := &existingRoleBindings.Items[i]
+		if _, ok := binding.Labels[ProjectRoleLabelKey]; !ok || wanted.Has(binding.Name) {
+			continue
+		}
+		if err := cli.Delete(ctx, binding); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+	var existingRoles rbacv1.ClusterRoleList
+	if err := cli.List(ctx, &existingRoles, selector); err != nil {
+		return err
+	}
+	for i := range existingRoles.Items {
+		role := &existingRoles.Items[i]
+		if _, ok := role.Labels[ProjectRoleLabelKey]; !ok || wanted.Has(role.Name) {
+			continue
+		}
+		if err := cli.Delete(ctx, role); err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func generateRBACRoleNameForProjectRole(groupName string) string {
+	return fmt.Sprintf("%s:projectrole:%s", RBACResourcesNamePrefix, groupName)
+}
+
+// generateClusterRBACRoleForProjectRole generates ClusterRole for the given ProjectRole in the given project
+//
+// The "create" verb is granted on the resource, all other verbs are granted on the named resources
+// that belong to the project. Note that a nil ClusterRole is returned if the role doesn't grant anything.
+func generateClusterRBACRoleForProjectRole(projectRole *kubermaticv1.ProjectRole, project *kubermaticv1.Project, resourceNames map[string][]string) *rbacv1.ClusterRole {
+	rules := []rbacv1.PolicyRule{}
+	for _, rule := range projectRole.Spec.Rules {
+		names, ok := resourceNames[rule.Resource]
+		if !ok {
+			continue
+		}
+		resource := projectRoleResources[rule.Resource].resource
+
+		namedVerbs := sets.NewString()
+		for _, verb := range rule.Verbs {
+			// projects don't belong to a project, creating them is not a matter of project roles
+			if (verb == "create" || verb == "*") && rule.Resource != kubermaticv1.ProjectKindName {
+				rules = append(rules, rbacv1.PolicyRule{
+					APIGroups: []string{kubermaticv1.SchemeGroupVersion.Group},
+					Resources: []string{resource},
+					Verbs:     []string{"create"},
+				})
+			}
+			if verb != "create" {
+				namedVerbs.Insert(verb)
+			}
+		}
+		if namedVerbs.Len() == 0 || len(names) == 0 {
+			continue
+		}
+
+		sortedNames := append([]string{}, names...)
+		sort.Strings(sortedNames)
+		rules = append(rules, rbacv1.PolicyRule{
+			APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
+			Resources:     []string{resource},
+			ResourceNames: sortedNames,
+			Verbs:         namedVerbs.List(),
+		})
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+
+	groupName := GenerateActualGroupNameFor(project.Name, projectRole.Name)
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   generateRBACRoleNameForProjectRole(groupName),
+			Labels: projectRoleLabels(projectRole, project),
+		},
+		Rules: rules,
+	}
+}
+
+// generateClusterRBACRoleBindingForProjectRole generates ClusterRoleBinding that binds
+// the group of the given ProjectRole in the given project to the corresponding ClusterRole
+func generateClusterRBACRoleBindingForProjectRole(projectRole *kubermaticv1.ProjectRole, project *kubermaticv1.Project) *rbacv1.ClusterRoleBinding {
+	groupName := GenerateActualGroupNameFor(project.Name, projectRole.Name)
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   generateRBACRoleNameForProjectRole(groupName),
+			Labels: projectRoleLabels(projectRole, project),
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Group",
+				Name:     groupName,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     generateRBACRoleNameForProjectRole(groupName),
+		},
+	}
+}
+
+func projectRoleLabels(projectRole *kubermaticv1.ProjectRole, project *kubermaticv1.Project) map[string]string {
+	return map[string]string{
+		kubermaticv1.ProjectIDLabelKey: project.Name,
+		ProjectRoleLabelKey:            projectRole.Name,
+	}
+}
+
+// getOwningProjectName returns the name of the project the given object belongs to,
+// it is taken from the OwnerReferences or, for cluster resources, from the labels
+func getOwningProjectName(object metav1.Object) string {
+	for _, owner := range object.GetOwnerReferences() {
+		if owner.APIVersion == kubermaticv1.SchemeGroupVersion.String() && owner.Kind == kubermaticv1.ProjectKindName &&
+			len(owner.Name) > 0 && len(owner.UID) > 0 {
+			return owner.Name
+		}
+	}
+	return object.GetLabels()[kubermaticv1.ProjectIDLabelKey]
+}
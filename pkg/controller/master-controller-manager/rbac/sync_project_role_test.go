@@ -0,0 +1,169 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac/test"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeruntime "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSyncProjectRoles(t *testing.T) {
+	clusterOperator := &kubermaticv1.ProjectRole{
+		ObjectMeta: metav1.ObjectMeta{Name: "clusteroperator"},
+		Spec: kubermaticv1.ProjectRoleSpec{
+			Rules: []kubermaticv1.ProjectRoleRule{
+				{Resource: kubermaticv1.ProjectKindName, Verbs: []string{"get"}},
+				{Resource: kubermaticv1.ClusterKindName, Verbs: []string{"get", "update"}},
+				{Resource: kubermaticv1.NodeDeploymentResourceKind, Verbs: []string{"*"}},
+			},
+		},
+	}
+	project := test.CreateProject("thunderball", test.CreateUser("James Bond"))
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "abcd",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "thunderball"},
+		},
+	}
+	staleRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "kubermatic:projectrole:auditor-thunderball",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "thunderball", ProjectRoleLabelKey: "auditor"},
+		},
+	}
+	builtInRole := &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "kubermatic:project-thunderball:owners",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "thunderball"},
+		},
+	}
+	labels := map[string]string{kubermaticv1.ProjectIDLabelKey: "thunderball", ProjectRoleLabelKey: "clusteroperator"}
+
+	tests := []struct {
+		name                      string
+		existingMasterObjects     []runtime.Object
+		existingSeedObjects       []runtime.Object
+		expectedMasterRoles       []rbacv1.ClusterRole
+		expectedSeedRoles         []rbacv1.ClusterRole
+		expectedMasterRoleBinding bool
+	}{
+		{
+			name:                  "scenario 1: roles are generated on the master and seed clusters, stale roles are removed",
+			existingMasterObjects: []runtime.Object{project, clusterOperator, staleRole.DeepCopy(), builtInRole.DeepCopy()},
+			existingSeedObjects:   []runtime.Object{cluster, staleRole.DeepCopy()},
+			expectedMasterRoles: []rbacv1.ClusterRole{
+				*builtInRole,
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "kubermatic:projectrole:clusteroperator-thunderball", Labels: labels},
+					Rules: []rbacv1.PolicyRule{
+						{
+							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
+							Resources:     []string{kubermaticv1.ProjectResourceName},
+							ResourceNames: []string{"thunderball"},
+							Verbs:         []string{"get"},
+						},
+					},
+				},
+			},
+			expectedSeedRoles: []rbacv1.ClusterRole{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "kubermatic:projectrole:clusteroperator-thunderball", Labels: labels},
+					Rules: []rbacv1.PolicyRule{
+						{
+							APIGroups:     []string{kubermaticv1.SchemeGroupVersion.Group},
+							Resources:     []string{kubermaticv1.ClusterResourceName},
+							ResourceNames: []string{"abcd"},
+							Verbs:         []string{"get", "update"},
+						},
+					},
+				},
+			},
+			expectedMasterRoleBinding: true,
+		},
+		{
+			name:                  "scenario 2: roles of a removed project are cleaned up",
+			existingMasterObjects: []runtime.Object{clusterOperator, staleRole.DeepCopy(), builtInRole.DeepCopy()},
+			existingSeedObjects:   []runtime.Object{cluster, staleRole.DeepCopy()},
+			expectedMasterRoles:   []rbacv1.ClusterRole{*builtInRole},
+			expectedSeedRoles:     []rbacv1.ClusterRole{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			masterClient := fakeruntime.NewFakeClient(test.existingMasterObjects...)
+			seedClient := fakeruntime.NewFakeClient(test.existingSeedObjects...)
+
+			target := projectRoleController{
+				ctx:           context.Background(),
+				client:        masterClient,
+				seedClientMap: map[string]client.Client{"us-central1": seedClient},
+			}
+			if err := target.sync(client.ObjectKey{Name: "thunderball"}); err != nil {
+				t.Fatal(err)
+			}
+
+			validateClusterRoles(t, masterClient, test.expectedMasterRoles)
+			validateClusterRoles(t, seedClient, test.expectedSeedRoles)
+
+			var binding rbacv1.ClusterRoleBinding
+			err := masterClient.Get(context.Background(), client.ObjectKey{Name: "kubermatic:projectrole:clusteroperator-thunderball"}, &binding)
+			if test.expectedMasterRoleBinding {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if binding.Subjects[0].Name != "clusteroperator-thunderball" {
+					t.Fatalf("expected the binding to be bound to the clusteroperator-thunderball group, got %q", binding.Subjects[0].Name)
+				}
+			} else if err == nil {
+				t.Fatal("expected the binding to be removed")
+			}
+		})
+	}
+}
+
+func validateClusterRoles(t *testing.T, cli client.Client, expectedRoles []rbacv1.ClusterRole) {
+	t.Helper()
+
+	var roles rbacv1.ClusterRoleList
+	if err := cli.List(context.Background(), &roles); err != nil {
+		t.Fatal(err)
+	}
+	if len(roles.Items) != len(expectedRoles) {
+		t.Fatalf("expected %d ClusterRoles, got %d", len(expectedRoles), len(roles.Items))
+	}
+
+expectedLoop:
+	for _, expected := range expectedRoles {
+		for _, existing := range roles.Items {
+			if existing.Name == expected.Name && equality.Semantic.DeepEqual(existing.Labels, expected.Labels) && equality.Semantic.DeepEqual(existing.Rules, expected.Rules) {
+				continue expectedLoop
+			}
+		}
+		t.Fatalf("expected ClusterRole %q not found, got %v", expected.Name, roles.Items)
+	}
+}
@@ -65,18 +65,7 @@ func (c *resourcesController) syncProjectResource(key client.ObjectKey) error {
 		return err
 	}
 
-	projectName := ""
-	for _, owner := range metaObject.GetOwnerReferences() {
-		if owner.APIVersion == kubermaticv1.SchemeGroupVersion.String() && owner.Kind == kubermaticv1.ProjectKindName &&
-			len(owner.Name) > 0 && len(owner.UID) > 0 {
-			projectName = owner.Name
-			break
-		}
-	}
-	if len(projectName) == 0 {
-		projectName = metaObject.GetLabels()[kubermaticv1.ProjectIDLabelKey]
-	}
-
+	projectName := getOwningProjectName(metaObject)
 	if len(projectName) == 0 {
 		return fmt.Errorf("unable to find owning project for the object name = %s, gvr = %s", metaObject.GetName(), rmapping)
 	}
@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttokencleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const ControllerName = "kubermatic_service_account_token_cleanup_controller"
+
+type reconciler struct {
+	ctx    context.Context
+	log    *zap.SugaredLogger
+	client ctrlruntimeclient.Client
+	now    func() time.Time
+}
+
+func Add(ctx context.Context, mgr manager.Manager, log *zap.SugaredLogger) error {
+	log = log.Named(ControllerName)
+	r := &reconciler{
+		ctx:    ctx,
+		log:    log,
+		client: mgr.GetClient(),
+		now:    time.Now,
+	}
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: r})
+	if err != nil {
+		return fmt.Errorf("failed to construct controller: %v", err)
+	}
+
+	tokenPredicate := predicateutil.Factory(func(m metav1.Object, _ runtime.Object) bool {
+		secret, ok := m.(*corev1.Secret)
+		return ok && secret.Namespace == resources.KubermaticNamespace && kubernetesprovider.IsToken(secret)
+	})
+	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForObject{}, tokenPredicate); err != nil {
+		return fmt.Errorf("failed to watch secrets: %v", err)
+	}
+
+	// a changed revocation period has to be applied to all existing tokens
+	if err := c.Watch(&source.Kind{Type: &kubermaticv1.KubermaticSetting{}}, enqueueAllTokens(r.client, log), predicateutil.ByName(kubermaticv1.GlobalSettingsName)); err != nil {
+		return fmt.Errorf("failed to watch settings: %v", err)
+	}
+
+	return nil
+}
+
+func enqueueAllTokens(client ctrlruntimeclient.Client, log *zap.SugaredLogger) *handler.EnqueueRequestsFromMapFunc {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(_ handler.MapObject) []reconcile.Request {
+		secrets := &corev1.SecretList{}
+		if err := client.List(context.Background(), secrets, ctrlruntimeclient.InNamespace(resources.KubermaticNamespace)); err != nil {
+			err = fmt.Errorf("failed to list secrets: %v", err)
+			log.Error(err)
+			utilruntime.HandleError(err)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, secret := range secrets.Items {
+			if kubernetesprovider.IsToken(&secret) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}})
+			}
+		}
+		return requests
+	})}
+}
+
+func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, secret); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+	if !kubernetesprovider.IsToken(secret) || secret.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	settings := &kubermaticv1.KubermaticSetting{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: kubermaticv1.GlobalSettingsName}, settings); err != nil && !kerrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed to get global settings: %v", err)
+	}
+
+	result, err := r.reconcile(log, secret, settings.Spec.ServiceAccountTokenOptions)
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+	}
+	return result, err
+}
+
+func (r *reconciler) reconcile(log *zap.SugaredLogger, secret *corev1.Secret, options kubermaticv1.ServiceAccountTokenOptions) (reconcile.Result, error) {
+	now := r.now()
+	result := reconcile.Result{}
+
+	if period := options.UnusedRevocationPeriod.Duration; period > 0 {
+		lastUsed, ok := kubernetesprovider.GetTokenLastUsed(secret)
+		if !ok {
+			lastUsed = secret.CreationTimestamp.Time
+		}
+		unusedFor := now.Sub(lastUsed)
+		if unusedFor >= period {
+			log.Infow("Revoking unused service account token", "last-used", lastUsed)
+			if err := r.client.Delete(r.ctx, secret); err != nil && !kerrors.IsNotFound(err) {
+				return reconcile.Result{}, fmt.Errorf("failed to revoke token: %v", err)
+			}
+			return reconcile.Result{}, nil
+		}
+		result.RequeueAfter = period - unusedFor
+	}
+
+	if expiry, ok := kubernetesprovider.GetPreviousTokenExpiry(secret); ok {
+		if now.Before(expiry) {
+			if result.RequeueAfter == 0 || expiry.Sub(now) < result.RequeueAfter {
+				result.RequeueAfter = expiry.Sub(now)
+			}
+			return result, nil
+		}
+
+		oldSecret := secret.DeepCopy()
+		kubernetesprovider.RemovePreviousToken(secret)
+		if err := r.client.Patch(r.ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to remove the rotated token: %v", err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccounttokencleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                 string
+		secret               *corev1.Secret
+		revocationPeriod     time.Duration
+		expectRevoked        bool
+		expectPreviousToken  bool
+		expectedRequeueAfter time.Duration
+	}{
+		{
+			name:             "scenario 1: token unused for longer than the revocation period is revoked",
+			secret:           genToken(now.Add(-48*time.Hour), nil),
+			revocationPeriod: 24 * time.Hour,
+			expectRevoked:    true,
+		},
+		{
+			name: "scenario 2: recently used token is kept",
+			secret: genToken(now.Add(-48*time.Hour), func(secret *corev1.Secret) {
+				kubernetesprovider.SetTokenLastUsed(secret, now.Add(-time.Hour))
+			}),
+			revocationPeriod:     24 * time.Hour,
+			expectedRequeueAfter: 23 * time.Hour,
+		},
+		{
+			name:             "scenario 3: tokens are not revoked when the revocation period is not set",
+			secret:           genToken(now.Add(-48*time.Hour), nil),
+			revocationPeriod: 0,
+		},
+		{
+			name: "scenario 4: the rotated token is removed after the overlap period",
+			secret: genToken(now.Add(-48*time.Hour), func(secret *corev1.Secret) {
+				kubernetesprovider.RotateToken(secret, "new-token", time.Hour, now.Add(-2*time.Hour))
+			}),
+		},
+		{
+			name: "scenario 5: the rotated token is kept during the overlap period",
+			secret: genToken(now.Add(-48*time.Hour), func(secret *corev1.Secret) {
+				kubernetesprovider.RotateToken(secret, "new-token", time.Hour, now.Add(-30*time.Minute))
+			}),
+			expectPreviousToken:  true,
+			expectedRequeueAfter: 30 * time.Minute,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			settings := &kubermaticv1.KubermaticSetting{
+				ObjectMeta: metav1.ObjectMeta{Name: kubermaticv1.GlobalSettingsName},
+				Spec: kubermaticv1.SettingSpec{
+					ServiceAccountTokenOptions: kubermaticv1.ServiceAccountTokenOptions{
+						UnusedRevocationPeriod: metav1.Duration{Duration: tc.revocationPeriod},
+					},
+				},
+			}
+			client := fake.NewFakeClientWithScheme(scheme.Scheme, []runtime.Object{settings, tc.secret}...)
+			r := &reconciler{
+				ctx:    context.Background(),
+				log:    kubermaticlog.Logger,
+				client: client,
+				now:    func() time.Time { return now },
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: tc.secret.Namespace, Name: tc.secret.Name}}
+			result, err := r.Reconcile(request)
+			if err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+			if result.RequeueAfter != tc.expectedRequeueAfter {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeueAfter, result.RequeueAfter)
+			}
+
+			secret := &corev1.Secret{}
+			err = client.Get(context.Background(), request.NamespacedName, secret)
+			if tc.expectRevoked {
+				if !kerrors.IsNotFound(err) {
+					t.Fatalf("expected the token to be revoked, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to get token: %v", err)
+			}
+
+			_, hasPreviousToken := kubernetesprovider.GetPreviousTokenExpiry(secret)
+			if hasPreviousToken != tc.expectPreviousToken {
+				t.Errorf("expected previous token to exist = %v, got %v", tc.expectPreviousToken, hasPreviousToken)
+			}
+		})
+	}
+}
+
+func genToken(creationTimestamp time.Time, modify func(*corev1.Secret)) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "sa-token-abcd",
+			Namespace:         resources.KubermaticNamespace,
+			CreationTimestamp: metav1.NewTime(creationTimestamp),
+		},
+		Data: map[string][]byte{"token": []byte("token")},
+	}
+	if modify != nil {
+		modify(secret)
+	}
+	return secret
+}
@@ -0,0 +1,315 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usagereport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+	"go.uber.org/zap"
+
+	clusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	controllerutil "k8c.io/kubermatic/v2/pkg/controller/util"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/pricing"
+	"k8c.io/kubermatic/v2/pkg/util/workerlabel"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "usage_report_controller"
+
+	// samplingInterval is the time between two samples of a cluster
+	samplingInterval = 15 * time.Minute
+	// maxSampleGap limits the time a single sample accounts for. The usage in between is unknown
+	// if the controller was down for a longer time, it is rather left out than charged.
+	maxSampleGap = 2 * samplingInterval
+)
+
+// Reconciler records the usage of the clusters of all seeds
+type Reconciler struct {
+	ctx    context.Context
+	log    *zap.SugaredLogger
+	client ctrlruntimeclient.Client
+	// apiReader reads the usage reports without the cache, as the report of a project is updated
+	// by the workers of all its clusters
+	apiReader   ctrlruntimeclient.Reader
+	workerName  string
+	seedClients map[string]ctrlruntimeclient.Client
+	now         func() time.Time
+	// userClusterClientGetter returns a client for the user cluster, it is a field to be able to replace it in tests
+	userClusterClientGetter func(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
+}
+
+func Add(
+	ctx context.Context,
+	mgr manager.Manager,
+	seedManagers map[string]manager.Manager,
+	log *zap.SugaredLogger,
+	workerName string,
+	numWorkers int,
+) error {
+	reconciler := &Reconciler{
+		ctx:                     ctx,
+		log:                     log.Named(ControllerName),
+		client:                  mgr.GetClient(),
+		apiReader:               mgr.GetAPIReader(),
+		workerName:              workerName,
+		seedClients:             map[string]ctrlruntimeclient.Client{},
+		now:                     time.Now,
+		userClusterClientGetter: getUserClusterClient,
+	}
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: numWorkers})
+	if err != nil {
+		return fmt.Errorf("failed to construct controller: %v", err)
+	}
+
+	for seedName, seedManager := range seedManagers {
+		reconciler.seedClients[seedName] = seedManager.GetClient()
+
+		clusterSource := &source.Kind{Type: &kubermaticv1.Cluster{}}
+		if err := clusterSource.InjectCache(seedManager.GetCache()); err != nil {
+			return fmt.Errorf("failed to inject cache into clusterSource for seed %s: %v", seedName, err)
+		}
+		if err := c.Watch(
+			clusterSource,
+			controllerutil.EnqueueClusterScopedObjectWithSeedName(seedName),
+			workerlabel.Predicates(workerName),
+		); err != nil {
+			return fmt.Errorf("failed to establish watch for clusters in seed %s: %v", seedName, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	err := r.reconcile(log, request)
+	if controllerutil.IsCacheNotStarted(err) {
+		return reconcile.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if err != nil {
+		log.Errorw("Reconciliation failed", zap.Error(err))
+		return reconcile.Result{}, err
+	}
+	// The usage is sampled, so the cluster gets requeued even if nothing changed
+	return reconcile.Result{RequeueAfter: samplingInterval}, nil
+}
+
+func (r *Reconciler) reconcile(log *zap.SugaredLogger, request reconcile.Request) error {
+	seedClient, ok := r.seedClients[request.Namespace]
+	if !ok {
+		log.Errorw("Got request for seed we don't have a client for", "seed", request.Namespace)
+		// The clients are inserted during controller initialization, so there is no point in retrying
+		return nil
+	}
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := seedClient.Get(r.ctx, types.NamespacedName{Name: request.Name}, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get cluster %s from seed %s: %v", request.Name, request.Namespace, err)
+	}
+
+	if cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		log.Debugw(
+			"Skipping because the cluster has a different worker name set",
+			"cluster-worker-name", cluster.Labels[kubermaticv1.WorkerNameLabelKey],
+		)
+		return nil
+	}
+
+	projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+	if projectID == "" {
+		log.Debug("Skipping cluster without project")
+		return nil
+	}
+
+	// A hibernated cluster neither has a control plane nor nodes, the time is recorded without any usage
+	var nodes map[string]int
+	if !cluster.IsHibernated() {
+		var err error
+		if nodes, err = r.countNodes(seedClient, cluster); err != nil {
+			return err
+		}
+	}
+
+	priceList := &kubermaticv1.PriceList{}
+	if err := r.client.Get(r.ctx, types.NamespacedName{Name: cluster.Spec.Cloud.DatacenterName}, priceList); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get price list: %v", err)
+		}
+		priceList = nil
+	}
+
+	return r.recordUsage(projectID, cluster, nodes, priceList)
+}
+
+// countNodes returns the number of machines per instance size, nil is returned if the cluster has no nodes yet
+func (r *Reconciler) countNodes(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (map[string]int, error) {
+	if cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusUp {
+		return nil, nil
+	}
+
+	userClusterClient, err := r.userClusterClientGetter(seedClient, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cluster client: %v", err)
+	}
+	machines := &clusterv1alpha1.MachineList{}
+	if err := userClusterClient.List(r.ctx, machines, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, fmt.Errorf("failed to list machines: %v", err)
+	}
+
+	nodes := map[string]int{}
+	for _, machine := range machines.Items {
+		size, err := pricing.MachineSize(machine.Spec)
+		if err != nil {
+			r.log.Debugw("Failed to determine the size of a machine", "machine", machine.Name, zap.Error(err))
+			size = "unknown"
+		}
+		nodes[size]++
+	}
+	return nodes, nil
+}
+
+// recordUsage adds the usage since the last sample of the cluster to the report of the current month
+func (r *Reconciler) recordUsage(projectID string, cluster *kubermaticv1.Cluster, nodes map[string]int, priceList *kubermaticv1.PriceList) error {
+	now := r.now().UTC()
+
+	// Other workers may update the same report concurrently, the update is retried with the latest report then
+	err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return kerrors.IsConflict(err) || kerrors.IsAlreadyExists(err)
+	}, func() error {
+		return r.updateUsageReport(projectID, cluster, nodes, priceList, now)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update usage report: %v", err)
+	}
+	return nil
+}
+
+func (r *Reconciler) updateUsageReport(projectID string, cluster *kubermaticv1.Cluster, nodes map[string]int, priceList *kubermaticv1.PriceList, now time.Time) error {
+	period := now.Format(kubermaticv1.UsageReportPeriodFormat)
+
+	report := &kubermaticv1.UsageReport{}
+	name := fmt.Sprintf("%s-%s", projectID, period)
+	if err := r.apiReader.Get(r.ctx, types.NamespacedName{Name: name}, report); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return err
+		}
+		report = &kubermaticv1.UsageReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: projectID},
+			},
+			Spec: kubermaticv1.UsageReportSpec{ProjectID: projectID, Period: period},
+		}
+		if err := r.client.Create(r.ctx, report); err != nil {
+			return err
+		}
+	}
+
+	oldReport := report.DeepCopy()
+	usage := clusterUsage(report, cluster, now)
+
+	hours := now.Sub(usage.LastSampled.Time)
+	if hours > maxSampleGap {
+		hours = maxSampleGap
+	}
+	if hours > 0 && !cluster.IsHibernated() {
+		addUsage(usage, hours.Hours(), nodes, priceList)
+	}
+	usage.ClusterName = cluster.Spec.HumanReadableName
+	usage.Datacenter = cluster.Spec.Cloud.DatacenterName
+	usage.LastSampled = metav1.NewTime(now)
+
+	return r.client.Patch(r.ctx, report, ctrlruntimeclient.MergeFromWithOptions(oldReport, ctrlruntimeclient.MergeFromWithOptimisticLock{}))
+}
+
+// clusterUsage returns the usage of the cluster within the report, a new one is added if it is not part of the report yet.
+// The usage of a new entry starts with the period or with the creation of the cluster.
+func clusterUsage(report *kubermaticv1.UsageReport, cluster *kubermaticv1.Cluster, now time.Time) *kubermaticv1.ClusterUsage {
+	for i := range report.Status.Clusters {
+		if report.Status.Clusters[i].ClusterID == cluster.Name {
+			return &report.Status.Clusters[i]
+		}
+	}
+
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if cluster.CreationTimestamp.Time.After(start) {
+		start = cluster.CreationTimestamp.Time
+	}
+	report.Status.Clusters = append(report.Status.Clusters, kubermaticv1.ClusterUsage{
+		ClusterID:   cluster.Name,
+		LastSampled: metav1.NewTime(start),
+	})
+	return &report.Status.Clusters[len(report.Status.Clusters)-1]
+}
+
+func addUsage(usage *kubermaticv1.ClusterUsage, hours float64, nodes map[string]int, priceList *kubermaticv1.PriceList) {
+	usage.ControlPlaneHours += hours
+	if priceList != nil {
+		usage.Currency = priceList.Spec.Currency
+		usage.ControlPlaneCost += hours * priceList.Spec.ControlPlane
+	}
+
+	for size, count := range nodes {
+		var nodeUsage *kubermaticv1.NodeUsage
+		for i := range usage.Nodes {
+			if usage.Nodes[i].Size == size {
+				nodeUsage = &usage.Nodes[i]
+				break
+			}
+		}
+		if nodeUsage == nil {
+			usage.Nodes = append(usage.Nodes, kubermaticv1.NodeUsage{Size: size})
+			nodeUsage = &usage.Nodes[len(usage.Nodes)-1]
+		}
+
+		nodeHours := hours * float64(count)
+		nodeUsage.NodeHours += nodeHours
+		if price, ok := pricing.NodePrice(priceList, size); ok {
+			nodeUsage.Cost += nodeHours * price
+		}
+	}
+	sort.Slice(usage.Nodes, func(i, j int) bool { return usage.Nodes[i].Size < usage.Nodes[j].Size })
+}
+
+func getUserClusterClient(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+	provider, err := clusterclient.NewExternal(seedClient)
+	if err != nil {
+		return nil, err
+	}
+	return provider.GetClient(cluster)
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usagereport
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	testSeed    = "test-seed"
+	testCluster = "test-cluster"
+	testProject = "test-project"
+)
+
+func init() {
+	if err := clusterv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+}
+
+func genCluster(created time.Time, phase kubermaticv1.ClusterPhase) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              testCluster,
+			Labels:            map[string]string{kubermaticv1.ProjectIDLabelKey: testProject},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: kubermaticv1.ClusterSpec{
+			HumanReadableName: "my-cluster",
+			Cloud:             kubermaticv1.CloudSpec{DatacenterName: "hetzner-fsn1"},
+		},
+		Status: kubermaticv1.ClusterStatus{
+			Phase:          phase,
+			ExtendedHealth: kubermaticv1.ExtendedClusterHealth{Apiserver: kubermaticv1.HealthStatusUp},
+		},
+	}
+}
+
+func genMachine(name, serverType string) *clusterv1alpha1.Machine {
+	return &clusterv1alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem},
+		Spec: clusterv1alpha1.MachineSpec{
+			ProviderSpec: clusterv1alpha1.ProviderSpec{
+				Value: &runtime.RawExtension{Raw: []byte(`{"cloudProvider":"hetzner","cloudProviderSpec":{"serverType":"` + serverType + `"},"operatingSystem":"ubuntu","operatingSystemSpec":{}}`)},
+			},
+		},
+	}
+}
+
+func genPriceList() *kubermaticv1.PriceList {
+	return &kubermaticv1.PriceList{
+		ObjectMeta: metav1.ObjectMeta{Name: "hetzner-fsn1"},
+		Spec: kubermaticv1.PriceListSpec{
+			Currency:     "EUR",
+			ControlPlane: 0.1,
+			Sizes:        map[string]float64{"cx21": 0.01, "cx31": 0.02},
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, time.October, 10, 12, 0, 0, 0, time.UTC)
+	machines := []runtime.Object{genMachine("a", "cx21"), genMachine("b", "cx21"), genMachine("c", "cx31"), genMachine("d", "cx51")}
+
+	testCases := []struct {
+		name           string
+		cluster        *kubermaticv1.Cluster
+		masterObjects  []runtime.Object
+		expectedUsages []kubermaticv1.ClusterUsage
+	}{
+		{
+			name:          "new cluster is recorded since its creation",
+			cluster:       genCluster(now.Add(-10*time.Minute), ""),
+			masterObjects: []runtime.Object{genPriceList()},
+			expectedUsages: []kubermaticv1.ClusterUsage{{
+				ClusterID:         testCluster,
+				ClusterName:       "my-cluster",
+				Datacenter:        "hetzner-fsn1",
+				Currency:          "EUR",
+				ControlPlaneHours: 1.0 / 6,
+				ControlPlaneCost:  0.1 / 6,
+				Nodes: []kubermaticv1.NodeUsage{
+					{Size: "cx21", NodeHours: 2.0 / 6, Cost: 0.02 / 6},
+					{Size: "cx31", NodeHours: 1.0 / 6, Cost: 0.02 / 6},
+					{Size: "cx51", NodeHours: 1.0 / 6},
+				},
+				LastSampled: metav1.NewTime(now),
+			}},
+		},
+		{
+			name:    "usage is added to the existing report and limited to the maximum gap",
+			cluster: genCluster(now.Add(-24*time.Hour), ""),
+			masterObjects: []runtime.Object{
+				genPriceList(),
+				&kubermaticv1.UsageReport{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-project-2020-10", ResourceVersion: "1"},
+					Spec:       kubermaticv1.UsageReportSpec{ProjectID: testProject, Period: "2020-10"},
+					Status: kubermaticv1.UsageReportStatus{Clusters: []kubermaticv1.ClusterUsage{{
+						ClusterID:         testCluster,
+						Currency:          "EUR",
+						ControlPlaneHours: 1,
+						ControlPlaneCost:  0.1,
+						Nodes:             []kubermaticv1.NodeUsage{{Size: "cx21", NodeHours: 2, Cost: 0.02}},
+						LastSampled:       metav1.NewTime(now.Add(-5 * time.Hour)),
+					}}},
+				},
+			},
+			expectedUsages: []kubermaticv1.ClusterUsage{{
+				ClusterID:         testCluster,
+				ClusterName:       "my-cluster",
+				Datacenter:        "hetzner-fsn1",
+				Currency:          "EUR",
+				ControlPlaneHours: 1.5,
+				ControlPlaneCost:  0.15,
+				Nodes: []kubermaticv1.NodeUsage{
+					{Size: "cx21", NodeHours: 3, Cost: 0.03},
+					{Size: "cx31", NodeHours: 0.5, Cost: 0.01},
+					{Size: "cx51", NodeHours: 0.5},
+				},
+				LastSampled: metav1.NewTime(now),
+			}},
+		},
+		{
+			name:    "hibernated cluster has no usage",
+			cluster: genCluster(now.Add(-10*time.Minute), kubermaticv1.ClusterPhaseHibernated),
+			expectedUsages: []kubermaticv1.ClusterUsage{{
+				ClusterID:   testCluster,
+				ClusterName: "my-cluster",
+				Datacenter:  "hetzner-fsn1",
+				LastSampled: metav1.NewTime(now),
+			}},
+		},
+		{
+			name:          "usage without price list has no costs",
+			cluster:       genCluster(now.Add(-10*time.Minute), ""),
+			masterObjects: []runtime.Object{},
+			expectedUsages: []kubermaticv1.ClusterUsage{{
+				ClusterID:         testCluster,
+				ClusterName:       "my-cluster",
+				Datacenter:        "hetzner-fsn1",
+				ControlPlaneHours: 1.0 / 6,
+				Nodes: []kubermaticv1.NodeUsage{
+					{Size: "cx21", NodeHours: 2.0 / 6},
+					{Size: "cx31", NodeHours: 1.0 / 6},
+					{Size: "cx51", NodeHours: 1.0 / 6},
+				},
+				LastSampled: metav1.NewTime(now),
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			masterClient := ctrlruntimefakeclient.NewFakeClient(tc.masterObjects...)
+			userClusterClient := ctrlruntimefakeclient.NewFakeClient(machines...)
+			r := &Reconciler{
+				ctx:         context.Background(),
+				log:         kubermaticlog.Logger,
+				client:      masterClient,
+				apiReader:   masterClient,
+				seedClients: map[string]ctrlruntimeclient.Client{testSeed: ctrlruntimefakeclient.NewFakeClient(tc.cluster)},
+				now:         func() time.Time { return now },
+				userClusterClientGetter: func(ctrlruntimeclient.Client, *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+					return userClusterClient, nil
+				},
+			}
+
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testSeed, Name: testCluster}})
+			if err != nil {
+				t.Fatalf("failed to reconcile: %v", err)
+			}
+			if result.RequeueAfter != samplingInterval {
+				t.Errorf("expected the cluster to be requeued after %v, got %v", samplingInterval, result.RequeueAfter)
+			}
+
+			report := &kubermaticv1.UsageReport{}
+			if err := masterClient.Get(context.Background(), types.NamespacedName{Name: "test-project-2020-10"}, report); err != nil {
+				t.Fatalf("failed to get usage report: %v", err)
+			}
+			if report.Spec.ProjectID != testProject || report.Spec.Period != "2020-10" {
+				t.Errorf("unexpected report spec %+v", report.Spec)
+			}
+			if len(report.Status.Clusters) != len(tc.expectedUsages) {
+				t.Fatalf("expected %d cluster usages, got %d", len(tc.expectedUsages), len(report.Status.Clusters))
+			}
+			for i, expected := range tc.expectedUsages {
+				assertUsage(t, expected, report.Status.Clusters[i])
+			}
+		})
+	}
+}
+
+// concurrentWriterClient records the usage of another cluster in the report right before the first patch,
+// as another worker would do
+type concurrentWriterClient struct {
+	ctrlruntimeclient.Client
+	written bool
+}
+
+func (c *concurrentWriterClient) Patch(ctx context.Context, obj runtime.Object, patch ctrlruntimeclient.Patch, opts ...ctrlruntimeclient.PatchOption) error {
+	if !c.written {
+		c.written = true
+		report := &kubermaticv1.UsageReport{}
+		if err := c.Client.Get(ctx, types.NamespacedName{Name: "test-project-2020-10"}, report); err != nil {
+			return err
+		}
+		report.Status.Clusters = append(report.Status.Clusters, kubermaticv1.ClusterUsage{ClusterID: "other-cluster"})
+		if err := c.Client.Update(ctx, report); err != nil {
+			return err
+		}
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestReconcileRetriesConcurrentUpdates(t *testing.T) {
+	now := time.Date(2020, time.October, 10, 12, 0, 0, 0, time.UTC)
+	masterClient := &concurrentWriterClient{Client: ctrlruntimefakeclient.NewFakeClient(&kubermaticv1.UsageReport{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-project-2020-10", ResourceVersion: "1"},
+		Spec:       kubermaticv1.UsageReportSpec{ProjectID: testProject, Period: "2020-10"},
+	})}
+	r := &Reconciler{
+		ctx:         context.Background(),
+		log:         kubermaticlog.Logger,
+		client:      masterClient,
+		apiReader:   masterClient,
+		seedClients: map[string]ctrlruntimeclient.Client{testSeed: ctrlruntimefakeclient.NewFakeClient(genCluster(now.Add(-10*time.Minute), kubermaticv1.ClusterPhaseHibernated))},
+		now:         func() time.Time { return now },
+	}
+
+	if _, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: testSeed, Name: testCluster}}); err != nil {
+		t.Fatalf("failed to reconcile: %v", err)
+	}
+
+	report := &kubermaticv1.UsageReport{}
+	if err := masterClient.Get(context.Background(), types.NamespacedName{Name: "test-project-2020-10"}, report); err != nil {
+		t.Fatalf("failed to get usage report: %v", err)
+	}
+	var clusterIDs []string
+	for _, usage := range report.Status.Clusters {
+		clusterIDs = append(clusterIDs, usage.ClusterID)
+	}
+	if len(clusterIDs) != 2 || clusterIDs[0] != "other-cluster" || clusterIDs[1] != testCluster {
+		t.Errorf("expected the usages of other-cluster and %s, got %v", testCluster, clusterIDs)
+	}
+}
+
+func assertUsage(t *testing.T, expected, actual kubermaticv1.ClusterUsage) {
+	t.Helper()
+
+	if expected.ClusterID != actual.ClusterID || expected.ClusterName != actual.ClusterName || expected.Datacenter != actual.Datacenter || expected.Currency != actual.Currency {
+		t.Errorf("expected cluster %s/%s in %s with currency %q, got %s/%s in %s with currency %q",
+			expected.ClusterID, expected.ClusterName, expected.Datacenter, expected.Currency, actual.ClusterID, actual.ClusterName, actual.Datacenter, actual.Currency)
+	}
+	if !expected.LastSampled.Equal(&actual.LastSampled) {
+		t.Errorf("expected last sample at %v, got %v", expected.LastSampled, actual.LastSampled)
+	}
+	if !almostEqual(expected.ControlPlaneHours, actual.ControlPlaneHours) || !almostEqual(expected.ControlPlaneCost, actual.ControlPlaneCost) {
+		t.Errorf("expected %f control plane hours costing %f, got %f costing %f", expected.ControlPlaneHours, expected.ControlPlaneCost, actual.ControlPlaneHours, actual.ControlPlaneCost)
+	}
+	if len(expected.Nodes) != len(actual.Nodes) {
+		t.Fatalf("expected node usages %+v, got %+v", expected.Nodes, actual.Nodes)
+	}
+	for i := range expected.Nodes {
+		if expected.Nodes[i].Size != actual.Nodes[i].Size || !almostEqual(expected.Nodes[i].NodeHours, actual.Nodes[i].NodeHours) || !almostEqual(expected.Nodes[i].Cost, actual.Nodes[i].Cost) {
+			t.Errorf("expected node usage %+v, got %+v", expected.Nodes[i], actual.Nodes[i])
+		}
+	}
+}
+
+func almostEqual(a, b float64) bool {
+	diff := a - b
+	return diff < 1e-9 && diff > -1e-9
+}
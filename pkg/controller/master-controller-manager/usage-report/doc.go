@@ -0,0 +1,23 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package usagereport contains a controller that records the usage of the user clusters for chargeback.
+It samples every cluster of all seeds periodically and adds the control plane hours and the node-hours
+per instance size since the last sample to the UsageReport of the project for the current month. The
+costs are calculated with the PriceList of the datacenter of the cluster at the time of the sample.
+*/
+package usagereport
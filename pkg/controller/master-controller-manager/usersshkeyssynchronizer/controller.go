@@ -19,6 +19,7 @@ package usersshkeyssynchronizer
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -36,12 +37,15 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
@@ -124,6 +128,15 @@ func Add(
 		return fmt.Errorf("failed to create watch for userSSHKey: %v", err)
 	}
 
+	// the keys of suspended users are removed from the clusters
+	if err := c.Watch(
+		&source.Kind{Type: &kubermaticv1.User{}},
+		enqueueAllClusters(reconciler.seedClients, workerSelector),
+		suspensionChangedPredicate(),
+	); err != nil {
+		return fmt.Errorf("failed to create watch for users: %v", err)
+	}
+
 	return nil
 }
 
@@ -197,7 +210,20 @@ func (r *Reconciler) reconcile(log *zap.SugaredLogger, request reconcile.Request
 		return nil
 	}
 
-	keys := buildUserSSHKeysForCluster(cluster.Name, userSSHKeys)
+	// The API detaches the keys when a cluster gets deleted with a grace period, this
+	// catches keys which were assigned to the cluster through other means in the meantime
+	var keys []kubermaticv1.UserSSHKey
+	if cluster.IsSoftDeleted() {
+		if err := r.cleanupUserSSHKeys(userSSHKeys.Items, cluster.Name); err != nil {
+			return fmt.Errorf("failed reconciling usersshkey: %v", err)
+		}
+	} else {
+		users := &kubermaticv1.UserList{}
+		if err := r.client.List(r.ctx, users); err != nil {
+			return fmt.Errorf("failed to list users: %v", err)
+		}
+		keys = buildUserSSHKeysForCluster(cluster.Name, userSSHKeys, suspendedUsers(users))
+	}
 
 	if err := reconciling.ReconcileSecrets(
 		r.ctx,
@@ -221,6 +247,9 @@ func (r *Reconciler) reconcile(log *zap.SugaredLogger, request reconcile.Request
 
 func (r *Reconciler) cleanupUserSSHKeys(keys []kubermaticv1.UserSSHKey, clusterName string) error {
 	for _, userSSHKey := range keys {
+		if !userSSHKey.IsUsedByCluster(clusterName) {
+			continue
+		}
 		userSSHKey.RemoveFromCluster(clusterName)
 		if err := r.client.Update(r.ctx, &userSSHKey); err != nil {
 			return fmt.Errorf("failed updating usersshkeys object: %v", err)
@@ -230,9 +259,12 @@ func (r *Reconciler) cleanupUserSSHKeys(keys []kubermaticv1.UserSSHKey, clusterN
 	return nil
 }
 
-func buildUserSSHKeysForCluster(clusterName string, list *kubermaticv1.UserSSHKeyList) []kubermaticv1.UserSSHKey {
+func buildUserSSHKeysForCluster(clusterName string, list *kubermaticv1.UserSSHKeyList, suspendedUsers sets.String) []kubermaticv1.UserSSHKey {
 	var clusterKeys []kubermaticv1.UserSSHKey
 	for _, item := range list.Items {
+		if suspendedUsers.Has(strings.ToLower(item.Spec.Owner)) {
+			continue
+		}
 		for _, clusterID := range item.Spec.Clusters {
 			if clusterName == clusterID {
 				clusterKeys = append(clusterKeys, item)
@@ -243,6 +275,42 @@ func buildUserSSHKeysForCluster(clusterName string, list *kubermaticv1.UserSSHKe
 	return clusterKeys
 }
 
+// suspendedUsers returns the lowercased email addresses of the suspended users
+func suspendedUsers(list *kubermaticv1.UserList) sets.String {
+	suspended := sets.NewString()
+	for _, user := range list.Items {
+		if user.Spec.Suspended {
+			suspended.Insert(strings.ToLower(user.Spec.Email))
+		}
+	}
+	return suspended
+}
+
+// suspensionChangedPredicate only lets through the users that have been suspended or reactivated
+func suspensionChangedPredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			user, ok := e.Object.(*kubermaticv1.User)
+			return ok && user.Spec.Suspended
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldUser, ok := e.ObjectOld.(*kubermaticv1.User)
+			if !ok {
+				return false
+			}
+			newUser, ok := e.ObjectNew.(*kubermaticv1.User)
+			return ok && oldUser.Spec.Suspended != newUser.Spec.Suspended
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			user, ok := e.Object.(*kubermaticv1.User)
+			return ok && user.Spec.Suspended
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
 // enqueueAllClusters enqueues all clusters
 func enqueueAllClusters(clients map[string]ctrlruntimeclient.Client, workerSelector labels.Selector) *handler.EnqueueRequestsFromMapFunc {
 	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
@@ -91,6 +91,62 @@ func TestUserSSHKeysClusterRemove(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Test cleanup cluster ids in UserSSHKey on cluster deletion with a grace period",
+			reconciler: &Reconciler{
+				ctx: context.Background(),
+				log: kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				client: fake.NewFakeClient(
+					&kubermaticv1.UserSSHKeyList{
+						Items: []kubermaticv1.UserSSHKey{
+							{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      "test_user_ssh_keys",
+									Namespace: "test_namespace",
+								},
+								Spec: kubermaticv1.SSHKeySpec{
+									Clusters: []string{
+										"test_cluster_1",
+										"test_cluster_2",
+									},
+								},
+							},
+						},
+					},
+				),
+				seedClients: map[string]client.Client{
+					"seed_test": fake.NewFakeClient(
+						&kubermaticv1.Cluster{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "test_cluster_1",
+							},
+							Spec: kubermaticv1.ClusterSpec{
+								SoftDeletion: &kubermaticv1.ClusterSoftDeletion{DeleteAt: deletionTimestamp},
+							},
+							Status: kubermaticv1.ClusterStatus{
+								NamespaceName: "cluster-test_cluster_1",
+							},
+						},
+					),
+				},
+			},
+			request: reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      "test_cluster_1", // cluster name
+					Namespace: "seed_test",      // seed name
+				},
+			},
+			expectedUserSSHKey: kubermaticv1.UserSSHKey{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test_user_ssh_keys",
+				},
+				Spec: kubermaticv1.SSHKeySpec{
+					Clusters: []string{
+						"test_cluster_2",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -112,3 +168,38 @@ func TestUserSSHKeysClusterRemove(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildUserSSHKeysForClusterSkipsSuspendedUsers(t *testing.T) {
+	keys := &kubermaticv1.UserSSHKeyList{
+		Items: []kubermaticv1.UserSSHKey{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "john_key"},
+				Spec:       kubermaticv1.SSHKeySpec{Owner: "john@acme.com", Clusters: []string{"test_cluster"}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "bob_key"},
+				Spec:       kubermaticv1.SSHKeySpec{Owner: "Bob@acme.com", Clusters: []string{"test_cluster"}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "unowned_key"},
+				Spec:       kubermaticv1.SSHKeySpec{Clusters: []string{"test_cluster"}},
+			},
+		},
+	}
+	users := &kubermaticv1.UserList{
+		Items: []kubermaticv1.User{
+			{Spec: kubermaticv1.UserSpec{Email: "john@acme.com"}},
+			{Spec: kubermaticv1.UserSpec{Email: "bob@acme.com", Suspended: true}},
+		},
+	}
+
+	clusterKeys := buildUserSSHKeysForCluster("test_cluster", keys, suspendedUsers(users))
+
+	names := []string{}
+	for _, key := range clusterKeys {
+		names = append(names, key.Name)
+	}
+	if expected := []string{"john_key", "unowned_key"}; !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected the keys %v to be synchronized, got %v", expected, names)
+	}
+}
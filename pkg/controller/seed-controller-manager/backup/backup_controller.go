@@ -362,9 +362,14 @@ func (r *Reconciler) cleanupJob(cluster *kubermaticv1.Cluster) *batchv1.Job {
 	}
 }
 
+// CronJobName returns the name of the backup cronjob of the given cluster
+func CronJobName(cluster *kubermaticv1.Cluster) string {
+	return fmt.Sprintf("%s-%s", cronJobPrefix, cluster.Name)
+}
+
 func (r *Reconciler) cronjob(cluster *kubermaticv1.Cluster) reconciling.NamedCronJobCreatorGetter {
 	return func() (string, reconciling.CronJobCreator) {
-		return fmt.Sprintf("%s-%s", cronJobPrefix, cluster.Name), func(cronJob *batchv1beta1.CronJob) (*batchv1beta1.CronJob, error) {
+		return CronJobName(cluster), func(cronJob *batchv1beta1.CronJob) (*batchv1beta1.CronJob, error) {
 			gv := kubermaticv1.SchemeGroupVersion
 			cronJob.OwnerReferences = []metav1.OwnerReference{
 				*metav1.NewControllerRef(cluster, gv.WithKind(kubermaticv1.ClusterKindName)),
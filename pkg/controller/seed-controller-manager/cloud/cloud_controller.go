@@ -152,9 +152,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, clus
 			finalizers.Has(kubermaticapiv1.NodeDeletionFinalizer) {
 			return &reconcile.Result{RequeueAfter: 5 * time.Second}, nil
 		}
-		if _, err := prov.CleanUpCloudProvider(cluster, r.updateCluster); err != nil {
+		cluster, err := prov.CleanUpCloudProvider(cluster, r.updateCluster)
+		if err != nil {
 			return nil, fmt.Errorf("failed cloud provider cleanup: %v", err)
 		}
+		// Providers which have to wait for the removal of their resources return without error
+		// while the resources are still listed
+		if lister, ok := prov.(provider.CloudResourceLister); ok && cluster != nil && len(lister.ListCloudResources(cluster)) > 0 {
+			log.Debug("Waiting for the cloud provider resources to be removed")
+			return &reconcile.Result{RequeueAfter: 10 * time.Second}, nil
+		}
 		return nil, nil
 
 	}
@@ -0,0 +1,26 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package hibernation contains a controller that is responsible for hibernating and resuming user clusters:
+  - On hibernation the MachineDeployments of the user cluster are scaled to zero, an etcd snapshot is taken
+    and all Deployments and StatefulSets in the cluster namespace are scaled to zero. If the worker nodes are not
+    gone within 30 minutes, e.g. because the user cluster is unreachable, the control plane is scaled down anyway
+  - On resume the control plane is scaled up again, and once the apiserver is healthy the MachineDeployments
+    get their former replica count back
+  - The progress is tracked in the phase of the cluster status
+*/
+package hibernation
@@ -0,0 +1,530 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+	"go.uber.org/zap"
+
+	k8cuserclusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	backupcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/backup"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	utilpointer "k8s.io/utils/pointer"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	ControllerName = "kubermatic_hibernation_controller"
+
+	// ReplicasAnnotation holds the replica count an object had before the cluster got hibernated
+	ReplicasAnnotation = "kubermatic.io/hibernation-replicas"
+
+	// snapshotJobSuffix is appended to the name of the backup cronjob to get the name
+	// of the job that takes the etcd snapshot before the control plane is scaled down
+	snapshotJobSuffix = "hibernation"
+
+	requeueInterval = 10 * time.Second
+
+	// userClusterTimeout is the time the worker nodes get to be scaled down. Once it passed, the control plane
+	// is scaled down anyway, otherwise an unreachable user cluster would keep it running forever.
+	userClusterTimeout = 30 * time.Minute
+	// userClusterRequestTimeout limits the single requests to the user cluster
+	userClusterRequestTimeout = 30 * time.Second
+)
+
+// userClusterConnectionProvider offers functions to retrieve clients for the given user clusters
+type userClusterConnectionProvider interface {
+	GetClient(*kubermaticv1.Cluster, ...k8cuserclusterclient.ConfigOption) (ctrlruntimeclient.Client, error)
+}
+
+// Reconciler scales the worker nodes and the control plane of hibernated clusters to zero
+type Reconciler struct {
+	ctrlruntimeclient.Client
+	log                     *zap.SugaredLogger
+	userClusterConnProvider userClusterConnectionProvider
+	workerName              string
+	recorder                record.EventRecorder
+}
+
+func Add(
+	mgr manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+	workerName string,
+	userClusterConnProvider userClusterConnectionProvider,
+) error {
+	reconciler := &Reconciler{
+		Client:                  mgr.GetClient(),
+		log:                     log.Named(ControllerName),
+		userClusterConnProvider: userClusterConnProvider,
+		workerName:              workerName,
+		recorder:                mgr.GetEventRecorderFor(ControllerName),
+	}
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: numWorkers})
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, &handler.EnqueueRequestForObject{})
+}
+
+func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		return reconcile.Result{}, nil
+	}
+	if cluster.Spec.Pause {
+		return reconcile.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, log, cluster)
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "ReconcilingError", "%v", err)
+	}
+	if result == nil {
+		result = &reconcile.Result{}
+	}
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	// A cluster that gets deleted must be resumed, the cleanup needs a running control plane
+	hibernate := cluster.Spec.Hibernated && cluster.DeletionTimestamp == nil
+
+	switch {
+	case hibernate && !cluster.IsHibernated():
+		log.Info("Hibernating cluster")
+		r.recorder.Event(cluster, corev1.EventTypeNormal, "Hibernating", "Scaling the cluster down")
+		return nil, r.setPhase(ctx, cluster, kubermaticv1.ClusterPhaseHibernating)
+	case hibernate && cluster.Status.Phase == kubermaticv1.ClusterPhaseHibernating:
+		return r.hibernate(ctx, log, cluster)
+	case !hibernate && cluster.IsHibernated():
+		log.Info("Resuming cluster")
+		r.recorder.Event(cluster, corev1.EventTypeNormal, "Resuming", "Scaling the cluster up")
+		return nil, r.setPhase(ctx, cluster, kubermaticv1.ClusterPhaseResuming)
+	case !hibernate && cluster.Status.Phase == kubermaticv1.ClusterPhaseResuming:
+		return r.resume(ctx, log, cluster)
+	}
+
+	return nil, nil
+}
+
+func (r *Reconciler) hibernate(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	// clusters which started hibernating before the transition time got recorded get the full timeout
+	if cluster.Status.PhaseTransitionTime.IsZero() {
+		return nil, r.setPhase(ctx, cluster, kubermaticv1.ClusterPhaseHibernating)
+	}
+
+	// Once the apiserver is scaled down we can not reach the user cluster anymore,
+	// this happens when the controller got restarted during the last step.
+	controlPlaneScaledDown, err := r.controlPlaneScaledDown(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if !controlPlaneScaledDown {
+		finished, err := r.scaleDownWorkers(ctx, log, cluster)
+		if err != nil || !finished {
+			if time.Since(cluster.Status.PhaseTransitionTime.Time) < userClusterTimeout {
+				if err != nil {
+					return nil, err
+				}
+				return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+			}
+
+			log.Warnw("Worker nodes were not scaled down in time, scaling down the control plane anyway", zap.Error(err))
+			r.recorder.Eventf(cluster, corev1.EventTypeWarning, "HibernationTimeout",
+				"The worker nodes were not scaled down and the etcd snapshot was not taken within %v, the control plane is scaled down anyway: %v", userClusterTimeout, err)
+		}
+	}
+
+	if err := r.setBackupSuspended(ctx, cluster, true); err != nil {
+		return nil, err
+	}
+
+	if err := r.scaleDownControlPlane(ctx, cluster); err != nil {
+		return nil, err
+	}
+
+	oldCluster := cluster.DeepCopy()
+	cluster.Status.Phase = kubermaticv1.ClusterPhaseHibernated
+	cluster.Status.PhaseTransitionTime = metav1.Now()
+	cluster.Status.ExtendedHealth = kubermaticv1.ExtendedClusterHealth{}
+	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		return nil, fmt.Errorf("failed to update cluster: %v", err)
+	}
+
+	log.Info("Cluster is hibernated")
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "Hibernated", "The cluster has been scaled down")
+	return nil, nil
+}
+
+// scaleDownWorkers scales the machine deployments of the user cluster to zero and takes the etcd snapshot.
+// It returns true once the machines are gone and the snapshot got taken.
+func (r *Reconciler) scaleDownWorkers(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (bool, error) {
+	userClusterClient, err := r.userClusterConnProvider.GetClient(cluster)
+	if err != nil {
+		return false, fmt.Errorf("failed to get user cluster client: %v", err)
+	}
+
+	userClusterCtx, cancel := context.WithTimeout(ctx, userClusterRequestTimeout)
+	defer cancel()
+
+	if err := scaleDownMachineDeployments(userClusterCtx, userClusterClient); err != nil {
+		return false, err
+	}
+
+	machines := &clusterv1alpha1.MachineList{}
+	if err := userClusterClient.List(userClusterCtx, machines, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return false, fmt.Errorf("failed to list machines: %v", err)
+	}
+	if len(machines.Items) > 0 {
+		log.Debugw("Waiting for machines to be deleted", "machines", len(machines.Items))
+		return false, nil
+	}
+
+	snapshotTaken, err := r.takeEtcdSnapshot(ctx, cluster)
+	if err != nil {
+		return false, err
+	}
+	if !snapshotTaken {
+		log.Debug("Waiting for the etcd snapshot")
+	}
+	return snapshotTaken, nil
+}
+
+func (r *Reconciler) resume(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if err := r.scaleUpControlPlane(ctx, cluster); err != nil {
+		return nil, err
+	}
+
+	if err := r.setBackupSuspended(ctx, cluster, false); err != nil {
+		return nil, err
+	}
+
+	if cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusUp {
+		log.Debug("Waiting for the apiserver to become healthy")
+		return &reconcile.Result{RequeueAfter: requeueInterval}, nil
+	}
+
+	userClusterClient, err := r.userClusterConnProvider.GetClient(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user cluster client: %v", err)
+	}
+	userClusterCtx, cancel := context.WithTimeout(ctx, userClusterRequestTimeout)
+	defer cancel()
+	if err := scaleUpMachineDeployments(userClusterCtx, userClusterClient); err != nil {
+		return nil, err
+	}
+
+	if err := r.setPhase(ctx, cluster, ""); err != nil {
+		return nil, err
+	}
+
+	log.Info("Cluster is resumed")
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "Resumed", "The cluster has been scaled up")
+	return nil, nil
+}
+
+func (r *Reconciler) setPhase(ctx context.Context, cluster *kubermaticv1.Cluster, phase kubermaticv1.ClusterPhase) error {
+	oldCluster := cluster.DeepCopy()
+	cluster.Status.Phase = phase
+	cluster.Status.PhaseTransitionTime = metav1.Now()
+	if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		return fmt.Errorf("failed to set cluster phase to %q: %v", phase, err)
+	}
+	return nil
+}
+
+func scaleDownMachineDeployments(ctx context.Context, client ctrlruntimeclient.Client) error {
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed to list machinedeployments: %v", err)
+	}
+
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+		if md.Spec.Replicas != nil && *md.Spec.Replicas == 0 {
+			continue
+		}
+
+		oldMD := md.DeepCopy()
+		scaleDown(&md.ObjectMeta, &md.Spec.Replicas)
+		if err := client.Patch(ctx, md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
+			return fmt.Errorf("failed to scale down machinedeployment %q: %v", md.Name, err)
+		}
+	}
+	return nil
+}
+
+func scaleUpMachineDeployments(ctx context.Context, client ctrlruntimeclient.Client) error {
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed to list machinedeployments: %v", err)
+	}
+
+	for i := range machineDeployments.Items {
+		md := &machineDeployments.Items[i]
+		if _, ok := md.Annotations[ReplicasAnnotation]; !ok {
+			continue
+		}
+
+		oldMD := md.DeepCopy()
+		scaleUp(&md.ObjectMeta, &md.Spec.Replicas)
+		if err := client.Patch(ctx, md, ctrlruntimeclient.MergeFrom(oldMD)); err != nil {
+			return fmt.Errorf("failed to scale up machinedeployment %q: %v", md.Name, err)
+		}
+	}
+	return nil
+}
+
+// takeEtcdSnapshot creates a one-off job from the backup cronjob of the cluster and returns
+// true once the job completed. Clusters without a backup cronjob are not snapshotted.
+func (r *Reconciler) takeEtcdSnapshot(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, error) {
+	cronJob := &batchv1beta1.CronJob{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: backupcontroller.CronJobName(cluster)}, cronJob); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get backup cronjob: %v", err)
+	}
+
+	jobName := types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: snapshotJobName(cluster)}
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, jobName, job); err != nil {
+		if !kerrors.IsNotFound(err) {
+			return false, fmt.Errorf("failed to get etcd snapshot job: %v", err)
+		}
+
+		job = &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            jobName.Name,
+				Namespace:       jobName.Namespace,
+				Labels:          cronJob.Spec.JobTemplate.Labels,
+				OwnerReferences: []metav1.OwnerReference{resources.GetClusterRef(cluster)},
+			},
+			Spec: *cronJob.Spec.JobTemplate.Spec.DeepCopy(),
+		}
+		if err := r.Create(ctx, job); err != nil {
+			return false, fmt.Errorf("failed to create etcd snapshot job: %v", err)
+		}
+		return false, nil
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return false, fmt.Errorf("etcd snapshot job %q failed: %s", job.Name, condition.Message)
+		}
+	}
+	return false, nil
+}
+
+// setBackupSuspended suspends or unsuspends the backup cronjob of the cluster. When the cluster gets resumed
+// the leftover snapshot job is removed as well, so the next hibernation takes a fresh snapshot.
+func (r *Reconciler) setBackupSuspended(ctx context.Context, cluster *kubermaticv1.Cluster, suspend bool) error {
+	if !suspend {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: snapshotJobName(cluster)},
+		}
+		if err := r.Delete(ctx, job, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete etcd snapshot job: %v", err)
+		}
+	}
+
+	cronJob := &batchv1beta1.CronJob{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: backupcontroller.CronJobName(cluster)}, cronJob); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get backup cronjob: %v", err)
+	}
+	if cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend == suspend {
+		return nil
+	}
+
+	oldCronJob := cronJob.DeepCopy()
+	cronJob.Spec.Suspend = utilpointer.BoolPtr(suspend)
+	if err := r.Patch(ctx, cronJob, ctrlruntimeclient.MergeFrom(oldCronJob)); err != nil {
+		return fmt.Errorf("failed to update backup cronjob: %v", err)
+	}
+	return nil
+}
+
+// controlPlaneScaledDown returns true if the apiserver of the cluster has already been scaled down
+func (r *Reconciler) controlPlaneScaledDown(ctx context.Context, cluster *kubermaticv1.Cluster) (bool, error) {
+	apiserver := &appsv1.Deployment{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: resources.ApiserverDeploymentName}, apiserver); err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get apiserver deployment: %v", err)
+	}
+	_, ok := apiserver.Annotations[ReplicasAnnotation]
+	return ok, nil
+}
+
+func (r *Reconciler) scaleDownControlPlane(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return fmt.Errorf("failed to list deployments: %v", err)
+	}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if deployment.Spec.Replicas != nil && *deployment.Spec.Replicas == 0 {
+			continue
+		}
+
+		oldDeployment := deployment.DeepCopy()
+		scaleDown(&deployment.ObjectMeta, &deployment.Spec.Replicas)
+		if err := r.Patch(ctx, deployment, ctrlruntimeclient.MergeFrom(oldDeployment)); err != nil {
+			return fmt.Errorf("failed to scale down deployment %q: %v", deployment.Name, err)
+		}
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return fmt.Errorf("failed to list statefulsets: %v", err)
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		if statefulSet.Spec.Replicas != nil && *statefulSet.Spec.Replicas == 0 {
+			continue
+		}
+
+		oldStatefulSet := statefulSet.DeepCopy()
+		scaleDown(&statefulSet.ObjectMeta, &statefulSet.Spec.Replicas)
+		if err := r.Patch(ctx, statefulSet, ctrlruntimeclient.MergeFrom(oldStatefulSet)); err != nil {
+			return fmt.Errorf("failed to scale down statefulset %q: %v", statefulSet.Name, err)
+		}
+	}
+	return nil
+}
+
+// scaleUpControlPlane restores the StatefulSets first, so etcd is already starting
+// when the apiserver comes up.
+func (r *Reconciler) scaleUpControlPlane(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := r.List(ctx, statefulSets, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return fmt.Errorf("failed to list statefulsets: %v", err)
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		if _, ok := statefulSet.Annotations[ReplicasAnnotation]; !ok {
+			continue
+		}
+
+		oldStatefulSet := statefulSet.DeepCopy()
+		scaleUp(&statefulSet.ObjectMeta, &statefulSet.Spec.Replicas)
+		if err := r.Patch(ctx, statefulSet, ctrlruntimeclient.MergeFrom(oldStatefulSet)); err != nil {
+			return fmt.Errorf("failed to scale up statefulset %q: %v", statefulSet.Name, err)
+		}
+	}
+
+	deployments := &appsv1.DeploymentList{}
+	if err := r.List(ctx, deployments, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+		return fmt.Errorf("failed to list deployments: %v", err)
+	}
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		if _, ok := deployment.Annotations[ReplicasAnnotation]; !ok {
+			continue
+		}
+
+		oldDeployment := deployment.DeepCopy()
+		scaleUp(&deployment.ObjectMeta, &deployment.Spec.Replicas)
+		if err := r.Patch(ctx, deployment, ctrlruntimeclient.MergeFrom(oldDeployment)); err != nil {
+			return fmt.Errorf("failed to scale up deployment %q: %v", deployment.Name, err)
+		}
+	}
+	return nil
+}
+
+// scaleDown records the current replica count in the annotations of the object and sets it to zero.
+// An already recorded count is kept, so retries do not overwrite it with zero.
+func scaleDown(meta *metav1.ObjectMeta, replicas **int32) {
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	if _, ok := meta.Annotations[ReplicasAnnotation]; !ok {
+		current := int32(1)
+		if *replicas != nil {
+			current = **replicas
+		}
+		meta.Annotations[ReplicasAnnotation] = strconv.Itoa(int(current))
+	}
+	*replicas = utilpointer.Int32Ptr(0)
+}
+
+// scaleUp restores the replica count recorded by scaleDown and removes the annotation.
+// Objects which got scaled up by someone else in the meantime are left as they are.
+func scaleUp(meta *metav1.ObjectMeta, replicas **int32) {
+	value := meta.Annotations[ReplicasAnnotation]
+	delete(meta.Annotations, ReplicasAnnotation)
+
+	if *replicas != nil && **replicas != 0 {
+		return
+	}
+	restored, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		restored = 1
+	}
+	*replicas = utilpointer.Int32Ptr(int32(restored))
+}
+
+func snapshotJobName(cluster *kubermaticv1.Cluster) string {
+	return fmt.Sprintf("%s-%s", backupcontroller.CronJobName(cluster), snapshotJobSuffix)
+}
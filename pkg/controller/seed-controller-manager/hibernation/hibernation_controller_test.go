@@ -0,0 +1,273 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hibernation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	k8cuserclusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
+	backupcontroller "k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/backup"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	batchv1beta1 "k8s.io/api/batch/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	utilpointer "k8s.io/utils/pointer"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func init() {
+	if err := clusterv1alpha1.AddToScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+}
+
+type fakeUserClusterConnectionProvider struct {
+	client ctrlruntimeclient.Client
+	err    error
+}
+
+func (p *fakeUserClusterConnectionProvider) GetClient(*kubermaticv1.Cluster, ...k8cuserclusterclient.ConfigOption) (ctrlruntimeclient.Client, error) {
+	return p.client, p.err
+}
+
+func TestHibernateAndResume(t *testing.T) {
+	ctx := context.Background()
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+		Spec:       kubermaticv1.ClusterSpec{Hibernated: true},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-test-cluster",
+			ExtendedHealth: kubermaticv1.ExtendedClusterHealth{
+				Apiserver: kubermaticv1.HealthStatusUp,
+				Etcd:      kubermaticv1.HealthStatusUp,
+			},
+		},
+	}
+	apiserver := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cluster.Status.NamespaceName, Name: resources.ApiserverDeploymentName},
+		Spec:       appsv1.DeploymentSpec{Replicas: utilpointer.Int32Ptr(2)},
+	}
+	etcd := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: cluster.Status.NamespaceName, Name: resources.EtcdStatefulSetName},
+		Spec:       appsv1.StatefulSetSpec{Replicas: utilpointer.Int32Ptr(3)},
+	}
+	cronJob := &batchv1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: backupcontroller.CronJobName(cluster)},
+		Spec:       batchv1beta1.CronJobSpec{Suspend: utilpointer.BoolPtr(false)},
+	}
+	machineDeployment := &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "workers"},
+		Spec:       clusterv1alpha1.MachineDeploymentSpec{Replicas: utilpointer.Int32Ptr(4)},
+	}
+
+	seedClient := ctrlruntimefakeclient.NewFakeClient(cluster, apiserver, etcd, cronJob)
+	userClusterClient := ctrlruntimefakeclient.NewFakeClient(machineDeployment)
+	r := &Reconciler{
+		Client:                  seedClient,
+		log:                     kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+		userClusterConnProvider: &fakeUserClusterConnectionProvider{client: userClusterClient},
+		recorder:                record.NewFakeRecorder(100),
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: cluster.Name}}
+	reconcileCluster := func() reconcile.Result {
+		t.Helper()
+		result, err := r.Reconcile(request)
+		if err != nil {
+			t.Fatalf("failed to reconcile: %v", err)
+		}
+		cluster = &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, request.NamespacedName, cluster); err != nil {
+			t.Fatalf("failed to get cluster: %v", err)
+		}
+		return result
+	}
+	getObject := func(client ctrlruntimeclient.Client, obj runtime.Object, namespace, name string) runtime.Object {
+		t.Helper()
+		if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, obj); err != nil {
+			t.Fatalf("failed to get %s/%s: %v", namespace, name, err)
+		}
+		return obj
+	}
+
+	// hibernate
+	reconcileCluster()
+	if cluster.Status.Phase != kubermaticv1.ClusterPhaseHibernating {
+		t.Fatalf("expected phase %q, got %q", kubermaticv1.ClusterPhaseHibernating, cluster.Status.Phase)
+	}
+
+	if result := reconcileCluster(); result.RequeueAfter == 0 {
+		t.Fatal("expected a requeue while the etcd snapshot is taken")
+	}
+	machineDeployment = getObject(userClusterClient, &clusterv1alpha1.MachineDeployment{}, metav1.NamespaceSystem, machineDeployment.Name).(*clusterv1alpha1.MachineDeployment)
+	if *machineDeployment.Spec.Replicas != 0 || machineDeployment.Annotations[ReplicasAnnotation] != "4" {
+		t.Fatalf("expected machinedeployment to be scaled down, got replicas %d and annotations %v", *machineDeployment.Spec.Replicas, machineDeployment.Annotations)
+	}
+
+	job := getObject(seedClient, &batchv1.Job{}, metav1.NamespaceSystem, snapshotJobName(cluster)).(*batchv1.Job)
+	job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+	if err := seedClient.Update(ctx, job); err != nil {
+		t.Fatalf("failed to update job: %v", err)
+	}
+
+	reconcileCluster()
+	if cluster.Status.Phase != kubermaticv1.ClusterPhaseHibernated {
+		t.Fatalf("expected phase %q, got %q", kubermaticv1.ClusterPhaseHibernated, cluster.Status.Phase)
+	}
+	if cluster.Status.ExtendedHealth.Apiserver != kubermaticv1.HealthStatusDown {
+		t.Errorf("expected apiserver health to be down")
+	}
+	apiserver = getObject(seedClient, &appsv1.Deployment{}, apiserver.Namespace, apiserver.Name).(*appsv1.Deployment)
+	if *apiserver.Spec.Replicas != 0 || apiserver.Annotations[ReplicasAnnotation] != "2" {
+		t.Errorf("expected apiserver to be scaled down, got replicas %d and annotations %v", *apiserver.Spec.Replicas, apiserver.Annotations)
+	}
+	etcd = getObject(seedClient, &appsv1.StatefulSet{}, etcd.Namespace, etcd.Name).(*appsv1.StatefulSet)
+	if *etcd.Spec.Replicas != 0 || etcd.Annotations[ReplicasAnnotation] != "3" {
+		t.Errorf("expected etcd to be scaled down, got replicas %d and annotations %v", *etcd.Spec.Replicas, etcd.Annotations)
+	}
+	cronJob = getObject(seedClient, &batchv1beta1.CronJob{}, cronJob.Namespace, cronJob.Name).(*batchv1beta1.CronJob)
+	if !*cronJob.Spec.Suspend {
+		t.Errorf("expected backup cronjob to be suspended")
+	}
+
+	// resume
+	cluster.Spec.Hibernated = false
+	if err := seedClient.Update(ctx, cluster); err != nil {
+		t.Fatalf("failed to update cluster: %v", err)
+	}
+	reconcileCluster()
+	if cluster.Status.Phase != kubermaticv1.ClusterPhaseResuming {
+		t.Fatalf("expected phase %q, got %q", kubermaticv1.ClusterPhaseResuming, cluster.Status.Phase)
+	}
+
+	if result := reconcileCluster(); result.RequeueAfter == 0 {
+		t.Fatal("expected a requeue while the apiserver is not healthy")
+	}
+	apiserver = getObject(seedClient, &appsv1.Deployment{}, apiserver.Namespace, apiserver.Name).(*appsv1.Deployment)
+	if *apiserver.Spec.Replicas != 2 {
+		t.Errorf("expected apiserver to be scaled up to 2 replicas, got %d", *apiserver.Spec.Replicas)
+	}
+	if _, ok := apiserver.Annotations[ReplicasAnnotation]; ok {
+		t.Errorf("expected replicas annotation to be removed from apiserver")
+	}
+	etcd = getObject(seedClient, &appsv1.StatefulSet{}, etcd.Namespace, etcd.Name).(*appsv1.StatefulSet)
+	if *etcd.Spec.Replicas != 3 {
+		t.Errorf("expected etcd to be scaled up to 3 replicas, got %d", *etcd.Spec.Replicas)
+	}
+	cronJob = getObject(seedClient, &batchv1beta1.CronJob{}, cronJob.Namespace, cronJob.Name).(*batchv1beta1.CronJob)
+	if *cronJob.Spec.Suspend {
+		t.Errorf("expected backup cronjob not to be suspended")
+	}
+
+	cluster.Status.ExtendedHealth.Apiserver = kubermaticv1.HealthStatusUp
+	if err := seedClient.Update(ctx, cluster); err != nil {
+		t.Fatalf("failed to update cluster: %v", err)
+	}
+	reconcileCluster()
+	if cluster.Status.Phase != "" {
+		t.Fatalf("expected empty phase, got %q", cluster.Status.Phase)
+	}
+	machineDeployment = getObject(userClusterClient, &clusterv1alpha1.MachineDeployment{}, metav1.NamespaceSystem, machineDeployment.Name).(*clusterv1alpha1.MachineDeployment)
+	if *machineDeployment.Spec.Replicas != 4 {
+		t.Errorf("expected machinedeployment to be scaled up to 4 replicas, got %d", *machineDeployment.Spec.Replicas)
+	}
+}
+
+func TestHibernateUnreachableUserCluster(t *testing.T) {
+	testCases := []struct {
+		name                string
+		phaseTransitionTime time.Time
+		expectedError       bool
+		expectedPhase       kubermaticv1.ClusterPhase
+		expectedReplicas    int32
+	}{
+		{
+			name:                "the control plane is kept while the worker nodes can still be scaled down",
+			phaseTransitionTime: time.Now().Add(-time.Minute),
+			expectedError:       true,
+			expectedPhase:       kubermaticv1.ClusterPhaseHibernating,
+			expectedReplicas:    2,
+		},
+		{
+			name:                "the control plane is scaled down once the timeout passed",
+			phaseTransitionTime: time.Now().Add(-userClusterTimeout),
+			expectedPhase:       kubermaticv1.ClusterPhaseHibernated,
+			expectedReplicas:    0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			cluster := &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-cluster"},
+				Spec:       kubermaticv1.ClusterSpec{Hibernated: true},
+				Status: kubermaticv1.ClusterStatus{
+					NamespaceName:       "cluster-test-cluster",
+					Phase:               kubermaticv1.ClusterPhaseHibernating,
+					PhaseTransitionTime: metav1.NewTime(tc.phaseTransitionTime),
+				},
+			}
+			apiserver := &appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Namespace: cluster.Status.NamespaceName, Name: resources.ApiserverDeploymentName},
+				Spec:       appsv1.DeploymentSpec{Replicas: utilpointer.Int32Ptr(2)},
+			}
+
+			seedClient := ctrlruntimefakeclient.NewFakeClient(cluster, apiserver)
+			r := &Reconciler{
+				Client:                  seedClient,
+				log:                     kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				userClusterConnProvider: &fakeUserClusterConnectionProvider{err: errors.New("connection refused")},
+				recorder:                record.NewFakeRecorder(100),
+			}
+
+			request := reconcile.Request{NamespacedName: types.NamespacedName{Name: cluster.Name}}
+			if _, err := r.Reconcile(request); (err != nil) != tc.expectedError {
+				t.Fatalf("expected error to be %t, got %v", tc.expectedError, err)
+			}
+
+			if err := seedClient.Get(ctx, request.NamespacedName, cluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if cluster.Status.Phase != tc.expectedPhase {
+				t.Errorf("expected phase %q, got %q", tc.expectedPhase, cluster.Status.Phase)
+			}
+			if err := seedClient.Get(ctx, types.NamespacedName{Namespace: apiserver.Namespace, Name: apiserver.Name}, apiserver); err != nil {
+				t.Fatalf("failed to get apiserver: %v", err)
+			}
+			if *apiserver.Spec.Replicas != tc.expectedReplicas {
+				t.Errorf("expected apiserver to have %d replicas, got %d", tc.expectedReplicas, *apiserver.Spec.Replicas)
+			}
+		})
+	}
+}
@@ -191,6 +191,12 @@ func Add(
 		}
 	}
 
+	// The cloud-config and the machine-controller contain the cloud credentials, so they have to be
+	// rendered again when the credentials get rotated
+	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, controllerutil.EnqueueClusterForCredentialSecret(mgr.GetClient())); err != nil {
+		return fmt.Errorf("failed to create watcher for credential secrets: %v", err)
+	}
+
 	return c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, &handler.EnqueueRequestForObject{})
 }
 
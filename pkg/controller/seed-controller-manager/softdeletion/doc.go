@@ -0,0 +1,23 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package softdeletion contains a controller that deletes clusters which got deleted with a grace period:
+  - The API hibernates such clusters and records the time at which they get deleted
+  - Once that time is reached the cluster is deleted with the cleanup options the deletion was requested with
+  - Clusters which got their deletion protection enabled in the meantime are kept
+*/
+package softdeletion
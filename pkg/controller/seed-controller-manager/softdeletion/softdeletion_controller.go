@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdeletion
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const ControllerName = "kubermatic_soft_deletion_controller"
+
+// Reconciler deletes soft-deleted clusters once their grace period is over
+type Reconciler struct {
+	ctrlruntimeclient.Client
+	log        *zap.SugaredLogger
+	workerName string
+	recorder   record.EventRecorder
+	now        func() time.Time
+}
+
+func Add(
+	mgr manager.Manager,
+	log *zap.SugaredLogger,
+	numWorkers int,
+	workerName string,
+) error {
+	reconciler := &Reconciler{
+		Client:     mgr.GetClient(),
+		log:        log.Named(ControllerName),
+		workerName: workerName,
+		recorder:   mgr.GetEventRecorderFor(ControllerName),
+		now:        time.Now,
+	}
+
+	c, err := controller.New(ControllerName, mgr, controller.Options{Reconciler: reconciler, MaxConcurrentReconciles: numWorkers})
+	if err != nil {
+		return err
+	}
+
+	return c.Watch(&source.Kind{Type: &kubermaticv1.Cluster{}}, &handler.EnqueueRequestForObject{})
+}
+
+func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	log := r.log.With("request", request)
+	log.Debug("Processing")
+
+	cluster := &kubermaticv1.Cluster{}
+	if err := r.Get(ctx, request.NamespacedName, cluster); err != nil {
+		if kerrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if cluster.Labels[kubermaticv1.WorkerNameLabelKey] != r.workerName {
+		return reconcile.Result{}, nil
+	}
+	if !cluster.IsSoftDeleted() || cluster.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	result, err := r.reconcile(ctx, log, cluster)
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+		r.recorder.Eventf(cluster, corev1.EventTypeWarning, "ReconcilingError", "%v", err)
+	}
+	if result == nil {
+		result = &reconcile.Result{}
+	}
+	return *result, err
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, log *zap.SugaredLogger, cluster *kubermaticv1.Cluster) (*reconcile.Result, error) {
+	if remaining := cluster.Spec.SoftDeletion.DeleteAt.Sub(r.now()); remaining > 0 {
+		return &reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if cluster.Spec.DeletionProtection {
+		r.recorder.Event(cluster, corev1.EventTypeWarning, "DeletionProtected", "The grace period is over, but the cluster is protected against deletion")
+		return nil, nil
+	}
+
+	// Same as for deletions through the API, the LB and PV finalizers would block the
+	// deletion of clusters whose control plane was never up
+	if kuberneteshelper.HasFinalizer(cluster, kubermaticapiv1.NodeDeletionFinalizer) {
+		oldCluster := cluster.DeepCopy()
+		if cluster.Spec.SoftDeletion.DeleteLoadBalancers {
+			kuberneteshelper.AddFinalizer(cluster, kubermaticapiv1.InClusterLBCleanupFinalizer)
+		}
+		if cluster.Spec.SoftDeletion.DeleteVolumes {
+			kuberneteshelper.AddFinalizer(cluster, kubermaticapiv1.InClusterPVCleanupFinalizer)
+		}
+		if err := r.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("Deleting cluster, the deletion grace period is over")
+	if err := r.Delete(ctx, cluster); err != nil && !kerrors.IsNotFound(err) {
+		return nil, err
+	}
+	r.recorder.Event(cluster, corev1.EventTypeNormal, "Deleting", "The deletion grace period is over")
+	return nil, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdeletion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrlruntimefakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcile(t *testing.T) {
+	now := time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+	genCluster := func(deleteAt time.Time, protected bool) *kubermaticv1.Cluster {
+		return &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-cluster",
+				Finalizers: []string{kubermaticapiv1.NodeDeletionFinalizer},
+			},
+			Spec: kubermaticv1.ClusterSpec{
+				Hibernated:         true,
+				DeletionProtection: protected,
+				SoftDeletion: &kubermaticv1.ClusterSoftDeletion{
+					DeleteAt:      metav1.NewTime(deleteAt),
+					DeleteVolumes: true,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		cluster         *kubermaticv1.Cluster
+		expectedDeleted bool
+		expectedRequeue time.Duration
+	}{
+		{
+			name:            "cluster is kept during the grace period",
+			cluster:         genCluster(now.Add(time.Hour), false),
+			expectedRequeue: time.Hour,
+		},
+		{
+			name:            "cluster is deleted after the grace period",
+			cluster:         genCluster(now.Add(-time.Minute), false),
+			expectedDeleted: true,
+		},
+		{
+			name:    "protected cluster is kept after the grace period",
+			cluster: genCluster(now.Add(-time.Minute), true),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := ctrlruntimefakeclient.NewFakeClient(tc.cluster)
+			r := &Reconciler{
+				Client:   client,
+				log:      kubermaticlog.New(true, kubermaticlog.FormatConsole).Sugar(),
+				recorder: record.NewFakeRecorder(10),
+				now:      func() time.Time { return now },
+			}
+
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.cluster.Name}})
+			if err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+			if result.RequeueAfter != tc.expectedRequeue {
+				t.Errorf("expected requeue after %v, got %v", tc.expectedRequeue, result.RequeueAfter)
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			err = client.Get(context.Background(), types.NamespacedName{Name: tc.cluster.Name}, cluster)
+			if err != nil && !kerrors.IsNotFound(err) {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			// The fake client removes objects right away, unless they have finalizers
+			deleted := kerrors.IsNotFound(err) || cluster.DeletionTimestamp != nil
+			if deleted != tc.expectedDeleted {
+				t.Fatalf("expected deleted to be %v, got %v", tc.expectedDeleted, deleted)
+			}
+		})
+	}
+}
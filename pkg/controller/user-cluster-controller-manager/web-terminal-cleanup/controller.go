@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webterminalcleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	predicateutil "k8c.io/kubermatic/v2/pkg/controller/util/predicate"
+	webterminal "k8c.io/kubermatic/v2/pkg/handler/v1/web-terminal"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// This controller removes expired web terminal service accounts.
+	controllerName = "web_terminal_cleanup_controller"
+)
+
+type reconciler struct {
+	ctx    context.Context
+	log    *zap.SugaredLogger
+	client ctrlruntimeclient.Client
+}
+
+func Add(ctx context.Context, log *zap.SugaredLogger, mgr manager.Manager) error {
+	log = log.Named(controllerName)
+
+	r := &reconciler{
+		ctx:    ctx,
+		log:    log,
+		client: mgr.GetClient(),
+	}
+	c, err := controller.New(controllerName, mgr, controller.Options{
+		Reconciler: r,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create controller: %v", err)
+	}
+
+	if err = c.Watch(&source.Kind{Type: &corev1.ServiceAccount{}}, &handler.EnqueueRequestForObject{}, predicateutil.ByLabel(resources.AppLabelKey, webterminal.AppLabel)); err != nil {
+		return fmt.Errorf("failed to establish watch for the ServiceAccounts %v", err)
+	}
+
+	return nil
+}
+
+func (r *reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.With("ServiceAccount", request.NamespacedName)
+	log.Debug("Reconciling")
+
+	serviceAccount := &corev1.ServiceAccount{}
+	if err := r.client.Get(r.ctx, request.NamespacedName, serviceAccount); err != nil {
+		return reconcile.Result{}, ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	result, err := r.reconcile(log, serviceAccount)
+	if err != nil {
+		log.Errorw("Reconciling failed", zap.Error(err))
+	}
+	return result, err
+}
+
+func (r *reconciler) reconcile(log *zap.SugaredLogger, serviceAccount *corev1.ServiceAccount) (reconcile.Result, error) {
+	if serviceAccount.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	// service accounts without a valid expiry are removed right away, they can't be told apart from leaked ones
+	expiry, err := time.Parse(time.RFC3339, serviceAccount.Annotations[webterminal.ExpiryAnnotation])
+	if err == nil {
+		if remaining := time.Until(expiry); remaining > 0 {
+			return reconcile.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	log.Info("Removing expired web terminal service account")
+	// the pod, the binding and the token secret are owned by the service account
+	err = r.client.Delete(r.ctx, serviceAccount, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !kerrors.IsNotFound(err) {
+		return reconcile.Result{}, fmt.Errorf("failed to delete service account: %v", err)
+	}
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webterminalcleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	webterminal "k8c.io/kubermatic/v2/pkg/handler/v1/web-terminal"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestReconcile(t *testing.T) {
+	testCases := []struct {
+		name            string
+		annotations     map[string]string
+		expectedRemoval bool
+		expectedRequeue bool
+	}{
+		{
+			name:            "service account of a running session is kept",
+			annotations:     map[string]string{webterminal.ExpiryAnnotation: time.Now().Add(time.Hour).UTC().Format(time.RFC3339)},
+			expectedRequeue: true,
+		},
+		{
+			name:            "expired service account is removed",
+			annotations:     map[string]string{webterminal.ExpiryAnnotation: time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)},
+			expectedRemoval: true,
+		},
+		{
+			name:            "service account without expiry is removed",
+			expectedRemoval: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			serviceAccount := &corev1.ServiceAccount{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "web-terminal-abcd",
+					Namespace:       metav1.NamespaceSystem,
+					Labels:          map[string]string{resources.AppLabelKey: webterminal.AppLabel},
+					Annotations:     tc.annotations,
+					ResourceVersion: "1",
+				},
+			}
+			client := fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme, serviceAccount)
+
+			r := &reconciler{
+				ctx:    context.Background(),
+				log:    kubermaticlog.Logger,
+				client: client,
+			}
+
+			name := types.NamespacedName{Namespace: serviceAccount.Namespace, Name: serviceAccount.Name}
+			result, err := r.Reconcile(reconcile.Request{NamespacedName: name})
+			if err != nil {
+				t.Fatalf("reconciling failed: %v", err)
+			}
+			if requeue := result.RequeueAfter > 0; requeue != tc.expectedRequeue {
+				t.Errorf("expected requeue to be %t, got %v", tc.expectedRequeue, result)
+			}
+
+			err = client.Get(context.Background(), name, &corev1.ServiceAccount{})
+			if removed := kerrors.IsNotFound(err); removed != tc.expectedRemoval {
+				t.Errorf("expected removal to be %t, got error %v", tc.expectedRemoval, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package webterminalcleanup contains a controller that removes the service accounts of web terminal sessions
+once they expired. The API removes them when a session ends, this controller cleans up the sessions the API
+could not clean up, e.g. because it was restarted. The pod, the token secret and the cluster role binding of
+a session are owned by its service account and get garbage collected together with it.
+*/
+package webterminalcleanup
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -50,6 +51,27 @@ func EnqueueClusterForNamespacedObject(client ctrlruntimeclient.Client) *handler
 	})}
 }
 
+// EnqueueClusterForCredentialSecret enqueues the cluster whose cloud credentials are stored in the secret, if any.
+// The credential secrets are kept in the kubermatic namespace, so they are not covered by EnqueueClusterForNamespacedObject.
+func EnqueueClusterForCredentialSecret(client ctrlruntimeclient.Client) *handler.EnqueueRequestsFromMapFunc {
+	return &handler.EnqueueRequestsFromMapFunc{ToRequests: handler.ToRequestsFunc(func(a handler.MapObject) []reconcile.Request {
+		if a.Meta.GetNamespace() != resources.KubermaticNamespace {
+			return []reconcile.Request{}
+		}
+		clusterList := &kubermaticv1.ClusterList{}
+		if err := client.List(context.Background(), clusterList); err != nil {
+			utilruntime.HandleError(fmt.Errorf("failed to list Clusters: %v", err))
+			return []reconcile.Request{}
+		}
+		for _, cluster := range clusterList.Items {
+			if cluster.GetSecretName() == a.Meta.GetName() {
+				return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: cluster.Name}}}
+			}
+		}
+		return []reconcile.Request{}
+	})}
+}
+
 // EnqueueClusterForNamespacedObjectWithSeedName enqueues the cluster that owns a namespaced object,
 // if any. The seedName is put into the namespace field
 // It is used by various controllers to react to changes in the resources in the cluster namespace
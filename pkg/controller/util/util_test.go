@@ -21,8 +21,12 @@ import (
 	"testing"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 )
 
 func TestConcurrencyLimitReached(t *testing.T) {
@@ -57,3 +61,44 @@ func TestConcurrencyLimitReached(t *testing.T) {
 		})
 	}
 }
+
+func TestEnqueueClusterForCredentialSecret(t *testing.T) {
+	testCases := []struct {
+		name             string
+		secret           *corev1.Secret
+		expectedClusters []string
+	}{
+		{
+			name:             "the cluster of a credential secret is enqueued",
+			secret:           &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "credential-aws-b", Namespace: resources.KubermaticNamespace}},
+			expectedClusters: []string{"b"},
+		},
+		{
+			name:   "secrets of other namespaces are ignored",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "credential-aws-b", Namespace: "cluster-b"}},
+		},
+		{
+			name:   "secrets without a cluster are ignored",
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "credential-aws-c", Namespace: resources.KubermaticNamespace}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.NewFakeClient(
+				&kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "a"}, Spec: kubermaticv1.ClusterSpec{Cloud: kubermaticv1.CloudSpec{Hetzner: &kubermaticv1.HetznerCloudSpec{}}}},
+				&kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "b"}, Spec: kubermaticv1.ClusterSpec{Cloud: kubermaticv1.CloudSpec{AWS: &kubermaticv1.AWSCloudSpec{}}}},
+			)
+
+			requests := EnqueueClusterForCredentialSecret(client).ToRequests.Map(handler.MapObject{Meta: tc.secret, Object: tc.secret})
+			if len(requests) != len(tc.expectedClusters) {
+				t.Fatalf("expected the clusters %v to be enqueued, got %v", tc.expectedClusters, requests)
+			}
+			for i, name := range tc.expectedClusters {
+				if requests[i].Name != name {
+					t.Errorf("expected the cluster %s to be enqueued, got %v", name, requests[i])
+				}
+			}
+		})
+	}
+}
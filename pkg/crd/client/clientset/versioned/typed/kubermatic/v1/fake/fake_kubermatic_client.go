@@ -24,6 +24,10 @@ func (c *FakeKubermaticV1) Clusters() v1.ClusterInterface {
 	return &FakeClusters{c}
 }
 
+func (c *FakeKubermaticV1) ClusterMigrations() v1.ClusterMigrationInterface {
+	return &FakeClusterMigrations{c}
+}
+
 func (c *FakeKubermaticV1) ConstraintTemplates() v1.ConstraintTemplateInterface {
 	return &FakeConstraintTemplates{c}
 }
@@ -32,14 +36,42 @@ func (c *FakeKubermaticV1) ExternalClusters() v1.ExternalClusterInterface {
 	return &FakeExternalClusters{c}
 }
 
+func (c *FakeKubermaticV1) GroupProjectBindings() v1.GroupProjectBindingInterface {
+	return &FakeGroupProjectBindings{c}
+}
+
 func (c *FakeKubermaticV1) KubermaticSettings() v1.KubermaticSettingInterface {
 	return &FakeKubermaticSettings{c}
 }
 
+func (c *FakeKubermaticV1) Operations() v1.OperationInterface {
+	return &FakeOperations{c}
+}
+
+func (c *FakeKubermaticV1) OrphanedCloudResources() v1.OrphanedCloudResourceInterface {
+	return &FakeOrphanedCloudResources{c}
+}
+
+func (c *FakeKubermaticV1) PriceLists() v1.PriceListInterface {
+	return &FakePriceLists{c}
+}
+
 func (c *FakeKubermaticV1) Projects() v1.ProjectInterface {
 	return &FakeProjects{c}
 }
 
+func (c *FakeKubermaticV1) ProjectInvitations() v1.ProjectInvitationInterface {
+	return &FakeProjectInvitations{c}
+}
+
+func (c *FakeKubermaticV1) ProjectRoles() v1.ProjectRoleInterface {
+	return &FakeProjectRoles{c}
+}
+
+func (c *FakeKubermaticV1) UsageReports() v1.UsageReportInterface {
+	return &FakeUsageReports{c}
+}
+
 func (c *FakeKubermaticV1) Users() v1.UserInterface {
 	return &FakeUsers{c}
 }
@@ -0,0 +1,106 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePriceLists implements PriceListInterface
+type FakePriceLists struct {
+	Fake *FakeKubermaticV1
+}
+
+var pricelistsResource = schema.GroupVersionResource{Group: "kubermatic.k8s.io", Version: "v1", Resource: "pricelists"}
+
+var pricelistsKind = schema.GroupVersionKind{Group: "kubermatic.k8s.io", Version: "v1", Kind: "PriceList"}
+
+// Get takes name of the priceList, and returns the corresponding priceList object, and an error if there is any.
+func (c *FakePriceLists) Get(ctx context.Context, name string, options v1.GetOptions) (result *kubermaticv1.PriceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(pricelistsResource, name), &kubermaticv1.PriceList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.PriceList), err
+}
+
+// List takes label and field selectors, and returns the list of PriceLists that match those selectors.
+func (c *FakePriceLists) List(ctx context.Context, opts v1.ListOptions) (result *kubermaticv1.PriceListList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(pricelistsResource, pricelistsKind, opts), &kubermaticv1.PriceListList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &kubermaticv1.PriceListList{ListMeta: obj.(*kubermaticv1.PriceListList).ListMeta}
+	for _, item := range obj.(*kubermaticv1.PriceListList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested priceLists.
+func (c *FakePriceLists) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(pricelistsResource, opts))
+}
+
+// Create takes the representation of a priceList and creates it.  Returns the server's representation of the priceList, and an error, if there is any.
+func (c *FakePriceLists) Create(ctx context.Context, priceList *kubermaticv1.PriceList, opts v1.CreateOptions) (result *kubermaticv1.PriceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(pricelistsResource, priceList), &kubermaticv1.PriceList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.PriceList), err
+}
+
+// Update takes the representation of a priceList and updates it. Returns the server's representation of the priceList, and an error, if there is any.
+func (c *FakePriceLists) Update(ctx context.Context, priceList *kubermaticv1.PriceList, opts v1.UpdateOptions) (result *kubermaticv1.PriceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(pricelistsResource, priceList), &kubermaticv1.PriceList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.PriceList), err
+}
+
+// Delete takes name of the priceList and deletes it. Returns an error if one occurs.
+func (c *FakePriceLists) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(pricelistsResource, name), &kubermaticv1.PriceList{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePriceLists) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(pricelistsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &kubermaticv1.PriceListList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched priceList.
+func (c *FakePriceLists) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kubermaticv1.PriceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(pricelistsResource, name, pt, data, subresources...), &kubermaticv1.PriceList{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.PriceList), err
+}
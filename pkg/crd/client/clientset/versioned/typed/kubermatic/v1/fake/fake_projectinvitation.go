@@ -0,0 +1,106 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeProjectInvitations implements ProjectInvitationInterface
+type FakeProjectInvitations struct {
+	Fake *FakeKubermaticV1
+}
+
+var projectinvitationsResource = schema.GroupVersionResource{Group: "kubermatic.k8s.io", Version: "v1", Resource: "projectinvitations"}
+
+var projectinvitationsKind = schema.GroupVersionKind{Group: "kubermatic.k8s.io", Version: "v1", Kind: "ProjectInvitation"}
+
+// Get takes name of the projectInvitation, and returns the corresponding projectInvitation object, and an error if there is any.
+func (c *FakeProjectInvitations) Get(ctx context.Context, name string, options v1.GetOptions) (result *kubermaticv1.ProjectInvitation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(projectinvitationsResource, name), &kubermaticv1.ProjectInvitation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectInvitation), err
+}
+
+// List takes label and field selectors, and returns the list of ProjectInvitations that match those selectors.
+func (c *FakeProjectInvitations) List(ctx context.Context, opts v1.ListOptions) (result *kubermaticv1.ProjectInvitationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(projectinvitationsResource, projectinvitationsKind, opts), &kubermaticv1.ProjectInvitationList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &kubermaticv1.ProjectInvitationList{ListMeta: obj.(*kubermaticv1.ProjectInvitationList).ListMeta}
+	for _, item := range obj.(*kubermaticv1.ProjectInvitationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested projectInvitations.
+func (c *FakeProjectInvitations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(projectinvitationsResource, opts))
+}
+
+// Create takes the representation of a projectInvitation and creates it.  Returns the server's representation of the projectInvitation, and an error, if there is any.
+func (c *FakeProjectInvitations) Create(ctx context.Context, projectInvitation *kubermaticv1.ProjectInvitation, opts v1.CreateOptions) (result *kubermaticv1.ProjectInvitation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(projectinvitationsResource, projectInvitation), &kubermaticv1.ProjectInvitation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectInvitation), err
+}
+
+// Update takes the representation of a projectInvitation and updates it. Returns the server's representation of the projectInvitation, and an error, if there is any.
+func (c *FakeProjectInvitations) Update(ctx context.Context, projectInvitation *kubermaticv1.ProjectInvitation, opts v1.UpdateOptions) (result *kubermaticv1.ProjectInvitation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(projectinvitationsResource, projectInvitation), &kubermaticv1.ProjectInvitation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectInvitation), err
+}
+
+// Delete takes name of the projectInvitation and deletes it. Returns an error if one occurs.
+func (c *FakeProjectInvitations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(projectinvitationsResource, name), &kubermaticv1.ProjectInvitation{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeProjectInvitations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(projectinvitationsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &kubermaticv1.ProjectInvitationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched projectInvitation.
+func (c *FakeProjectInvitations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kubermaticv1.ProjectInvitation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(projectinvitationsResource, name, pt, data, subresources...), &kubermaticv1.ProjectInvitation{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectInvitation), err
+}
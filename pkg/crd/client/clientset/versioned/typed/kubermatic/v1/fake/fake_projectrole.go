@@ -0,0 +1,106 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeProjectRoles implements ProjectRoleInterface
+type FakeProjectRoles struct {
+	Fake *FakeKubermaticV1
+}
+
+var projectrolesResource = schema.GroupVersionResource{Group: "kubermatic.k8s.io", Version: "v1", Resource: "projectroles"}
+
+var projectrolesKind = schema.GroupVersionKind{Group: "kubermatic.k8s.io", Version: "v1", Kind: "ProjectRole"}
+
+// Get takes name of the projectRole, and returns the corresponding projectRole object, and an error if there is any.
+func (c *FakeProjectRoles) Get(ctx context.Context, name string, options v1.GetOptions) (result *kubermaticv1.ProjectRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(projectrolesResource, name), &kubermaticv1.ProjectRole{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectRole), err
+}
+
+// List takes label and field selectors, and returns the list of ProjectRoles that match those selectors.
+func (c *FakeProjectRoles) List(ctx context.Context, opts v1.ListOptions) (result *kubermaticv1.ProjectRoleList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(projectrolesResource, projectrolesKind, opts), &kubermaticv1.ProjectRoleList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &kubermaticv1.ProjectRoleList{ListMeta: obj.(*kubermaticv1.ProjectRoleList).ListMeta}
+	for _, item := range obj.(*kubermaticv1.ProjectRoleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested projectRoles.
+func (c *FakeProjectRoles) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(projectrolesResource, opts))
+}
+
+// Create takes the representation of a projectRole and creates it.  Returns the server's representation of the projectRole, and an error, if there is any.
+func (c *FakeProjectRoles) Create(ctx context.Context, projectRole *kubermaticv1.ProjectRole, opts v1.CreateOptions) (result *kubermaticv1.ProjectRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(projectrolesResource, projectRole), &kubermaticv1.ProjectRole{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectRole), err
+}
+
+// Update takes the representation of a projectRole and updates it. Returns the server's representation of the projectRole, and an error, if there is any.
+func (c *FakeProjectRoles) Update(ctx context.Context, projectRole *kubermaticv1.ProjectRole, opts v1.UpdateOptions) (result *kubermaticv1.ProjectRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(projectrolesResource, projectRole), &kubermaticv1.ProjectRole{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectRole), err
+}
+
+// Delete takes name of the projectRole and deletes it. Returns an error if one occurs.
+func (c *FakeProjectRoles) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(projectrolesResource, name), &kubermaticv1.ProjectRole{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeProjectRoles) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(projectrolesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &kubermaticv1.ProjectRoleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched projectRole.
+func (c *FakeProjectRoles) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kubermaticv1.ProjectRole, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(projectrolesResource, name, pt, data, subresources...), &kubermaticv1.ProjectRole{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.ProjectRole), err
+}
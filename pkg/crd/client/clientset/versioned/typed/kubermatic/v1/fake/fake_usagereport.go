@@ -0,0 +1,117 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeUsageReports implements UsageReportInterface
+type FakeUsageReports struct {
+	Fake *FakeKubermaticV1
+}
+
+var usagereportsResource = schema.GroupVersionResource{Group: "kubermatic.k8s.io", Version: "v1", Resource: "usagereports"}
+
+var usagereportsKind = schema.GroupVersionKind{Group: "kubermatic.k8s.io", Version: "v1", Kind: "UsageReport"}
+
+// Get takes name of the usageReport, and returns the corresponding usageReport object, and an error if there is any.
+func (c *FakeUsageReports) Get(ctx context.Context, name string, options v1.GetOptions) (result *kubermaticv1.UsageReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(usagereportsResource, name), &kubermaticv1.UsageReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.UsageReport), err
+}
+
+// List takes label and field selectors, and returns the list of UsageReports that match those selectors.
+func (c *FakeUsageReports) List(ctx context.Context, opts v1.ListOptions) (result *kubermaticv1.UsageReportList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(usagereportsResource, usagereportsKind, opts), &kubermaticv1.UsageReportList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &kubermaticv1.UsageReportList{ListMeta: obj.(*kubermaticv1.UsageReportList).ListMeta}
+	for _, item := range obj.(*kubermaticv1.UsageReportList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested usageReports.
+func (c *FakeUsageReports) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(usagereportsResource, opts))
+}
+
+// Create takes the representation of a usageReport and creates it.  Returns the server's representation of the usageReport, and an error, if there is any.
+func (c *FakeUsageReports) Create(ctx context.Context, usageReport *kubermaticv1.UsageReport, opts v1.CreateOptions) (result *kubermaticv1.UsageReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(usagereportsResource, usageReport), &kubermaticv1.UsageReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.UsageReport), err
+}
+
+// Update takes the representation of a usageReport and updates it. Returns the server's representation of the usageReport, and an error, if there is any.
+func (c *FakeUsageReports) Update(ctx context.Context, usageReport *kubermaticv1.UsageReport, opts v1.UpdateOptions) (result *kubermaticv1.UsageReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(usagereportsResource, usageReport), &kubermaticv1.UsageReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.UsageReport), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeUsageReports) UpdateStatus(ctx context.Context, usageReport *kubermaticv1.UsageReport, opts v1.UpdateOptions) (*kubermaticv1.UsageReport, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(usagereportsResource, "status", usageReport), &kubermaticv1.UsageReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.UsageReport), err
+}
+
+// Delete takes name of the usageReport and deletes it. Returns an error if one occurs.
+func (c *FakeUsageReports) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(usagereportsResource, name), &kubermaticv1.UsageReport{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeUsageReports) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(usagereportsResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &kubermaticv1.UsageReportList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched usageReport.
+func (c *FakeUsageReports) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *kubermaticv1.UsageReport, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(usagereportsResource, name, pt, data, subresources...), &kubermaticv1.UsageReport{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*kubermaticv1.UsageReport), err
+}
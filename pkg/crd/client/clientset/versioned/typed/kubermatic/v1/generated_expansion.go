@@ -8,14 +8,30 @@ type AddonConfigExpansion interface{}
 
 type ClusterExpansion interface{}
 
+type ClusterMigrationExpansion interface{}
+
 type ConstraintTemplateExpansion interface{}
 
 type ExternalClusterExpansion interface{}
 
+type GroupProjectBindingExpansion interface{}
+
 type KubermaticSettingExpansion interface{}
 
+type OperationExpansion interface{}
+
+type OrphanedCloudResourceExpansion interface{}
+
+type PriceListExpansion interface{}
+
 type ProjectExpansion interface{}
 
+type ProjectInvitationExpansion interface{}
+
+type ProjectRoleExpansion interface{}
+
+type UsageReportExpansion interface{}
+
 type UserExpansion interface{}
 
 type UserProjectBindingExpansion interface{}
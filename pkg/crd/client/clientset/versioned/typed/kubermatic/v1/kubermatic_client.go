@@ -13,10 +13,18 @@ type KubermaticV1Interface interface {
 	AddonsGetter
 	AddonConfigsGetter
 	ClustersGetter
+	ClusterMigrationsGetter
 	ConstraintTemplatesGetter
 	ExternalClustersGetter
+	GroupProjectBindingsGetter
 	KubermaticSettingsGetter
+	OperationsGetter
+	OrphanedCloudResourcesGetter
+	PriceListsGetter
 	ProjectsGetter
+	ProjectInvitationsGetter
+	ProjectRolesGetter
+	UsageReportsGetter
 	UsersGetter
 	UserProjectBindingsGetter
 	UserSSHKeysGetter
@@ -39,6 +47,10 @@ func (c *KubermaticV1Client) Clusters() ClusterInterface {
 	return newClusters(c)
 }
 
+func (c *KubermaticV1Client) ClusterMigrations() ClusterMigrationInterface {
+	return newClusterMigrations(c)
+}
+
 func (c *KubermaticV1Client) ConstraintTemplates() ConstraintTemplateInterface {
 	return newConstraintTemplates(c)
 }
@@ -47,14 +59,42 @@ func (c *KubermaticV1Client) ExternalClusters() ExternalClusterInterface {
 	return newExternalClusters(c)
 }
 
+func (c *KubermaticV1Client) GroupProjectBindings() GroupProjectBindingInterface {
+	return newGroupProjectBindings(c)
+}
+
 func (c *KubermaticV1Client) KubermaticSettings() KubermaticSettingInterface {
 	return newKubermaticSettings(c)
 }
 
+func (c *KubermaticV1Client) Operations() OperationInterface {
+	return newOperations(c)
+}
+
+func (c *KubermaticV1Client) OrphanedCloudResources() OrphanedCloudResourceInterface {
+	return newOrphanedCloudResources(c)
+}
+
+func (c *KubermaticV1Client) PriceLists() PriceListInterface {
+	return newPriceLists(c)
+}
+
 func (c *KubermaticV1Client) Projects() ProjectInterface {
 	return newProjects(c)
 }
 
+func (c *KubermaticV1Client) ProjectInvitations() ProjectInvitationInterface {
+	return newProjectInvitations(c)
+}
+
+func (c *KubermaticV1Client) ProjectRoles() ProjectRoleInterface {
+	return newProjectRoles(c)
+}
+
+func (c *KubermaticV1Client) UsageReports() UsageReportInterface {
+	return newUsageReports(c)
+}
+
 func (c *KubermaticV1Client) Users() UserInterface {
 	return newUsers(c)
 }
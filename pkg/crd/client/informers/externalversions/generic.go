@@ -43,14 +43,30 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().AddonConfigs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("clusters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().Clusters().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("clustermigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().ClusterMigrations().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("constrainttemplates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().ConstraintTemplates().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("externalclusters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().ExternalClusters().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("groupprojectbindings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().GroupProjectBindings().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("kubermaticsettings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().KubermaticSettings().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("operations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().Operations().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("orphanedcloudresources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().OrphanedCloudResources().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("pricelists"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().PriceLists().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("projects"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().Projects().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("projectinvitations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().ProjectInvitations().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("projectroles"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().ProjectRoles().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("usagereports"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().UsageReports().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("users"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Kubermatic().V1().Users().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("userprojectbindings"):
@@ -14,14 +14,30 @@ type Interface interface {
 	AddonConfigs() AddonConfigInformer
 	// Clusters returns a ClusterInformer.
 	Clusters() ClusterInformer
+	// ClusterMigrations returns a ClusterMigrationInformer.
+	ClusterMigrations() ClusterMigrationInformer
 	// ConstraintTemplates returns a ConstraintTemplateInformer.
 	ConstraintTemplates() ConstraintTemplateInformer
 	// ExternalClusters returns a ExternalClusterInformer.
 	ExternalClusters() ExternalClusterInformer
+	// GroupProjectBindings returns a GroupProjectBindingInformer.
+	GroupProjectBindings() GroupProjectBindingInformer
 	// KubermaticSettings returns a KubermaticSettingInformer.
 	KubermaticSettings() KubermaticSettingInformer
+	// Operations returns a OperationInformer.
+	Operations() OperationInformer
+	// OrphanedCloudResources returns a OrphanedCloudResourceInformer.
+	OrphanedCloudResources() OrphanedCloudResourceInformer
+	// PriceLists returns a PriceListInformer.
+	PriceLists() PriceListInformer
 	// Projects returns a ProjectInformer.
 	Projects() ProjectInformer
+	// ProjectInvitations returns a ProjectInvitationInformer.
+	ProjectInvitations() ProjectInvitationInformer
+	// ProjectRoles returns a ProjectRoleInformer.
+	ProjectRoles() ProjectRoleInformer
+	// UsageReports returns a UsageReportInformer.
+	UsageReports() UsageReportInformer
 	// Users returns a UserInformer.
 	Users() UserInformer
 	// UserProjectBindings returns a UserProjectBindingInformer.
@@ -56,6 +72,11 @@ func (v *version) Clusters() ClusterInformer {
 	return &clusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterMigrations returns a ClusterMigrationInformer.
+func (v *version) ClusterMigrations() ClusterMigrationInformer {
+	return &clusterMigrationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ConstraintTemplates returns a ConstraintTemplateInformer.
 func (v *version) ConstraintTemplates() ConstraintTemplateInformer {
 	return &constraintTemplateInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -66,16 +87,51 @@ func (v *version) ExternalClusters() ExternalClusterInformer {
 	return &externalClusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// GroupProjectBindings returns a GroupProjectBindingInformer.
+func (v *version) GroupProjectBindings() GroupProjectBindingInformer {
+	return &groupProjectBindingInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // KubermaticSettings returns a KubermaticSettingInformer.
 func (v *version) KubermaticSettings() KubermaticSettingInformer {
 	return &kubermaticSettingInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Operations returns a OperationInformer.
+func (v *version) Operations() OperationInformer {
+	return &operationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// OrphanedCloudResources returns a OrphanedCloudResourceInformer.
+func (v *version) OrphanedCloudResources() OrphanedCloudResourceInformer {
+	return &orphanedCloudResourceInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// PriceLists returns a PriceListInformer.
+func (v *version) PriceLists() PriceListInformer {
+	return &priceListInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Projects returns a ProjectInformer.
 func (v *version) Projects() ProjectInformer {
 	return &projectInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ProjectInvitations returns a ProjectInvitationInformer.
+func (v *version) ProjectInvitations() ProjectInvitationInformer {
+	return &projectInvitationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ProjectRoles returns a ProjectRoleInformer.
+func (v *version) ProjectRoles() ProjectRoleInformer {
+	return &projectRoleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// UsageReports returns a UsageReportInformer.
+func (v *version) UsageReports() UsageReportInformer {
+	return &usageReportInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Users returns a UserInformer.
 func (v *version) Users() UserInformer {
 	return &userInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -18,6 +18,10 @@ type AddonConfigListerExpansion interface{}
 // ClusterLister.
 type ClusterListerExpansion interface{}
 
+// ClusterMigrationListerExpansion allows custom methods to be added to
+// ClusterMigrationLister.
+type ClusterMigrationListerExpansion interface{}
+
 // ConstraintTemplateListerExpansion allows custom methods to be added to
 // ConstraintTemplateLister.
 type ConstraintTemplateListerExpansion interface{}
@@ -26,14 +30,42 @@ type ConstraintTemplateListerExpansion interface{}
 // ExternalClusterLister.
 type ExternalClusterListerExpansion interface{}
 
+// GroupProjectBindingListerExpansion allows custom methods to be added to
+// GroupProjectBindingLister.
+type GroupProjectBindingListerExpansion interface{}
+
 // KubermaticSettingListerExpansion allows custom methods to be added to
 // KubermaticSettingLister.
 type KubermaticSettingListerExpansion interface{}
 
+// OperationListerExpansion allows custom methods to be added to
+// OperationLister.
+type OperationListerExpansion interface{}
+
+// OrphanedCloudResourceListerExpansion allows custom methods to be added to
+// OrphanedCloudResourceLister.
+type OrphanedCloudResourceListerExpansion interface{}
+
+// PriceListListerExpansion allows custom methods to be added to
+// PriceListLister.
+type PriceListListerExpansion interface{}
+
 // ProjectListerExpansion allows custom methods to be added to
 // ProjectLister.
 type ProjectListerExpansion interface{}
 
+// ProjectInvitationListerExpansion allows custom methods to be added to
+// ProjectInvitationLister.
+type ProjectInvitationListerExpansion interface{}
+
+// ProjectRoleListerExpansion allows custom methods to be added to
+// ProjectRoleLister.
+type ProjectRoleListerExpansion interface{}
+
+// UsageReportListerExpansion allows custom methods to be added to
+// UsageReportLister.
+type UsageReportListerExpansion interface{}
+
 // UserListerExpansion allows custom methods to be added to
 // UserLister.
 type UserListerExpansion interface{}
@@ -0,0 +1,52 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// OperationLister helps list Operations.
+// All objects returned here must be treated as read-only.
+type OperationLister interface {
+	// List lists all Operations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.Operation, err error)
+	// Get retrieves the Operation from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.Operation, error)
+	OperationListerExpansion
+}
+
+// operationLister implements the OperationLister interface.
+type operationLister struct {
+	indexer cache.Indexer
+}
+
+// NewOperationLister returns a new OperationLister.
+func NewOperationLister(indexer cache.Indexer) OperationLister {
+	return &operationLister{indexer: indexer}
+}
+
+// List lists all Operations in the indexer.
+func (s *operationLister) List(selector labels.Selector) (ret []*v1.Operation, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.Operation))
+	})
+	return ret, err
+}
+
+// Get retrieves the Operation from the index for a given name.
+func (s *operationLister) Get(name string) (*v1.Operation, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("operation"), name)
+	}
+	return obj.(*v1.Operation), nil
+}
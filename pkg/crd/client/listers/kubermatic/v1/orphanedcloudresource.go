@@ -0,0 +1,52 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// OrphanedCloudResourceLister helps list OrphanedCloudResources.
+// All objects returned here must be treated as read-only.
+type OrphanedCloudResourceLister interface {
+	// List lists all OrphanedCloudResources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.OrphanedCloudResource, err error)
+	// Get retrieves the OrphanedCloudResource from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.OrphanedCloudResource, error)
+	OrphanedCloudResourceListerExpansion
+}
+
+// orphanedCloudResourceLister implements the OrphanedCloudResourceLister interface.
+type orphanedCloudResourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewOrphanedCloudResourceLister returns a new OrphanedCloudResourceLister.
+func NewOrphanedCloudResourceLister(indexer cache.Indexer) OrphanedCloudResourceLister {
+	return &orphanedCloudResourceLister{indexer: indexer}
+}
+
+// List lists all OrphanedCloudResources in the indexer.
+func (s *orphanedCloudResourceLister) List(selector labels.Selector) (ret []*v1.OrphanedCloudResource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.OrphanedCloudResource))
+	})
+	return ret, err
+}
+
+// Get retrieves the OrphanedCloudResource from the index for a given name.
+func (s *orphanedCloudResourceLister) Get(name string) (*v1.OrphanedCloudResource, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("orphanedcloudresource"), name)
+	}
+	return obj.(*v1.OrphanedCloudResource), nil
+}
@@ -0,0 +1,52 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// PriceListLister helps list PriceLists.
+// All objects returned here must be treated as read-only.
+type PriceListLister interface {
+	// List lists all PriceLists in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.PriceList, err error)
+	// Get retrieves the PriceList from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.PriceList, error)
+	PriceListListerExpansion
+}
+
+// priceListLister implements the PriceListLister interface.
+type priceListLister struct {
+	indexer cache.Indexer
+}
+
+// NewPriceListLister returns a new PriceListLister.
+func NewPriceListLister(indexer cache.Indexer) PriceListLister {
+	return &priceListLister{indexer: indexer}
+}
+
+// List lists all PriceLists in the indexer.
+func (s *priceListLister) List(selector labels.Selector) (ret []*v1.PriceList, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.PriceList))
+	})
+	return ret, err
+}
+
+// Get retrieves the PriceList from the index for a given name.
+func (s *priceListLister) Get(name string) (*v1.PriceList, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("pricelist"), name)
+	}
+	return obj.(*v1.PriceList), nil
+}
@@ -0,0 +1,52 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ProjectInvitationLister helps list ProjectInvitations.
+// All objects returned here must be treated as read-only.
+type ProjectInvitationLister interface {
+	// List lists all ProjectInvitations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.ProjectInvitation, err error)
+	// Get retrieves the ProjectInvitation from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.ProjectInvitation, error)
+	ProjectInvitationListerExpansion
+}
+
+// projectInvitationLister implements the ProjectInvitationLister interface.
+type projectInvitationLister struct {
+	indexer cache.Indexer
+}
+
+// NewProjectInvitationLister returns a new ProjectInvitationLister.
+func NewProjectInvitationLister(indexer cache.Indexer) ProjectInvitationLister {
+	return &projectInvitationLister{indexer: indexer}
+}
+
+// List lists all ProjectInvitations in the indexer.
+func (s *projectInvitationLister) List(selector labels.Selector) (ret []*v1.ProjectInvitation, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.ProjectInvitation))
+	})
+	return ret, err
+}
+
+// Get retrieves the ProjectInvitation from the index for a given name.
+func (s *projectInvitationLister) Get(name string) (*v1.ProjectInvitation, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("projectinvitation"), name)
+	}
+	return obj.(*v1.ProjectInvitation), nil
+}
@@ -0,0 +1,52 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ProjectRoleLister helps list ProjectRoles.
+// All objects returned here must be treated as read-only.
+type ProjectRoleLister interface {
+	// List lists all ProjectRoles in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.ProjectRole, err error)
+	// Get retrieves the ProjectRole from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.ProjectRole, error)
+	ProjectRoleListerExpansion
+}
+
+// projectRoleLister implements the ProjectRoleLister interface.
+type projectRoleLister struct {
+	indexer cache.Indexer
+}
+
+// NewProjectRoleLister returns a new ProjectRoleLister.
+func NewProjectRoleLister(indexer cache.Indexer) ProjectRoleLister {
+	return &projectRoleLister{indexer: indexer}
+}
+
+// List lists all ProjectRoles in the indexer.
+func (s *projectRoleLister) List(selector labels.Selector) (ret []*v1.ProjectRole, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.ProjectRole))
+	})
+	return ret, err
+}
+
+// Get retrieves the ProjectRole from the index for a given name.
+func (s *projectRoleLister) Get(name string) (*v1.ProjectRole, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("projectrole"), name)
+	}
+	return obj.(*v1.ProjectRole), nil
+}
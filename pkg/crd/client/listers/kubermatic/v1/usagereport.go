@@ -0,0 +1,52 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// UsageReportLister helps list UsageReports.
+// All objects returned here must be treated as read-only.
+type UsageReportLister interface {
+	// List lists all UsageReports in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1.UsageReport, err error)
+	// Get retrieves the UsageReport from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1.UsageReport, error)
+	UsageReportListerExpansion
+}
+
+// usageReportLister implements the UsageReportLister interface.
+type usageReportLister struct {
+	indexer cache.Indexer
+}
+
+// NewUsageReportLister returns a new UsageReportLister.
+func NewUsageReportLister(indexer cache.Indexer) UsageReportLister {
+	return &usageReportLister{indexer: indexer}
+}
+
+// List lists all UsageReports in the indexer.
+func (s *usageReportLister) List(selector labels.Selector) (ret []*v1.UsageReport, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1.UsageReport))
+	})
+	return ret, err
+}
+
+// Get retrieves the UsageReport from the index for a given name.
+func (s *usageReportLister) Get(name string) (*v1.UsageReport, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1.Resource("usagereport"), name)
+	}
+	return obj.(*v1.UsageReport), nil
+}
@@ -107,6 +107,18 @@ type ClusterSpec struct {
 	// PauseReason is the reason why the cluster is no being managed.
 	PauseReason string `json:"pauseReason,omitempty"`
 
+	// Hibernated tells that the worker nodes and the control plane of this cluster should be scaled to zero.
+	// The progress is reported in the phase of the cluster status.
+	Hibernated bool `json:"hibernated,omitempty"`
+
+	// DeletionProtection prevents the cluster from being deleted through the API until it is disabled.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+	// SoftDeletion is set when the cluster got deleted with a grace period. The cluster is hibernated
+	// and gets deleted once the grace period is over, unless it is undeleted before.
+	SoftDeletion *ClusterSoftDeletion `json:"softDeletion,omitempty"`
+	// DeletionRetention lists the in-cluster resources which are kept when the cluster gets deleted.
+	DeletionRetention *ClusterDeletionRetention `json:"deletionRetention,omitempty"`
+
 	// Optional component specific overrides
 	ComponentsOverride ComponentSettings `json:"componentsOverride"`
 
@@ -259,8 +271,50 @@ type ClusterStatus struct {
 
 	// InheritedLabels are labels the cluster inherited from the project. They are read-only for users.
 	InheritedLabels map[string]string `json:"inheritedLabels,omitempty"`
+
+	// Phase is the hibernation phase of the cluster, it is empty for running clusters
+	Phase ClusterPhase `json:"phase,omitempty"`
+	// PhaseTransitionTime is the time the cluster entered its current phase
+	PhaseTransitionTime metav1.Time `json:"phaseTransitionTime,omitempty"`
+}
+
+// ClusterPhase is the hibernation phase of a cluster
+type ClusterPhase string
+
+// ClusterSoftDeletion holds the details of a pending cluster deletion.
+type ClusterSoftDeletion struct {
+	// DeleteAt is the time at which the cluster gets deleted.
+	DeleteAt metav1.Time `json:"deleteAt"`
+	// DeleteVolumes and DeleteLoadBalancers are the cleanup options the deletion was requested with.
+	DeleteVolumes       bool `json:"deleteVolumes,omitempty"`
+	DeleteLoadBalancers bool `json:"deleteLoadBalancers,omitempty"`
+	// Hibernated is true if the cluster was already hibernated before it got deleted,
+	// it is kept hibernated in case it gets undeleted.
+	Hibernated bool `json:"hibernated,omitempty"`
+	// SSHKeys are the IDs of the SSH keys which got detached from the cluster, they are assigned again in case it gets undeleted.
+	SSHKeys []string `json:"sshKeys,omitempty"`
 }
 
+// ClusterDeletionRetention holds the resources which are not removed by the cluster cleanup.
+// The cloud provider resources behind them, e.g. disks and load balancers, are kept as well.
+type ClusterDeletionRetention struct {
+	// LoadBalancers are the Services of type LoadBalancer to keep, in the form "namespace/name".
+	LoadBalancers []string `json:"loadBalancers,omitempty"`
+	// PersistentVolumes are the names of the PersistentVolumes to keep, the claims bound to them are kept as well.
+	PersistentVolumes []string `json:"persistentVolumes,omitempty"`
+	// Machines are the names of the Machines in the kube-system namespace whose instances are kept.
+	Machines []string `json:"machines,omitempty"`
+}
+
+const (
+	// ClusterPhaseHibernating means the worker nodes and the control plane are being scaled down
+	ClusterPhaseHibernating ClusterPhase = "Hibernating"
+	// ClusterPhaseHibernated means the worker nodes and the control plane are scaled to zero
+	ClusterPhaseHibernated ClusterPhase = "Hibernated"
+	// ClusterPhaseResuming means the control plane and the worker nodes are being scaled up again
+	ClusterPhaseResuming ClusterPhase = "Resuming"
+)
+
 // HasConditionValue returns true if the cluster status has the given condition with the given status.
 // It does not verify that the condition has been set by a certain Kubermatic version, it just checks
 // the existence.
@@ -422,6 +476,9 @@ type DigitaloceanCloudSpec struct {
 	CredentialsReference *providerconfig.GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
 
 	Token string `json:"token,omitempty"` // Token is used to authenticate with the DigitalOcean API.
+
+	// FirewallID is the ID of the cloud firewall which is created for the cluster.
+	FirewallID string `json:"firewallID,omitempty"`
 }
 
 // HetznerCloudSpec specifies access data to hetzner cloud.
@@ -429,6 +486,10 @@ type HetznerCloudSpec struct {
 	CredentialsReference *providerconfig.GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
 
 	Token string `json:"token,omitempty"` // Token is used to authenticate with the Hetzner cloud API.
+
+	// Network is the ID or the name of the private network the nodes are attached to.
+	// If not set, a network is created for the cluster and deleted together with it.
+	Network string `json:"network,omitempty"`
 }
 
 // AzureCloudSpec specifies acceess credentials to Azure cloud.
@@ -483,6 +544,18 @@ type VSphereCloudSpec struct {
 	// exclusive with Datastore.
 	// +optional
 	DatastoreCluster string `json:"datastoreCluster,omitempty"`
+	// ResourcePool is used to manage resources such as cpu and memory for
+	// the virtual machines. The resource pool must be defined on vSphere
+	// cluster level.
+	// +optional
+	ResourcePool string `json:"resourcePool,omitempty"`
+	// TagCategory is the name of the tag category in which a tag named after
+	// the cluster gets created. The tag is attached to the folder created for
+	// the cluster and the virtual machines in it. Virtual machines still carrying
+	// the tag get removed together with the cluster. Defaults to
+	// the `DefaultTagCategory` of the Datacenter.
+	// +optional
+	TagCategory string `json:"tagCategory,omitempty"`
 
 	// This user will be used for everything except cloud provider functionality
 	InfraManagementUser VSphereCredentials `json:"infraManagementUser"`
@@ -519,6 +592,11 @@ type OpenstackCloudSpec struct {
 	Tenant   string `json:"tenant,omitempty"`
 	TenantID string `json:"tenantID,omitempty"`
 	Domain   string `json:"domain,omitempty"`
+	// ApplicationCredentialID and ApplicationCredentialSecret are used instead of the username and password
+	// when set. Application credentials are bound to a project, the tenant and domain are not used with them.
+	// They are only used by the control plane, nodes can not be provisioned with them.
+	ApplicationCredentialID     string `json:"applicationCredentialID,omitempty"`
+	ApplicationCredentialSecret string `json:"applicationCredentialSecret,omitempty"`
 	// Network holds the name of the internal network
 	// When specified, all worker nodes will be attached to this network. If not specified, a network, subnet & router will be created
 	//
@@ -560,6 +638,10 @@ type KubevirtCloudSpec struct {
 	CredentialsReference *providerconfig.GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
 
 	Kubeconfig string `json:"kubeconfig,omitempty"`
+
+	// Namespace is the namespace in the KubeVirt infra cluster the virtual machines of the cluster are
+	// created in. It is created for the cluster and deleted together with it.
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // AlibabaCloudSpec specifies the access data to Alibaba.
@@ -568,6 +650,13 @@ type AlibabaCloudSpec struct {
 
 	AccessKeyID     string `json:"accessKeyId,omitempty"`
 	AccessKeySecret string `json:"accessKeySecret,omitempty"`
+
+	// VPCID and VSwitchID are the IDs of the network resources of the cluster.
+	// Resources which are not set are created for the cluster and deleted together with it.
+	VPCID     string `json:"vpcID,omitempty"`
+	VSwitchID string `json:"vSwitchID,omitempty"`
+	// ZoneID is the zone of the vSwitch, nodes which use the vSwitch of the cluster are created in this zone.
+	ZoneID string `json:"zoneID,omitempty"`
 }
 
 type HealthStatus int
@@ -681,6 +770,17 @@ func (cluster *Cluster) IsOpenshift() bool {
 	return cluster.Annotations["kubermatic.io/openshift"] != ""
 }
 
+// IsHibernated returns true if the control plane of the cluster is scaled down or is being scaled down,
+// controllers must not reconcile the control plane of such a cluster unless it is being deleted.
+func (cluster *Cluster) IsHibernated() bool {
+	return cluster.Status.Phase == ClusterPhaseHibernating || cluster.Status.Phase == ClusterPhaseHibernated
+}
+
+// IsSoftDeleted returns true if the cluster is waiting for its deletion after it got deleted with a grace period.
+func (cluster *Cluster) IsSoftDeleted() bool {
+	return cluster.Spec.SoftDeletion != nil
+}
+
 func (cluster *Cluster) IsKubernetes() bool {
 	return !cluster.IsOpenshift()
 }
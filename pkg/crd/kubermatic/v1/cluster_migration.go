@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterMigrationResourceName represents "Resource" defined in Kubernetes
+	ClusterMigrationResourceName = "clustermigrations"
+
+	// ClusterMigrationKind represents "Kind" defined in Kubernetes
+	ClusterMigrationKind = "ClusterMigration"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterMigration moves the control plane of a user cluster from the seed that currently hosts it
+// to another seed. It lives in the master cluster and its status reports the progress of the migration.
+type ClusterMigration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterMigrationSpec   `json:"spec"`
+	Status ClusterMigrationStatus `json:"status,omitempty"`
+}
+
+// ClusterMigrationSpec specifies the cluster to migrate and where to migrate it to
+type ClusterMigrationSpec struct {
+	// ClusterID is the name of the cluster object that gets migrated
+	ClusterID string `json:"clusterId"`
+	// SourceSeed is the name of the seed that currently hosts the control plane of the cluster
+	SourceSeed string `json:"sourceSeed"`
+	// TargetSeed is the name of the seed the control plane gets migrated to
+	TargetSeed string `json:"targetSeed"`
+	// TargetDatacenter is the datacenter of the target seed the cluster belongs to after the migration,
+	// it must use the same cloud provider as the current datacenter of the cluster
+	TargetDatacenter string `json:"targetDatacenter"`
+}
+
+// ClusterMigrationPhase is the step a cluster migration is currently in
+type ClusterMigrationPhase string
+
+const (
+	// ClusterMigrationPhaseCreatingTarget means the source cluster gets paused and the
+	// cluster object, its secrets and the etcd volumes are created on the target seed
+	ClusterMigrationPhaseCreatingTarget ClusterMigrationPhase = "CreatingTarget"
+	// ClusterMigrationPhaseRestoringEtcd means a snapshot of the source etcd gets restored into the etcd volumes on the target seed
+	ClusterMigrationPhaseRestoringEtcd ClusterMigrationPhase = "RestoringEtcd"
+	// ClusterMigrationPhaseStartingControlPlane means the control plane is being started on the target seed
+	ClusterMigrationPhaseStartingControlPlane ClusterMigrationPhase = "StartingControlPlane"
+	// ClusterMigrationPhaseSwitchingEndpoints means the kubelets of the nodes are switched to the new cluster address
+	ClusterMigrationPhaseSwitchingEndpoints ClusterMigrationPhase = "SwitchingEndpoints"
+	// ClusterMigrationPhaseCleaningUp means the cluster object is being removed from the source seed
+	ClusterMigrationPhaseCleaningUp ClusterMigrationPhase = "CleaningUp"
+	// ClusterMigrationPhaseCompleted means the cluster is running on the target seed
+	ClusterMigrationPhaseCompleted ClusterMigrationPhase = "Completed"
+	// ClusterMigrationPhaseFailed means the migration can not proceed, deleting the migration
+	// rolls the cluster back to the source seed if the nodes were not switched yet
+	ClusterMigrationPhaseFailed ClusterMigrationPhase = "Failed"
+)
+
+// ClusterMigrationStatus reports the progress of a cluster migration
+type ClusterMigrationStatus struct {
+	Phase ClusterMigrationPhase `json:"phase,omitempty"`
+	// Message contains details about the current phase, for example why the migration failed
+	Message        string       `json:"message,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterMigrationList is a list of cluster migrations
+type ClusterMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ClusterMigration `json:"items"`
+}
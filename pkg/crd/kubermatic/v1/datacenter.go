@@ -179,6 +179,9 @@ type DatacenterSpecHetzner struct {
 	// Optional: Detailed location of the datacenter, like "Hamburg" or "Datacenter 7".
 	// For informational purposes only.
 	Location string `json:"location"`
+	// Optional: The network zone of the private networks created for the clusters,
+	// e.g. "eu-central". Defaults to "eu-central".
+	NetworkZone string `json:"networkZone,omitempty"`
 }
 
 // DatacenterSpecDigitalocean describes a DigitalOcean datacenter
@@ -186,6 +189,10 @@ type DatacenterSpecDigitalocean struct {
 	// Datacenter location, e.g. "ams3". A list of existing datacenters can be found
 	// at https://www.digitalocean.com/docs/platform/availability-matrix/
 	Region string `json:"region"`
+	// Optional: The addresses in CIDR notation the traffic of the seed to the nodes originates from.
+	// The firewalls of the clusters only allow kubelet and NodePort traffic from these addresses,
+	// NodePorts are reachable from the load balancers of the cluster as well.
+	SeedEgressAddresses []string `json:"seedEgressAddresses,omitempty"`
 }
 
 // DatacenterSpecOpenstack describes an OpenStack datacenter
@@ -258,6 +265,11 @@ type DatacenterSpecVSphere struct {
 	// except the cloud provider functionality, which will still use the credentials
 	// passed in via the Kubermatic dashboard/API.
 	InfraManagementUser *VSphereCredentials `json:"infra_management_user,omitempty"`
+
+	// Optional: The name of the tag category used to tag the objects of a cluster,
+	// in case no `TagCategory` is provided at Cluster level. The category gets
+	// created if it does not exist yet.
+	DefaultTagCategory string `json:"default_tag_category,omitempty"`
 }
 
 // DatacenterSpecAWS describes an AWS datacenter
@@ -306,6 +318,13 @@ type DatacenterSpecFake struct {
 
 // DatacenterSpecKubevirt describes a kubevirt datacenter.
 type DatacenterSpecKubevirt struct {
+	// Optional: ResourceQuota limits the resources every user cluster can use in the KubeVirt infra cluster.
+	// If not set, the namespaces of the user clusters are not limited.
+	ResourceQuota corev1.ResourceList `json:"resourceQuota,omitempty"`
+	// Optional: PodCIDRs are the pod networks of the KubeVirt infra cluster. Traffic from outside the infra cluster,
+	// like LoadBalancer traffic, is allowed into the namespaces of the user clusters, traffic from pods of other
+	// namespaces is not. If not set, only traffic between the virtual machines of a user cluster is allowed.
+	PodCIDRs []string `json:"podCIDRs,omitempty"`
 }
 
 // DatacenterSpecAlibaba describes a alibaba datacenter.
@@ -0,0 +1,63 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GroupProjectBindingResourceName represents "Resource" defined in Kubernetes
+	GroupProjectBindingResourceName = "groupprojectbindings"
+
+	// GroupProjectBindingKind represents "Kind" defined in Kubernetes
+	GroupProjectBindingKind = "GroupProjectBinding"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GroupProjectBinding specifies a binding between a group of the identity provider and a project
+// The members of the group are evaluated from the groups claim of the verified token on every request,
+// thus changes to the group membership in the identity provider take effect without touching the bindings
+type GroupProjectBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GroupProjectBindingSpec `json:"spec"`
+}
+
+// GroupProjectBindingSpec specifies a group
+type GroupProjectBindingSpec struct {
+	// Group is the value of the groups claim in the OIDC token
+	Group     string `json:"group"`
+	ProjectID string `json:"projectId"`
+	// Role is the name of the project group the members of the Group are mapped to,
+	// for example "editors" or the name of a custom ProjectRole
+	Role string `json:"role"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GroupProjectBindingList is a list of group project bindings
+type GroupProjectBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []GroupProjectBinding `json:"items"`
+}
@@ -35,6 +35,7 @@ import (
 // ClusterReconcileWrapper is a wrapper that should be used around
 // any cluster reconciliaton. It:
 // * Checks if the cluster is paused
+// * Checks if the cluster is hibernated
 // * Checks if the worker-name matches
 // * Sets the ReconcileSuccess condition for the controller
 func ClusterReconcileWrapper(
@@ -51,6 +52,9 @@ func ClusterReconcileWrapper(
 	if cluster.Spec.Pause {
 		return nil, nil
 	}
+	if cluster.IsHibernated() && cluster.DeletionTimestamp == nil {
+		return nil, nil
+	}
 
 	reconcilingStatus := corev1.ConditionFalse
 	result, err := reconcile()
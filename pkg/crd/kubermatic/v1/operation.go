@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OperationResourceName represents "Resource" defined in Kubernetes
+	OperationResourceName = "operations"
+
+	// OperationKind represents "Kind" defined in Kubernetes
+	OperationKind = "Operation"
+
+	// OperationClusterLabelKey is the label holding the ID of the cluster an operation belongs to
+	OperationClusterLabelKey = "cluster"
+)
+
+// OperationType is the kind of asynchronous action an operation tracks
+type OperationType string
+
+const (
+	OperationTypeClusterCreation OperationType = "ClusterCreation"
+	OperationTypeClusterUpgrade  OperationType = "ClusterUpgrade"
+	OperationTypeClusterDeletion OperationType = "ClusterDeletion"
+)
+
+// OperationPhase is the progress of an operation, operations end up either succeeded or failed
+type OperationPhase string
+
+const (
+	OperationPhaseRunning   OperationPhase = "Running"
+	OperationPhaseSucceeded OperationPhase = "Succeeded"
+	OperationPhaseFailed    OperationPhase = "Failed"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Operation tracks an asynchronous action on a cluster, e.g. its creation, until it is finished
+// It is created by the API and its status is updated by the operation controller
+type Operation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OperationSpec   `json:"spec"`
+	Status OperationStatus `json:"status,omitempty"`
+}
+
+// OperationSpec specifies the action and the cluster it is applied to
+type OperationSpec struct {
+	Type      OperationType `json:"type"`
+	ProjectID string        `json:"projectId"`
+	ClusterID string        `json:"clusterId"`
+	// Version is the target version of cluster upgrades
+	Version string `json:"version,omitempty"`
+	// UserEmail is the email of the user who started the operation
+	UserEmail string `json:"userEmail"`
+}
+
+// OperationStatus describes the progress of an operation
+type OperationStatus struct {
+	Phase OperationPhase  `json:"phase,omitempty"`
+	Steps []OperationStep `json:"steps,omitempty"`
+	// Percentage is the share of finished steps
+	Percentage int `json:"percentage"`
+	// CurrentCondition is the cluster condition which changed last, in the form "<type>: <reason> <message>"
+	CurrentCondition string `json:"currentCondition,omitempty"`
+	// Errors are the error messages of the cluster and of its failing conditions
+	Errors []string `json:"errors,omitempty"`
+	// CompletionTime is the time at which the operation succeeded or failed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// OperationStep is a milestone of an operation
+type OperationStep struct {
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+}
+
+// IsFinished returns true if the operation either succeeded or failed
+func (o *Operation) IsFinished() bool {
+	return o.Status.Phase == OperationPhaseSucceeded || o.Status.Phase == OperationPhaseFailed
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OperationList is a list of operations
+type OperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Operation `json:"items"`
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// OrphanedCloudResourceResourceName represents "Resource" defined in Kubernetes
+	OrphanedCloudResourceResourceName = "orphanedcloudresources"
+
+	// OrphanedCloudResourceKind represents "Kind" defined in Kubernetes
+	OrphanedCloudResourceKind = "OrphanedCloudResource"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OrphanedCloudResource is a resource at a cloud provider which was created for a cluster that does not exist anymore.
+// The orphaned cloud resources are maintained by the orphaned cloud resource controller, which looks for them
+// with the credentials of every preset.
+type OrphanedCloudResource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrphanedCloudResourceSpec   `json:"spec"`
+	Status OrphanedCloudResourceStatus `json:"status,omitempty"`
+}
+
+// OrphanedCloudResourceSpec identifies the resource at the cloud provider
+type OrphanedCloudResourceSpec struct {
+	// Provider is the name of the cloud provider, for example "aws"
+	Provider string `json:"provider"`
+	// Preset is the name of the preset whose credentials have access to the resource
+	Preset string `json:"preset"`
+	// Datacenter is the datacenter the resource was found in, resources which are not bound to a
+	// region are reported for the first datacenter they were found in
+	Datacenter string `json:"datacenter"`
+	// Kind is the type of the resource, for example "SecurityGroup"
+	Kind string `json:"kind"`
+	// ID identifies the resource at the cloud provider
+	ID           string `json:"id"`
+	ResourceName string `json:"resourceName"`
+	// ClusterName is the name of the deleted cluster the resource was created for
+	ClusterName string `json:"clusterName,omitempty"`
+}
+
+// OrphanedCloudResourceStatus holds the observations of the controller
+type OrphanedCloudResourceStatus struct {
+	// FirstSeen is the time the resource was first found to be orphaned, the grace period for the deletion starts then
+	FirstSeen metav1.Time `json:"firstSeen"`
+	// LastSeen is the time the resource was last found to be orphaned
+	LastSeen metav1.Time `json:"lastSeen"`
+	// DeletionError is the error of the last failed deletion attempt
+	DeletionError string `json:"deletionError,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OrphanedCloudResourceList is a list of orphaned cloud resources
+type OrphanedCloudResourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []OrphanedCloudResource `json:"items"`
+}
@@ -115,11 +115,14 @@ type AWS struct {
 }
 
 type Openstack struct {
-	Username string `json:"username"`
-	Password string `json:"password"`
-	Tenant   string `json:"tenant"`
-	TenantID string `json:"tenantID"`
-	Domain   string `json:"domain"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Tenant   string `json:"tenant,omitempty"`
+	TenantID string `json:"tenantID,omitempty"`
+	Domain   string `json:"domain,omitempty"`
+
+	ApplicationCredentialID     string `json:"applicationCredentialID,omitempty"`
+	ApplicationCredentialSecret string `json:"applicationCredentialSecret,omitempty"`
 
 	Network        string `json:"network,,omitempty"`
 	SecurityGroups string `json:"securityGroups,omitempty"`
@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PriceListResourceName represents "Resource" defined in Kubernetes
+	PriceListResourceName = "pricelists"
+
+	// PriceListKind represents "Kind" defined in Kubernetes
+	PriceListKind = "PriceList"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PriceList holds the prices of the datacenter it is named after. It is maintained by the admins
+// and used to estimate the cost of clusters and to charge back the usage of projects.
+type PriceList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PriceListSpec `json:"spec"`
+}
+
+// PriceListSpec specifies the hourly prices of a datacenter
+type PriceListSpec struct {
+	// Currency of all prices, for example "EUR"
+	Currency string `json:"currency"`
+	// ControlPlane is the hourly price of the control plane of a cluster
+	ControlPlane float64 `json:"controlPlane,omitempty"`
+	// Sizes maps the instance sizes of the cloud provider to their hourly price. The keys are the
+	// sizes returned by the size endpoints of the API, for example "t3.medium" on AWS or "cx21" on
+	// Hetzner. Providers without sizes use "<cpus>cpu-<memory>mb", for example "2cpu-4096mb".
+	Sizes map[string]float64 `json:"sizes,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PriceListList is a list of price lists
+type PriceListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PriceList `json:"items"`
+}
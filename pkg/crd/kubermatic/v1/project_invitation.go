@@ -0,0 +1,70 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProjectInvitationResourceName represents "Resource" defined in Kubernetes
+	ProjectInvitationResourceName = "projectinvitations"
+
+	// ProjectInvitationKind represents "Kind" defined in Kubernetes
+	ProjectInvitationKind = "ProjectInvitation"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectInvitation is a pending invitation of a user to a project
+// The invitee becomes a member of the project once the invitation is accepted, the invitation
+// is removed when it gets accepted, declined or revoked
+type ProjectInvitation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectInvitationSpec `json:"spec"`
+}
+
+// ProjectInvitationSpec specifies the invitee and the role in the project
+type ProjectInvitationSpec struct {
+	ProjectID string `json:"projectId"`
+	UserEmail string `json:"userEmail"`
+	// Group is the project group the invitee is bound to, for example "editors-<projectID>"
+	Group string `json:"group"`
+	// InvitedBy is the email of the user who created the invitation
+	InvitedBy string `json:"invitedBy"`
+	// ExpiresAt is the time after which the invitation can no longer be accepted
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// IsExpired returns true if the invitation can no longer be accepted
+func (i *ProjectInvitation) IsExpired(now metav1.Time) bool {
+	return !now.Before(&i.Spec.ExpiresAt)
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectInvitationList is a list of project invitations
+type ProjectInvitationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ProjectInvitation `json:"items"`
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ProjectRoleResourceName represents "Resource" defined in Kubernetes
+	ProjectRoleResourceName = "projectroles"
+
+	// ProjectRoleKindName represents "Kind" defined in Kubernetes
+	ProjectRoleKindName = "ProjectRole"
+
+	// NodeDeploymentResourceKind is the kind used in ProjectRole rules to grant access to the node deployments
+	// of the project's clusters. Node deployments live in the user clusters and are guarded by the API only.
+	NodeDeploymentResourceKind = "NodeDeployment"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectRole is a custom role that can be granted to the members of a project
+// in addition to the built-in owners, editors and viewers groups.
+//
+// The name of a ProjectRole is used as the group prefix of a UserProjectBinding,
+// so it must not contain a dash.
+type ProjectRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ProjectRoleSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectRoleList specifies a list of project roles
+type ProjectRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ProjectRole `json:"items"`
+}
+
+// ProjectRoleSpec specifies the permissions granted by a project role
+type ProjectRoleSpec struct {
+	// HumanReadableName is the name of the role displayed in the UI
+	HumanReadableName string `json:"humanReadableName,omitempty"`
+
+	// Rules holds the allowed verbs per resource
+	Rules []ProjectRoleRule `json:"rules"`
+}
+
+// ProjectRoleRule grants a set of verbs on a kind of project resource
+type ProjectRoleRule struct {
+	// Resource is the kind of the resource the rule applies to, for example Project, Cluster, UserSSHKey,
+	// ExternalCluster or NodeDeployment
+	Resource string `json:"resource"`
+
+	// Verbs is the list of allowed verbs, supported are get, create, update and delete
+	Verbs []string `json:"verbs"`
+}
+
+// Allows tells whether the role grants the given verb on the given kind of resource
+func (r *ProjectRole) Allows(resource, verb string) bool {
+	for _, rule := range r.Spec.Rules {
+		if rule.Resource != resource {
+			continue
+		}
+		for _, ruleVerb := range rule.Verbs {
+			if ruleVerb == verb || ruleVerb == "*" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -77,6 +77,22 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ExternalClusterList{},
 		&ConstraintTemplate{},
 		&ConstraintTemplateList{},
+		&ProjectRole{},
+		&ProjectRoleList{},
+		&GroupProjectBinding{},
+		&GroupProjectBindingList{},
+		&ClusterMigration{},
+		&ClusterMigrationList{},
+		&ProjectInvitation{},
+		&ProjectInvitationList{},
+		&PriceList{},
+		&PriceListList{},
+		&UsageReport{},
+		&UsageReportList{},
+		&Operation{},
+		&OperationList{},
+		&OrphanedCloudResource{},
+		&OrphanedCloudResourceList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -17,6 +17,8 @@ limitations under the License.
 package v1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -55,6 +57,17 @@ type SettingSpec struct {
 	UserProjectsLimit           int64          `json:"userProjectsLimit"`
 	RestrictProjectCreation     bool           `json:"restrictProjectCreation"`
 	EnableExternalClusterImport bool           `json:"enableExternalClusterImport"`
+	// EnableWebTerminal allows project owners and editors to open an interactive kubectl shell for their clusters in the browser
+	EnableWebTerminal bool `json:"enableWebTerminal"`
+	// ServiceAccountTokenOptions control the lifecycle of project service account tokens
+	ServiceAccountTokenOptions ServiceAccountTokenOptions `json:"serviceAccountTokenOptions"`
+	// ClusterDeletionGracePeriod is the period for which deleted clusters are kept hibernated and can be
+	// undeleted before they are removed. A zero value deletes clusters right away.
+	ClusterDeletionGracePeriod metav1.Duration `json:"clusterDeletionGracePeriod"`
+	// MaintenanceMode makes the API reject mutating requests of non-admin users, e.g. during upgrades
+	MaintenanceMode MaintenanceMode `json:"maintenanceMode"`
+	// Announcements are displayed to all users while they are active
+	Announcements []Announcement `json:"announcements,omitempty"`
 
 	// TODO: Datacenters, presets, user management, Google Analytics and default addons.
 }
@@ -73,6 +86,70 @@ type CleanupOptions struct {
 	Enforced bool
 }
 
+type ServiceAccountTokenOptions struct {
+	// MaxLifetime is the longest lifetime a token can be issued with.
+	// A zero value means that tokens are not bounded and get the default lifetime.
+	MaxLifetime metav1.Duration `json:"maxLifetime"`
+	// RotationOverlap is the period during which the previous token
+	// is still accepted after the token has been rotated.
+	// It defaults to 24 hours, a zero value rejects the previous token right away.
+	RotationOverlap *metav1.Duration `json:"rotationOverlap,omitempty"`
+	// UnusedRevocationPeriod is the period after which tokens that haven't been used get revoked.
+	// A zero value disables the automatic revocation.
+	UnusedRevocationPeriod metav1.Duration `json:"unusedRevocationPeriod"`
+}
+
+const (
+	AnnouncementSeverityInfo     = "info"
+	AnnouncementSeverityWarning  = "warning"
+	AnnouncementSeverityCritical = "critical"
+)
+
+// MaintenanceMode can optionally be restricted to a time window with the RFC 3339 times start and end.
+type MaintenanceMode struct {
+	Enabled bool `json:"enabled"`
+	// Message is displayed to the users and returned with every rejected request
+	Message string `json:"message,omitempty"`
+	// Start optionally delays the maintenance, without it the maintenance begins right away.
+	// swagger:strfmt date-time
+	Start *metav1.Time `json:"start,omitempty"`
+	// End optionally ends the maintenance, without it the maintenance lasts until it gets disabled.
+	// swagger:strfmt date-time
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// IsActive returns true if the maintenance mode is enabled and the given time is within its window
+func (m MaintenanceMode) IsActive(now time.Time) bool {
+	return m.Enabled && inTimeWindow(m.Start, m.End, now)
+}
+
+// Announcement can optionally be restricted to a time window with the RFC 3339 times start and end.
+type Announcement struct {
+	// ID identifies the announcement, e.g. so that users can dismiss it
+	ID      string `json:"id"`
+	Message string `json:"message"`
+	// Severity is one of info, warning or critical
+	Severity string `json:"severity,omitempty"`
+	// Start is the time from which the announcement is displayed, it is displayed right away if not set.
+	// swagger:strfmt date-time
+	Start *metav1.Time `json:"start,omitempty"`
+	// End is the time until which the announcement is displayed, it is displayed until it gets removed if not set.
+	// swagger:strfmt date-time
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// IsActive returns true if the given time is within the window of the announcement
+func (a Announcement) IsActive(now time.Time) bool {
+	return inTimeWindow(a.Start, a.End, now)
+}
+
+func inTimeWindow(start, end *metav1.Time, now time.Time) bool {
+	if start != nil && now.Before(start.Time) {
+		return false
+	}
+	return end == nil || now.Before(end.Time)
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // KubermaticSettingList is a list of settings
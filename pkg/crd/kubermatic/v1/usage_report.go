@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// UsageReportResourceName represents "Resource" defined in Kubernetes
+	UsageReportResourceName = "usagereports"
+
+	// UsageReportKind represents "Kind" defined in Kubernetes
+	UsageReportKind = "UsageReport"
+
+	// UsageReportPeriodFormat is the layout of the month a usage report covers
+	UsageReportPeriodFormat = "2006-01"
+)
+
+//+genclient
+//+genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UsageReport accumulates the node-hours of the clusters of a project within one month.
+// The reports are written by the usage report controller and named "<projectID>-<period>".
+type UsageReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UsageReportSpec   `json:"spec"`
+	Status UsageReportStatus `json:"status,omitempty"`
+}
+
+// UsageReportSpec specifies the project and the month of a report
+type UsageReportSpec struct {
+	ProjectID string `json:"projectId"`
+	// Period is the month the report covers, for example "2020-10"
+	Period string `json:"period"`
+}
+
+// UsageReportStatus holds the usage of all clusters the project had during the period
+type UsageReportStatus struct {
+	Clusters []ClusterUsage `json:"clusters,omitempty"`
+}
+
+// ClusterUsage is the usage of a single cluster. The costs are calculated with the
+// price list of the datacenter that was valid when the usage was recorded.
+type ClusterUsage struct {
+	ClusterID   string `json:"clusterId"`
+	ClusterName string `json:"clusterName"`
+	Datacenter  string `json:"datacenter"`
+	// Currency is empty if the datacenter has no price list
+	Currency          string      `json:"currency,omitempty"`
+	ControlPlaneHours float64     `json:"controlPlaneHours"`
+	ControlPlaneCost  float64     `json:"controlPlaneCost"`
+	Nodes             []NodeUsage `json:"nodes,omitempty"`
+	// LastSampled is the time the usage of the cluster was last recorded
+	LastSampled metav1.Time `json:"lastSampled"`
+}
+
+// NodeUsage is the usage of all nodes of an instance size within a cluster
+type NodeUsage struct {
+	Size      string  `json:"size"`
+	NodeHours float64 `json:"nodeHours"`
+	Cost      float64 `json:"cost"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// UsageReportList is a list of usage reports
+type UsageReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []UsageReport `json:"items"`
+}
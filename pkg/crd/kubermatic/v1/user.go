@@ -51,6 +51,7 @@ type UserSpec struct {
 	IsAdmin                 bool                                    `json:"admin"`
 	Settings                *UserSettings                           `json:"settings,omitempty"`
 	TokenBlackListReference *providerconfig.GlobalSecretKeySelector `json:"tokenBlackListReference,omitempty"`
+	Suspended               bool                                    `json:"suspended,omitempty"`
 }
 
 // UserSettings represent an user settings
@@ -20,13 +20,21 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/serviceaccount"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
+// tokenLastUsedUpdateInterval limits how often the last used time is written to the token,
+// so that an active token doesn't cause an update on every request
+const tokenLastUsedUpdateInterval = 10 * time.Minute
+
 // ServiceAccountAuthClient implements TokenExtractorVerifier interface
 type ServiceAccountAuthClient struct {
 	headerBearerTokenExtractor TokenExtractor
@@ -62,13 +70,14 @@ func (s *ServiceAccountAuthClient) Verify(ctx context.Context, token string) (To
 		return TokenClaims{}, fmt.Errorf("sa: found more than one token with the given id %s", customClaims.TokenID)
 	}
 	rawToken := tokenList[0]
-	tokenFromDB, ok := rawToken.Data["token"]
-	if !ok {
+	if _, ok := rawToken.Data["token"]; !ok {
 		return TokenClaims{}, fmt.Errorf("sa: cannot verify the token (%s) because the corresponding token in the database is invalid", customClaims.TokenID)
 	}
-	if string(tokenFromDB) != token {
+	now := serviceaccount.Now()
+	if !kubernetesprovider.IsTokenAccepted(rawToken, token, now) {
 		return TokenClaims{}, fmt.Errorf("sa: the token %s has been revoked for %s", customClaims.TokenID, customClaims.Email)
 	}
+	s.recordLastUsed(rawToken, now)
 
 	return TokenClaims{
 		Name:    customClaims.TokenID,
@@ -76,3 +85,16 @@ func (s *ServiceAccountAuthClient) Verify(ctx context.Context, token string) (To
 		Subject: customClaims.Email,
 	}, nil
 }
+
+// recordLastUsed updates the last used time of the token. Failures are not fatal
+// as the token has already been verified.
+func (s *ServiceAccountAuthClient) recordLastUsed(token *corev1.Secret, now time.Time) {
+	if lastUsed, ok := kubernetesprovider.GetTokenLastUsed(token); ok && now.Sub(lastUsed) < tokenLastUsedUpdateInterval {
+		return
+	}
+	tokenCpy := token.DeepCopy()
+	kubernetesprovider.SetTokenLastUsed(tokenCpy, now)
+	if _, err := s.saTokenProvider.UpdateUnsecured(tokenCpy); err != nil {
+		kubermaticlog.Logger.Debugw("failed to record the last usage of the service account token", "token", token.Name, "error", err)
+	}
+}
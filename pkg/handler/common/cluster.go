@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 	"time"
 
@@ -29,6 +30,7 @@ import (
 	"go.uber.org/zap"
 
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/middleware"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
@@ -36,16 +38,21 @@ import (
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/resources/cloudcontroller"
 	"k8c.io/kubermatic/v2/pkg/resources/cluster"
 	machineresource "k8c.io/kubermatic/v2/pkg/resources/machine"
+	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
 	"k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/validation"
 
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -82,7 +89,7 @@ type patchCluster struct {
 
 func CreateEndpoint(ctx context.Context, projectID string, body apiv1.CreateClusterSpec, sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
 	initNodeDeploymentFailures *prometheus.CounterVec, eventRecorderProvider provider.EventRecorderProvider, credentialManager provider.PresetProvider,
-	exposeStrategy corev1.ServiceType, userInfoGetter provider.UserInfoGetter) (interface{}, error) {
+	exposeStrategy corev1.ServiceType, userInfoGetter provider.UserInfoGetter, operationProvider provider.OperationProvider) (interface{}, error) {
 
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
@@ -94,6 +101,11 @@ func CreateEndpoint(ctx context.Context, projectID string, body apiv1.CreateClus
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	// the initial node deployment is created with the access granted for the creation of the cluster
+	projectRole, ok := ctx.Value(kubermaticcontext.ProjectRoleContextKey).(*kubermaticv1.ProjectRole)
+	if ok && body.NodeDeployment != nil && !projectRole.Allows(kubermaticv1.NodeDeploymentResourceKind, "create") {
+		return nil, errors.New(http.StatusForbidden, fmt.Sprintf("the project role %q doesn't allow to create %s resources", projectRole.Name, kubermaticv1.NodeDeploymentResourceKind))
+	}
 	k8sClient := privilegedClusterProvider.GetSeedClusterAdminClient()
 
 	seed, dc, err := provider.DatacenterFromSeedMap(adminUserInfo, seedsGetter, body.Cluster.Spec.Cloud.DatacenterName)
@@ -135,6 +147,16 @@ func CreateEndpoint(ctx context.Context, projectID string, body apiv1.CreateClus
 	if err = validation.ValidateUpdateWindow(spec.UpdateWindow); err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+
+	cloudProvider, err := cloud.Provider(dc, secretKeyGetter)
+	if err != nil {
+		return nil, err
+	}
+	preflightWarnings, err := ClusterPreflightChecks(cloudProvider, spec.Cloud, body.NodeDeployment)
+	if err != nil {
+		return nil, err
+	}
+
 	partialCluster := &kubermaticv1.Cluster{}
 	partialCluster.Labels = body.Cluster.Labels
 	if partialCluster.Labels == nil {
@@ -211,6 +233,9 @@ func CreateEndpoint(ctx context.Context, projectID string, body apiv1.CreateClus
 
 	log := kubermaticlog.Logger.With("cluster", newCluster.Name)
 
+	apiCluster := convertInternalClusterToExternal(newCluster, true)
+	apiCluster.PreflightWarnings = preflightWarnings
+
 	// Block for up to 10 seconds to give the rbac controller time to create the bindings.
 	// During that time we swallow all errors
 	if err := wait.PollImmediate(time.Second, 10*time.Second, func() (bool, error) {
@@ -222,10 +247,10 @@ func CreateEndpoint(ctx context.Context, projectID string, body apiv1.CreateClus
 		return true, nil
 	}); err != nil {
 		log.Error("Timed out waiting for cluster to become ready")
-		return convertInternalClusterToExternal(newCluster, true), errors.New(http.StatusInternalServerError, "timed out waiting for cluster to become ready")
+		return apiCluster, errors.New(http.StatusInternalServerError, "timed out waiting for cluster to become ready")
 	}
 
-	return convertInternalClusterToExternal(newCluster, true), nil
+	return startOperation(ctx, userInfoGetter, operationProvider, project, newCluster.Name, kubermaticv1.OperationTypeClusterCreation, "", apiCluster), nil
 }
 
 func GetExternalClusters(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID string) ([]*apiv1.Cluster, error) {
@@ -267,7 +292,7 @@ func GetEndpoint(ctx context.Context, projectProvider provider.ProjectProvider,
 	return convertInternalClusterToExternal(cluster, true), nil
 }
 
-func DeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, deleteVolumes, deleteLoadBalancers bool, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+func DeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, deleteVolumes, deleteLoadBalancers, skipGracePeriod bool, retention *kubermaticv1.ClusterDeletionRetention, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, settingsProvider provider.SettingsProvider, operationProvider provider.OperationProvider) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 
@@ -276,20 +301,61 @@ func DeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if existingCluster.Spec.DeletionProtection {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is protected against deletion, disable the deletion protection first", clusterID))
+	}
+	if skipGracePeriod {
+		adminUserInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !adminUserInfo.IsAdmin {
+			return nil, errors.New(http.StatusForbidden, "only admins can skip the deletion grace period")
+		}
+	}
+	// Admins can delete a cluster right away which is already waiting for its grace period to end
+	if existingCluster.IsSoftDeleted() && !skipGracePeriod {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is already scheduled for deletion", clusterID))
+	}
+	existingCluster.Spec.DeletionRetention = retention
+
+	globalSettings, err := settingsProvider.GetGlobalSettings()
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
 	clusterSSHKeys, err := sshKeyProvider.List(project, &provider.SSHKeyListOptions{ClusterName: clusterID})
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
-	for _, clusterSSHKey := range clusterSSHKeys {
-		clusterSSHKey.RemoveFromCluster(clusterID)
-		if err := UpdateClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterSSHKey, projectID); err != nil {
+	// The cluster is kept hibernated for the grace period, the seed controller deletes it afterwards.
+	// Its SSH keys are detached right away and remembered, so they can be assigned again on undelete.
+	if gracePeriod := globalSettings.Spec.ClusterDeletionGracePeriod.Duration; gracePeriod > 0 && !skipGracePeriod && existingCluster.DeletionTimestamp == nil {
+		existingCluster.Spec.SoftDeletion = &kubermaticv1.ClusterSoftDeletion{
+			DeleteAt:            metav1.NewTime(time.Now().Add(gracePeriod)),
+			DeleteVolumes:       deleteVolumes,
+			DeleteLoadBalancers: deleteLoadBalancers,
+			Hibernated:          existingCluster.Spec.Hibernated,
+		}
+		for _, clusterSSHKey := range clusterSSHKeys {
+			existingCluster.Spec.SoftDeletion.SSHKeys = append(existingCluster.Spec.SoftDeletion.SSHKeys, clusterSSHKey.Name)
+		}
+		existingCluster.Spec.Hibernated = true
+		if _, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := detachSSHKeys(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterSSHKeys, projectID, clusterID); err != nil {
 			return nil, err
 		}
+		return startOperation(ctx, userInfoGetter, operationProvider, project, clusterID, kubermaticv1.OperationTypeClusterDeletion, "", nil), nil
 	}
 
-	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
-	if err != nil {
+	if err := detachSSHKeys(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterSSHKeys, projectID, clusterID); err != nil {
 		return nil, err
 	}
 
@@ -305,10 +371,13 @@ func DeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 		}
 	}
 
-	return nil, updateAndDeleteCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster)
+	if err := updateAndDeleteCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster); err != nil {
+		return nil, err
+	}
+	return startOperation(ctx, userInfoGetter, operationProvider, project, clusterID, kubermaticv1.OperationTypeClusterDeletion, "", nil), nil
 }
 
-func PatchEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, patch json.RawMessage, seedsGetter provider.SeedsGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+func PatchEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, patch json.RawMessage, seedsGetter provider.SeedsGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, operationProvider provider.OperationProvider) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 
@@ -365,6 +434,7 @@ func PatchEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 	newInternalCluster.Spec.Openshift = patchedCluster.Spec.Openshift
 	newInternalCluster.Spec.UpdateWindow = patchedCluster.Spec.UpdateWindow
 	newInternalCluster.Spec.OPAIntegration = patchedCluster.Spec.OPAIntegration
+	newInternalCluster.Spec.DeletionProtection = patchedCluster.Spec.DeletionProtection
 
 	incompatibleKubelets, err := common.CheckClusterVersionSkew(ctx, userInfoGetter, clusterProvider, newInternalCluster, projectID)
 	if err != nil {
@@ -415,9 +485,337 @@ func PatchEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
+	response := convertInternalClusterToExternal(updatedCluster, true)
+	if newVersion := updatedCluster.Spec.Version.String(); newVersion != oldInternalCluster.Spec.Version.String() {
+		return startOperation(ctx, userInfoGetter, operationProvider, project, clusterID, kubermaticv1.OperationTypeClusterUpgrade, newVersion, response), nil
+	}
+	return response, nil
+}
+
+// HibernateEndpoint sets the desired hibernation state of the cluster, scaling the cluster
+// down or up again is done by the hibernation controller in the seed.
+func HibernateEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, hibernate bool, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if existingCluster.DeletionTimestamp != nil {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is being deleted", clusterID))
+	}
+	if existingCluster.Spec.Hibernated == hibernate {
+		return convertInternalClusterToExternal(existingCluster, true), nil
+	}
+	if existingCluster.IsSoftDeleted() {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is scheduled for deletion, undelete it first", clusterID))
+	}
+
+	existingCluster.Spec.Hibernated = hibernate
+	updatedCluster, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
 	return convertInternalClusterToExternal(updatedCluster, true), nil
 }
 
+func detachSSHKeys(ctx context.Context, userInfoGetter provider.UserInfoGetter, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, clusterSSHKeys []*kubermaticv1.UserSSHKey, projectID, clusterID string) error {
+	for _, clusterSSHKey := range clusterSSHKeys {
+		clusterSSHKey.RemoveFromCluster(clusterID)
+		if err := UpdateClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterSSHKey, projectID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UndeleteEndpoint cancels the pending deletion of a cluster which got deleted with a grace period
+func UndeleteEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if existingCluster.DeletionTimestamp != nil {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is already being deleted", clusterID))
+	}
+	if !existingCluster.IsSoftDeleted() {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is not scheduled for deletion", clusterID))
+	}
+
+	// Keys which got removed from the project in the meantime are skipped
+	detachedSSHKeys := sets.NewString(existingCluster.Spec.SoftDeletion.SSHKeys...)
+	projectSSHKeys, err := sshKeyProvider.List(project, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	existingCluster.Spec.Hibernated = existingCluster.Spec.SoftDeletion.Hibernated
+	existingCluster.Spec.SoftDeletion = nil
+	existingCluster.Spec.DeletionRetention = nil
+	updatedCluster, err := updateCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, existingCluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	for _, projectSSHKey := range projectSSHKeys {
+		if !detachedSSHKeys.Has(projectSSHKey.Name) || projectSSHKey.IsUsedByCluster(clusterID) {
+			continue
+		}
+		projectSSHKey.AddToCluster(clusterID)
+		if err := UpdateClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, projectSSHKey, projectID); err != nil {
+			return nil, err
+		}
+	}
+
+	return convertInternalClusterToExternal(updatedCluster, true), nil
+}
+
+// MoveEndpoint reassigns a cluster to another project, the caller has to be an owner of both projects
+func MoveEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, move apiv1.ClusterMove, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	if move.ProjectID == "" {
+		return nil, errors.NewBadRequest("the target project ID is required")
+	}
+	if move.ProjectID == projectID {
+		return nil, errors.NewBadRequest("cluster %s already belongs to project %s", clusterID, projectID)
+	}
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	targetProject, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, move.ProjectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	for _, id := range []string{projectID, move.ProjectID} {
+		if err := checkProjectOwner(ctx, userInfoGetter, id); err != nil {
+			return nil, err
+		}
+	}
+
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if existingCluster.DeletionTimestamp != nil || existingCluster.IsSoftDeleted() {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is being deleted", clusterID))
+	}
+
+	// Validate the keys before anything gets changed
+	targetKeys, err := sshKeyProvider.List(targetProject, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	keysToAssign := []*kubermaticv1.UserSSHKey{}
+	for _, keyID := range move.SSHKeys {
+		var found bool
+		for _, key := range targetKeys {
+			if key.Name == keyID {
+				keysToAssign = append(keysToAssign, key)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.NewBadRequest("SSH key %s does not belong to project %s", keyID, move.ProjectID)
+		}
+	}
+
+	clusterSSHKeys, err := sshKeyProvider.List(project, &provider.SSHKeyListOptions{ClusterName: clusterID})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	keyChanges := []clusterSSHKeyChange{}
+	for _, clusterSSHKey := range clusterSSHKeys {
+		keyChanges = append(keyChanges, clusterSSHKeyChange{key: clusterSSHKey, projectID: projectID})
+	}
+	for _, key := range keysToAssign {
+		keyChanges = append(keyChanges, clusterSSHKeyChange{key: key, projectID: move.ProjectID, assign: true})
+	}
+	if err := applyClusterSSHKeyChanges(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterID, keyChanges); err != nil {
+		return nil, err
+	}
+
+	// Keep the original cluster to be able to move it back when a later step fails
+	originalCluster := existingCluster.DeepCopy()
+
+	// Drop the labels inherited from the current project, the project label synchronizer only ever adds labels
+	for key, value := range existingCluster.Status.InheritedLabels {
+		if existingCluster.Labels[key] == value {
+			delete(existingCluster.Labels, key)
+		}
+	}
+	existingCluster.Status.InheritedLabels = nil
+	for key, value := range targetProject.Labels {
+		if kubermaticv1.ProtectedClusterLabels.Has(key) {
+			continue
+		}
+		if existingCluster.Labels == nil {
+			existingCluster.Labels = map[string]string{}
+		}
+		if existingCluster.Status.InheritedLabels == nil {
+			existingCluster.Status.InheritedLabels = map[string]string{}
+		}
+		existingCluster.Labels[key] = value
+		existingCluster.Status.InheritedLabels[key] = value
+	}
+
+	// Changing the project label makes the RBAC controllers regenerate the bindings for the target project
+	updatedCluster, err := moveCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, projectID, targetProject, existingCluster)
+	if err != nil {
+		if rollbackErr := revertClusterSSHKeyChanges(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterID, keyChanges); rollbackErr != nil {
+			return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to move cluster: %v, failed to restore its SSH keys: %v", err, rollbackErr))
+		}
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	// The RBAC controller only generates the bindings for the target project, the ones of the source project have to go
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+	if err := moveCredentialSecret(ctx, seedClient, updatedCluster, move.ProjectID); err == nil {
+		err = rbac.RevokeClusterRBACForProject(ctx, seedClient, updatedCluster, projectID)
+	}
+	if err != nil {
+		if rollbackErr := rollbackClusterMove(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, sshKeyProvider, privilegedSSHKeyProvider, project, move.ProjectID, originalCluster, updatedCluster, keyChanges); rollbackErr != nil {
+			return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to move cluster: %v, failed to move it back: %v", err, rollbackErr))
+		}
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return convertInternalClusterToExternal(updatedCluster, true), nil
+}
+
+func checkProjectOwner(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string) error {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if adminUserInfo.IsAdmin {
+		return nil
+	}
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if rbac.ExtractGroupPrefix(userInfo.Group) != rbac.OwnerGroupNamePrefix {
+		return errors.New(http.StatusForbidden, fmt.Sprintf("only owners of project %s can move clusters", projectID))
+	}
+	return nil
+}
+
+// moveCluster updates the cluster with the credentials of the current project, the
+// cluster provider sets the project label of the target project
+func moveCluster(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, privilegedClusterProvider provider.PrivilegedClusterProvider, projectID string, targetProject *kubermaticv1.Project, cluster *kubermaticv1.Cluster) (*kubermaticv1.Cluster, error) {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user information: %v", err)
+	}
+	if adminUserInfo.IsAdmin {
+		return privilegedClusterProvider.UpdateUnsecured(targetProject, cluster)
+	}
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user information: %v", err)
+	}
+	return clusterProvider.Update(targetProject, userInfo, cluster)
+}
+
+// clusterSSHKeyChange is an SSH key that gets assigned to or detached from a moved cluster
+type clusterSSHKeyChange struct {
+	key       *kubermaticv1.UserSSHKey
+	projectID string
+	assign    bool
+}
+
+// applyClusterSSHKeyChanges updates the given keys, the keys that were already
+// updated are restored when an update fails
+func applyClusterSSHKeyChanges(ctx context.Context, userInfoGetter provider.UserInfoGetter, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, clusterID string, changes []clusterSSHKeyChange) error {
+	for i, change := range changes {
+		if err := updateMovedClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterID, change, change.assign); err != nil {
+			if rollbackErr := revertClusterSSHKeyChanges(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterID, changes[:i]); rollbackErr != nil {
+				return errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to update SSH key %s: %v, failed to restore the other keys: %v", change.key.Name, err, rollbackErr))
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// revertClusterSSHKeyChanges restores the given keys, all keys are tried even if one of them fails
+func revertClusterSSHKeyChanges(ctx context.Context, userInfoGetter provider.UserInfoGetter, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, clusterID string, changes []clusterSSHKeyChange) error {
+	var failed []string
+	for _, change := range changes {
+		if err := updateMovedClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, clusterID, change, !change.assign); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", change.key.Name, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to restore SSH keys: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func updateMovedClusterSSHKey(ctx context.Context, userInfoGetter provider.UserInfoGetter, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, clusterID string, change clusterSSHKeyChange, assign bool) error {
+	if assign {
+		change.key.AddToCluster(clusterID)
+	} else {
+		change.key.RemoveFromCluster(clusterID)
+	}
+	return UpdateClusterSSHKey(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, change.key, change.projectID)
+}
+
+// rollbackClusterMove moves the cluster and its credential secret back to the source project
+// and restores its SSH keys, the RBAC controller then regenerates the RBAC of the source project
+func rollbackClusterMove(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, privilegedClusterProvider provider.PrivilegedClusterProvider, sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, sourceProject *kubermaticv1.Project, targetProjectID string, originalCluster, movedCluster *kubermaticv1.Cluster, keyChanges []clusterSSHKeyChange) error {
+	cluster := originalCluster.DeepCopy()
+	cluster.ResourceVersion = movedCluster.ResourceVersion
+	restoredCluster, err := moveCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, targetProjectID, sourceProject, cluster)
+	if err != nil {
+		return err
+	}
+	if err := moveCredentialSecret(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), restoredCluster, sourceProject.Name); err != nil {
+		return err
+	}
+	return revertClusterSSHKeyChanges(ctx, userInfoGetter, sshKeyProvider, privilegedSSHKeyProvider, restoredCluster.Name, keyChanges)
+}
+
+func moveCredentialSecret(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, projectID string) error {
+	name := cluster.GetSecretName()
+	if name == "" {
+		return nil
+	}
+	secret := &corev1.Secret{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: name}, secret); err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	oldSecret := secret.DeepCopy()
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[kubermaticv1.ProjectIDLabelKey] = projectID
+	return seedClient.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret))
+}
+
 func GetClusterEventsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, eventType string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
@@ -466,17 +864,55 @@ func HealthEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
 
-	return apiv1.ClusterHealth{
-		Apiserver:                    existingCluster.Status.ExtendedHealth.Apiserver,
-		Scheduler:                    existingCluster.Status.ExtendedHealth.Scheduler,
-		Controller:                   existingCluster.Status.ExtendedHealth.Controller,
-		MachineController:            existingCluster.Status.ExtendedHealth.MachineController,
-		Etcd:                         existingCluster.Status.ExtendedHealth.Etcd,
-		CloudProviderInfrastructure:  existingCluster.Status.ExtendedHealth.CloudProviderInfrastructure,
-		UserClusterControllerManager: existingCluster.Status.ExtendedHealth.UserClusterControllerManager,
+	return convertInternalHealthToExternal(existingCluster), nil
+}
+
+// HealthStatusEndpoint returns the health of the cluster's components together with the conditions of the cluster
+func HealthStatusEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	conditions := make([]apiv1.ClusterCondition, 0, len(existingCluster.Status.Conditions))
+	for _, condition := range existingCluster.Status.Conditions {
+		conditions = append(conditions, apiv1.ClusterCondition{
+			Type:               string(condition.Type),
+			Status:             string(condition.Status),
+			LastTransitionTime: apiv1.NewTime(condition.LastTransitionTime.Time),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+		})
+	}
+	sort.Slice(conditions, func(i, j int) bool {
+		return conditions[i].Type < conditions[j].Type
+	})
+
+	return apiv1.ClusterHealthStatus{
+		ClusterHealth: convertInternalHealthToExternal(existingCluster),
+		Conditions:    conditions,
 	}, nil
 }
 
+func convertInternalHealthToExternal(cluster *kubermaticv1.Cluster) apiv1.ClusterHealth {
+	return apiv1.ClusterHealth{
+		Apiserver:                    cluster.Status.ExtendedHealth.Apiserver,
+		Scheduler:                    cluster.Status.ExtendedHealth.Scheduler,
+		Controller:                   cluster.Status.ExtendedHealth.Controller,
+		MachineController:            cluster.Status.ExtendedHealth.MachineController,
+		Etcd:                         cluster.Status.ExtendedHealth.Etcd,
+		CloudProviderInfrastructure:  cluster.Status.ExtendedHealth.CloudProviderInfrastructure,
+		UserClusterControllerManager: cluster.Status.ExtendedHealth.UserClusterControllerManager,
+	}
+}
+
 func GetMetricsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
 	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
 	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
@@ -563,10 +999,13 @@ func AssignSSHKeyEndpoint(ctx context.Context, userInfoGetter provider.UserInfoG
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
-	_, err = GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	existingCluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
+	if existingCluster.IsSoftDeleted() {
+		return nil, errors.New(http.StatusConflict, fmt.Sprintf("cluster %s is scheduled for deletion, undelete it first", clusterID))
+	}
 
 	// sanity check, make sure that the key belongs to the project
 	// alternatively we could examine the owner references
@@ -799,7 +1238,7 @@ func createInitialNodeDeployment(endpointContext context.Context, nodeDeployment
 	ctx, cancelCtx := context.WithCancel(context.Background())
 	defer cancelCtx()
 
-	nd, err := machineresource.Validate(nodeDeployment, cluster.Spec.Version.Semver())
+	nd, err := machineresource.Validate(nodeDeployment, cluster)
 	if err != nil {
 		return fmt.Errorf("node deployment is not valid: %v", err)
 	}
@@ -924,10 +1363,19 @@ func convertInternalClusterToExternal(internalCluster *kubermaticv1.Cluster, fil
 			UsePodNodeSelectorAdmissionPlugin:   internalCluster.Spec.UsePodNodeSelectorAdmissionPlugin,
 			AdmissionPlugins:                    internalCluster.Spec.AdmissionPlugins,
 			OPAIntegration:                      internalCluster.Spec.OPAIntegration,
+			DeletionProtection:                  internalCluster.Spec.DeletionProtection,
 		},
 		Status: apiv1.ClusterStatus{
 			Version: internalCluster.Spec.Version,
 			URL:     internalCluster.Address.URL,
+			Phase:   internalCluster.Status.Phase,
+			DeleteAt: func() *apiv1.Time {
+				if internalCluster.Spec.SoftDeletion != nil {
+					deleteAt := apiv1.NewTime(internalCluster.Spec.SoftDeletion.DeleteAt.Time)
+					return &deleteAt
+				}
+				return nil
+			}(),
 		},
 		Type: apiv1.KubernetesClusterType,
 	}
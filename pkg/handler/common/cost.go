@@ -0,0 +1,274 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/middleware"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/pricing"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// UsageReportFormatJSON returns the usage reports as JSON, it is the default format
+	UsageReportFormatJSON = "json"
+	// UsageReportFormatCSV returns the usage reports as CSV with one line per cluster and size
+	UsageReportFormatCSV = "csv"
+)
+
+// EstimateClusterCostEndpoint estimates the monthly cost of a cluster and its initial node deployment before it gets created
+func EstimateClusterCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string, spec apiv1.CreateClusterSpec, seedsGetter provider.SeedsGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, priceListProvider provider.PriceListProvider) (interface{}, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	datacenterName := spec.Cluster.Spec.Cloud.DatacenterName
+	if _, _, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, datacenterName); err != nil {
+		return nil, err
+	}
+
+	priceList, err := getPriceList(priceListProvider, datacenterName)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodeDeployments []apiv1.NodeDeployment
+	if spec.NodeDeployment != nil {
+		nodeDeployments = append(nodeDeployments, *spec.NodeDeployment)
+	}
+	return estimateCost(priceList, true, nodeDeployments)
+}
+
+// EstimateMachineDeploymentCostEndpoint estimates the monthly cost of a node deployment of an existing cluster before it gets created
+func EstimateMachineDeploymentCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, nodeDeployment apiv1.NodeDeployment, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, priceListProvider provider.PriceListProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	cluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	priceList, err := getPriceList(priceListProvider, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, err
+	}
+	return estimateCost(priceList, false, []apiv1.NodeDeployment{nodeDeployment})
+}
+
+func getPriceList(priceListProvider provider.PriceListProvider, datacenter string) (*kubermaticv1.PriceList, error) {
+	priceList, err := priceListProvider.GetUnsecured(datacenter)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, k8cerrors.NewNotFound("price list", datacenter)
+		}
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return priceList, nil
+}
+
+func estimateCost(priceList *kubermaticv1.PriceList, includeControlPlane bool, nodeDeployments []apiv1.NodeDeployment) (*apiv1.CostEstimate, error) {
+	estimate := &apiv1.CostEstimate{
+		Datacenter:      priceList.Name,
+		Currency:        priceList.Spec.Currency,
+		NodeDeployments: []apiv1.NodeDeploymentCostEstimate{},
+	}
+	if includeControlPlane {
+		estimate.ControlPlane = priceList.Spec.ControlPlane * pricing.HoursPerMonth
+		estimate.Hourly = priceList.Spec.ControlPlane
+	}
+
+	missingPrices := sets.NewString()
+	for _, nodeDeployment := range nodeDeployments {
+		size, err := pricing.NodeSize(nodeDeployment.Spec.Template.Cloud)
+		if err != nil {
+			return nil, k8cerrors.NewBadRequest("cannot determine the size of node deployment %q: %v", nodeDeployment.Name, err)
+		}
+		price, ok := pricing.NodePrice(priceList, size)
+		if !ok {
+			missingPrices.Insert(size)
+		}
+		hourly := price * float64(nodeDeployment.Spec.Replicas)
+		estimate.NodeDeployments = append(estimate.NodeDeployments, apiv1.NodeDeploymentCostEstimate{
+			Name:     nodeDeployment.Name,
+			Size:     size,
+			Replicas: nodeDeployment.Spec.Replicas,
+			Hourly:   hourly,
+			Monthly:  hourly * pricing.HoursPerMonth,
+		})
+		estimate.Hourly += hourly
+	}
+	estimate.Monthly = estimate.Hourly * pricing.HoursPerMonth
+	estimate.MissingPrices = missingPrices.List()
+
+	return estimate, nil
+}
+
+// ListUsageReportsEndpoint lists the usage reports of a project
+func ListUsageReportsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, period, format string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, usageReportProvider provider.UsageReportProvider) (interface{}, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	reports, err := usageReportProvider.ListUnsecured(projectID, period)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return NewUsageReportsResponse(reports, format), nil
+}
+
+// usageReportsResponse carries the requested format to EncodeUsageReports
+type usageReportsResponse struct {
+	format  string
+	reports []apiv1.UsageReport
+}
+
+// NewUsageReportsResponse converts the given reports into a response for EncodeUsageReports, sorted by period and project
+func NewUsageReportsResponse(reports []kubermaticv1.UsageReport, format string) interface{} {
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].Spec.Period != reports[j].Spec.Period {
+			return reports[i].Spec.Period < reports[j].Spec.Period
+		}
+		return reports[i].Spec.ProjectID < reports[j].Spec.ProjectID
+	})
+
+	response := &usageReportsResponse{format: format, reports: []apiv1.UsageReport{}}
+	for _, report := range reports {
+		response.reports = append(response.reports, convertInternalUsageReportToExternal(&report))
+	}
+	return response
+}
+
+func convertInternalUsageReportToExternal(report *kubermaticv1.UsageReport) apiv1.UsageReport {
+	apiReport := apiv1.UsageReport{
+		ProjectID: report.Spec.ProjectID,
+		Period:    report.Spec.Period,
+		Clusters:  []apiv1.ClusterUsage{},
+	}
+	for _, cluster := range report.Status.Clusters {
+		apiCluster := apiv1.ClusterUsage{
+			ClusterID:         cluster.ClusterID,
+			ClusterName:       cluster.ClusterName,
+			Datacenter:        cluster.Datacenter,
+			Currency:          cluster.Currency,
+			ControlPlaneHours: cluster.ControlPlaneHours,
+			ControlPlaneCost:  cluster.ControlPlaneCost,
+			Nodes:             []apiv1.NodeUsage{},
+		}
+		for _, node := range cluster.Nodes {
+			apiCluster.Nodes = append(apiCluster.Nodes, apiv1.NodeUsage{Size: node.Size, NodeHours: node.NodeHours, Cost: node.Cost})
+		}
+		apiReport.Clusters = append(apiReport.Clusters, apiCluster)
+	}
+	return apiReport
+}
+
+// DecodeUsageReportQuery decodes the optional period and format query parameters
+func DecodeUsageReportQuery(r *http.Request) (period string, format string, err error) {
+	period = r.URL.Query().Get("period")
+	if period != "" {
+		if _, err := time.Parse(kubermaticv1.UsageReportPeriodFormat, period); err != nil {
+			return "", "", k8cerrors.NewBadRequest("invalid period %q, the expected format is YYYY-MM", period)
+		}
+	}
+
+	format = r.URL.Query().Get("format")
+	switch format {
+	case "":
+		format = UsageReportFormatJSON
+	case UsageReportFormatJSON, UsageReportFormatCSV:
+	default:
+		return "", "", k8cerrors.NewBadRequest("unsupported format %q, use %q or %q", format, UsageReportFormatJSON, UsageReportFormatCSV)
+	}
+	return period, format, nil
+}
+
+// EncodeUsageReports writes the usage reports as JSON or as CSV, the CSV format is meant to be imported into billing systems
+func EncodeUsageReports(c context.Context, w http.ResponseWriter, response interface{}) error {
+	rsp := response.(*usageReportsResponse)
+
+	if rsp.format != UsageReportFormatCSV {
+		w.Header().Set("Content-Type", "application/json")
+		return json.NewEncoder(w).Encode(rsp.reports)
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-disposition", "attachment; filename=usage-reports.csv")
+	w.Header().Add("Cache-Control", "no-cache")
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"period", "project_id", "cluster_id", "cluster_name", "datacenter", "item", "hours", "cost", "currency"}); err != nil {
+		return err
+	}
+	for _, report := range rsp.reports {
+		for _, cluster := range report.Clusters {
+			prefix := []string{report.Period, report.ProjectID, cluster.ClusterID, cluster.ClusterName, cluster.Datacenter}
+			lines := [][]string{{"control-plane", formatFloat(cluster.ControlPlaneHours), formatFloat(cluster.ControlPlaneCost)}}
+			for _, node := range cluster.Nodes {
+				lines = append(lines, []string{fmt.Sprintf("node:%s", node.Size), formatFloat(node.NodeHours), formatFloat(node.Cost)})
+			}
+			for _, line := range lines {
+				record := append(append(append([]string{}, prefix...), line...), cluster.Currency)
+				for i := range record {
+					record[i] = escapeCSVFormula(record[i])
+				}
+				if err := writer.Write(record); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// escapeCSVFormula prefixes cells which spreadsheet applications would evaluate as a formula with a
+// single quote, the cluster names are user input
+func escapeCSVFormula(cell string) string {
+	if cell != "" && strings.ContainsAny(cell[:1], "=+-@\t\r") {
+		return "'" + cell
+	}
+	return cell
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/middleware"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	"k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// GetDeletionPreviewEndpoint lists the resources which are removed when the cluster gets deleted
+func GetDeletionPreviewEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, seedsGetter provider.SeedsGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	// The preview is read with admin privileges, so it is restricted to the members who can delete the cluster
+	if err := checkProjectEditor(ctx, userInfoGetter, projectID); err != nil {
+		return nil, err
+	}
+
+	cluster, err := GetInternalCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project, projectID, clusterID, &provider.ClusterGetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	preview := apiv1.ClusterDeletionPreview{
+		LoadBalancers:          []apiv1.DeletionPreviewResource{},
+		PersistentVolumes:      []apiv1.DeletionPreviewResource{},
+		PersistentVolumeClaims: []apiv1.DeletionPreviewResource{},
+		Machines:               []apiv1.DeletionPreviewResource{},
+		CloudResources:         []apiv1.DeletionPreviewResource{},
+		Addons:                 []apiv1.DeletionPreviewResource{},
+	}
+
+	// The in-cluster resources are only cleaned up if the cluster was up once, see DeleteEndpoint
+	if kuberneteshelper.HasFinalizer(cluster, apiv1.NodeDeletionFinalizer) {
+		if cluster.IsHibernated() {
+			// This includes soft-deleted clusters, their control plane is only brought up again for the deletion
+			preview.InClusterResourcesUnavailable = true
+		} else {
+			// The access to the cluster was checked above. Unlike GetClusterClient the admin client
+			// doesn't reject soft-deleted clusters, which is where the preview matters most.
+			client, err := clusterProvider.GetAdminClientForCustomerCluster(cluster)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if err := addInClusterDeletionPreview(ctx, client, &preview); err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+		}
+	}
+
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+	if cluster.Status.NamespaceName != "" {
+		addons := &kubermaticv1.AddonList{}
+		if err := seedClient.List(ctx, addons, ctrlruntimeclient.InNamespace(cluster.Status.NamespaceName)); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		for _, addon := range addons.Items {
+			preview.Addons = append(preview.Addons, apiv1.DeletionPreviewResource{Name: addon.Name})
+		}
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dc: %v", err)
+	}
+	cloudProvider, err := cloud.Provider(dc, provider.SecretKeySelectorValueFuncFactory(ctx, seedClient))
+	if err != nil {
+		return nil, err
+	}
+	if lister, ok := cloudProvider.(provider.CloudResourceLister); ok {
+		for _, resource := range lister.ListCloudResources(cluster) {
+			preview.CloudResources = append(preview.CloudResources, apiv1.DeletionPreviewResource{Kind: resource.Kind, Name: resource.Name})
+		}
+	}
+
+	return preview, nil
+}
+
+func checkProjectEditor(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string) error {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if adminUserInfo.IsAdmin {
+		return nil
+	}
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	switch rbac.ExtractGroupPrefix(userInfo.Group) {
+	case rbac.OwnerGroupNamePrefix, rbac.EditorGroupNamePrefix:
+		return nil
+	}
+	return errors.New(http.StatusForbidden, fmt.Sprintf("only owners and editors of project %s can preview the deletion of clusters", projectID))
+}
+
+func addInClusterDeletionPreview(ctx context.Context, client ctrlruntimeclient.Client, preview *apiv1.ClusterDeletionPreview) error {
+	services := &corev1.ServiceList{}
+	if err := client.List(ctx, services); err != nil {
+		return err
+	}
+	for _, service := range services.Items {
+		if service.Spec.Type == corev1.ServiceTypeLoadBalancer {
+			preview.LoadBalancers = append(preview.LoadBalancers, apiv1.DeletionPreviewResource{Name: service.Name, Namespace: service.Namespace})
+		}
+	}
+
+	pvs := &corev1.PersistentVolumeList{}
+	if err := client.List(ctx, pvs); err != nil {
+		return err
+	}
+	for _, pv := range pvs.Items {
+		preview.PersistentVolumes = append(preview.PersistentVolumes, apiv1.DeletionPreviewResource{Name: pv.Name})
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := client.List(ctx, pvcs); err != nil {
+		return err
+	}
+	for _, pvc := range pvcs.Items {
+		preview.PersistentVolumeClaims = append(preview.PersistentVolumeClaims, apiv1.DeletionPreviewResource{Name: pvc.Name, Namespace: pvc.Namespace})
+	}
+
+	machines := &clusterv1alpha1.MachineList{}
+	if err := client.List(ctx, machines, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		// Happens during cluster creation when the CRD is not setup yet
+		if _, ok := err.(*meta.NoKindMatchError); ok {
+			return nil
+		}
+		return err
+	}
+	for _, machine := range machines.Items {
+		preview.Machines = append(preview.Machines, apiv1.DeletionPreviewResource{Name: machine.Name, Namespace: machine.Namespace})
+	}
+
+	return nil
+}
+
+// DeletionRetention parses the RetainLoadBalancers, RetainVolumes and RetainMachines headers of a cluster deletion,
+// all are comma-separated lists. Nil is returned if nothing is retained.
+func DeletionRetention(retainLoadBalancers, retainVolumes, retainMachines string) (*kubermaticv1.ClusterDeletionRetention, error) {
+	loadBalancers, volumes, machines := splitHeaderList(retainLoadBalancers), splitHeaderList(retainVolumes), splitHeaderList(retainMachines)
+	for _, lb := range loadBalancers {
+		if parts := strings.Split(lb, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.NewBadRequest("invalid load balancer %q, expected the form namespace/name", lb)
+		}
+	}
+	for _, machine := range machines {
+		if strings.Contains(machine, "/") {
+			return nil, errors.NewBadRequest("invalid machine %q, expected the name of a machine in the %s namespace", machine, metav1.NamespaceSystem)
+		}
+	}
+	if len(loadBalancers) == 0 && len(volumes) == 0 && len(machines) == 0 {
+		return nil, nil
+	}
+	return &kubermaticv1.ClusterDeletionRetention{
+		LoadBalancers:     loadBalancers,
+		PersistentVolumes: volumes,
+		Machines:          machines,
+	}, nil
+}
+
+func splitHeaderList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"go.uber.org/zap"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// OperationIDHeader is the response header which holds the ID of the operation started by a request
+const OperationIDHeader = "Operation-ID"
+
+// operationResponse carries the ID of the started operation to EncodeOperation
+type operationResponse struct {
+	operationID string
+	response    interface{}
+}
+
+// EncodeOperation returns an encoder which sets the OperationIDHeader if the request started an operation
+// and encodes the actual response with the given encoder
+func EncodeOperation(encoder httptransport.EncodeResponseFunc) httptransport.EncodeResponseFunc {
+	return func(c context.Context, w http.ResponseWriter, response interface{}) error {
+		if rsp, ok := response.(*operationResponse); ok {
+			w.Header().Set(OperationIDHeader, rsp.operationID)
+			response = rsp.response
+		}
+		return encoder(c, w, response)
+	}
+}
+
+// startOperation starts tracking the given action on the cluster and attaches the operation to the response.
+// The action has already been applied at this point, so a failure is only logged and the response is returned as is.
+func startOperation(ctx context.Context, userInfoGetter provider.UserInfoGetter, operationProvider provider.OperationProvider, project *kubermaticv1.Project, clusterID string, operationType kubermaticv1.OperationType, version string, response interface{}) interface{} {
+	log := kubermaticlog.Logger.With("cluster", clusterID, "operation", operationType)
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		log.Errorw("Failed to get the user of the operation", zap.Error(err))
+		return response
+	}
+	operation, err := operationProvider.CreateUnsecured(project, clusterID, operationType, version, userInfo.Email)
+	if err != nil {
+		log.Errorw("Failed to create operation", zap.Error(err))
+		return response
+	}
+	return &operationResponse{operationID: operation.Name, response: response}
+}
+
+// GetOperationEndpoint returns the given operation of the project
+func GetOperationEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, operationID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, operationProvider provider.OperationProvider) (interface{}, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	operation, err := operationProvider.GetUnsecured(operationID)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, k8cerrors.NewNotFound("operation", operationID)
+		}
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	// Operations of other projects are hidden
+	if operation.Spec.ProjectID != projectID {
+		return nil, k8cerrors.NewNotFound("operation", operationID)
+	}
+	return convertInternalOperationToExternal(operation), nil
+}
+
+// ListOperationsEndpoint returns the operations of the project, optionally only those of the given cluster
+func ListOperationsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, operationProvider provider.OperationProvider) (interface{}, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	operations, err := operationProvider.ListUnsecured(projectID, clusterID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	result := []apiv1.Operation{}
+	for i := range operations {
+		result = append(result, convertInternalOperationToExternal(&operations[i]))
+	}
+	return result, nil
+}
+
+func convertInternalOperationToExternal(operation *kubermaticv1.Operation) apiv1.Operation {
+	result := apiv1.Operation{
+		ObjectMeta: apiv1.ObjectMeta{
+			ID:                operation.Name,
+			Name:              operation.Name,
+			CreationTimestamp: apiv1.NewTime(operation.CreationTimestamp.Time),
+		},
+		Type:             string(operation.Spec.Type),
+		ClusterID:        operation.Spec.ClusterID,
+		Version:          operation.Spec.Version,
+		Phase:            string(operation.Status.Phase),
+		Steps:            []apiv1.OperationStep{},
+		Percentage:       operation.Status.Percentage,
+		CurrentCondition: operation.Status.CurrentCondition,
+		Errors:           operation.Status.Errors,
+	}
+	for _, step := range operation.Status.Steps {
+		result.Steps = append(result.Steps, apiv1.OperationStep{Name: step.Name, Done: step.Done})
+	}
+	if operation.Status.CompletionTime != nil {
+		completionTime := apiv1.NewTime(operation.Status.CompletionTime.Time)
+		result.CompletionTime = &completionTime
+	}
+	return result
+}
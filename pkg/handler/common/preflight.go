@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// ClusterPreflightChecks runs the preflight checks of the cloud provider for a new cluster and its initial node deployment,
+// if the cloud provider supports them. It returns the warnings of the checks or an error with the failed checks as details.
+func ClusterPreflightChecks(cloudProvider provider.CloudProvider, spec kubermaticv1.CloudSpec, nodeDeployment *apiv1.NodeDeployment) ([]string, error) {
+	checker, ok := cloudProvider.(provider.PreflightChecker)
+	if !ok {
+		return nil, nil
+	}
+
+	var results []provider.PreflightCheckResult
+	clusterResults, err := checker.PreflightCheckCluster(spec)
+	if err != nil {
+		results = append(results, provider.PreflightCheckResult{Message: fmt.Sprintf("the cluster could not be checked: %v", err)})
+	}
+	results = append(results, clusterResults...)
+	if nodeDeployment != nil && nodeDeployment.Spec.Replicas > 0 {
+		results = append(results, preflightCheckNodes(checker, spec, nodeDeployment)...)
+	}
+	return evaluatePreflightCheckResults(results)
+}
+
+// NodeDeploymentPreflightChecks runs the preflight checks of the cloud provider for a new node deployment,
+// if the cloud provider supports them. It returns the warnings of the checks or an error with the failed checks as details.
+func NodeDeploymentPreflightChecks(cloudProvider provider.CloudProvider, spec kubermaticv1.CloudSpec, nodeDeployment *apiv1.NodeDeployment) ([]string, error) {
+	checker, ok := cloudProvider.(provider.PreflightChecker)
+	if !ok || nodeDeployment.Spec.Replicas <= 0 {
+		return nil, nil
+	}
+	return evaluatePreflightCheckResults(preflightCheckNodes(checker, spec, nodeDeployment))
+}
+
+func preflightCheckNodes(checker provider.PreflightChecker, spec kubermaticv1.CloudSpec, nodeDeployment *apiv1.NodeDeployment) []provider.PreflightCheckResult {
+	results, err := checker.PreflightCheckNodes(spec, nodeDeployment.Spec.Template.Cloud, int(nodeDeployment.Spec.Replicas))
+	if err != nil {
+		// The checks are best effort, the nodes may still be created
+		return []provider.PreflightCheckResult{{Message: fmt.Sprintf("the nodes could not be checked: %v", err)}}
+	}
+	return results
+}
+
+func evaluatePreflightCheckResults(results []provider.PreflightCheckResult) ([]string, error) {
+	var warnings, failures []string
+	for _, result := range results {
+		if result.Error {
+			failures = append(failures, result.Message)
+		} else {
+			warnings = append(warnings, result.Message)
+		}
+	}
+	if len(failures) > 0 {
+		return nil, k8cerrors.NewWithDetails(http.StatusBadRequest, "preflight checks failed", failures)
+	}
+	return warnings, nil
+}
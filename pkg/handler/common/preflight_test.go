@@ -0,0 +1,107 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+type fakePreflightChecker struct {
+	provider.CloudProvider
+	clusterResults []provider.PreflightCheckResult
+	clusterErr     error
+	nodeResults    []provider.PreflightCheckResult
+}
+
+func (c *fakePreflightChecker) PreflightCheckCluster(_ kubermaticv1.CloudSpec) ([]provider.PreflightCheckResult, error) {
+	return c.clusterResults, c.clusterErr
+}
+
+func (c *fakePreflightChecker) PreflightCheckNodes(_ kubermaticv1.CloudSpec, _ apiv1.NodeCloudSpec, _ int) ([]provider.PreflightCheckResult, error) {
+	return c.nodeResults, nil
+}
+
+func TestClusterPreflightChecks(t *testing.T) {
+	nodeDeployment := &apiv1.NodeDeployment{Spec: apiv1.NodeDeploymentSpec{Replicas: 3}}
+	testCases := []struct {
+		name             string
+		checker          *fakePreflightChecker
+		nodeDeployment   *apiv1.NodeDeployment
+		expectedWarnings []string
+		expectedDetails  []string
+	}{
+		{
+			name: "warnings are returned",
+			checker: &fakePreflightChecker{
+				clusterResults: []provider.PreflightCheckResult{{Message: "cluster warning"}},
+				nodeResults:    []provider.PreflightCheckResult{{Message: "node warning"}},
+			},
+			nodeDeployment:   nodeDeployment,
+			expectedWarnings: []string{"cluster warning", "node warning"},
+		},
+		{
+			name: "nodes are not checked without a node deployment",
+			checker: &fakePreflightChecker{
+				nodeResults: []provider.PreflightCheckResult{{Error: true, Message: "node error"}},
+			},
+		},
+		{
+			name: "failed checks reject the request",
+			checker: &fakePreflightChecker{
+				clusterResults: []provider.PreflightCheckResult{{Message: "cluster warning"}},
+				nodeResults:    []provider.PreflightCheckResult{{Error: true, Message: "node error"}},
+			},
+			nodeDeployment:  nodeDeployment,
+			expectedDetails: []string{"node error"},
+		},
+		{
+			name:             "checks which could not be run are a warning",
+			checker:          &fakePreflightChecker{clusterErr: errors.New("unauthorized")},
+			expectedWarnings: []string{"the cluster could not be checked: unauthorized"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := ClusterPreflightChecks(tc.checker, kubermaticv1.CloudSpec{}, tc.nodeDeployment)
+			if tc.expectedDetails != nil {
+				httpErr, ok := err.(k8cerrors.HTTPError)
+				if !ok {
+					t.Fatalf("expected an HTTP error, got %v", err)
+				}
+				if diff := deep.Equal(httpErr.Details(), tc.expectedDetails); diff != nil {
+					t.Errorf("unexpected error details: %v", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := deep.Equal(warnings, tc.expectedWarnings); diff != nil {
+				t.Errorf("unexpected warnings: %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"k8c.io/kubermatic/v2/pkg/handler/auth"
+	"k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// MaintenanceMode is a HTTP middleware that rejects mutating requests of non-admin users
+// with 503 Service Unavailable while the maintenance mode of the global settings is active.
+// Users can still log out during the maintenance.
+func MaintenanceMode(settingsProvider provider.SettingsProvider, userProvider provider.UserProvider, tokenVerifier auth.TokenVerifier, tokenExtractor auth.TokenExtractor) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			if !isMutatingRequest(req) || strings.HasSuffix(req.URL.Path, "/me/logout") {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			settings, err := settingsProvider.GetGlobalSettings()
+			if err != nil {
+				ErrorEncoder(req.Context(), err, w)
+				return
+			}
+			maintenance := settings.Spec.MaintenanceMode
+			if !maintenance.IsActive(time.Now()) || isAdminRequest(req, userProvider, tokenVerifier, tokenExtractor) {
+				next.ServeHTTP(w, req)
+				return
+			}
+
+			msg := "the platform is under maintenance, changes are not possible at the moment"
+			if maintenance.Message != "" {
+				msg = fmt.Sprintf("%s: %s", msg, maintenance.Message)
+			}
+			var details []string
+			if maintenance.End != nil {
+				details = append(details, fmt.Sprintf("the maintenance is scheduled to end at %s", maintenance.End.UTC().Format(time.RFC3339)))
+			}
+			ErrorEncoder(req.Context(), errors.NewWithDetails(http.StatusServiceUnavailable, msg, details), w)
+		})
+	}
+}
+
+func isMutatingRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isAdminRequest returns true if the request carries a valid token of an admin
+func isAdminRequest(req *http.Request, userProvider provider.UserProvider, tokenVerifier auth.TokenVerifier, tokenExtractor auth.TokenExtractor) bool {
+	token, err := tokenExtractor.Extract(req)
+	if err != nil {
+		return false
+	}
+	claims, err := tokenVerifier.Verify(req.Context(), token)
+	if err != nil || claims.Email == "" {
+		return false
+	}
+	user, err := userProvider.UserByEmail(claims.Email)
+	if err != nil {
+		if err != provider.ErrNotFound {
+			log.Logger.Debug(err)
+		}
+		return false
+	}
+	return user.Spec.IsAdmin
+}
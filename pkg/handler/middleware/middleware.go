@@ -26,6 +26,7 @@ import (
 	transporthttp "github.com/go-kit/kit/transport/http"
 
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/auth"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
@@ -68,6 +69,9 @@ const (
 	// PrivilegedAddonProviderContextKey key under which the current PrivilegedAddonProvider is kept in the ctx
 	PrivilegedAddonProviderContextKey kubermaticcontext.Key = "privileged-addon-provider"
 
+	// TokenGroupsContextKey key under which the groups claim of the verified token (OpenID ID Token) is kept in the ctx
+	TokenGroupsContextKey = kubermaticcontext.TokenGroupsContextKey
+
 	UserCRContextKey                            = kubermaticcontext.UserCRContextKey
 	SeedsGetterContextKey kubermaticcontext.Key = "seeds-getter"
 )
@@ -174,6 +178,48 @@ func UserInfoUnauthorized(userProjectMapper provider.ProjectMemberMapper, userPr
 	}
 }
 
+// ProjectRoles is a middleware that enforces custom project roles (ProjectRole).
+// Members of the built-in groups are passed through, for the other members the role must allow
+// the given verb on the given resource. The role and the verb are kept in the ctx so that handlers know the request was authorized.
+func ProjectRoles(userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider, resource, verb string) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			prjIDGetter, ok := request.(common.ProjectIDGetter)
+			if !ok {
+				return nil, k8cerrors.NewBadRequest("you can only use ProjectRoles middleware for endpoints that accepts project ID")
+			}
+			adminUserInfo, err := userInfoGetter(ctx, "")
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if adminUserInfo.IsAdmin {
+				return next(ctx, request)
+			}
+			userInfo, err := userInfoGetter(ctx, prjIDGetter.GetProjectID())
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			groupPrefix := rbac.ExtractGroupPrefix(userInfo.Group)
+			if rbac.IsBuiltInGroupPrefix(groupPrefix) {
+				return next(ctx, request)
+			}
+
+			projectRole, err := projectRoleProvider.Get(userInfo, groupPrefix)
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					return nil, k8cerrors.New(http.StatusForbidden, fmt.Sprintf("the project role %q doesn't exist", groupPrefix))
+				}
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if !projectRole.Allows(resource, verb) {
+				return nil, k8cerrors.New(http.StatusForbidden, fmt.Sprintf("the project role %q doesn't allow to %s %s resources", groupPrefix, verb, resource))
+			}
+			ctx = context.WithValue(ctx, kubermaticcontext.ProjectRoleContextKey, projectRole)
+			return next(context.WithValue(ctx, kubermaticcontext.ProjectRoleVerbContextKey, verb), request)
+		}
+	}
+}
+
 // TokenVerifier knows how to verify a token from the incoming request
 func TokenVerifier(tokenVerifier auth.TokenVerifier, userProvider provider.UserProvider) endpoint.Middleware {
 	return func(next endpoint.Endpoint) endpoint.Endpoint {
@@ -225,6 +271,7 @@ func TokenVerifier(tokenVerifier auth.TokenVerifier, userProvider provider.UserP
 			}
 
 			ctx = context.WithValue(ctx, TokenExpiryContextKey, claims.Expiry)
+			ctx = context.WithValue(ctx, TokenGroupsContextKey, claims.Groups)
 			return next(context.WithValue(ctx, AuthenticatedUserContextKey, user), request)
 		}
 	}
@@ -340,6 +387,7 @@ func getClusterProviderByClusterID(ctx context.Context, seeds map[string]*kuberm
 	return nil, ctx, k8cerrors.NewNotFound("cluster-provider", clusterID)
 }
 
+// checkBlockedTokens rejects the tokens of suspended users and the tokens the users logged out with
 func checkBlockedTokens(email, token string, userProvider provider.UserProvider) error {
 	user, err := userProvider.UserByEmail(email)
 	if err != nil {
@@ -348,6 +396,9 @@ func checkBlockedTokens(email, token string, userProvider provider.UserProvider)
 		}
 		return nil
 	}
+	if user.Spec.Suspended {
+		return k8cerrors.New(http.StatusForbidden, "the account has been suspended")
+	}
 	blockedTokens, err := userProvider.GetUserBlacklistTokens(user)
 	if err != nil {
 		return common.KubernetesErrorToHTTPError(err)
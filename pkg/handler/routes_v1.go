@@ -48,9 +48,12 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
 	"k8c.io/kubermatic/v2/pkg/handler/middleware"
 	v1 "k8c.io/kubermatic/v2/pkg/handler/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/addon"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/admin"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/cluster"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/dc"
@@ -58,6 +61,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/handler/v1/label"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/node"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/openshift"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/operation"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/presets"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/project"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/provider"
@@ -69,6 +73,8 @@ import (
 
 // RegisterV1 declares all router paths for v1
 func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
+	mux.Use(MaintenanceMode(r.settingsProvider, r.userProvider, r.tokenVerifiers, r.tokenExtractors))
+
 	//
 	// no-op endpoint that always returns HTTP 200
 	mux.Methods(http.MethodGet).
@@ -205,6 +211,22 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/providers/vsphere/folders").
 		Handler(r.listVSphereFolders())
 
+	mux.Methods(http.MethodGet).
+		Path("/providers/vsphere/datastores").
+		Handler(r.listVSphereDatastores())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/vsphere/datastoreclusters").
+		Handler(r.listVSphereDatastoreClusters())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/vsphere/resourcepools").
+		Handler(r.listVSphereResourcePools())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/vsphere/storagepolicies").
+		Handler(r.listVSphereStoragePolicies())
+
 	mux.Methods(http.MethodGet).
 		Path("/providers/packet/sizes").
 		Handler(r.listPacketSizes())
@@ -221,6 +243,22 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/providers/alibaba/zones").
 		Handler(r.listAlibabaZones())
 
+	mux.Methods(http.MethodGet).
+		Path("/providers/alibaba/vpcs").
+		Handler(r.listAlibabaVPCs())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/alibaba/vswitches").
+		Handler(r.listAlibabaVSwitches())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/kubevirt/storageclasses").
+		Handler(r.listKubevirtStorageClasses())
+
+	mux.Methods(http.MethodGet).
+		Path("/providers/kubevirt/vmpresets").
+		Handler(r.listKubevirtVMPresets())
+
 	mux.Methods(http.MethodGet).
 		Path("/providers/{provider_name}/presets/credentials").
 		Handler(r.listCredentials())
@@ -303,6 +341,18 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/health").
 		Handler(r.getClusterHealth())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/hibernate").
+		Handler(r.hibernateCluster())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/resume").
+		Handler(r.resumeCluster())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/undelete").
+		Handler(r.undeleteCluster())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/upgrades").
 		Handler(r.getClusterUpgrades())
@@ -564,6 +614,22 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/folders").
 		Handler(r.listVSphereFoldersNoCredentials())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastores").
+		Handler(r.listVSphereDatastoresNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastoreclusters").
+		Handler(r.listVSphereDatastoreClustersNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/resourcepools").
+		Handler(r.listVSphereResourcePoolsNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/storagepolicies").
+		Handler(r.listVSphereStoragePoliciesNoCredentials())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/packet/sizes").
 		Handler(r.listPacketSizesNoCredentials())
@@ -576,6 +642,22 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/zones").
 		Handler(r.listAlibabaZonesNoCredentials())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vpcs").
+		Handler(r.listAlibabaVPCsNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vswitches").
+		Handler(r.listAlibabaVSwitchesNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/storageclasses").
+		Handler(r.listKubevirtStorageClassesNoCredentials())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/vmpresets").
+		Handler(r.listKubevirtVMPresetsNoCredentials())
+
 	//
 	// Defines a set of openshift-specific endpoints
 	mux.Methods(http.MethodGet).
@@ -607,6 +689,44 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/projects/{project_id}/users/{user_id}").
 		Handler(r.deleteUserFromProject())
 
+	//
+	// Defines set of HTTP endpoints for the groups of the identity provider bound to the given project
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/groupbindings").
+		Handler(r.addGroupBindingToProject())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/groupbindings").
+		Handler(r.getGroupBindingsForProject())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/groupbindings/{binding_name}").
+		Handler(r.deleteGroupBindingFromProject())
+
+	//
+	// Defines set of HTTP endpoints for the pending invitations of users to the given project
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/invitations").
+		Handler(r.createProjectInvitation())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/invitations").
+		Handler(r.listProjectInvitations())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/invitations/{invitation_id}").
+		Handler(r.revokeProjectInvitation())
+
+	//
+	// Defines set of HTTP endpoints for the operations of the given project
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/operations").
+		Handler(r.listOperations())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/operations/{operation_id}").
+		Handler(r.getOperation())
+
 	//
 	// Defines set of HTTP endpoints for ServiceAccounts of the given project
 	mux.Methods(http.MethodPost).
@@ -639,6 +759,9 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 	mux.Methods(http.MethodPatch).
 		Path("/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}").
 		Handler(r.patchServiceAccountToken())
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}/rotate").
+		Handler(r.rotateServiceAccountToken())
 	mux.Methods(http.MethodDelete).
 		Path("/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}").
 		Handler(r.deleteServiceAccountToken())
@@ -671,6 +794,18 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/me/settings").
 		Handler(r.patchCurrentUserSettings())
 
+	mux.Methods(http.MethodGet).
+		Path("/me/invitations").
+		Handler(r.listCurrentUserInvitations())
+
+	mux.Methods(http.MethodPost).
+		Path("/me/invitations/{invitation_id}/accept").
+		Handler(r.acceptInvitation())
+
+	mux.Methods(http.MethodPost).
+		Path("/me/invitations/{invitation_id}/decline").
+		Handler(r.declineInvitation())
+
 	mux.Methods(http.MethodGet).
 		Path("/labels/system").
 		Handler(r.listSystemLabels())
@@ -680,6 +815,12 @@ func (r Routing) RegisterV1(mux *mux.Router, metrics common.ServerMetrics) {
 	mux.Methods(http.MethodGet).
 		Path("/admission/plugins/{version}").
 		Handler(r.getAdmissionPlugins())
+
+	//
+	// Defines an endpoint to list the custom project roles
+	mux.Methods(http.MethodGet).
+		Path("/projectroles").
+		Handler(r.listProjectRoles())
 }
 
 // swagger:route GET /api/v1/projects/{project_id}/sshkeys project listSSHKeys
@@ -1088,6 +1229,94 @@ func (r Routing) listVSphereFolders() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/providers/vsphere/datastores vsphere listVSphereDatastores
+//
+// Lists datastores from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereDatastore
+func (r Routing) listVSphereDatastores() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.VsphereDatastoresEndpoint(r.seedsGetter, r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeVSphereReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/providers/vsphere/datastoreclusters vsphere listVSphereDatastoreClusters
+//
+// Lists datastore clusters from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereDatastoreCluster
+func (r Routing) listVSphereDatastoreClusters() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.VsphereDatastoreClustersEndpoint(r.seedsGetter, r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeVSphereReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/providers/vsphere/resourcepools vsphere listVSphereResourcePools
+//
+// Lists resource pools from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereResourcePool
+func (r Routing) listVSphereResourcePools() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.VsphereResourcePoolsEndpoint(r.seedsGetter, r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeVSphereReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/providers/vsphere/storagepolicies vsphere listVSphereStoragePolicies
+//
+// Lists storage policies from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereStoragePolicy
+func (r Routing) listVSphereStoragePolicies() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.VsphereStoragePoliciesEndpoint(r.seedsGetter, r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeVSphereReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/providers/packet/sizes packet listPacketSizes
 //
 // Lists sizes from packet
@@ -1310,6 +1539,94 @@ func (r Routing) listAlibabaZones() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/providers/alibaba/vpcs alibaba listAlibabaVPCs
+//
+// Lists available Alibaba VPCs.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: AlibabaVPCList
+func (r Routing) listAlibabaVPCs() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.AlibabaVPCsEndpoint(r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeAlibabaReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/providers/alibaba/vswitches alibaba listAlibabaVSwitches
+//
+// Lists available Alibaba vSwitches, optionally filtered by VPC.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: AlibabaVSwitchList
+func (r Routing) listAlibabaVSwitches() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.AlibabaVSwitchesEndpoint(r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeAlibabaVSwitchesReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/providers/kubevirt/storageclasses kubevirt listKubevirtStorageClasses
+//
+// Lists storage classes of a KubeVirt infra cluster
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: KubevirtStorageClassList
+func (r Routing) listKubevirtStorageClasses() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.KubevirtStorageClassesEndpoint(r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeKubevirtReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/providers/kubevirt/vmpresets kubevirt listKubevirtVMPresets
+//
+// Lists VM presets of a KubeVirt infra cluster
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: KubevirtVMPresetList
+func (r Routing) listKubevirtVMPresets() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(provider.KubevirtVMPresetsEndpoint(r.presetsProvider, r.userInfoGetter)),
+		provider.DecodeKubevirtReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/dc datacenter listDatacenters
 //
 //     Produces:
@@ -1765,11 +2082,12 @@ func (r Routing) createCluster(initNodeDeploymentFailures *prometheus.CounterVec
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "create"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.CreateEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, initNodeDeploymentFailures, r.eventRecorderProvider, r.presetsProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.updateManager)),
+		)(cluster.CreateEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, initNodeDeploymentFailures, r.eventRecorderProvider, r.presetsProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.updateManager, r.operationProvider)),
 		cluster.DecodeCreateReq,
-		SetStatusCreatedHeader(EncodeJSON),
+		handlercommon.EncodeOperation(SetStatusCreatedHeader(EncodeJSON)),
 		r.defaultServerOptions()...,
 	)
 }
@@ -1866,11 +2184,12 @@ func (r Routing) patchCluster() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.operationProvider)),
 		cluster.DecodePatchReq,
-		EncodeJSON,
+		handlercommon.EncodeOperation(EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
@@ -1959,7 +2278,8 @@ func (r Routing) getOidcClusterKubeconfig() http.Handler {
 // Delete the cluster
 // swagger:route DELETE /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id} project deleteCluster
 //
-//     Deletes the specified cluster
+//     Deletes the specified cluster. If a deletion grace period is configured, the cluster is hibernated
+//     and can be undeleted until the grace period is over.
 //
 //     Produces:
 //     - application/json
@@ -1969,6 +2289,7 @@ func (r Routing) getOidcClusterKubeconfig() http.Handler {
 //       200: empty
 //       401: empty
 //       403: empty
+//       409: empty
 func (r Routing) deleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
@@ -1976,9 +2297,9 @@ func (r Routing) deleteCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.settingsProvider, r.operationProvider)),
 		cluster.DecodeDeleteReq,
-		EncodeJSON,
+		handlercommon.EncodeOperation(EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
@@ -2009,118 +2330,202 @@ func (r Routing) getClusterHealth() http.Handler {
 	)
 }
 
-// swagger:route PUT /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys/{key_id} project assignSSHKeyToCluster
-//
-//     Assigns an existing ssh key to the given cluster
+// swagger:route POST /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/hibernate project hibernateCluster
 //
-//     Consumes:
-//     - application/json
+//     Scales the worker nodes and the control plane of the cluster to zero.
+//     The progress is reported in the phase of the cluster status.
 //
 //     Produces:
 //     - application/json
 //
 //     Responses:
 //       default: errorResponse
-//       201: SSHKey
+//       200: Cluster
 //       401: empty
 //       403: empty
-func (r Routing) assignSSHKeyToCluster() http.Handler {
+//       409: empty
+func (r Routing) hibernateCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeAssignSSHKeyReq,
-		SetStatusCreatedHeader(EncodeJSON),
+		)(cluster.HibernateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		common.DecodeGetClusterReq,
+		EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys project listSSHKeysAssignedToCluster
+// swagger:route POST /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/resume project resumeCluster
 //
-//     Lists ssh keys that are assigned to the cluster
-//     The returned collection is sorted by creation timestamp.
-//
-//     Consumes:
-//     - application/json
+//     Scales the control plane and the worker nodes of a hibernated cluster up again.
+//     The progress is reported in the phase of the cluster status.
 //
 //     Produces:
 //     - application/json
 //
 //     Responses:
 //       default: errorResponse
-//       200: []SSHKey
+//       200: Cluster
 //       401: empty
 //       403: empty
-func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
+//       409: empty
+func (r Routing) resumeCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.ListSSHKeysEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeListSSHKeysReq,
+		)(cluster.ResumeEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		common.DecodeGetClusterReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys/{key_id} project detachSSHKeyFromCluster
-//
-//     Unassignes an ssh key from the given cluster
+// swagger:route POST /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/undelete project undeleteCluster
 //
-//     Consumes:
-//     - application/json
+//     Cancels the deletion of a cluster which is kept for the deletion grace period.
+//     The cluster is resumed unless it was hibernated before it got deleted.
 //
 //     Produces:
 //     - application/json
 //
 //     Responses:
 //       default: errorResponse
-//       200: empty
+//       200: Cluster
 //       401: empty
 //       403: empty
-func (r Routing) detachSSHKeyFromCluster() http.Handler {
+//       409: empty
+func (r Routing) undeleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeDetachSSHKeysReq,
+		)(cluster.UndeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		common.DecodeGetClusterReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/token project revokeClusterAdminToken
+// swagger:route PUT /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys/{key_id} project assignSSHKeyToCluster
 //
-//     Revokes the current admin token
+//     Assigns an existing ssh key to the given cluster
+//
+//     Consumes:
+//     - application/json
 //
 //     Produces:
 //     - application/json
 //
 //     Responses:
 //       default: errorResponse
-//       200: empty
+//       201: SSHKey
 //       401: empty
 //       403: empty
-func (r Routing) revokeClusterAdminToken() http.Handler {
+func (r Routing) assignSSHKeyToCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.RevokeAdminTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeAdminTokenReq,
-		EncodeJSON,
-		r.defaultServerOptions()...,
-	)
+		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAssignSSHKeyReq,
+		SetStatusCreatedHeader(EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys project listSSHKeysAssignedToCluster
+//
+//     Lists ssh keys that are assigned to the cluster
+//     The returned collection is sorted by creation timestamp.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []SSHKey
+//       401: empty
+//       403: empty
+func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListSSHKeysEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeListSSHKeysReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys/{key_id} project detachSSHKeyFromCluster
+//
+//     Unassignes an ssh key from the given cluster
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) detachSSHKeyFromCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeDetachSSHKeysReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/token project revokeClusterAdminToken
+//
+//     Revokes the current admin token
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) revokeClusterAdminToken() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.RevokeAdminTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAdminTokenReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
 }
 
 // swagger:route PUT /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/viewertoken project revokeClusterViewerToken
@@ -2166,6 +2571,7 @@ func (r Routing) getClusterUpgrades() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.GetUpgradesEndpoint(r.updateManager, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -2216,6 +2622,7 @@ func (r Routing) upgradeClusterNodeDeployments() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -2245,7 +2652,7 @@ func (r Routing) addUserToProject() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(user.AddEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter)),
+		)(user.AddEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter, r.projectRoleProvider)),
 		user.DecodeAddReq,
 		SetStatusCreatedHeader(EncodeJSON),
 		r.defaultServerOptions()...,
@@ -2299,7 +2706,7 @@ func (r Routing) editUserInProject() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(user.EditEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter)),
+		)(user.EditEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userProvider, r.projectMemberProvider, r.privilegedProjectMemberProvider, r.userInfoGetter, r.projectRoleProvider)),
 		user.DecodeEditReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -2333,6 +2740,205 @@ func (r Routing) deleteUserFromProject() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v1/projects/{project_id}/groupbindings users addGroupBindingToProject
+//
+//     Binds the given group of the identity provider to the given role within the project
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       201: GroupProjectBinding
+//       401: empty
+//       403: empty
+func (r Routing) addGroupBindingToProject() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.AddGroupBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider, r.privilegedGroupProjectBindingProvider, r.userInfoGetter, r.projectRoleProvider)),
+		user.DecodeAddGroupBindingReq,
+		SetStatusCreatedHeader(EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/groupbindings users getGroupBindingsForProject
+//
+//     Get list of the groups of the identity provider bound to the given project
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []GroupProjectBinding
+//       401: empty
+//       403: empty
+func (r Routing) getGroupBindingsForProject() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.ListGroupBindingsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider, r.privilegedGroupProjectBindingProvider, r.userInfoGetter)),
+		common.DecodeGetProject,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/projects/{project_id}/groupbindings/{binding_name} users deleteGroupBindingFromProject
+//
+//     Removes the given group binding from the project
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) deleteGroupBindingFromProject() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.DeleteGroupBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.groupProjectBindingProvider, r.privilegedGroupProjectBindingProvider, r.userInfoGetter)),
+		user.DecodeDeleteGroupBindingReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v1/projects/{project_id}/invitations users createProjectInvitation
+//
+//     Invites the given user to the given group within the project
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       201: ProjectInvitation
+//       401: empty
+//       403: empty
+//       409: empty
+func (r Routing) createProjectInvitation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.CreateInvitationEndpoint(r.projectProvider, r.privilegedProjectProvider, r.projectMemberProvider, r.projectInvitationProvider, r.privilegedProjectInvitationProvider, r.invitationNotifier, r.userInfoGetter, r.projectRoleProvider)),
+		user.DecodeCreateInvitationReq,
+		SetStatusCreatedHeader(EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/invitations users listProjectInvitations
+//
+//     Get list of the pending invitations of the given project
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []ProjectInvitation
+//       401: empty
+//       403: empty
+func (r Routing) listProjectInvitations() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.ListInvitationsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.projectInvitationProvider, r.privilegedProjectInvitationProvider, r.userInfoGetter)),
+		common.DecodeGetProject,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/operations project listOperations
+//
+//     Lists the operations of the given project, the newest first
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []Operation
+//       401: empty
+//       403: empty
+func (r Routing) listOperations() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(operation.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.operationProvider, r.userInfoGetter)),
+		operation.DecodeListReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/operations/{operation_id} project getOperation
+//
+//     Gets the status of the given operation
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: Operation
+//       401: empty
+//       403: empty
+func (r Routing) getOperation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(operation.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.operationProvider, r.userInfoGetter)),
+		operation.DecodeGetReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/projects/{project_id}/invitations/{invitation_id} users revokeProjectInvitation
+//
+//     Revokes the given invitation
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) revokeProjectInvitation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.RevokeInvitationEndpoint(r.projectProvider, r.privilegedProjectProvider, r.projectInvitationProvider, r.privilegedProjectInvitationProvider, r.userInfoGetter)),
+		user.DecodeRevokeInvitationReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/me users getCurrentUser
 //
 //     Returns information about the current user.
@@ -2429,6 +3035,79 @@ func (r Routing) patchCurrentUserSettings() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/me/invitations users listCurrentUserInvitations
+//
+//     Get list of the pending invitations of the current user
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []ProjectInvitation
+//       401: empty
+func (r Routing) listCurrentUserInvitations() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.ListCurrentUserInvitationsEndpoint(r.privilegedProjectProvider, r.privilegedProjectInvitationProvider)),
+		common.DecodeEmptyReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v1/me/invitations/{invitation_id}/accept users acceptInvitation
+//
+//     Accepts the given invitation and adds the current user to the project
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: User
+//       401: empty
+//       403: empty
+//       409: empty
+//       410: empty
+func (r Routing) acceptInvitation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.AcceptInvitationEndpoint(r.privilegedProjectProvider, r.privilegedProjectMemberProvider, r.privilegedProjectInvitationProvider, r.userProjectMapper)),
+		user.DecodeInvitationIDReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v1/me/invitations/{invitation_id}/decline users declineInvitation
+//
+//     Declines the given invitation
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) declineInvitation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(user.DeclineInvitationEndpoint(r.privilegedProjectInvitationProvider)),
+		user.DecodeInvitationIDReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route POST /api/v1/projects/{project_id}/serviceaccounts serviceaccounts addServiceAccountToProject
 //
 //     Adds the given service account to the given project
@@ -2552,7 +3231,7 @@ func (r Routing) addTokenToServiceAccount() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(serviceaccount.CreateTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.serviceAccountProvider, r.privilegedServiceAccountProvider, r.serviceAccountTokenProvider, r.privilegedServiceAccountTokenProvider, r.saTokenAuthenticator, r.saTokenGenerator, r.userInfoGetter)),
+		)(serviceaccount.CreateTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.serviceAccountProvider, r.privilegedServiceAccountProvider, r.serviceAccountTokenProvider, r.privilegedServiceAccountTokenProvider, r.settingsProvider, r.saTokenAuthenticator, r.saTokenGenerator, r.userInfoGetter)),
 		serviceaccount.DecodeAddTokenReq,
 		SetStatusCreatedHeader(EncodeJSON),
 		r.defaultServerOptions()...,
@@ -2603,7 +3282,7 @@ func (r Routing) updateServiceAccountToken() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(serviceaccount.UpdateTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.serviceAccountProvider, r.privilegedServiceAccountProvider, r.serviceAccountTokenProvider, r.privilegedServiceAccountTokenProvider, r.saTokenAuthenticator, r.saTokenGenerator, r.userInfoGetter)),
+		)(serviceaccount.UpdateTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.serviceAccountProvider, r.privilegedServiceAccountProvider, r.serviceAccountTokenProvider, r.privilegedServiceAccountTokenProvider, r.settingsProvider, r.saTokenAuthenticator, r.saTokenGenerator, r.userInfoGetter)),
 		serviceaccount.DecodeUpdateTokenReq,
 		EncodeJSON,
 		r.defaultServerOptions()...,
@@ -2637,6 +3316,30 @@ func (r Routing) patchServiceAccountToken() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}/rotate tokens rotateServiceAccountToken
+//
+//     Rotates the token, the previous token stays valid for the configured rotation overlap period. Expired tokens can be rotated as well.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: ServiceAccountToken
+//       401: empty
+//       403: empty
+func (r Routing) rotateServiceAccountToken() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(serviceaccount.RotateTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.serviceAccountProvider, r.privilegedServiceAccountProvider, r.serviceAccountTokenProvider, r.privilegedServiceAccountTokenProvider, r.settingsProvider, r.saTokenAuthenticator, r.saTokenGenerator, r.userInfoGetter)),
+		serviceaccount.DecodeRotateTokenReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route DELETE /api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id} tokens deleteServiceAccountToken
 //
 //     Deletes the token
@@ -2700,6 +3403,7 @@ func (r Routing) listAWSSubnetsNoCredentials() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(provider.AWSSubnetWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
@@ -3117,6 +3821,102 @@ func (r Routing) listVSphereFoldersNoCredentials() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastores vsphere listVSphereDatastoresNoCredentials
+//
+// Lists datastores from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereDatastore
+func (r Routing) listVSphereDatastoresNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.VsphereDatastoresWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeVSphereNoCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastoreclusters vsphere listVSphereDatastoreClustersNoCredentials
+//
+// Lists datastore clusters from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereDatastoreCluster
+func (r Routing) listVSphereDatastoreClustersNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.VsphereDatastoreClustersWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeVSphereNoCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/resourcepools vsphere listVSphereResourcePoolsNoCredentials
+//
+// Lists resource pools from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereResourcePool
+func (r Routing) listVSphereResourcePoolsNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.VsphereResourcePoolsWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeVSphereNoCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/storagepolicies vsphere listVSphereStoragePoliciesNoCredentials
+//
+// Lists storage policies from vsphere datacenter
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []VSphereStoragePolicy
+func (r Routing) listVSphereStoragePoliciesNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.VsphereStoragePoliciesWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeVSphereNoCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/instancetypes alibaba listAlibabaInstanceTypesNoCredentials
 //
 // Lists available Alibaba Instance Types
@@ -3165,9 +3965,107 @@ func (r Routing) listAlibabaZonesNoCredentials() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vpcs alibaba listAlibabaVPCsNoCredentials
+//
+// Lists available Alibaba VPCs
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: AlibabaVPCList
+func (r Routing) listAlibabaVPCsNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.AlibabaVPCsWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeAlibabaNoCredentialReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vswitches alibaba listAlibabaVSwitchesNoCredentials
+//
+// Lists available Alibaba vSwitches
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: AlibabaVSwitchList
+func (r Routing) listAlibabaVSwitchesNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.AlibabaVSwitchesWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		provider.DecodeAlibabaVSwitchesNoCredentialReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/storageclasses kubevirt listKubevirtStorageClassesNoCredentials
+//
+// Lists storage classes of the KubeVirt infra cluster of the cluster
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: KubevirtStorageClassList
+func (r Routing) listKubevirtStorageClassesNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.KubevirtStorageClassesWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		provider.DecodeKubevirtNoCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/vmpresets kubevirt listKubevirtVMPresetsNoCredentials
+//
+// Lists VM presets of the KubeVirt infra cluster of the cluster
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: KubevirtVMPresetList
+func (r Routing) listKubevirtVMPresetsNoCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(provider.KubevirtVMPresetsWithClusterCredentialsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		provider.DecodeKubevirtNoCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route POST /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments project createNodeDeployment
 //
-//     Creates a node deployment that will belong to the given cluster
+//     Creates a node deployment that will belong to the given cluster. AWS node deployments without
+//     subnet and availability zone are spread across the availability zones of the cluster VPC,
+//     one node deployment per zone. All of them are returned in zoneNodeDeployments.
 //
 //     Consumes:
 //     - application/json
@@ -3185,6 +4083,7 @@ func (r Routing) createNodeDeployment() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "create"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.CreateNodeDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
@@ -3211,6 +4110,7 @@ func (r Routing) listNodeDeployments() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.ListNodeDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3237,6 +4137,7 @@ func (r Routing) getNodeDeployment() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.GetNodeDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3263,6 +4164,7 @@ func (r Routing) listNodeDeploymentNodes() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.ListNodeDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3289,6 +4191,7 @@ func (r Routing) listNodeDeploymentMetrics() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.ListNodeDeploymentMetrics(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3316,6 +4219,7 @@ func (r Routing) listNodeDeploymentNodesEvents() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.ListNodeDeploymentNodesEvents(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3346,6 +4250,7 @@ func (r Routing) patchNodeDeployment() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.PatchNodeDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
@@ -3372,6 +4277,7 @@ func (r Routing) deleteNodeDeployment() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "delete"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(node.DeleteNodeDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3599,6 +4505,7 @@ func (r Routing) getClusterMetrics() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.GetMetricsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -3964,6 +4871,7 @@ func (r Routing) listNamespace() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListNamespaceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4043,6 +4951,7 @@ func (r Routing) bindUserToRole() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.BindUserToRoleEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4072,6 +4981,7 @@ func (r Routing) unbindUserFromRoleBinding() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UnbindUserFromRoleBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4099,6 +5009,7 @@ func (r Routing) listRoleBinding() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListRoleBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4128,6 +5039,7 @@ func (r Routing) bindUserToClusterRole() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.BindUserToClusterRoleEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4157,6 +5069,7 @@ func (r Routing) unbindUserFromClusterRoleBinding() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UnbindUserFromClusterRoleBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4184,6 +5097,7 @@ func (r Routing) listClusterRoleBinding() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListClusterRoleBindingEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -4285,3 +5199,26 @@ func (r Routing) getAdmissionPlugins() http.Handler {
 		r.defaultServerOptions()...,
 	)
 }
+
+// swagger:route GET /api/v1/projectroles project listProjectRoles
+//
+//     Lists the custom project roles that can be assigned to the members of a project.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []ProjectRole
+//       401: empty
+func (r Routing) listProjectRoles() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ListProjectRolesEndpoint(r.userInfoGetter, r.projectRoleProvider)),
+		common.DecodeEmptyReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
@@ -23,6 +23,7 @@ import (
 	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
 	"k8c.io/kubermatic/v2/pkg/handler/middleware"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/admin"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
@@ -40,6 +41,14 @@ func (r Routing) RegisterV1Admin(mux *mux.Router) {
 		Path("/admin").
 		Handler(r.setAdmin())
 
+	mux.Methods(http.MethodPut).
+		Path("/admin/users/suspension").
+		Handler(r.setUserSuspension())
+
+	mux.Methods(http.MethodPost).
+		Path("/admin/users/offboarding").
+		Handler(r.offboardUser())
+
 	mux.Methods(http.MethodGet).
 		Path("/admin/settings").
 		Handler(r.getKubermaticSettings())
@@ -69,6 +78,23 @@ func (r Routing) RegisterV1Admin(mux *mux.Router) {
 		Path("/admin/admission/plugins/{name}").
 		Handler(r.updateAdmissionPlugin())
 
+	// Defines a set of HTTP endpoints for the custom project roles
+	mux.Methods(http.MethodPost).
+		Path("/admin/projectroles").
+		Handler(r.createProjectRole())
+
+	mux.Methods(http.MethodGet).
+		Path("/admin/projectroles/{name}").
+		Handler(r.getProjectRole())
+
+	mux.Methods(http.MethodPatch).
+		Path("/admin/projectroles/{name}").
+		Handler(r.updateProjectRole())
+
+	mux.Methods(http.MethodDelete).
+		Path("/admin/projectroles/{name}").
+		Handler(r.deleteProjectRole())
+
 	// Defines a set of HTTP endpoints for the seeds
 	mux.Methods(http.MethodGet).
 		Path("/admin/seeds").
@@ -85,6 +111,33 @@ func (r Routing) RegisterV1Admin(mux *mux.Router) {
 	mux.Methods(http.MethodDelete).
 		Path("/admin/seeds/{seed_name}").
 		Handler(r.deleteSeed())
+
+	// Defines a set of HTTP endpoints for the price lists and the usage reports
+	mux.Methods(http.MethodGet).
+		Path("/admin/pricelists").
+		Handler(r.listPriceLists())
+
+	mux.Methods(http.MethodPut).
+		Path("/admin/pricelists/{datacenter}").
+		Handler(r.updatePriceList())
+
+	mux.Methods(http.MethodDelete).
+		Path("/admin/pricelists/{datacenter}").
+		Handler(r.deletePriceList())
+
+	mux.Methods(http.MethodGet).
+		Path("/admin/usagereports").
+		Handler(r.listAllUsageReports())
+
+	// Defines an endpoint for the resources of deleted clusters which are left at the cloud providers
+	mux.Methods(http.MethodGet).
+		Path("/admin/orphanedcloudresources").
+		Handler(r.listOrphanedCloudResources())
+
+	// Defines an endpoint to replace the cloud credentials of a preset and of the clusters using them
+	mux.Methods(http.MethodPost).
+		Path("/admin/credentialrotations").
+		Handler(r.rotateCredentials())
 }
 
 // swagger:route GET /api/v1/admin/settings admin getKubermaticSettings
@@ -208,6 +261,62 @@ func (r Routing) setAdmin() http.Handler {
 	)
 }
 
+// swagger:route PUT /api/v1/admin/users/suspension admin setUserSuspension
+//
+//     Suspends or reactivates the given user. Suspending a user rejects their tokens,
+//     revokes the tokens of the service accounts they created and removes their SSH keys from the clusters.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: UserSuspension
+//       401: empty
+//       403: empty
+func (r Routing) setUserSuspension() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.SetUserSuspensionEndpoint(r.userInfoGetter, r.adminProvider)),
+		admin.DecodeSetUserSuspensionReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v1/admin/users/offboarding admin offboardUser
+//
+//     Suspends the given user, transfers the projects they exclusively own to the given admin
+//     and removes them from all projects.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: UserOffboarding
+//       401: empty
+//       403: empty
+func (r Routing) offboardUser() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.OffboardUserEndpoint(r.userInfoGetter, r.adminProvider)),
+		admin.DecodeOffboardUserReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/admin/admission/plugins admin listAdmissionPlugins
 //
 //     Returns all admission plugins from the CRDs.
@@ -307,6 +416,108 @@ func (r Routing) updateAdmissionPlugin() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v1/admin/projectroles admin createProjectRole
+//
+//     Creates a custom project role.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       201: ProjectRole
+//       401: empty
+//       403: empty
+func (r Routing) createProjectRole() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.CreateProjectRoleEndpoint(r.userInfoGetter, r.projectRoleProvider)),
+		admin.DecodeCreateProjectRoleReq,
+		SetStatusCreatedHeader(EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/admin/projectroles/{name} admin getProjectRole
+//
+//     Gets the custom project role.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: ProjectRole
+//       401: empty
+//       403: empty
+func (r Routing) getProjectRole() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.GetProjectRoleEndpoint(r.userInfoGetter, r.projectRoleProvider)),
+		admin.DecodeProjectRoleReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v1/admin/projectroles/{name} admin updateProjectRole
+//
+//     Updates the custom project role.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: ProjectRole
+//       401: empty
+//       403: empty
+func (r Routing) updateProjectRole() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.UpdateProjectRoleEndpoint(r.userInfoGetter, r.projectRoleProvider)),
+		admin.DecodeUpdateProjectRoleReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/admin/projectroles/{name} admin deleteProjectRole
+//
+//     Deletes the custom project role.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) deleteProjectRole() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.DeleteProjectRoleEndpoint(r.userInfoGetter, r.projectRoleProvider)),
+		admin.DecodeProjectRoleReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v1/admin/seeds admin listSeeds
 //
 //     Returns all seeds from the CRDs.
@@ -405,3 +616,154 @@ func (r Routing) deleteSeed() http.Handler {
 		r.defaultServerOptions()...,
 	)
 }
+
+// swagger:route GET /api/v1/admin/pricelists admin listPriceLists
+//
+//     Returns the price lists of all datacenters.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []PriceList
+//       401: empty
+//       403: empty
+func (r Routing) listPriceLists() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ListPriceListsEndpoint(r.userInfoGetter, r.priceListProvider)),
+		common.DecodeEmptyReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v1/admin/pricelists/{datacenter} admin updatePriceList
+//
+//     Creates or replaces the price list of the datacenter.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: PriceList
+//       401: empty
+//       403: empty
+func (r Routing) updatePriceList() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.UpdatePriceListEndpoint(r.userInfoGetter, r.seedsGetter, r.priceListProvider)),
+		admin.DecodeUpdatePriceListReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v1/admin/pricelists/{datacenter} admin deletePriceList
+//
+//     Deletes the price list of the datacenter.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: empty
+//       401: empty
+//       403: empty
+func (r Routing) deletePriceList() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.DeletePriceListEndpoint(r.userInfoGetter, r.priceListProvider)),
+		admin.DecodePriceListReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/admin/usagereports admin listAllUsageReports
+//
+//     Returns the monthly usage reports of all projects, the CSV format is meant for chargeback.
+//
+//     Produces:
+//     - application/json
+//     - text/csv
+//
+//     Responses:
+//       default: errorResponse
+//       200: []UsageReport
+//       401: empty
+//       403: empty
+func (r Routing) listAllUsageReports() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ListUsageReportsEndpoint(r.userInfoGetter, r.usageReportProvider)),
+		admin.DecodeListUsageReportsReq,
+		handlercommon.EncodeUsageReports,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v1/admin/orphanedcloudresources admin listOrphanedCloudResources
+//
+//     Returns the resources at the cloud providers whose clusters do not exist anymore.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []OrphanedCloudResource
+//       401: empty
+//       403: empty
+func (r Routing) listOrphanedCloudResources() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.ListOrphanedCloudResourcesEndpoint(r.userInfoGetter, r.orphanedCloudResourceProvider)),
+		common.DecodeEmptyReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v1/admin/credentialrotations admin rotateCredentials
+//
+//     Replaces the cloud credentials of a preset or of clusters and updates the clusters using them.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: []CredentialRotationResult
+//       401: empty
+//       403: empty
+func (r Routing) rotateCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(admin.RotateCredentialsEndpoint(r.userInfoGetter, r.credentialRotationProvider)),
+		admin.DecodeRotateCredentialsReq,
+		EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
@@ -21,19 +21,33 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"time"
 
 	v1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/auth"
+	"k8c.io/kubermatic/v2/pkg/handler/middleware"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/cluster"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/node"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/operation"
+	webterminal "k8c.io/kubermatic/v2/pkg/handler/v1/web-terminal"
 	wsh "k8c.io/kubermatic/v2/pkg/handler/websocket"
 	"k8c.io/kubermatic/v2/pkg/log"
 	"k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/util/hash"
 	"k8c.io/kubermatic/v2/pkg/watcher"
 
+	"github.com/go-kit/kit/endpoint"
+	httptransport "github.com/go-kit/kit/transport/http"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 )
 
+// clusterResourceResyncPeriod is the interval in which resources that are not part
+// of the cluster object, like node deployments and events, are refreshed.
+const clusterResourceResyncPeriod = 10 * time.Second
+
 var upgrader = websocket.Upgrader{
 	ReadBufferSize:  1024,
 	WriteBufferSize: 1024,
@@ -65,14 +79,88 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-type WebsocketSettingsWriter func(providers watcher.Providers, ws *websocket.Conn)
+type WebsocketSettingsWriter func(ctx context.Context, providers watcher.Providers, ws *websocket.Conn)
 type WebsocketUserWriter func(providers watcher.Providers, ws *websocket.Conn, userEmail string)
+type WebsocketClustersWriter func(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter wsh.ClusterResourceGetter, projectID string)
+type WebsocketOperationWriter func(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter wsh.ClusterResourceGetter, projectID string)
+type WebsocketClusterResourceWriter func(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter wsh.ClusterResourceGetter, projectID, clusterID string, resyncPeriod time.Duration)
 
 func (r Routing) RegisterV1Websocket(mux *mux.Router) {
 	providers := getProviders(r)
 
 	mux.HandleFunc("/ws/admin/settings", getSettingsWatchHandler(wsh.WriteSettings, providers, r))
 	mux.HandleFunc("/ws/me", getUserWatchHandler(wsh.WriteUser, providers, r))
+	mux.HandleFunc("/ws/projects/{project_id}/clusters", getClustersWatchHandler(wsh.WriteClusters, providers, r, r.clustersEndpoint(), common.DecodeGetProject))
+	mux.HandleFunc("/ws/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/health", getClusterResourceWatchHandler(wsh.WriteClusterResource, providers, r, r.clusterHealthEndpoint(), common.DecodeGetClusterReq, 0))
+	mux.HandleFunc("/ws/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments", getClusterResourceWatchHandler(wsh.WriteClusterResource, providers, r, r.nodeDeploymentsEndpoint(), node.DecodeListNodeDeployments, clusterResourceResyncPeriod))
+	mux.HandleFunc("/ws/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/events", getClusterResourceWatchHandler(wsh.WriteClusterResource, providers, r, r.clusterEventsEndpoint(), cluster.DecodeGetClusterEvents, clusterResourceResyncPeriod))
+	mux.HandleFunc("/ws/projects/{project_id}/operations/{operation_id}", getOperationWatchHandler(wsh.WriteOperation, providers, r, r.operationEndpoint()))
+	mux.Handle("/ws/projects/{project_id}/clusters/{cluster_id}/terminal", r.webTerminal())
+}
+
+// webTerminal opens an interactive shell with kubectl and helm for the given cluster
+func (r Routing) webTerminal() http.Handler {
+	return webterminal.TerminalEndpoint(
+		r.log,
+		middleware.TokenExtractor(r.tokenExtractors),
+		r.projectProvider,
+		r.privilegedProjectProvider,
+		r.userInfoGetter,
+		r.settingsProvider,
+		upgrader,
+		r.webTerminalImage,
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		),
+	)
+}
+
+// The endpoints below are used by the cluster streams to get the data for every single message.
+// They use the same middlewares as their REST equivalents, this way the token and the
+// user's access to the project and the cluster are checked again for each message.
+
+func (r Routing) clustersEndpoint() endpoint.Endpoint {
+	return endpoint.Chain(
+		middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+		middleware.UserSaver(r.userProvider),
+	)(cluster.ListAllEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.clusterProviderGetter, r.userInfoGetter))
+}
+
+func (r Routing) clusterHealthEndpoint() endpoint.Endpoint {
+	return endpoint.Chain(
+		middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+		middleware.UserSaver(r.userProvider),
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+	)(cluster.HealthStatusEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))
+}
+
+func (r Routing) nodeDeploymentsEndpoint() endpoint.Endpoint {
+	return endpoint.Chain(
+		middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+		middleware.UserSaver(r.userProvider),
+		middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "get"),
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+	)(node.ListNodeDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))
+}
+
+func (r Routing) clusterEventsEndpoint() endpoint.Endpoint {
+	return endpoint.Chain(
+		middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+		middleware.UserSaver(r.userProvider),
+		middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+	)(cluster.GetClusterEventsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter))
+}
+
+func (r Routing) operationEndpoint() endpoint.Endpoint {
+	return endpoint.Chain(
+		middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+		middleware.UserSaver(r.userProvider),
+	)(operation.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.operationProvider, r.userInfoGetter))
 }
 
 func getProviders(r Routing) watcher.Providers {
@@ -82,6 +170,7 @@ func getProviders(r Routing) watcher.Providers {
 		UserProvider:     r.userProvider,
 		UserWatcher:      r.userWatcher,
 		MemberMapper:     r.userProjectMapper,
+		ClusterWatcher:   r.clusterWatcher,
 	}
 }
 
@@ -99,7 +188,10 @@ func getSettingsWatchHandler(writer WebsocketSettingsWriter, providers watcher.P
 			return
 		}
 
-		go writer(providers, ws)
+		// The reader returns once the connection is closed, also if the client disconnected without a close message
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go writer(ctx, providers, ws)
 		requestLoggingReader(ws)
 	}
 }
@@ -123,6 +215,93 @@ func getUserWatchHandler(writer WebsocketUserWriter, providers watcher.Providers
 	}
 }
 
+func getClustersWatchHandler(writer WebsocketClustersWriter, providers watcher.Providers, routing Routing, e endpoint.Endpoint, decoder httptransport.DecodeRequestFunc) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		getter, err := newClusterResourceGetter(req, routing, e, decoder)
+		if err != nil {
+			log.Logger.Debug(err)
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		// The reader returns once the connection is closed, also if the client disconnected without a close message
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go writer(ctx, providers, ws, getter, mux.Vars(req)["project_id"])
+		requestLoggingReader(ws)
+	}
+}
+
+func getOperationWatchHandler(writer WebsocketOperationWriter, providers watcher.Providers, routing Routing, e endpoint.Endpoint) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		getter, err := newClusterResourceGetter(req, routing, e, operation.DecodeGetReq)
+		if err != nil {
+			log.Logger.Debug(err)
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		// The reader returns once the connection is closed, also if the client disconnected without a close message
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go writer(ctx, providers, ws, getter, mux.Vars(req)["project_id"])
+		requestLoggingReader(ws)
+	}
+}
+
+func getClusterResourceWatchHandler(writer WebsocketClusterResourceWriter, providers watcher.Providers, routing Routing, e endpoint.Endpoint, decoder httptransport.DecodeRequestFunc, resyncPeriod time.Duration) func(w http.ResponseWriter, req *http.Request) {
+	return func(w http.ResponseWriter, req *http.Request) {
+		getter, err := newClusterResourceGetter(req, routing, e, decoder)
+		if err != nil {
+			log.Logger.Debug(err)
+			ErrorEncoder(req.Context(), err, w)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, req, nil)
+		if err != nil {
+			log.Logger.Debug(err)
+			return
+		}
+
+		// The reader returns once the connection is closed, also if the client disconnected without a close message
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go writer(ctx, providers, ws, getter, mux.Vars(req)["project_id"], mux.Vars(req)["cluster_id"], resyncPeriod)
+		requestLoggingReader(ws)
+	}
+}
+
+// newClusterResourceGetter decodes the request and binds it together with the token to the given endpoint.
+// The endpoint is called once to reject unauthorized subscriptions before the connection is upgraded.
+func newClusterResourceGetter(req *http.Request, routing Routing, e endpoint.Endpoint, decoder httptransport.DecodeRequestFunc) (wsh.ClusterResourceGetter, error) {
+	ctx := middleware.TokenExtractor(routing.tokenExtractors)(context.Background(), req)
+	request, err := decoder(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	getter := func() (interface{}, error) {
+		return e(ctx, request)
+	}
+	if _, err := getter(); err != nil {
+		return nil, err
+	}
+	return getter, nil
+}
+
 func verifyAuthorizationToken(req *http.Request, tokenVerifier auth.TokenVerifier, tokenExtractor auth.TokenExtractor) (*v1.User, error) {
 	token, err := tokenExtractor.Extract(req)
 	if err != nil {
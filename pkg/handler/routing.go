@@ -67,12 +67,25 @@ type Routing struct {
 	eventRecorderProvider                 provider.EventRecorderProvider
 	exposeStrategy                        corev1.ServiceType
 	accessibleAddons                      sets.String
+	webTerminalImage                      string
 	userInfoGetter                        provider.UserInfoGetter
 	settingsProvider                      provider.SettingsProvider
 	adminProvider                         provider.AdminProvider
 	admissionPluginProvider               provider.AdmissionPluginsProvider
+	projectRoleProvider                   provider.ProjectRoleProvider
+	groupProjectBindingProvider           provider.GroupProjectBindingProvider
+	privilegedGroupProjectBindingProvider provider.PrivilegedGroupProjectBindingProvider
+	projectInvitationProvider             provider.ProjectInvitationProvider
+	privilegedProjectInvitationProvider   provider.PrivilegedProjectInvitationProvider
+	invitationNotifier                    provider.InvitationNotifier
+	priceListProvider                     provider.PriceListProvider
+	usageReportProvider                   provider.UsageReportProvider
+	operationProvider                     provider.OperationProvider
+	orphanedCloudResourceProvider         provider.OrphanedCloudResourceProvider
+	credentialRotationProvider            provider.CredentialRotationProvider
 	settingsWatcher                       watcher.SettingsWatcher
 	userWatcher                           watcher.UserWatcher
+	clusterWatcher                        watcher.ClusterWatcher
 }
 
 // NewRouting creates a new Routing.
@@ -108,12 +121,25 @@ func NewRouting(routingParams RoutingParams) Routing {
 		eventRecorderProvider:                 routingParams.EventRecorderProvider,
 		exposeStrategy:                        routingParams.ExposeStrategy,
 		accessibleAddons:                      routingParams.AccessibleAddons,
+		webTerminalImage:                      routingParams.WebTerminalImage,
 		userInfoGetter:                        routingParams.UserInfoGetter,
 		settingsProvider:                      routingParams.SettingsProvider,
 		adminProvider:                         routingParams.AdminProvider,
 		admissionPluginProvider:               routingParams.AdmissionPluginProvider,
+		projectRoleProvider:                   routingParams.ProjectRoleProvider,
+		groupProjectBindingProvider:           routingParams.GroupProjectBindingProvider,
+		privilegedGroupProjectBindingProvider: routingParams.PrivilegedGroupProjectBindingProvider,
+		projectInvitationProvider:             routingParams.ProjectInvitationProvider,
+		privilegedProjectInvitationProvider:   routingParams.PrivilegedProjectInvitationProvider,
+		invitationNotifier:                    routingParams.InvitationNotifier,
+		priceListProvider:                     routingParams.PriceListProvider,
+		usageReportProvider:                   routingParams.UsageReportProvider,
+		operationProvider:                     routingParams.OperationProvider,
+		orphanedCloudResourceProvider:         routingParams.OrphanedCloudResourceProvider,
+		credentialRotationProvider:            routingParams.CredentialRotationProvider,
 		settingsWatcher:                       routingParams.SettingsWatcher,
 		userWatcher:                           routingParams.UserWatcher,
+		clusterWatcher:                        routingParams.ClusterWatcher,
 	}
 }
 
@@ -155,13 +181,26 @@ type RoutingParams struct {
 	EventRecorderProvider                 provider.EventRecorderProvider
 	ExposeStrategy                        corev1.ServiceType
 	AccessibleAddons                      sets.String
+	WebTerminalImage                      string
 	UserInfoGetter                        provider.UserInfoGetter
 	SettingsProvider                      provider.SettingsProvider
 	AdminProvider                         provider.AdminProvider
 	AdmissionPluginProvider               provider.AdmissionPluginsProvider
 	SettingsWatcher                       watcher.SettingsWatcher
 	UserWatcher                           watcher.UserWatcher
+	ClusterWatcher                        watcher.ClusterWatcher
 	ExternalClusterProvider               provider.ExternalClusterProvider
 	PrivilegedExternalClusterProvider     provider.PrivilegedExternalClusterProvider
 	ConstraintTemplateProvider            provider.ConstraintTemplateProvider
+	ProjectRoleProvider                   provider.ProjectRoleProvider
+	GroupProjectBindingProvider           provider.GroupProjectBindingProvider
+	PrivilegedGroupProjectBindingProvider provider.PrivilegedGroupProjectBindingProvider
+	ProjectInvitationProvider             provider.ProjectInvitationProvider
+	PrivilegedProjectInvitationProvider   provider.PrivilegedProjectInvitationProvider
+	InvitationNotifier                    provider.InvitationNotifier
+	PriceListProvider                     provider.PriceListProvider
+	UsageReportProvider                   provider.UsageReportProvider
+	OperationProvider                     provider.OperationProvider
+	OrphanedCloudResourceProvider         provider.OrphanedCloudResourceProvider
+	CredentialRotationProvider            provider.CredentialRotationProvider
 }
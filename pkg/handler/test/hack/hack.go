@@ -75,9 +75,18 @@ func NewTestRouting(
 	admissionPluginProvider provider.AdmissionPluginsProvider,
 	settingsWatcher watcher.SettingsWatcher,
 	userWatcher watcher.UserWatcher,
+	clusterWatcher watcher.ClusterWatcher,
 	externalClusterProvider provider.ExternalClusterProvider,
 	privilegedExternalClusterProvider provider.PrivilegedExternalClusterProvider,
-	constraintTemplateProvider provider.ConstraintTemplateProvider) http.Handler {
+	constraintTemplateProvider provider.ConstraintTemplateProvider,
+	projectRoleProvider provider.ProjectRoleProvider,
+	groupProjectBindingProvider *kubernetes.GroupProjectBindingProvider,
+	projectInvitationProvider *kubernetes.ProjectInvitationProvider,
+	priceListProvider provider.PriceListProvider,
+	usageReportProvider provider.UsageReportProvider,
+	operationProvider provider.OperationProvider,
+	orphanedCloudResourceProvider provider.OrphanedCloudResourceProvider,
+	credentialRotationProvider provider.CredentialRotationProvider) http.Handler {
 
 	updateManager := version.New(versions, updates)
 
@@ -117,9 +126,20 @@ func NewTestRouting(
 		AdmissionPluginProvider:               admissionPluginProvider,
 		SettingsWatcher:                       settingsWatcher,
 		UserWatcher:                           userWatcher,
+		ClusterWatcher:                        clusterWatcher,
 		ExternalClusterProvider:               externalClusterProvider,
 		PrivilegedExternalClusterProvider:     privilegedExternalClusterProvider,
 		ConstraintTemplateProvider:            constraintTemplateProvider,
+		ProjectRoleProvider:                   projectRoleProvider,
+		GroupProjectBindingProvider:           groupProjectBindingProvider,
+		PrivilegedGroupProjectBindingProvider: groupProjectBindingProvider,
+		ProjectInvitationProvider:             projectInvitationProvider,
+		PrivilegedProjectInvitationProvider:   projectInvitationProvider,
+		PriceListProvider:                     priceListProvider,
+		UsageReportProvider:                   usageReportProvider,
+		OperationProvider:                     operationProvider,
+		OrphanedCloudResourceProvider:         orphanedCloudResourceProvider,
+		CredentialRotationProvider:            credentialRotationProvider,
 	}
 
 	r := handler.NewRouting(routingParams)
@@ -170,9 +170,18 @@ type newRoutingFunc func(
 	admissionPluginProvider provider.AdmissionPluginsProvider,
 	settingsWatcher watcher.SettingsWatcher,
 	userWatcher watcher.UserWatcher,
+	clusterWatcher watcher.ClusterWatcher,
 	externalClusterProvider provider.ExternalClusterProvider,
 	privilegedExternalClusterProvider provider.PrivilegedExternalClusterProvider,
 	constraintTemplateProvider provider.ConstraintTemplateProvider,
+	projectRoleProvider provider.ProjectRoleProvider,
+	groupProjectBindingProvider *kubernetes.GroupProjectBindingProvider,
+	projectInvitationProvider *kubernetes.ProjectInvitationProvider,
+	priceListProvider provider.PriceListProvider,
+	usageReportProvider provider.UsageReportProvider,
+	operationProvider provider.OperationProvider,
+	orphanedCloudResourceProvider provider.OrphanedCloudResourceProvider,
+	credentialRotationProvider provider.CredentialRotationProvider,
 ) http.Handler
 
 func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObjects, machineObjects, kubermaticObjects []runtime.Object, versions []*version.Version, updates []*version.Update, routingFunc newRoutingFunc) (http.Handler, *ClientsSets, error) {
@@ -309,6 +318,15 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		FakeClient: fakeClient,
 	}
 
+	projectRoleProvider := kubernetes.NewProjectRoleProvider(context.Background(), fakeClient)
+	groupProjectBindingProvider := kubernetes.NewGroupProjectBindingProvider(fakeImpersonationClient, fakeClient)
+	projectInvitationProvider := kubernetes.NewProjectInvitationProvider(fakeImpersonationClient, fakeClient)
+	priceListProvider := kubernetes.NewPriceListProvider(context.Background(), fakeClient)
+	usageReportProvider := kubernetes.NewUsageReportProvider(context.Background(), fakeClient)
+	operationProvider := kubernetes.NewOperationProvider(context.Background(), fakeClient, fakeClient)
+	orphanedCloudResourceProvider := kubernetes.NewOrphanedCloudResourceProvider(context.Background(), fakeClient)
+	credentialRotationProvider := kubernetes.NewCredentialRotationProvider(context.Background(), fakeClient, seedsGetter, seedClientGetter)
+
 	eventRecorderProvider := kubernetes.NewEventRecorder()
 
 	settingsWatcher, err := kuberneteswatcher.NewSettingsWatcher(settingsProvider)
@@ -321,6 +339,8 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		return nil, nil, err
 	}
 
+	clusterWatcher := kuberneteswatcher.NewClusterWatcher(seedsGetter, clusterProviderGetter)
+
 	// Disable the metrics endpoint in tests
 	var prometheusClient prometheusapi.Client
 
@@ -358,9 +378,18 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		admissionPluginProvider,
 		settingsWatcher,
 		userWatcher,
+		clusterWatcher,
 		fakeExternalClusterProvider,
 		externalClusterProvider,
 		fakeConstraintTemplateProvider,
+		projectRoleProvider,
+		groupProjectBindingProvider,
+		projectInvitationProvider,
+		priceListProvider,
+		usageReportProvider,
+		operationProvider,
+		orphanedCloudResourceProvider,
+		credentialRotationProvider,
 	)
 
 	return mainRouter, &ClientsSets{kubermaticClient, fakeClient, kubernetesClient, tokenAuth, tokenGenerator}, nil
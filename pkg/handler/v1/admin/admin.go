@@ -105,3 +105,96 @@ func DecodeSetAdminReq(c context.Context, r *http.Request) (interface{}, error)
 
 	return req, nil
 }
+
+// SetUserSuspensionEndpoint suspends or reactivates the given user
+func SetUserSuspensionEndpoint(userInfoGetter provider.UserInfoGetter, adminProvider provider.AdminProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(setUserSuspensionReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if len(req.Body.Email) == 0 {
+			return nil, k8cerrors.NewBadRequest("the email address cannot be empty")
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		user, err := adminProvider.SuspendUser(userInfo, req.Body.Email, req.Body.Suspended)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return apiv1.UserSuspension{
+			Email:     user.Spec.Email,
+			Suspended: user.Spec.Suspended,
+		}, nil
+	}
+}
+
+// OffboardUserEndpoint suspends the given user and transfers the projects the user exclusively owns to the given admin
+func OffboardUserEndpoint(userInfoGetter provider.UserInfoGetter, adminProvider provider.AdminProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(offboardUserReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if len(req.Body.Email) == 0 || len(req.Body.NewOwner) == 0 {
+			return nil, k8cerrors.NewBadRequest("both the email address of the user and of the new owner are required")
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		offboarding, err := adminProvider.OffboardUser(userInfo, req.Body.Email, req.Body.NewOwner)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return apiv1.UserOffboarding{
+			Email:               offboarding.User.Spec.Email,
+			NewOwner:            req.Body.NewOwner,
+			TransferredProjects: offboarding.TransferredProjects,
+			RemovedBindings:     offboarding.RemovedBindings,
+			RevokedTokens:       offboarding.RevokedTokens,
+		}, nil
+	}
+}
+
+// setUserSuspensionReq defines HTTP request for setUserSuspension
+// swagger:parameters setUserSuspension
+type setUserSuspensionReq struct {
+	// in: body
+	Body apiv1.UserSuspension
+}
+
+// DecodeSetUserSuspensionReq decodes an HTTP request into setUserSuspensionReq
+func DecodeSetUserSuspensionReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req setUserSuspensionReq
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, k8cerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
+
+// offboardUserReq defines HTTP request for offboardUser
+// swagger:parameters offboardUser
+type offboardUserReq struct {
+	// in: body
+	Body apiv1.UserOffboarding
+}
+
+// DecodeOffboardUserReq decodes an HTTP request into offboardUserReq
+func DecodeOffboardUserReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req offboardUserReq
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, k8cerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// RotateCredentialsEndpoint replaces the cloud credentials of a preset or of clusters and
+// returns the clusters whose credentials were rotated
+func RotateCredentialsEndpoint(userInfoGetter provider.UserInfoGetter, credentialRotationProvider provider.CredentialRotationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(rotateCredentialsReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		results, err := credentialRotationProvider.Rotate(userInfo, &provider.CredentialRotation{
+			ProviderName:   req.Body.Provider,
+			PresetName:     req.Body.Preset,
+			Credentials:    req.Body.Credentials,
+			NewCredentials: req.Body.NewCredentials,
+		})
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		resultList := []apiv1.CredentialRotationResult{}
+		for _, result := range results {
+			resultList = append(resultList, convertCredentialRotationResult(result))
+		}
+		return resultList, nil
+	}
+}
+
+// rotateCredentialsReq defines HTTP request for rotateCredentials
+// swagger:parameters rotateCredentials
+type rotateCredentialsReq struct {
+	// in: body
+	Body apiv1.CredentialRotation
+}
+
+func DecodeRotateCredentialsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req rotateCredentialsReq
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func convertCredentialRotationResult(result provider.CredentialRotationResult) apiv1.CredentialRotationResult {
+	apiResult := apiv1.CredentialRotationResult{
+		Seed:        result.SeedName,
+		ClusterID:   result.ClusterName,
+		ClusterName: result.HumanReadableName,
+		ProjectID:   result.ProjectID,
+	}
+	if result.Error != nil {
+		apiResult.Error = result.Error.Error()
+	}
+	return apiResult
+}
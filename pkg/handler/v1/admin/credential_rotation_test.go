@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestRotateCredentialsEndpoint(t *testing.T) {
+	t.Parallel()
+	preset := &kubermaticv1.Preset{
+		ObjectMeta: v1.ObjectMeta{Name: "team-a"},
+		Spec: kubermaticv1.PresetSpec{
+			AWS: &kubermaticv1.AWS{AccessKeyID: "old", SecretAccessKey: "secret-old"},
+		},
+	}
+	cluster := test.GenCluster("abc", "my-cluster", test.GenDefaultProject().Name, time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC), func(cluster *kubermaticv1.Cluster) {
+		cluster.Spec.Cloud = kubermaticv1.CloudSpec{DatacenterName: "regular-do1", AWS: &kubermaticv1.AWSCloudSpec{}}
+	})
+	secret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{Name: cluster.GetSecretName(), Namespace: resources.KubermaticNamespace},
+		Data: map[string][]byte{
+			resources.AWSAccessKeyID:     []byte("old"),
+			resources.AWSSecretAccessKey: []byte("secret-old"),
+		},
+	}
+	testcases := []struct {
+		name                   string
+		body                   string
+		expectedResponse       string
+		httpStatus             int
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:                   "scenario 1: not authorized user can not rotate credentials",
+			body:                   `{"provider":"aws","preset":"team-a","newCredentials":{"accessKeyId":"new","secretAccessKey":"secret-new"}}`,
+			expectedResponse:       `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			httpStatus:             http.StatusForbidden,
+			existingKubermaticObjs: []runtime.Object{preset, cluster},
+		},
+		{
+			name:                   "scenario 2: admin can not rotate credentials of an unknown provider",
+			body:                   `{"provider":"cloud","preset":"team-a","newCredentials":{"token":"new"}}`,
+			expectedResponse:       `{"error":{"code":400,"message":"the credentials of provider \"cloud\" can not be rotated"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), preset, cluster},
+		},
+		{
+			name:                   "scenario 3: admin rotates the credentials of a preset and its clusters",
+			body:                   `{"provider":"aws","preset":"team-a","newCredentials":{"accessKeyId":"new","secretAccessKey":"secret-new"}}`,
+			expectedResponse:       `[{"seed":"us-central1","clusterID":"abc","clusterName":"my-cluster","projectID":"my-first-project-ID"}]`,
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), preset, cluster},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1/admin/credentialrotations", strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []runtime.Object{secret.DeepCopy()}, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
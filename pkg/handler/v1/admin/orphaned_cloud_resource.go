@@ -0,0 +1,64 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+// ListOrphanedCloudResourcesEndpoint returns the resources at the cloud providers whose clusters do not exist anymore
+func ListOrphanedCloudResourcesEndpoint(userInfoGetter provider.UserInfoGetter, orphanedCloudResourceProvider provider.OrphanedCloudResourceProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		resources, err := orphanedCloudResourceProvider.List(userInfo)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		resultList := []apiv1.OrphanedCloudResource{}
+		for _, resource := range resources {
+			resultList = append(resultList, convertOrphanedCloudResource(resource))
+		}
+		return resultList, nil
+	}
+}
+
+func convertOrphanedCloudResource(resource kubermaticv1.OrphanedCloudResource) apiv1.OrphanedCloudResource {
+	return apiv1.OrphanedCloudResource{
+		Name:          resource.Name,
+		Provider:      resource.Spec.Provider,
+		Preset:        resource.Spec.Preset,
+		Datacenter:    resource.Spec.Datacenter,
+		Kind:          resource.Spec.Kind,
+		ID:            resource.Spec.ID,
+		ResourceName:  resource.Spec.ResourceName,
+		ClusterName:   resource.Spec.ClusterName,
+		FirstSeen:     apiv1.NewTime(resource.Status.FirstSeen.Time),
+		LastSeen:      apiv1.NewTime(resource.Status.LastSeen.Time),
+		DeletionError: resource.Status.DeletionError,
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListOrphanedCloudResourcesEndpoint(t *testing.T) {
+	t.Parallel()
+	seen := v1.NewTime(time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC))
+	resource := &kubermaticv1.OrphanedCloudResource{
+		ObjectMeta: v1.ObjectMeta{Name: "aws-0123456789abcdef"},
+		Spec: kubermaticv1.OrphanedCloudResourceSpec{
+			Provider:     "aws",
+			Preset:       "team-a",
+			Datacenter:   "regular-do1",
+			Kind:         "SecurityGroup",
+			ID:           "sg-123",
+			ResourceName: "kubernetes-abc",
+			ClusterName:  "abc",
+		},
+		Status: kubermaticv1.OrphanedCloudResourceStatus{FirstSeen: seen, LastSeen: seen},
+	}
+	testcases := []struct {
+		name                   string
+		expectedResponse       string
+		httpStatus             int
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:                   "scenario 1: not authorized user can not list orphaned cloud resources",
+			expectedResponse:       `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			httpStatus:             http.StatusForbidden,
+			existingKubermaticObjs: []runtime.Object{resource},
+		},
+		{
+			name:                   "scenario 2: admin lists orphaned cloud resources",
+			expectedResponse:       `[{"name":"aws-0123456789abcdef","provider":"aws","preset":"team-a","datacenter":"regular-do1","kind":"SecurityGroup","id":"sg-123","resourceName":"kubernetes-abc","clusterName":"abc","firstSeen":"2020-10-01T12:00:00Z","lastSeen":"2020-10-01T12:00:00Z"}]`,
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), resource},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/v1/admin/orphanedcloudresources", strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
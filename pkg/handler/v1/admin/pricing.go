@@ -0,0 +1,235 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListPriceListsEndpoint returns the price lists of all datacenters
+func ListPriceListsEndpoint(userInfoGetter provider.UserInfoGetter, priceListProvider provider.PriceListProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		priceLists, err := priceListProvider.List(userInfo)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		resultList := []apiv1.PriceList{}
+		for _, priceList := range priceLists {
+			resultList = append(resultList, convertPriceList(priceList))
+		}
+		return resultList, nil
+	}
+}
+
+// UpdatePriceListEndpoint creates or replaces the price list of a datacenter
+func UpdatePriceListEndpoint(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, priceListProvider provider.PriceListProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updatePriceListReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if err := req.Validate(); err != nil {
+			return nil, k8cerrors.NewBadRequest(err.Error())
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, k8cerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+		if err := datacenterExists(seedsGetter, req.Datacenter); err != nil {
+			return nil, err
+		}
+
+		priceList, err := priceListProvider.CreateOrUpdate(userInfo, &kubermaticv1.PriceList{
+			ObjectMeta: metav1.ObjectMeta{Name: req.Datacenter},
+			Spec:       req.Body.Spec,
+		})
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertPriceList(*priceList), nil
+	}
+}
+
+// DeletePriceListEndpoint deletes the price list of a datacenter
+func DeletePriceListEndpoint(userInfoGetter provider.UserInfoGetter, priceListProvider provider.PriceListProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(priceListReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := priceListProvider.Delete(userInfo, req.Datacenter); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+// ListUsageReportsEndpoint returns the usage reports of all projects
+func ListUsageReportsEndpoint(userInfoGetter provider.UserInfoGetter, usageReportProvider provider.UsageReportProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(listUsageReportsReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if !userInfo.IsAdmin {
+			return nil, k8cerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+		}
+
+		reports, err := usageReportProvider.ListUnsecured("", req.Period)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		return handlercommon.NewUsageReportsResponse(reports, req.Format), nil
+	}
+}
+
+func datacenterExists(seedsGetter provider.SeedsGetter, datacenter string) error {
+	seeds, err := seedsGetter()
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	for _, seed := range seeds {
+		if _, ok := seed.Spec.Datacenters[datacenter]; ok {
+			return nil
+		}
+	}
+	return k8cerrors.NewNotFound("datacenter", datacenter)
+}
+
+// priceListReq defines HTTP request for deletePriceList
+// swagger:parameters deletePriceList
+type priceListReq struct {
+	// in: path
+	// required: true
+	Datacenter string `json:"datacenter"`
+}
+
+// updatePriceListReq defines HTTP request for updatePriceList
+// swagger:parameters updatePriceList
+type updatePriceListReq struct {
+	priceListReq
+	// in: body
+	Body apiv1.PriceList
+}
+
+// Validate validates UpdatePriceListEndpoint request
+func (r updatePriceListReq) Validate() error {
+	if r.Body.Datacenter != "" && r.Body.Datacenter != r.Datacenter {
+		return fmt.Errorf("datacenter mismatch, you requested to update the price list of %s but body contains %s", r.Datacenter, r.Body.Datacenter)
+	}
+	if r.Body.Spec.Currency == "" {
+		return fmt.Errorf("the currency is required")
+	}
+	if r.Body.Spec.ControlPlane < 0 {
+		return fmt.Errorf("the control plane price must not be negative")
+	}
+	for size, price := range r.Body.Spec.Sizes {
+		if price < 0 {
+			return fmt.Errorf("the price of size %s must not be negative", size)
+		}
+	}
+	return nil
+}
+
+// listUsageReportsReq defines HTTP request for listAllUsageReports
+// swagger:parameters listAllUsageReports
+type listUsageReportsReq struct {
+	// Period restricts the reports to a month, for example "2020-10"
+	// in: query
+	Period string `json:"period,omitempty"`
+	// Format of the response, either "json" or "csv", defaults to "json"
+	// in: query
+	Format string `json:"format,omitempty"`
+}
+
+func DecodePriceListReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req priceListReq
+	datacenter := mux.Vars(r)["datacenter"]
+	if datacenter == "" {
+		return nil, fmt.Errorf("'datacenter' parameter is required but was not provided")
+	}
+	req.Datacenter = datacenter
+
+	return req, nil
+}
+
+func DecodeUpdatePriceListReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req updatePriceListReq
+	datacenterReq, err := DecodePriceListReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.priceListReq = datacenterReq.(priceListReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func DecodeListUsageReportsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req listUsageReportsReq
+	var err error
+
+	req.Period, req.Format, err = handlercommon.DecodeUsageReportQuery(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func convertPriceList(priceList kubermaticv1.PriceList) apiv1.PriceList {
+	return apiv1.PriceList{
+		Datacenter: priceList.Name,
+		Spec:       priceList.Spec,
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestUpdatePriceListEndpoint(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name                   string
+		datacenter             string
+		body                   string
+		expectedResponse       string
+		httpStatus             int
+		existingAPIUser        *apiv1.User
+		existingKubermaticObjs []runtime.Object
+		expectedSizes          map[string]float64
+	}{
+		{
+			name:                   "scenario 1: not authorized user can not change price lists",
+			datacenter:             "regular-do1",
+			body:                   `{"spec":{"currency":"EUR","controlPlane":0.25}}`,
+			expectedResponse:       `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			httpStatus:             http.StatusForbidden,
+			existingKubermaticObjs: []runtime.Object{},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 2: admin creates a price list",
+			datacenter:             "regular-do1",
+			body:                   `{"spec":{"currency":"EUR","controlPlane":0.25,"sizes":{"s-2vcpu-4gb":0.5}}}`,
+			expectedResponse:       `{"datacenter":"regular-do1","spec":{"currency":"EUR","controlPlane":0.25,"sizes":{"s-2vcpu-4gb":0.5}}}`,
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+			expectedSizes:          map[string]float64{"s-2vcpu-4gb": 0.5},
+		},
+		{
+			name:             "scenario 3: admin replaces a price list",
+			datacenter:       "regular-do1",
+			body:             `{"spec":{"currency":"USD","sizes":{"s-4vcpu-8gb":1}}}`,
+			expectedResponse: `{"datacenter":"regular-do1","spec":{"currency":"USD","sizes":{"s-4vcpu-8gb":1}}}`,
+			httpStatus:       http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true),
+				&kubermaticv1.PriceList{
+					ObjectMeta: v1.ObjectMeta{Name: "regular-do1"},
+					Spec:       kubermaticv1.PriceListSpec{Currency: "EUR", Sizes: map[string]float64{"s-2vcpu-4gb": 0.5}},
+				}},
+			existingAPIUser: test.GenDefaultAPIUser(),
+			expectedSizes:   map[string]float64{"s-4vcpu-8gb": 1},
+		},
+		{
+			name:                   "scenario 4: the datacenter must exist",
+			datacenter:             "unknown-dc",
+			body:                   `{"spec":{"currency":"EUR"}}`,
+			expectedResponse:       `{"error":{"code":404,"message":"datacenter \"unknown-dc\" not found"}}`,
+			httpStatus:             http.StatusNotFound,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 5: prices must not be negative",
+			datacenter:             "regular-do1",
+			body:                   `{"spec":{"currency":"EUR","sizes":{"s-2vcpu-4gb":-1}}}`,
+			expectedResponse:       `{"error":{"code":400,"message":"the price of size s-2vcpu-4gb must not be negative"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("PUT", "/api/v1/admin/pricelists/"+tc.datacenter, strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, nil, nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.expectedResponse)
+
+			if tc.expectedSizes != nil {
+				priceList := &kubermaticv1.PriceList{}
+				if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: tc.datacenter}, priceList); err != nil {
+					t.Fatalf("failed to get price list: %v", err)
+				}
+				if len(priceList.Spec.Sizes) != len(tc.expectedSizes) {
+					t.Fatalf("expected sizes %v, got %v", tc.expectedSizes, priceList.Spec.Sizes)
+				}
+				for size, price := range tc.expectedSizes {
+					if priceList.Spec.Sizes[size] != price {
+						t.Errorf("expected sizes %v, got %v", tc.expectedSizes, priceList.Spec.Sizes)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestListAllUsageReportsEndpoint(t *testing.T) {
+	t.Parallel()
+	genReport := func(projectID string) *kubermaticv1.UsageReport {
+		return &kubermaticv1.UsageReport{
+			ObjectMeta: v1.ObjectMeta{Name: projectID + "-2020-10"},
+			Spec:       kubermaticv1.UsageReportSpec{ProjectID: projectID, Period: "2020-10"},
+			Status: kubermaticv1.UsageReportStatus{Clusters: []kubermaticv1.ClusterUsage{{
+				ClusterID:         "cluster-" + projectID,
+				ClusterName:       "test",
+				Datacenter:        "regular-do1",
+				ControlPlaneHours: 1,
+			}}},
+		}
+	}
+	testcases := []struct {
+		name                   string
+		expectedResponse       string
+		httpStatus             int
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:                   "scenario 1: not authorized user can not list the reports of all projects",
+			expectedResponse:       `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			httpStatus:             http.StatusForbidden,
+			existingKubermaticObjs: []runtime.Object{genReport("a")},
+		},
+		{
+			name: "scenario 2: admin exports the reports of all projects",
+			expectedResponse: "period,project_id,cluster_id,cluster_name,datacenter,item,hours,cost,currency\n" +
+				"2020-10,a,cluster-a,test,regular-do1,control-plane,1.00,0.00,\n" +
+				"2020-10,b,cluster-b,test,regular-do1,control-plane,1.00,0.00,\n",
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), genReport("b"), genReport("a")},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/v1/admin/usagereports?format=csv", strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			if tc.httpStatus != http.StatusOK {
+				test.CompareWithResult(t, res, tc.expectedResponse)
+				return
+			}
+			if res.Body.String() != tc.expectedResponse {
+				t.Fatalf("Expected CSV\n%s\ngot\n%s", tc.expectedResponse, res.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// projectRoleNameRegexp matches the names that can be used for project roles,
+// they become a prefix of the group name, so a dash is not allowed
+var projectRoleNameRegexp = regexp.MustCompile(`^[a-z0-9]+$`)
+
+// supportedProjectRoleVerbs holds the verbs that can be used in the rules of a project role
+var supportedProjectRoleVerbs = sets.NewString("get", "create", "update", "delete", "*")
+
+// ListProjectRolesEndpoint returns the list of custom project roles
+func ListProjectRolesEndpoint(userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		projectRoles, err := projectRoleProvider.List(userInfo)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		resultList := []apiv1.ProjectRole{}
+		for _, projectRole := range projectRoles {
+			resultList = append(resultList, convertProjectRole(projectRole))
+		}
+		return resultList, nil
+	}
+}
+
+// GetProjectRoleEndpoint returns the custom project role
+func GetProjectRoleEndpoint(userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(projectRoleReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		projectRole, err := projectRoleProvider.Get(userInfo, req.Name)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertProjectRole(*projectRole), nil
+	}
+}
+
+// CreateProjectRoleEndpoint creates the custom project role
+func CreateProjectRoleEndpoint(userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(createProjectRoleReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if err := validateProjectRole(req.Body); err != nil {
+			return nil, k8cerrors.NewBadRequest(err.Error())
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		projectRole, err := projectRoleProvider.Create(userInfo, &kubermaticv1.ProjectRole{
+			ObjectMeta: v1.ObjectMeta{Name: req.Body.Name},
+			Spec: kubermaticv1.ProjectRoleSpec{
+				HumanReadableName: req.Body.HumanReadableName,
+				Rules:             req.Body.Rules,
+			},
+		})
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertProjectRole(*projectRole), nil
+	}
+}
+
+// UpdateProjectRoleEndpoint updates the custom project role
+func UpdateProjectRoleEndpoint(userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updateProjectRoleReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if err := req.Validate(); err != nil {
+			return nil, k8cerrors.NewBadRequest(err.Error())
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		projectRole, err := projectRoleProvider.Update(userInfo, &kubermaticv1.ProjectRole{
+			ObjectMeta: v1.ObjectMeta{Name: req.Name},
+			Spec: kubermaticv1.ProjectRoleSpec{
+				HumanReadableName: req.Body.HumanReadableName,
+				Rules:             req.Body.Rules,
+			},
+		})
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertProjectRole(*projectRole), nil
+	}
+}
+
+// DeleteProjectRoleEndpoint deletes the custom project role
+func DeleteProjectRoleEndpoint(userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(projectRoleReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := projectRoleProvider.Delete(userInfo, req.Name); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+// projectRoleReq defines HTTP request for getProjectRole and deleteProjectRole
+// swagger:parameters getProjectRole deleteProjectRole
+type projectRoleReq struct {
+	// in: path
+	// required: true
+	Name string `json:"name"`
+}
+
+// createProjectRoleReq defines HTTP request for createProjectRole
+// swagger:parameters createProjectRole
+type createProjectRoleReq struct {
+	// in: body
+	Body apiv1.ProjectRole
+}
+
+// updateProjectRoleReq defines HTTP request for updateProjectRole
+// swagger:parameters updateProjectRole
+type updateProjectRoleReq struct {
+	projectRoleReq
+	// in: body
+	Body apiv1.ProjectRole
+}
+
+// Validate validates UpdateProjectRoleEndpoint request
+func (r updateProjectRoleReq) Validate() error {
+	if r.Name != r.Body.Name {
+		return fmt.Errorf("project role name mismatch, you requested to update ProjectRole = %s but body contains ProjectRole = %s", r.Name, r.Body.Name)
+	}
+	return validateProjectRole(r.Body)
+}
+
+func validateProjectRole(projectRole apiv1.ProjectRole) error {
+	if !projectRoleNameRegexp.MatchString(projectRole.Name) {
+		return fmt.Errorf("the project role name %q is invalid, only lower case alphanumeric characters are allowed", projectRole.Name)
+	}
+	if rbac.IsBuiltInGroupPrefix(projectRole.Name) {
+		return fmt.Errorf("the project role name %q is reserved", projectRole.Name)
+	}
+	if len(projectRole.Rules) == 0 {
+		return fmt.Errorf("the project role must have at least one rule")
+	}
+	for _, rule := range projectRole.Rules {
+		if !rbac.IsProjectRoleResource(rule.Resource) {
+			return fmt.Errorf("unsupported resource %q", rule.Resource)
+		}
+		for _, verb := range rule.Verbs {
+			if !supportedProjectRoleVerbs.Has(verb) {
+				return fmt.Errorf("unsupported verb %q for resource %q, supported are %v", verb, rule.Resource, supportedProjectRoleVerbs.List())
+			}
+		}
+	}
+	return nil
+}
+
+func DecodeProjectRoleReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req projectRoleReq
+	name := mux.Vars(r)["name"]
+	if name == "" {
+		return nil, fmt.Errorf("'name' parameter is required but was not provided")
+	}
+	req.Name = name
+
+	return req, nil
+}
+
+func DecodeCreateProjectRoleReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req createProjectRoleReq
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func DecodeUpdateProjectRoleReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req updateProjectRoleReq
+	roleReq, err := DecodeProjectRoleReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.projectRoleReq = roleReq.(projectRoleReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func convertProjectRole(projectRole kubermaticv1.ProjectRole) apiv1.ProjectRole {
+	return apiv1.ProjectRole{
+		Name:              projectRole.Name,
+		HumanReadableName: projectRole.Spec.HumanReadableName,
+		Rules:             projectRole.Spec.Rules,
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestListProjectRolesEndpoint(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name                   string
+		expectedResponse       string
+		httpStatus             int
+		existingAPIUser        *apiv1.User
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:                   "scenario 1: a regular user gets the project roles",
+			expectedResponse:       `[{"name":"clusteroperator","humanReadableName":"Cluster Operator","rules":[{"resource":"NodeDeployment","verbs":["get","update"]}]}]`,
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", false), genProjectRole("clusteroperator")},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/api/v1/projectroles", strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, nil, nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
+
+func TestCreateProjectRoleEndpoint(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name                   string
+		body                   string
+		expectedResponse       string
+		httpStatus             int
+		existingAPIUser        *apiv1.User
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:                   "scenario 1: not authorized user can't create a project role",
+			body:                   `{"name":"clusteroperator","rules":[{"resource":"NodeDeployment","verbs":["get","update"]}]}`,
+			expectedResponse:       `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			httpStatus:             http.StatusForbidden,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", false)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 2: admin creates a project role",
+			body:                   `{"name":"clusteroperator","rules":[{"resource":"NodeDeployment","verbs":["get","update"]}]}`,
+			expectedResponse:       `{"name":"clusteroperator","rules":[{"resource":"NodeDeployment","verbs":["get","update"]}]}`,
+			httpStatus:             http.StatusCreated,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 3: the name of a project role can't contain a dash",
+			body:                   `{"name":"cluster-operator","rules":[{"resource":"NodeDeployment","verbs":["get"]}]}`,
+			expectedResponse:       `{"error":{"code":400,"message":"the project role name \"cluster-operator\" is invalid, only lower case alphanumeric characters are allowed"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 4: the built-in groups can't be redefined",
+			body:                   `{"name":"owners","rules":[{"resource":"NodeDeployment","verbs":["get"]}]}`,
+			expectedResponse:       `{"error":{"code":400,"message":"the project role name \"owners\" is reserved"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 5: unsupported verbs are rejected",
+			body:                   `{"name":"clusteroperator","rules":[{"resource":"Cluster","verbs":["scale"]}]}`,
+			expectedResponse:       `{"error":{"code":400,"message":"unsupported verb \"scale\" for resource \"Cluster\", supported are [* create delete get update]"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/api/v1/admin/projectroles", strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, nil, nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
+
+func TestUpdateProjectRoleEndpoint(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name                   string
+		roleName               string
+		body                   string
+		expectedResponse       string
+		httpStatus             int
+		existingAPIUser        *apiv1.User
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:                   "scenario 1: admin updates the rules of a project role",
+			roleName:               "clusteroperator",
+			body:                   `{"name":"clusteroperator","humanReadableName":"Cluster Operator","rules":[{"resource":"NodeDeployment","verbs":["*"]}]}`,
+			expectedResponse:       `{"name":"clusteroperator","humanReadableName":"Cluster Operator","rules":[{"resource":"NodeDeployment","verbs":["*"]}]}`,
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), genProjectRole("clusteroperator")},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		{
+			name:                   "scenario 2: the name in the body must match",
+			roleName:               "clusteroperator",
+			body:                   `{"name":"auditor","rules":[{"resource":"NodeDeployment","verbs":["get"]}]}`,
+			expectedResponse:       `{"error":{"code":400,"message":"project role name mismatch, you requested to update ProjectRole = clusteroperator but body contains ProjectRole = auditor"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), genProjectRole("clusteroperator")},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("PATCH", "/api/v1/admin/projectroles/"+tc.roleName, strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, nil, nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
+
+func genProjectRole(name string) *kubermaticv1.ProjectRole {
+	return &kubermaticv1.ProjectRole{
+		ObjectMeta: v1.ObjectMeta{
+			Name: name,
+		},
+		Spec: kubermaticv1.ProjectRoleSpec{
+			HumanReadableName: "Cluster Operator",
+			Rules: []kubermaticv1.ProjectRoleRule{
+				{
+					Resource: kubermaticv1.NodeDeploymentResourceKind,
+					Verbs:    []string{"get", "update"},
+				},
+			},
+		},
+	}
+}
@@ -19,6 +19,7 @@ package admin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
@@ -30,6 +31,9 @@ import (
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/util/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // KubermaticSettingsEndpoint returns global settings
@@ -83,6 +87,9 @@ func UpdateKubermaticSettingsEndpoint(userInfoGetter provider.UserInfoGetter, se
 		if err != nil {
 			return nil, errors.NewBadRequest("cannot decode patched settings: %v", err)
 		}
+		if err := validateSchedules(patchedGlobalSettingsSpec); err != nil {
+			return nil, errors.NewBadRequest("invalid settings: %v", err)
+		}
 
 		existingGlobalSettings.Spec = *patchedGlobalSettingsSpec
 		globalSettings, err := settingsProvider.UpdateGlobalSettings(userInfo, existingGlobalSettings)
@@ -94,6 +101,42 @@ func UpdateKubermaticSettingsEndpoint(userInfoGetter provider.UserInfoGetter, se
 	}
 }
 
+func validateSchedules(spec *kubermaticv1.SettingSpec) error {
+	if err := validateTimeWindow(spec.MaintenanceMode.Start, spec.MaintenanceMode.End); err != nil {
+		return fmt.Errorf("maintenance mode: %v", err)
+	}
+
+	ids := sets.NewString()
+	for _, announcement := range spec.Announcements {
+		if announcement.ID == "" {
+			return fmt.Errorf("announcement ID must not be empty")
+		}
+		if ids.Has(announcement.ID) {
+			return fmt.Errorf("announcement ID %q is not unique", announcement.ID)
+		}
+		ids.Insert(announcement.ID)
+		if announcement.Message == "" {
+			return fmt.Errorf("announcement %q: message must not be empty", announcement.ID)
+		}
+		switch announcement.Severity {
+		case "", kubermaticv1.AnnouncementSeverityInfo, kubermaticv1.AnnouncementSeverityWarning, kubermaticv1.AnnouncementSeverityCritical:
+		default:
+			return fmt.Errorf("announcement %q: unknown severity %q", announcement.ID, announcement.Severity)
+		}
+		if err := validateTimeWindow(announcement.Start, announcement.End); err != nil {
+			return fmt.Errorf("announcement %q: %v", announcement.ID, err)
+		}
+	}
+	return nil
+}
+
+func validateTimeWindow(start, end *metav1.Time) error {
+	if start != nil && end != nil && !end.After(start.Time) {
+		return fmt.Errorf("end must be after start")
+	}
+	return nil
+}
+
 // patchKubermaticSettingsReq defines HTTP request for patchKubermaticSettings endpoint
 // swagger:parameters patchKubermaticSettings
 type patchKubermaticSettingsReq struct {
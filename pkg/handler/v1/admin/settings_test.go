@@ -21,11 +21,14 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/test"
 	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -42,7 +45,7 @@ func TestGetGlobalSettings(t *testing.T) {
 		// scenario 1
 		{
 			name:                   "scenario 1: user gets settings first time",
-			expectedResponse:       `{"customLinks":[],"cleanupOptions":{"Enabled":false,"Enforced":false},"defaultNodeCount":10,"clusterTypeOptions":1,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":false,"enableDashboard":true,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true}`,
+			expectedResponse:       `{"customLinks":[],"cleanupOptions":{"Enabled":false,"Enforced":false},"defaultNodeCount":10,"clusterTypeOptions":1,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":false,"enableDashboard":true,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true,"enableWebTerminal":false,"serviceAccountTokenOptions":{"maxLifetime":"0s","unusedRevocationPeriod":"0s"},"clusterDeletionGracePeriod":"0s","maintenanceMode":{"enabled":false}}`,
 			httpStatus:             http.StatusOK,
 			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
 			existingAPIUser:        test.GenDefaultAPIUser(),
@@ -50,7 +53,7 @@ func TestGetGlobalSettings(t *testing.T) {
 		// scenario 2
 		{
 			name:             "scenario 2: user gets existing global settings",
-			expectedResponse: `{"customLinks":[{"label":"label","url":"url:label","icon":"icon","location":"EU"}],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":5,"clusterTypeOptions":5,"displayDemoInfo":true,"displayAPIDocs":true,"displayTermsOfService":true,"enableDashboard":false,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true}`,
+			expectedResponse: `{"customLinks":[{"label":"label","url":"url:label","icon":"icon","location":"EU"}],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":5,"clusterTypeOptions":5,"displayDemoInfo":true,"displayAPIDocs":true,"displayTermsOfService":true,"enableDashboard":false,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true,"enableWebTerminal":false,"serviceAccountTokenOptions":{"maxLifetime":"0s","unusedRevocationPeriod":"0s"},"clusterDeletionGracePeriod":"0s","maintenanceMode":{"enabled":false}}`,
 			httpStatus:       http.StatusOK,
 			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true),
 				test.GenDefaultGlobalSettings()},
@@ -106,7 +109,7 @@ func TestUpdateGlobalSettings(t *testing.T) {
 		{
 			name:                   "scenario 2: authorized user updates default settings",
 			body:                   `{"customLinks":[{"label":"label","url":"url:label","icon":"icon","location":"EU"}],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":100,"clusterTypeOptions":20,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":true}`,
-			expectedResponse:       `{"customLinks":[{"label":"label","url":"url:label","icon":"icon","location":"EU"}],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":100,"clusterTypeOptions":20,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":true,"enableDashboard":true,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true}`,
+			expectedResponse:       `{"customLinks":[{"label":"label","url":"url:label","icon":"icon","location":"EU"}],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":100,"clusterTypeOptions":20,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":true,"enableDashboard":true,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true,"enableWebTerminal":false,"serviceAccountTokenOptions":{"maxLifetime":"0s","unusedRevocationPeriod":"0s"},"clusterDeletionGracePeriod":"0s","maintenanceMode":{"enabled":false}}`,
 			httpStatus:             http.StatusOK,
 			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
 			existingAPIUser:        test.GenDefaultAPIUser(),
@@ -115,12 +118,30 @@ func TestUpdateGlobalSettings(t *testing.T) {
 		{
 			name:             "scenario 3: authorized user updates existing global settings",
 			body:             `{"customLinks":[],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":100,"clusterTypeOptions":20,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":true,"userProjectsLimit":10,"restrictProjectCreation":true}`,
-			expectedResponse: `{"customLinks":[],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":100,"clusterTypeOptions":20,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":true,"enableDashboard":false,"enableOIDCKubeconfig":false,"userProjectsLimit":10,"restrictProjectCreation":true,"enableExternalClusterImport":true}`,
+			expectedResponse: `{"customLinks":[],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":100,"clusterTypeOptions":20,"displayDemoInfo":false,"displayAPIDocs":false,"displayTermsOfService":true,"enableDashboard":false,"enableOIDCKubeconfig":false,"userProjectsLimit":10,"restrictProjectCreation":true,"enableExternalClusterImport":true,"enableWebTerminal":false,"serviceAccountTokenOptions":{"maxLifetime":"0s","unusedRevocationPeriod":"0s"},"clusterDeletionGracePeriod":"0s","maintenanceMode":{"enabled":false}}`,
 			httpStatus:       http.StatusOK,
 			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true),
 				test.GenDefaultGlobalSettings()},
 			existingAPIUser: test.GenDefaultAPIUser(),
 		},
+		// scenario 4
+		{
+			name:                   "scenario 4: authorized user schedules an announcement that ends before it starts",
+			body:                   `{"announcements":[{"id":"upgrade","message":"Upgrade on Monday","start":"2030-01-02T00:00:00Z","end":"2030-01-01T00:00:00Z"}]}`,
+			expectedResponse:       `{"error":{"code":400,"message":"invalid settings: announcement \"upgrade\": end must be after start"}}`,
+			httpStatus:             http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
+		// scenario 5
+		{
+			name:                   "scenario 5: authorized user schedules an announcement",
+			body:                   `{"announcements":[{"id":"upgrade","message":"Upgrade on Monday","severity":"warning","start":"2030-01-01T00:00:00Z","end":"2030-01-02T00:00:00Z"}]}`,
+			expectedResponse:       `{"customLinks":[{"label":"label","url":"url:label","icon":"icon","location":"EU"}],"cleanupOptions":{"Enabled":true,"Enforced":true},"defaultNodeCount":5,"clusterTypeOptions":5,"displayDemoInfo":true,"displayAPIDocs":true,"displayTermsOfService":true,"enableDashboard":false,"enableOIDCKubeconfig":false,"userProjectsLimit":0,"restrictProjectCreation":false,"enableExternalClusterImport":true,"enableWebTerminal":false,"serviceAccountTokenOptions":{"maxLifetime":"0s","unusedRevocationPeriod":"0s"},"clusterDeletionGracePeriod":"0s","maintenanceMode":{"enabled":false},"announcements":[{"id":"upgrade","message":"Upgrade on Monday","severity":"warning","start":"2030-01-01T00:00:00Z","end":"2030-01-02T00:00:00Z"}]}`,
+			httpStatus:             http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true), test.GenDefaultGlobalSettings()},
+			existingAPIUser:        test.GenDefaultAPIUser(),
+		},
 	}
 
 	for _, tc := range testcases {
@@ -152,3 +173,99 @@ func genUser(name, email string, isAdmin bool) *kubermaticv1.User {
 	user.Spec.IsAdmin = isAdmin
 	return user
 }
+
+func TestMaintenanceMode(t *testing.T) {
+	t.Parallel()
+
+	activeMaintenance := kubermaticv1.MaintenanceMode{
+		Enabled: true,
+		Message: "upgrading to the next release",
+		Start:   &metav1.Time{Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+		End:     &metav1.Time{Time: time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	scheduledMaintenance := kubermaticv1.MaintenanceMode{
+		Enabled: true,
+		Start:   &metav1.Time{Time: time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	testcases := []struct {
+		name                   string
+		method                 string
+		path                   string
+		body                   string
+		maintenance            kubermaticv1.MaintenanceMode
+		existingKubermaticObjs []runtime.Object
+		httpStatus             int
+		expectedResponse       string
+	}{
+		{
+			name:                   "scenario 1: non-admin can't modify resources during the maintenance",
+			method:                 http.MethodDelete,
+			path:                   "/api/v1/projects/my-first-project-ID/sshkeys/key-abc",
+			maintenance:            activeMaintenance,
+			existingKubermaticObjs: test.GenDefaultKubermaticObjects(),
+			httpStatus:             http.StatusServiceUnavailable,
+			expectedResponse:       `{"error":{"code":503,"message":"the platform is under maintenance, changes are not possible at the moment: upgrading to the next release","details":["the maintenance is scheduled to end at 2099-01-01T00:00:00Z"]}}`,
+		},
+		{
+			name:                   "scenario 2: non-admin can't modify resources of the v2 API during the maintenance",
+			method:                 http.MethodPost,
+			path:                   "/api/v2/projects/my-first-project-ID/clusters",
+			body:                   `{}`,
+			maintenance:            activeMaintenance,
+			existingKubermaticObjs: test.GenDefaultKubermaticObjects(),
+			httpStatus:             http.StatusServiceUnavailable,
+			expectedResponse:       `{"error":{"code":503,"message":"the platform is under maintenance, changes are not possible at the moment: upgrading to the next release","details":["the maintenance is scheduled to end at 2099-01-01T00:00:00Z"]}}`,
+		},
+		{
+			name:                   "scenario 3: non-admin can read resources during the maintenance",
+			method:                 http.MethodGet,
+			path:                   "/api/v1/projects/my-first-project-ID/sshkeys",
+			maintenance:            activeMaintenance,
+			existingKubermaticObjs: test.GenDefaultKubermaticObjects(),
+			httpStatus:             http.StatusOK,
+			expectedResponse:       `[]`,
+		},
+		{
+			name:                   "scenario 4: non-admin can modify resources before a scheduled maintenance",
+			method:                 http.MethodDelete,
+			path:                   "/api/v1/projects/my-first-project-ID/sshkeys/key-abc",
+			maintenance:            scheduledMaintenance,
+			existingKubermaticObjs: test.GenDefaultKubermaticObjects(),
+			httpStatus:             http.StatusNotFound,
+		},
+		{
+			name:                   "scenario 5: admin can modify resources during the maintenance",
+			method:                 http.MethodPatch,
+			path:                   "/api/v1/admin/settings",
+			body:                   `{"maintenanceMode":{"enabled":false}}`,
+			maintenance:            activeMaintenance,
+			existingKubermaticObjs: []runtime.Object{genUser("Bob", "bob@acme.com", true)},
+			httpStatus:             http.StatusOK,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			settings := test.GenDefaultGlobalSettings()
+			settings.Spec.MaintenanceMode = tc.maintenance
+			kubermaticObj := append([]runtime.Object{settings}, tc.existingKubermaticObjs...)
+
+			req := httptest.NewRequest(tc.method, tc.path, strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, nil, nil, kubermaticObj, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			if tc.expectedResponse != "" {
+				test.CompareWithResult(t, res, tc.expectedResponse)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+	"k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestSetUserSuspension(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                   string
+		body                   string
+		expectedResponse       string
+		expectedTokens         int
+		httpStatus             int
+		existingAPIUser        *apiv1.User
+		existingKubermaticObjs []runtime.Object
+	}{
+		{
+			name:             "scenario 1: unauthorized user tries to suspend a user",
+			body:             `{"email":"john@acme.com","suspended":true}`,
+			expectedResponse: `{"error":{"code":403,"message":"forbidden: \"bob@acme.com\" doesn't have admin rights"}}`,
+			expectedTokens:   2,
+			httpStatus:       http.StatusForbidden,
+			existingKubermaticObjs: []runtime.Object{
+				genUser("Bob", "bob@acme.com", false),
+				genUser("John", "john@acme.com", false),
+				genServiceAccountCreatedBy("1", "plan9-ID", "john@acme.com"),
+				genServiceAccountCreatedBy("2", "plan9-ID", "alice@acme.com"),
+			},
+			existingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			name:             "scenario 2: admin suspends john which revokes the tokens of the service accounts john created",
+			body:             `{"email":"john@acme.com","suspended":true}`,
+			expectedResponse: `{"email":"john@acme.com","suspended":true}`,
+			expectedTokens:   1,
+			httpStatus:       http.StatusOK,
+			existingKubermaticObjs: []runtime.Object{
+				genUser("Bob", "bob@acme.com", true),
+				genUser("John", "john@acme.com", false),
+				genServiceAccountCreatedBy("1", "plan9-ID", "john@acme.com"),
+				genServiceAccountCreatedBy("2", "plan9-ID", "alice@acme.com"),
+			},
+			existingAPIUser: test.GenDefaultAPIUser(),
+		},
+		{
+			name:             "scenario 3: admin can't suspend own account",
+			body:             `{"email":"bob@acme.com","suspended":true}`,
+			expectedResponse: `{"error":{"code":400,"message":"can not suspend own account"}}`,
+			expectedTokens:   2,
+			httpStatus:       http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{
+				genUser("Bob", "bob@acme.com", true),
+				genServiceAccountCreatedBy("1", "plan9-ID", "john@acme.com"),
+				genServiceAccountCreatedBy("2", "plan9-ID", "alice@acme.com"),
+			},
+			existingAPIUser: test.GenDefaultAPIUser(),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			kubeObj := []runtime.Object{
+				test.GenDefaultSaToken("plan9-ID", "serviceaccount-1", "test-1", "1"),
+				test.GenDefaultSaToken("plan9-ID", "serviceaccount-2", "test-2", "2"),
+			}
+			req := httptest.NewRequest("PUT", "/api/v1/admin/users/suspension", strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.existingAPIUser, nil, kubeObj, nil, tc.existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.expectedResponse)
+
+			tokens := &corev1.SecretList{}
+			if err := clients.FakeClient.List(context.Background(), tokens, ctrlruntimeclient.InNamespace("kubermatic")); err != nil {
+				t.Fatalf("failed to list the tokens: %v", err)
+			}
+			if len(tokens.Items) != tc.expectedTokens {
+				t.Fatalf("expected %d tokens to be left, got %d", tc.expectedTokens, len(tokens.Items))
+			}
+		})
+	}
+}
+
+func TestSuspendedUserIsRejected(t *testing.T) {
+	t.Parallel()
+
+	bob := genUser("Bob", "bob@acme.com", false)
+	bob.Spec.Suspended = true
+
+	req := httptest.NewRequest("GET", "/api/v1/me", nil)
+	res := httptest.NewRecorder()
+	ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, []runtime.Object{bob}, nil, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint due to %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusForbidden {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusForbidden, res.Code, res.Body.String())
+	}
+	test.CompareWithResult(t, res, `{"error":{"code":403,"message":"the account has been suspended"}}`)
+}
+
+func TestOffboardUser(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                string
+		body                string
+		expectedResponse    string
+		httpStatus          int
+		expectedOwnerGroups map[string]string
+	}{
+		{
+			name:             "scenario 1: the project john exclusively owns is transferred to alice",
+			body:             `{"email":"john@acme.com","newOwner":"alice@acme.com"}`,
+			expectedResponse: `{"email":"john@acme.com","newOwner":"alice@acme.com","transferredProjects":["plan9-ID"],"removedBindings":["plan9-ID-john@acme.com-owners","planX-ID-john@acme.com-owners","planY-ID-john@acme.com-editors"],"revokedTokens":["1"]}`,
+			httpStatus:       http.StatusOK,
+			expectedOwnerGroups: map[string]string{
+				"plan9-ID": "owners-plan9-ID",
+				"planY-ID": "viewers-planY-ID",
+			},
+		},
+		{
+			name:             "scenario 2: the projects can only be transferred to an admin",
+			body:             `{"email":"john@acme.com","newOwner":"maria@acme.com"}`,
+			expectedResponse: `{"error":{"code":400,"message":"the projects can only be transferred to an admin, maria@acme.com is not an admin"}}`,
+			httpStatus:       http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			john := genUser("John", "john@acme.com", false)
+			kubermaticObj := []runtime.Object{
+				genUser("Bob", "bob@acme.com", true),
+				genUser("Alice", "alice@acme.com", true),
+				genUser("Maria", "maria@acme.com", false),
+				john,
+				test.GenProject("plan9", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp(), metav1.OwnerReference{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.UserKindName,
+					Name:       john.Name,
+				}),
+				test.GenProject("planX", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenProject("planY", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("planX-ID", "john@acme.com", "owners"),
+				test.GenBinding("planX-ID", "maria@acme.com", "owners"),
+				test.GenBinding("planY-ID", "john@acme.com", "editors"),
+				test.GenBinding("planY-ID", "alice@acme.com", "viewers"),
+				genServiceAccountCreatedBy("1", "plan9-ID", "john@acme.com"),
+			}
+			kubeObj := []runtime.Object{
+				test.GenDefaultSaToken("plan9-ID", "serviceaccount-1", "test-1", "1"),
+			}
+			req := httptest.NewRequest("POST", "/api/v1/admin/users/offboarding", strings.NewReader(tc.body))
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, kubeObj, nil, kubermaticObj, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.expectedResponse)
+			if tc.expectedOwnerGroups == nil {
+				return
+			}
+
+			offboardedUser := &kubermaticv1.User{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: john.Name}, offboardedUser); err != nil {
+				t.Fatalf("failed to get the offboarded user: %v", err)
+			}
+			if !offboardedUser.Spec.Suspended {
+				t.Fatal("expected the offboarded user to be suspended")
+			}
+
+			bindings := &kubermaticv1.UserProjectBindingList{}
+			if err := clients.FakeClient.List(context.Background(), bindings); err != nil {
+				t.Fatalf("failed to list the bindings: %v", err)
+			}
+			aliceGroups := map[string]string{}
+			for _, binding := range bindings.Items {
+				switch binding.Spec.UserEmail {
+				case "john@acme.com":
+					t.Fatalf("expected all the bindings of john to be removed, found %s", binding.Name)
+				case "alice@acme.com":
+					aliceGroups[binding.Spec.ProjectID] = binding.Spec.Group
+				}
+			}
+			if len(aliceGroups) != len(tc.expectedOwnerGroups) {
+				t.Fatalf("expected alice to be bound to %v, got %v", tc.expectedOwnerGroups, aliceGroups)
+			}
+			for project, group := range tc.expectedOwnerGroups {
+				if aliceGroups[project] != group {
+					t.Fatalf("expected alice to be bound to %v, got %v", tc.expectedOwnerGroups, aliceGroups)
+				}
+			}
+
+			project := &kubermaticv1.Project{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "plan9-ID"}, project); err != nil {
+				t.Fatalf("failed to get the transferred project: %v", err)
+			}
+			if len(project.OwnerReferences) != 1 || project.OwnerReferences[0].Name == john.Name {
+				t.Fatalf("expected the transferred project to be owned by alice, got %v", project.OwnerReferences)
+			}
+		})
+	}
+}
+
+func genServiceAccountCreatedBy(id, projectID, creator string) *kubermaticv1.User {
+	sa := test.GenServiceAccount(id, "test-"+id, "editors", projectID)
+	sa.Annotations = map[string]string{kubernetes.ServiceAccountAnnotationCreator: creator}
+	return sa
+}
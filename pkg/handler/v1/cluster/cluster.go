@@ -43,7 +43,7 @@ import (
 
 func CreateEndpoint(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
 	initNodeDeploymentFailures *prometheus.CounterVec, eventRecorderProvider provider.EventRecorderProvider, credentialManager provider.PresetProvider,
-	exposeStrategy corev1.ServiceType, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, updateManager common.UpdateManager) endpoint.Endpoint {
+	exposeStrategy corev1.ServiceType, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, updateManager common.UpdateManager, operationProvider provider.OperationProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateReq)
 		globalSettings, err := settingsProvider.GetGlobalSettings()
@@ -55,7 +55,7 @@ func CreateEndpoint(sshKeyProvider provider.SSHKeyProvider, projectProvider prov
 			return nil, errors.NewBadRequest(err.Error())
 		}
 
-		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, sshKeyProvider, projectProvider, privilegedProjectProvider, seedsGetter, initNodeDeploymentFailures, eventRecorderProvider, credentialManager, exposeStrategy, userInfoGetter)
+		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, sshKeyProvider, projectProvider, privilegedProjectProvider, seedsGetter, initNodeDeploymentFailures, eventRecorderProvider, credentialManager, exposeStrategy, userInfoGetter, operationProvider)
 	}
 }
 
@@ -66,10 +66,10 @@ func GetEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProv
 	}
 }
 
-func PatchEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func PatchEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, operationProvider provider.OperationProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(PatchReq)
-		return handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter, projectProvider, privilegedProjectProvider)
+		return handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter, projectProvider, privilegedProjectProvider, operationProvider)
 	}
 }
 
@@ -115,10 +115,10 @@ func ListAllEndpoint(projectProvider provider.ProjectProvider, privilegedProject
 	}
 }
 
-func DeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func DeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, operationProvider provider.OperationProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(DeleteReq)
-		return handlercommon.DeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider)
+		return handlercommon.DeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, req.SkipGracePeriod, req.retention, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider, settingsProvider, operationProvider)
 	}
 }
 
@@ -136,6 +136,34 @@ func HealthEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 	}
 }
 
+func HibernateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(common.GetClusterReq)
+		return handlercommon.HibernateEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, true, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func ResumeEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(common.GetClusterReq)
+		return handlercommon.HibernateEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, false, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func UndeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(common.GetClusterReq)
+		return handlercommon.UndeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func HealthStatusEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(common.GetClusterReq)
+		return handlercommon.HealthStatusEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
 func AssignSSHKeyEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(AssignSSHKeysReq)
@@ -429,6 +457,20 @@ type DeleteReq struct {
 	// in: header
 	// DeleteLoadBalancers if true all load balancers will be deleted from cluster
 	DeleteLoadBalancers bool
+	// in: header
+	// RetainLoadBalancers is a comma-separated list of load balancers in the form "namespace/name" which are kept when DeleteLoadBalancers is set
+	RetainLoadBalancers string
+	// in: header
+	// RetainVolumes is a comma-separated list of PV names which are kept together with their PVC's when DeleteVolumes is set
+	RetainVolumes string
+	// in: header
+	// RetainMachines is a comma-separated list of Machine names in the kube-system namespace whose instances are kept
+	RetainMachines string
+	// in: header
+	// SkipGracePeriod if true the cluster is deleted right away, even if a deletion grace period is configured. Only admins can skip it.
+	SkipGracePeriod bool
+
+	retention *kubermaticv1.ClusterDeletionRetention
 }
 
 func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
@@ -459,6 +501,23 @@ func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 		req.DeleteLoadBalancers = deleteLB
 	}
 
+	headerValue = r.Header.Get("SkipGracePeriod")
+	if len(headerValue) > 0 {
+		skipGracePeriod, err := strconv.ParseBool(headerValue)
+		if err != nil {
+			return nil, err
+		}
+		req.SkipGracePeriod = skipGracePeriod
+	}
+
+	req.RetainLoadBalancers = r.Header.Get("RetainLoadBalancers")
+	req.RetainVolumes = r.Header.Get("RetainVolumes")
+	req.RetainMachines = r.Header.Get("RetainMachines")
+	req.retention, err = handlercommon.DeletionRetention(req.RetainLoadBalancers, req.RetainVolumes, req.RetainMachines)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
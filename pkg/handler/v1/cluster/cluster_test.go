@@ -29,6 +29,7 @@ import (
 	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
 	"k8c.io/kubermatic/v2/pkg/handler/test"
 	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
@@ -40,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const fakeDC = "fake-dc"
@@ -173,6 +175,9 @@ func TestDeleteClusterEndpoint(t *testing.T) {
 				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
 			}
 			test.CompareWithResult(t, res, tc.ExpectedResponse)
+			if res.Header().Get(handlercommon.OperationIDHeader) == "" {
+				t.Errorf("expected the response to contain the %s header", handlercommon.OperationIDHeader)
+			}
 
 			// validate if the cluster was deleted
 			req = httptest.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/dc/us-central1/clusters/abcd/sshkeys", tc.ProjectToSync), strings.NewReader(tc.Body))
@@ -185,6 +190,26 @@ func TestDeleteClusterEndpoint(t *testing.T) {
 	}
 }
 
+func TestDeleteClusterEndpointRejectsInvalidRetention(t *testing.T) {
+	t.Parallel()
+	cluster := test.GenCluster("clusterAbcID", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+
+	req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s/dc/us-central1/clusters/%s", test.GenDefaultProject().Name, cluster.Name), nil)
+	req.Header.Set("DeleteLoadBalancers", "true")
+	req.Header.Set("RetainLoadBalancers", "ingress")
+	res := httptest.NewRecorder()
+	ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []runtime.Object{}, test.GenDefaultKubermaticObjects(cluster), nil, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint due to %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusBadRequest, res.Code, res.Body.String())
+	}
+}
+
 func TestDetachSSHKeyFromClusterEndpoint(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -1056,6 +1081,86 @@ func TestGetClusterHealth(t *testing.T) {
 	}
 }
 
+func TestHibernateCluster(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		Action                 string
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExpectedHibernated     bool
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:               "scenario 1: hibernate a running cluster",
+			Action:             "hibernate",
+			ExpectedResponse:   `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885"}}`,
+			HTTPStatus:         http.StatusOK,
+			ExpectedHibernated: true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenDefaultCluster(),
+			),
+		},
+		{
+			Name:             "scenario 2: resume a hibernated cluster",
+			Action:           "resume",
+			ExpectedResponse: `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","phase":"Hibernated"}}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenDefaultCluster()
+					cluster.Spec.Hibernated = true
+					cluster.Status.Phase = kubermaticv1.ClusterPhaseHibernated
+					return cluster
+				}(),
+			),
+		},
+		{
+			Name:             "scenario 3: undelete a cluster which is scheduled for deletion",
+			Action:           "undelete",
+			ExpectedResponse: `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885"}}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenDefaultCluster()
+					cluster.Spec.Hibernated = true
+					cluster.Spec.SoftDeletion = &kubermaticv1.ClusterSoftDeletion{DeleteAt: metav1.NewTime(time.Now().Add(time.Hour))}
+					return cluster
+				}(),
+			),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/v1/projects/%s/dc/us-central1/clusters/%s/%s", test.ProjectName, test.GenDefaultCluster().Name, tc.Action), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []runtime.Object{}, []runtime.Object{}, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: test.GenDefaultCluster().Name}, cluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if cluster.Spec.Hibernated != tc.ExpectedHibernated {
+				t.Fatalf("expected hibernated to be %v, got %v", tc.ExpectedHibernated, cluster.Spec.Hibernated)
+			}
+			if cluster.IsSoftDeleted() {
+				t.Fatal("expected cluster to not be scheduled for deletion")
+			}
+		})
+	}
+}
+
 func TestPatchCluster(t *testing.T) {
 	t.Parallel()
 
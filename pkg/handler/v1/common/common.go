@@ -33,12 +33,14 @@ import (
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
 	kubermaticerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/version"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corev1interface "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
@@ -249,6 +251,36 @@ func ForwardPort(log *zap.SugaredLogger, forwarder *portforward.PortForwarder) e
 	return nil
 }
 
+// GetUserProjectMappings returns the list of projects (bindings) for the given user including the projects
+// the user belongs to through the groups claim of the verified token, a direct membership takes precedence
+func GetUserProjectMappings(ctx context.Context, memberMapper provider.ProjectMemberMapper, userEmail string) ([]*kubermaticv1.UserProjectBinding, error) {
+	userMappings, err := memberMapper.MappingsFor(userEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, _ := ctx.Value(kubermaticcontext.TokenGroupsContextKey).([]string)
+	if len(groups) == 0 {
+		return userMappings, nil
+	}
+	groupMappings, err := memberMapper.GroupMappingsFor(userEmail, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	projects := sets.NewString()
+	for _, userMapping := range userMappings {
+		projects.Insert(userMapping.Spec.ProjectID)
+	}
+	for _, groupMapping := range groupMappings {
+		if !projects.Has(groupMapping.Spec.ProjectID) {
+			userMappings = append(userMappings, groupMapping)
+		}
+	}
+
+	return userMappings, nil
+}
+
 func GetOwnersForProject(userInfo *provider.UserInfo, project *kubermaticv1.Project, memberProvider provider.ProjectMemberProvider, userProvider provider.UserProvider) ([]apiv1.User, error) {
 	allProjectMembers, err := memberProvider.List(userInfo, project, &provider.ProjectMemberListOptions{SkipPrivilegeVerification: true})
 	if err != nil {
@@ -297,6 +329,12 @@ func GetProject(ctx context.Context, userInfoGetter provider.UserInfoGetter, pro
 }
 
 func GetClusterClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) (ctrlruntimeclient.Client, error) {
+	if cluster.IsSoftDeleted() {
+		return nil, kubermaticerrors.New(http.StatusConflict, fmt.Sprintf("cluster %s is scheduled for deletion, undelete it first", cluster.Name))
+	}
+	if cluster.IsHibernated() {
+		return nil, kubermaticerrors.New(http.StatusConflict, fmt.Sprintf("cluster %s is hibernated, resume it first", cluster.Name))
+	}
 	adminUserInfo, err := userInfoGetter(ctx, "")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user information: %v", err)
@@ -309,5 +347,22 @@ func GetClusterClient(ctx context.Context, userInfoGetter provider.UserInfoGette
 	if err != nil {
 		return nil, fmt.Errorf("failed to get user information: %v", err)
 	}
+	if !rbac.IsBuiltInGroupPrefix(rbac.ExtractGroupPrefix(userInfo.Group)) {
+		// there is no RBAC for custom project roles inside of the user cluster, the access has to be checked
+		// by the middleware.ProjectRoles middleware and the user is impersonated as a member of the built-in
+		// group that matches the granted verb
+		verb, ok := ctx.Value(kubermaticcontext.ProjectRoleVerbContextKey).(string)
+		if !ok {
+			return nil, kubermaticerrors.New(http.StatusForbidden, fmt.Sprintf("the project role of %s doesn't allow to access the cluster", userInfo.Email))
+		}
+		groupPrefix := rbac.EditorGroupNamePrefix
+		if verb == "get" {
+			groupPrefix = rbac.ViewerGroupNamePrefix
+		}
+		userInfo = &provider.UserInfo{
+			Email: userInfo.Email,
+			Group: rbac.GenerateActualGroupNameFor(projectID, groupPrefix),
+		}
+	}
 	return clusterProvider.GetClientForCustomerCluster(userInfo, cluster)
 }
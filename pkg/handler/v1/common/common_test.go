@@ -0,0 +1,115 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// fakeClusterProvider records the user that a client for the user cluster was requested for
+type fakeClusterProvider struct {
+	provider.ClusterProvider
+	userInfo *provider.UserInfo
+	admin    bool
+}
+
+func (p *fakeClusterProvider) GetAdminClientForCustomerCluster(*kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+	p.admin = true
+	return nil, nil
+}
+
+func (p *fakeClusterProvider) GetClientForCustomerCluster(userInfo *provider.UserInfo, _ *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+	p.userInfo = userInfo
+	return nil, nil
+}
+
+func TestGetClusterClient(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		name          string
+		group         string
+		verb          string
+		expectedGroup string
+		expectedError bool
+	}{
+		{
+			name:          "scenario 1: members of the built-in groups are impersonated with their group",
+			group:         "editors-my-project",
+			expectedGroup: "editors-my-project",
+		},
+		{
+			name:          "scenario 2: members of a custom role that may only get resources are impersonated as viewers",
+			group:         "operators-my-project",
+			verb:          "get",
+			expectedGroup: "viewers-my-project",
+		},
+		{
+			name:          "scenario 3: members of a custom role that may modify resources are impersonated as editors",
+			group:         "operators-my-project",
+			verb:          "update",
+			expectedGroup: "editors-my-project",
+		},
+		{
+			name:          "scenario 4: members of a custom role can not access the cluster without being authorized by the role",
+			group:         "operators-my-project",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			userInfoGetter := func(_ context.Context, projectID string) (*provider.UserInfo, error) {
+				if projectID == "" {
+					return &provider.UserInfo{Email: "bob@acme.com"}, nil
+				}
+				return &provider.UserInfo{Email: "bob@acme.com", Group: tc.group}, nil
+			}
+			ctx := context.Background()
+			if tc.verb != "" {
+				ctx = context.WithValue(ctx, kubermaticcontext.ProjectRoleVerbContextKey, tc.verb)
+			}
+			clusterProvider := &fakeClusterProvider{}
+			cluster := &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "abcd"}}
+
+			_, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, "my-project")
+			if tc.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if clusterProvider.admin {
+				t.Fatal("expected the user to be impersonated, got the admin client")
+			}
+			if clusterProvider.userInfo == nil || clusterProvider.userInfo.Group != tc.expectedGroup || clusterProvider.userInfo.Email != "bob@acme.com" {
+				t.Fatalf("expected bob@acme.com to be impersonated as %q, got %v", tc.expectedGroup, clusterProvider.userInfo)
+			}
+		})
+	}
+}
@@ -39,16 +39,20 @@ import (
 	"k8c.io/kubermatic/v2/pkg/handler/v1/label"
 	machineconversions "k8c.io/kubermatic/v2/pkg/machine"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	awsprovider "k8c.io/kubermatic/v2/pkg/provider/cloud/aws"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 	machineresource "k8c.io/kubermatic/v2/pkg/resources/machine"
 	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
 
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -131,7 +135,7 @@ func CreateNodeDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvide
 			return nil, fmt.Errorf("error getting dc: %v", err)
 		}
 
-		nd, err := machineresource.Validate(&req.Body, cluster.Spec.Version.Semver())
+		nd, err := machineresource.Validate(&req.Body, cluster)
 		if err != nil {
 			return nil, k8cerrors.NewBadRequest(fmt.Sprintf("node deployment validation failed: %s", err.Error()))
 		}
@@ -141,23 +145,116 @@ func CreateNodeDeployment(sshKeyProvider provider.SSHKeyProvider, projectProvide
 			return nil, k8cerrors.New(http.StatusInternalServerError, "clusterprovider is not a kubernetesprovider.Clusterprovider, can not create secret")
 		}
 
+		cloudProvider, err := cloud.Provider(dc, provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient()))
+		if err != nil {
+			return nil, err
+		}
+		preflightWarnings, err := handlercommon.NodeDeploymentPreflightChecks(cloudProvider, cluster.Spec.Cloud, nd)
+		if err != nil {
+			return nil, err
+		}
+
 		data := common.CredentialsData{
 			Ctx:               ctx,
 			KubermaticCluster: cluster,
 			Client:            assertedClusterProvider.GetSeedClusterAdminRuntimeClient(),
 		}
 
-		md, err := machineresource.Deployment(cluster, nd, dc, keys, data)
+		// AWS node deployments without a subnet are spread across the availability zones of the cluster VPC
+		nodeDeployments := []*apiv1.NodeDeployment{nd}
+		awsProvider, isAWS := cloudProvider.(*awsprovider.AmazonEC2)
+		if isAWS && nd.Spec.Template.Cloud.AWS != nil && nd.Spec.Template.Cloud.AWS.SubnetID == "" && nd.Spec.Template.Cloud.AWS.AvailabilityZone == "" {
+			distribution, err := awsProvider.DistributeReplicas(cluster.Spec.Cloud, nd.Spec.Replicas)
+			if err != nil {
+				return nil, fmt.Errorf("failed to spread the node deployment across availability zones: %v", err)
+			}
+			nodeDeployments, err = spreadNodeDeployment(nd, distribution)
+			if err != nil {
+				return nil, k8cerrors.NewBadRequest(err.Error())
+			}
+		}
+
+		// The zone node deployments are checked on their own, the checks of the whole node deployment
+		// already ran above
+		var zonePreflightWarnings [][]string
+		if len(nodeDeployments) > 1 {
+			for _, zoneNodeDeployment := range nodeDeployments {
+				warnings, err := handlercommon.NodeDeploymentPreflightChecks(cloudProvider, cluster.Spec.Cloud, zoneNodeDeployment)
+				if err != nil {
+					return nil, err
+				}
+				zonePreflightWarnings = append(zonePreflightWarnings, warnings)
+			}
+		}
+
+		var machineDeployments []*clusterv1alpha1.MachineDeployment
+		for _, zoneNodeDeployment := range nodeDeployments {
+			md, err := machineresource.Deployment(cluster, zoneNodeDeployment, dc, keys, data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create machine deployment from template: %v", err)
+			}
+			machineDeployments = append(machineDeployments, md)
+		}
+		if err := createMachineDeployments(ctx, client, machineDeployments); err != nil {
+			return nil, err
+		}
+
+		nodeDeployment, err := outputMachineDeployment(machineDeployments[0])
 		if err != nil {
-			return nil, fmt.Errorf("failed to create machine deployment from template: %v", err)
+			return nil, err
+		}
+		nodeDeployment.PreflightWarnings = preflightWarnings
+		if len(machineDeployments) > 1 {
+			for i, md := range machineDeployments {
+				zoneNodeDeployment, err := outputMachineDeployment(md)
+				if err != nil {
+					return nil, err
+				}
+				zoneNodeDeployment.PreflightWarnings = zonePreflightWarnings[i]
+				nodeDeployment.ZoneNodeDeployments = append(nodeDeployment.ZoneNodeDeployments, *zoneNodeDeployment)
+			}
 		}
+		return nodeDeployment, nil
+	}
+}
 
+// createMachineDeployments creates all machine deployments of a node deployment. If one of them fails, the ones
+// already created are deleted again, so that the node deployment is either created completely or not at all.
+func createMachineDeployments(ctx context.Context, client ctrlruntimeclient.Client, mds []*clusterv1alpha1.MachineDeployment) error {
+	for i, md := range mds {
 		if err := client.Create(ctx, md); err != nil {
-			return nil, fmt.Errorf("failed to create machine deployment: %v", err)
+			for _, created := range mds[:i] {
+				if deleteErr := client.Delete(ctx, created); deleteErr != nil && !kerrors.IsNotFound(deleteErr) {
+					return fmt.Errorf("failed to create machine deployment: %v, failed to delete the already created machine deployment %s: %v", err, created.Name, deleteErr)
+				}
+			}
+			return fmt.Errorf("failed to create machine deployment: %v", err)
 		}
+	}
+	return nil
+}
 
-		return outputMachineDeployment(md)
+// spreadNodeDeployment returns a copy of the node deployment for every availability zone of the distribution,
+// the zone is appended to the names of the copies if a name was given. The resulting names must not be longer
+// than 63 characters.
+func spreadNodeDeployment(nd *apiv1.NodeDeployment, distribution []awsprovider.ZoneReplicas) ([]*apiv1.NodeDeployment, error) {
+	var nodeDeployments []*apiv1.NodeDeployment
+	for _, zone := range distribution {
+		zoneNodeDeployment := *nd
+		awsSpec := *nd.Spec.Template.Cloud.AWS
+		zoneNodeDeployment.Spec.Template.Cloud.AWS = &awsSpec
+		if nd.Name != "" && len(distribution) > 1 {
+			zoneNodeDeployment.Name = fmt.Sprintf("%s-%s", nd.Name, zone.AvailabilityZone)
+			if len(zoneNodeDeployment.Name) > validation.DNS1123LabelMaxLength {
+				return nil, fmt.Errorf("the name %q of the node deployment in availability zone %s is longer than %d characters, use a shorter name", zoneNodeDeployment.Name, zone.AvailabilityZone, validation.DNS1123LabelMaxLength)
+			}
+		}
+		zoneNodeDeployment.Spec.Replicas = zone.Replicas
+		zoneNodeDeployment.Spec.Template.Cloud.AWS.AvailabilityZone = zone.AvailabilityZone
+		zoneNodeDeployment.Spec.Template.Cloud.AWS.SubnetID = zone.SubnetID
+		nodeDeployments = append(nodeDeployments, &zoneNodeDeployment)
 	}
+	return nodeDeployments, nil
 }
 
 func outputMachineDeployment(md *clusterv1alpha1.MachineDeployment) (*apiv1.NodeDeployment, error) {
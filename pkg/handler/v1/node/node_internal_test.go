@@ -0,0 +1,184 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package node
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	awsprovider "k8c.io/kubermatic/v2/pkg/provider/cloud/aws"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func init() {
+	if err := clusterv1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme); err != nil {
+		panic(err)
+	}
+}
+
+// failingCreateClient fails to create the machine deployment with the given name
+type failingCreateClient struct {
+	ctrlruntimeclient.Client
+	failName string
+}
+
+func (c *failingCreateClient) Create(ctx context.Context, obj runtime.Object, opts ...ctrlruntimeclient.CreateOption) error {
+	if md, ok := obj.(*clusterv1alpha1.MachineDeployment); ok && md.Name == c.failName {
+		return errors.New("create failed")
+	}
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func TestCreateMachineDeployments(t *testing.T) {
+	testCases := []struct {
+		name          string
+		failName      string
+		expectedNames []string
+		expectedError bool
+	}{
+		{
+			name:          "all machine deployments are created",
+			expectedNames: []string{"md-eu-central-1a", "md-eu-central-1b", "md-eu-central-1c"},
+		},
+		{
+			name:          "already created machine deployments are deleted if one fails",
+			failName:      "md-eu-central-1c",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &failingCreateClient{
+				Client:   fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme),
+				failName: tc.failName,
+			}
+			var mds []*clusterv1alpha1.MachineDeployment
+			for _, name := range []string{"md-eu-central-1a", "md-eu-central-1b", "md-eu-central-1c"} {
+				mds = append(mds, &clusterv1alpha1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem},
+				})
+			}
+
+			err := createMachineDeployments(context.Background(), client, mds)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error: %v, got: %v", tc.expectedError, err)
+			}
+
+			machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+			if err := client.List(context.Background(), machineDeployments); err != nil {
+				t.Fatalf("failed to list machine deployments: %v", err)
+			}
+			var names []string
+			for _, md := range machineDeployments.Items {
+				names = append(names, md.Name)
+			}
+			if len(names) != len(tc.expectedNames) {
+				t.Fatalf("expected machine deployments %v, got %v", tc.expectedNames, names)
+			}
+			for i := range names {
+				if names[i] != tc.expectedNames[i] {
+					t.Errorf("expected machine deployments %v, got %v", tc.expectedNames, names)
+				}
+			}
+		})
+	}
+}
+
+func TestSpreadNodeDeployment(t *testing.T) {
+	distribution := []awsprovider.ZoneReplicas{
+		{AvailabilityZone: "eu-central-1a", SubnetID: "subnet-a", Replicas: 2},
+		{AvailabilityZone: "eu-central-1b", SubnetID: "subnet-b", Replicas: 1},
+	}
+	testCases := []struct {
+		name          string
+		ndName        string
+		distribution  []awsprovider.ZoneReplicas
+		expectedNames []string
+		expectedError bool
+	}{
+		{
+			name:          "the zone is appended to the name",
+			ndName:        "workers",
+			distribution:  distribution,
+			expectedNames: []string{"workers-eu-central-1a", "workers-eu-central-1b"},
+		},
+		{
+			name:          "the name is kept for a single zone",
+			ndName:        "workers",
+			distribution:  distribution[:1],
+			expectedNames: []string{"workers"},
+		},
+		{
+			name:          "names are generated later if none was given",
+			distribution:  distribution,
+			expectedNames: []string{"", ""},
+		},
+		{
+			name:          "the name with the zone is too long",
+			ndName:        strings.Repeat("a", 50),
+			distribution:  distribution,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nd := &apiv1.NodeDeployment{
+				ObjectMeta: apiv1.ObjectMeta{Name: tc.ndName},
+				Spec: apiv1.NodeDeploymentSpec{
+					Replicas: 3,
+					Template: apiv1.NodeSpec{
+						Cloud: apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}},
+					},
+				},
+			}
+			nodeDeployments, err := spreadNodeDeployment(nd, tc.distribution)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error: %t, got: %v", tc.expectedError, err)
+			}
+			if tc.expectedError {
+				return
+			}
+			if len(nodeDeployments) != len(tc.expectedNames) {
+				t.Fatalf("expected %d node deployments, got %d", len(tc.expectedNames), len(nodeDeployments))
+			}
+			for i, zoneNodeDeployment := range nodeDeployments {
+				zone := tc.distribution[i]
+				if zoneNodeDeployment.Name != tc.expectedNames[i] {
+					t.Errorf("expected name %q, got %q", tc.expectedNames[i], zoneNodeDeployment.Name)
+				}
+				awsSpec := zoneNodeDeployment.Spec.Template.Cloud.AWS
+				if zoneNodeDeployment.Spec.Replicas != zone.Replicas || awsSpec.AvailabilityZone != zone.AvailabilityZone || awsSpec.SubnetID != zone.SubnetID {
+					t.Errorf("expected node deployment in %+v, got %d replicas in %s/%s", zone, zoneNodeDeployment.Spec.Replicas, awsSpec.AvailabilityZone, awsSpec.SubnetID)
+				}
+			}
+			if nd.Spec.Template.Cloud.AWS.AvailabilityZone != "" {
+				t.Error("the spread modified the original node deployment")
+			}
+		})
+	}
+}
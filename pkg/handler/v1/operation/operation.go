@@ -0,0 +1,90 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// GetEndpoint returns the given operation of the project
+func GetEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, operationProvider provider.OperationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetReq)
+		return handlercommon.GetOperationEndpoint(ctx, userInfoGetter, req.ProjectID, req.OperationID, projectProvider, privilegedProjectProvider, operationProvider)
+	}
+}
+
+// ListEndpoint returns the operations of the project
+func ListEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, operationProvider provider.OperationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListReq)
+		return handlercommon.ListOperationsEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider, operationProvider)
+	}
+}
+
+// GetReq defines HTTP request for getOperation
+// swagger:parameters getOperation
+type GetReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	OperationID string `json:"operation_id"`
+}
+
+func DecodeGetReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req GetReq
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	req.OperationID = mux.Vars(r)["operation_id"]
+	if req.OperationID == "" {
+		return nil, k8cerrors.NewBadRequest("'operation_id' parameter is required but was not provided")
+	}
+	return req, nil
+}
+
+// ListReq defines HTTP request for listOperations
+// swagger:parameters listOperations
+type ListReq struct {
+	common.ProjectReq
+	// ClusterID restricts the operations to a single cluster
+	// in: query
+	ClusterID string `json:"cluster_id,omitempty"`
+}
+
+func DecodeListReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req ListReq
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+	req.ClusterID = r.URL.Query().Get("cluster_id")
+	return req, nil
+}
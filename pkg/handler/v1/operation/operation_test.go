@@ -0,0 +1,149 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func genOperation(name, projectID, clusterID string, operationType kubermaticv1.OperationType, created time.Time) *kubermaticv1.Operation {
+	return &kubermaticv1.Operation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey:        projectID,
+				kubermaticv1.OperationClusterLabelKey: clusterID,
+			},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: kubermaticv1.OperationSpec{
+			Type:      operationType,
+			ProjectID: projectID,
+			ClusterID: clusterID,
+			UserEmail: "bob@acme.com",
+		},
+		Status: kubermaticv1.OperationStatus{
+			Phase:            kubermaticv1.OperationPhaseRunning,
+			Steps:            []kubermaticv1.OperationStep{{Name: "DeletionStarted", Done: true}, {Name: "ResourcesCleanedUp"}},
+			Percentage:       50,
+			CurrentCondition: "ClusterControllerReconciledSuccessfully: ReconcilingSuccess",
+		},
+	}
+}
+
+func existingOperations() []runtime.Object {
+	return test.GenDefaultKubermaticObjects(
+		genOperation("op1", test.GenDefaultProject().Name, "clusterAbcID", kubermaticv1.OperationTypeClusterDeletion, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC)),
+		genOperation("op2", test.GenDefaultProject().Name, "clusterDefID", kubermaticv1.OperationTypeClusterDeletion, time.Date(2013, 02, 04, 19, 54, 0, 0, time.UTC)),
+		genOperation("op3", "other-project-ID", "clusterGhiID", kubermaticv1.OperationTypeClusterDeletion, time.Date(2013, 02, 05, 19, 54, 0, 0, time.UTC)),
+	)
+}
+
+func TestListOperations(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		Query            string
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the operations of the project are listed, the newest first",
+			ExpectedResponse: `[{"id":"op2","name":"op2","creationTimestamp":"2013-02-04T19:54:00Z","type":"ClusterDeletion","clusterID":"clusterDefID","phase":"Running","steps":[{"name":"DeletionStarted","done":true},{"name":"ResourcesCleanedUp","done":false}],"percentage":50,"currentCondition":"ClusterControllerReconciledSuccessfully: ReconcilingSuccess"},{"id":"op1","name":"op1","creationTimestamp":"2013-02-03T19:54:00Z","type":"ClusterDeletion","clusterID":"clusterAbcID","phase":"Running","steps":[{"name":"DeletionStarted","done":true},{"name":"ResourcesCleanedUp","done":false}],"percentage":50,"currentCondition":"ClusterControllerReconciledSuccessfully: ReconcilingSuccess"}]`,
+		},
+		{
+			Name:             "scenario 2: the operations can be limited to a single cluster",
+			Query:            "?cluster_id=clusterAbcID",
+			ExpectedResponse: `[{"id":"op1","name":"op1","creationTimestamp":"2013-02-03T19:54:00Z","type":"ClusterDeletion","clusterID":"clusterAbcID","phase":"Running","steps":[{"name":"DeletionStarted","done":true},{"name":"ResourcesCleanedUp","done":false}],"percentage":50,"currentCondition":"ClusterControllerReconciledSuccessfully: ReconcilingSuccess"}]`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/operations%s", test.GenDefaultProject().Name, tc.Query), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, existingOperations(), nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusOK {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestGetOperation(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		OperationID      string
+		HTTPStatus       int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the operation of the project is returned",
+			OperationID:      "op1",
+			HTTPStatus:       http.StatusOK,
+			ExpectedResponse: `{"id":"op1","name":"op1","creationTimestamp":"2013-02-03T19:54:00Z","type":"ClusterDeletion","clusterID":"clusterAbcID","phase":"Running","steps":[{"name":"DeletionStarted","done":true},{"name":"ResourcesCleanedUp","done":false}],"percentage":50,"currentCondition":"ClusterControllerReconciledSuccessfully: ReconcilingSuccess"}`,
+		},
+		{
+			Name:             "scenario 2: the operation of another project can't be read",
+			OperationID:      "op3",
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"operation \"op3\" not found"}}`,
+		},
+		{
+			Name:             "scenario 3: missing operation",
+			OperationID:      "op4",
+			HTTPStatus:       http.StatusNotFound,
+			ExpectedResponse: `{"error":{"code":404,"message":"operation \"op4\" not found"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/operations/%s", test.GenDefaultProject().Name, tc.OperationID), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), nil, existingOperations(), nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -50,7 +50,7 @@ type AlibabaCommonReq struct {
 }
 
 // AlibabaReq represent a request for Alibaba instance types.
-// swagger:parameters listAlibabaInstanceTypes listAlibabaZones
+// swagger:parameters listAlibabaInstanceTypes listAlibabaZones listAlibabaVPCs
 type AlibabaReq struct {
 	AlibabaCommonReq
 	// in: header
@@ -59,7 +59,7 @@ type AlibabaReq struct {
 }
 
 // AlibabaNoCredentialReq represent a request for Alibaba instance types.
-// swagger:parameters listAlibabaInstanceTypesNoCredentials listAlibabaZonesNoCredentials
+// swagger:parameters listAlibabaInstanceTypesNoCredentials listAlibabaZonesNoCredentials listAlibabaVPCsNoCredentials
 type AlibabaNoCredentialReq struct {
 	common.GetClusterReq
 	// in: header
@@ -67,6 +67,24 @@ type AlibabaNoCredentialReq struct {
 	Region string
 }
 
+// AlibabaVSwitchesReq represent a request for Alibaba vSwitches.
+// swagger:parameters listAlibabaVSwitches
+type AlibabaVSwitchesReq struct {
+	AlibabaReq
+	// in: query
+	// name: vpc
+	VPCID string
+}
+
+// AlibabaVSwitchesNoCredentialReq represent a request for Alibaba vSwitches with the credentials of the cluster.
+// swagger:parameters listAlibabaVSwitchesNoCredentials
+type AlibabaVSwitchesNoCredentialReq struct {
+	AlibabaNoCredentialReq
+	// in: query
+	// name: vpc
+	VPCID string
+}
+
 func DecodeAlibabaReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req AlibabaReq
 
@@ -104,6 +122,32 @@ func DecodeAlibabaNoCredentialReq(c context.Context, r *http.Request) (interface
 	return req, nil
 }
 
+func DecodeAlibabaVSwitchesReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req AlibabaVSwitchesReq
+
+	alibabaReq, err := DecodeAlibabaReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.AlibabaReq = alibabaReq.(AlibabaReq)
+	req.VPCID = r.URL.Query().Get("vpc")
+
+	return req, nil
+}
+
+func DecodeAlibabaVSwitchesNoCredentialReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req AlibabaVSwitchesNoCredentialReq
+
+	alibabaReq, err := DecodeAlibabaNoCredentialReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.AlibabaNoCredentialReq = alibabaReq.(AlibabaNoCredentialReq)
+	req.VPCID = r.URL.Query().Get("vpc")
+
+	return req, nil
+}
+
 const requestScheme = "https"
 
 func AlibabaInstanceTypesEndpoint(presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
@@ -311,3 +355,165 @@ func listAlibabaZones(ctx context.Context, accessKeyID string, accessKeySecret s
 
 	return zones, nil
 }
+
+func AlibabaVPCsEndpoint(presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AlibabaReq)
+
+		accessKeyID, accessKeySecret, err := getAlibabaCredentials(ctx, presetsProvider, userInfoGetter, req.AlibabaCommonReq)
+		if err != nil {
+			return nil, err
+		}
+		return listAlibabaVPCs(accessKeyID, accessKeySecret, req.Region)
+	}
+}
+
+func AlibabaVPCsWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AlibabaNoCredentialReq)
+
+		accessKeyID, accessKeySecret, region, err := getAlibabaClusterCredentials(ctx, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter, req)
+		if err != nil {
+			return nil, err
+		}
+		return listAlibabaVPCs(accessKeyID, accessKeySecret, region)
+	}
+}
+
+func AlibabaVSwitchesEndpoint(presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AlibabaVSwitchesReq)
+
+		accessKeyID, accessKeySecret, err := getAlibabaCredentials(ctx, presetsProvider, userInfoGetter, req.AlibabaCommonReq)
+		if err != nil {
+			return nil, err
+		}
+		return listAlibabaVSwitches(accessKeyID, accessKeySecret, req.Region, req.VPCID)
+	}
+}
+
+func AlibabaVSwitchesWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(AlibabaVSwitchesNoCredentialReq)
+
+		accessKeyID, accessKeySecret, region, err := getAlibabaClusterCredentials(ctx, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter, req.AlibabaNoCredentialReq)
+		if err != nil {
+			return nil, err
+		}
+		return listAlibabaVSwitches(accessKeyID, accessKeySecret, region, req.VPCID)
+	}
+}
+
+// getAlibabaCredentials returns the credentials from the request or from the preset of the request.
+func getAlibabaCredentials(ctx context.Context, presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, req AlibabaCommonReq) (string, string, error) {
+	accessKeyID := req.AccessKeyID
+	accessKeySecret := req.AccessKeySecret
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return "", "", common.KubernetesErrorToHTTPError(err)
+	}
+	if len(req.Credential) > 0 {
+		preset, err := presetsProvider.GetPreset(userInfo, req.Credential)
+		if err != nil {
+			return "", "", errors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
+		}
+		if credentials := preset.Spec.Alibaba; credentials != nil {
+			accessKeyID = credentials.AccessKeyID
+			accessKeySecret = credentials.AccessKeySecret
+		}
+	}
+	return accessKeyID, accessKeySecret, nil
+}
+
+// getAlibabaClusterCredentials returns the credentials of the cluster and the region, which defaults to
+// the region of the datacenter of the cluster.
+func getAlibabaClusterCredentials(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, req AlibabaNoCredentialReq) (string, string, string, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	if err != nil {
+		return "", "", "", err
+	}
+	if cluster.Spec.Cloud.Alibaba == nil {
+		return "", "", "", errors.NewNotFound("cloud spec for %s", req.ClusterID)
+	}
+
+	datacenterName := cluster.Spec.Cloud.DatacenterName
+
+	assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return "", "", "", errors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return "", "", "", common.KubernetesErrorToHTTPError(err)
+	}
+	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, datacenterName)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to find Datacenter %q: %v", datacenterName, err)
+	}
+
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+	accessKeyID, accessKeySecret, err := alibaba.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector, datacenter.Spec.Alibaba)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	region := req.Region
+	if region == "" {
+		region = datacenter.Spec.Alibaba.Region
+	}
+	return accessKeyID, accessKeySecret, region, nil
+}
+
+func listAlibabaVPCs(accessKeyID string, accessKeySecret string, region string) (apiv1.AlibabaVPCList, error) {
+	client, err := alibaba.NewECSClient(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to create client: %v", err))
+	}
+
+	vpcs, err := alibaba.ListVPCs(client)
+	if err != nil {
+		return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to list VPCs: %v", err))
+	}
+
+	vpcList := apiv1.AlibabaVPCList{}
+	for _, vpc := range vpcs {
+		vpcList = append(vpcList, apiv1.AlibabaVPC{
+			ID:        vpc.VpcId,
+			Name:      vpc.VpcName,
+			CidrBlock: vpc.CidrBlock,
+			Status:    vpc.Status,
+			IsDefault: vpc.IsDefault,
+		})
+	}
+	return vpcList, nil
+}
+
+func listAlibabaVSwitches(accessKeyID string, accessKeySecret string, region string, vpcID string) (apiv1.AlibabaVSwitchList, error) {
+	client, err := alibaba.NewECSClient(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to create client: %v", err))
+	}
+
+	vSwitches, err := alibaba.ListVSwitches(client, vpcID)
+	if err != nil {
+		return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("failed to list vSwitches: %v", err))
+	}
+
+	vSwitchList := apiv1.AlibabaVSwitchList{}
+	for _, vSwitch := range vSwitches {
+		vSwitchList = append(vSwitchList, apiv1.AlibabaVSwitch{
+			ID:                      vSwitch.VSwitchId,
+			Name:                    vSwitch.VSwitchName,
+			VPCID:                   vSwitch.VpcId,
+			ZoneID:                  vSwitch.ZoneId,
+			CidrBlock:               vSwitch.CidrBlock,
+			AvailableIPAddressCount: vSwitch.AvailableIpAddressCount,
+			IsDefault:               vSwitch.IsDefault,
+		})
+	}
+	return vSwitchList, nil
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
+	"k8c.io/kubermatic/v2/pkg/handler/middleware"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	kubevirtprovider "k8c.io/kubermatic/v2/pkg/provider/cloud/kubevirt"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/util/errors"
+
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// kubevirtVMPresetListGVK is the kind of the VirtualMachineInstancePresets, which are read as unstructured
+// objects as the KubeVirt API types are not vendored
+var kubevirtVMPresetListGVK = schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1alpha3", Kind: "VirtualMachineInstancePresetList"}
+
+type kubevirtListFunc func(ctx context.Context, client ctrlruntimeclient.Client) (interface{}, error)
+
+func KubevirtStorageClassesWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return kubevirtWithClusterCredentialsEndpoint(projectProvider, privilegedProjectProvider, userInfoGetter, kubevirtStorageClasses)
+}
+
+func KubevirtStorageClassesEndpoint(presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return kubevirtEndpoint(presetsProvider, userInfoGetter, kubevirtStorageClasses)
+}
+
+func KubevirtVMPresetsWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return kubevirtWithClusterCredentialsEndpoint(projectProvider, privilegedProjectProvider, userInfoGetter, kubevirtVMPresets)
+}
+
+func KubevirtVMPresetsEndpoint(presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return kubevirtEndpoint(presetsProvider, userInfoGetter, kubevirtVMPresets)
+}
+
+func kubevirtWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, list kubevirtListFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(KubevirtNoCredentialsReq)
+		clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+		cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+		if err != nil {
+			return nil, err
+		}
+		if cluster.Spec.Cloud.Kubevirt == nil {
+			return nil, errors.NewNotFound("cloud spec for ", req.ClusterID)
+		}
+
+		assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+		if !ok {
+			return nil, errors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+		}
+
+		secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+		kubeconfig, err := kubevirtprovider.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector)
+		if err != nil {
+			return nil, err
+		}
+
+		return kubevirtList(ctx, kubeconfig, list)
+	}
+}
+
+func kubevirtEndpoint(presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter, list kubevirtListFunc) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(KubevirtReq)
+
+		kubeconfig := req.Kubeconfig
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		if len(req.Credential) > 0 {
+			preset, err := presetsProvider.GetPreset(userInfo, req.Credential)
+			if err != nil {
+				return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
+			}
+			if credentials := preset.Spec.Kubevirt; credentials != nil {
+				kubeconfig = credentials.Kubeconfig
+			}
+		}
+
+		return kubevirtList(ctx, kubeconfig, list)
+	}
+}
+
+func kubevirtList(ctx context.Context, kubeconfig string, list kubevirtListFunc) (interface{}, error) {
+	client, err := kubevirtprovider.NewInfraClient(kubeconfig)
+	if err != nil {
+		return nil, errors.NewBadRequest("invalid kubeconfig: %v", err)
+	}
+	return list(ctx, client)
+}
+
+func kubevirtStorageClasses(ctx context.Context, client ctrlruntimeclient.Client) (interface{}, error) {
+	storageClasses := &storagev1.StorageClassList{}
+	if err := client.List(ctx, storageClasses); err != nil {
+		return nil, fmt.Errorf("failed to list storage classes: %v", err)
+	}
+
+	storageClassList := apiv1.KubevirtStorageClassList{}
+	for _, storageClass := range storageClasses.Items {
+		storageClassList = append(storageClassList, apiv1.KubevirtStorageClass{
+			Name:        storageClass.Name,
+			Provisioner: storageClass.Provisioner,
+			Default:     storageClass.Annotations[defaultStorageClassAnnotation] == "true",
+		})
+	}
+	return storageClassList, nil
+}
+
+func kubevirtVMPresets(ctx context.Context, client ctrlruntimeclient.Client) (interface{}, error) {
+	presets := &unstructured.UnstructuredList{}
+	presets.SetGroupVersionKind(kubevirtVMPresetListGVK)
+	if err := client.List(ctx, presets); err != nil {
+		// KubeVirt might not be installed yet
+		if meta.IsNoMatchError(err) {
+			return apiv1.KubevirtVMPresetList{}, nil
+		}
+		return nil, fmt.Errorf("failed to list VM presets: %v", err)
+	}
+
+	presetList := apiv1.KubevirtVMPresetList{}
+	for _, preset := range presets.Items {
+		apiPreset := apiv1.KubevirtVMPreset{
+			Name:      preset.GetName(),
+			Namespace: preset.GetNamespace(),
+		}
+		if cores, found, err := unstructured.NestedFieldNoCopy(preset.Object, "spec", "domain", "cpu", "cores"); err == nil && found {
+			apiPreset.CPUs = fmt.Sprint(cores)
+		}
+		if memory, found, err := unstructured.NestedString(preset.Object, "spec", "domain", "resources", "requests", "memory"); err == nil && found {
+			apiPreset.Memory = memory
+		}
+		presetList = append(presetList, apiPreset)
+	}
+	return presetList, nil
+}
+
+// KubevirtNoCredentialsReq represent a request for KubeVirt resources of the infra cluster of a cluster,
+// note that the request doesn't have credentials for autN
+// swagger:parameters listKubevirtStorageClassesNoCredentials listKubevirtVMPresetsNoCredentials
+type KubevirtNoCredentialsReq struct {
+	common.GetClusterReq
+}
+
+func DecodeKubevirtNoCredentialsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req KubevirtNoCredentialsReq
+	cr, err := common.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.GetClusterReq = cr.(common.GetClusterReq)
+	return req, nil
+}
+
+// KubevirtReq represent a request for KubeVirt resources of an infra cluster
+// swagger:parameters listKubevirtStorageClasses listKubevirtVMPresets
+type KubevirtReq struct {
+	// in: header
+	// Kubeconfig the kubeconfig of the KubeVirt infra cluster, either base64 encoded or plain
+	Kubeconfig string
+	// in: header
+	// Credential predefined Kubermatic credential name from the presets
+	Credential string
+}
+
+func DecodeKubevirtReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req KubevirtReq
+
+	req.Kubeconfig = r.Header.Get("Kubeconfig")
+	req.Credential = r.Header.Get("Credential")
+	return req, nil
+}
@@ -31,6 +31,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud/openstack"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
 	"k8c.io/kubermatic/v2/pkg/util/errors"
 )
 
@@ -51,11 +52,11 @@ func OpenstackSizeEndpoint(seedsGetter provider.SeedsGetter, presetsProvider pro
 			return nil, fmt.Errorf("error getting dc: %v", err)
 		}
 
-		username, password, domain, tenant, tenantID, err := getOpenstackCredentials(userInfo, req.Credential, req.Username, req.Password, req.Domain, req.Tenant, req.TenantID, presetsProvider)
+		creds, err := getOpenstackCredentials(userInfo, req.Credential, req.credentials(), presetsProvider)
 		if err != nil {
 			return nil, fmt.Errorf("error getting OpenStack credentials: %v", err)
 		}
-		return getOpenstackSizes(username, password, tenant, tenantID, domain, datacenterName, datacenter)
+		return getOpenstackSizes(creds, datacenter)
 	}
 }
 
@@ -91,12 +92,12 @@ func OpenstackSizeWithClusterCredentialsEndpoint(projectProvider provider.Projec
 			return nil, err
 		}
 
-		return getOpenstackSizes(creds.Username, creds.Password, creds.Tenant, creds.TenantID, creds.Domain, datacenterName, datacenter)
+		return getOpenstackSizes(creds, datacenter)
 	}
 }
 
-func getOpenstackSizes(username, password, tenant, tenantID, domain, datacenterName string, datacenter *kubermaticv1.Datacenter) ([]apiv1.OpenstackSize, error) {
-	flavors, err := openstack.GetFlavors(username, password, domain, tenant, tenantID, datacenter.Spec.Openstack.AuthURL, datacenter.Spec.Openstack.Region)
+func getOpenstackSizes(creds resources.OpenstackCredentials, datacenter *kubermaticv1.Datacenter) ([]apiv1.OpenstackSize, error) {
+	flavors, err := openstack.GetFlavors(creds, datacenter.Spec.Openstack.AuthURL, datacenter.Spec.Openstack.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -140,11 +141,11 @@ func OpenstackTenantEndpoint(seedsGetter provider.SeedsGetter, presetsProvider p
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
-		username, password, domain, _, _, err := getOpenstackCredentials(userInfo, req.Credential, req.Username, req.Password, req.Domain, "", "", presetsProvider)
+		creds, err := getOpenstackCredentials(userInfo, req.Credential, req.credentials(), presetsProvider)
 		if err != nil {
 			return nil, fmt.Errorf("error getting OpenStack credentials: %v", err)
 		}
-		return getOpenstackTenants(userInfo, seedsGetter, username, password, domain, "", "", req.DatacenterName)
+		return getOpenstackTenants(userInfo, seedsGetter, creds, req.DatacenterName)
 	}
 }
 
@@ -174,17 +175,17 @@ func OpenstackTenantWithClusterCredentialsEndpoint(projectProvider provider.Proj
 		if err != nil {
 			return nil, err
 		}
-		return getOpenstackTenants(userInfo, seedsGetter, creds.Username, creds.Password, creds.Domain, creds.Tenant, creds.TenantID, datacenterName)
+		return getOpenstackTenants(userInfo, seedsGetter, creds, datacenterName)
 	}
 }
 
-func getOpenstackTenants(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, domain, tenant, tenantID, datacenterName string) ([]apiv1.OpenstackTenant, error) {
+func getOpenstackTenants(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, creds resources.OpenstackCredentials, datacenterName string) ([]apiv1.OpenstackTenant, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	tenants, err := openstack.GetTenants(username, password, domain, tenant, tenantID, authURL, region)
+	tenants, err := openstack.GetTenants(creds, authURL, region)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get tenants: %v", err)
 	}
@@ -212,11 +213,11 @@ func OpenstackNetworkEndpoint(seedsGetter provider.SeedsGetter, presetsProvider
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
-		username, password, domain, tenant, tenantID, err := getOpenstackCredentials(userInfo, req.Credential, req.Username, req.Password, req.Domain, req.Tenant, req.TenantID, presetsProvider)
+		creds, err := getOpenstackCredentials(userInfo, req.Credential, req.credentials(), presetsProvider)
 		if err != nil {
 			return nil, fmt.Errorf("error getting OpenStack credentials: %v", err)
 		}
-		return getOpenstackNetworks(userInfo, seedsGetter, username, password, tenant, tenantID, domain, req.DatacenterName)
+		return getOpenstackNetworks(userInfo, seedsGetter, creds, req.DatacenterName)
 	}
 }
 
@@ -246,17 +247,17 @@ func OpenstackNetworkWithClusterCredentialsEndpoint(projectProvider provider.Pro
 		if err != nil {
 			return nil, err
 		}
-		return getOpenstackNetworks(userInfo, seedsGetter, creds.Username, creds.Password, creds.Tenant, creds.TenantID, creds.Domain, datacenterName)
+		return getOpenstackNetworks(userInfo, seedsGetter, creds, datacenterName)
 	}
 }
 
-func getOpenstackNetworks(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, tenant, tenantID, domain, datacenterName string) ([]apiv1.OpenstackNetwork, error) {
+func getOpenstackNetworks(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, creds resources.OpenstackCredentials, datacenterName string) ([]apiv1.OpenstackNetwork, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	networks, err := openstack.GetNetworks(username, password, domain, tenant, tenantID, authURL, region)
+	networks, err := openstack.GetNetworks(creds, authURL, region)
 	if err != nil {
 		return nil, err
 	}
@@ -285,11 +286,11 @@ func OpenstackSecurityGroupEndpoint(seedsGetter provider.SeedsGetter, presetsPro
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
-		username, password, domain, tenant, tenantID, err := getOpenstackCredentials(userInfo, req.Credential, req.Username, req.Password, req.Domain, req.Tenant, req.TenantID, presetsProvider)
+		creds, err := getOpenstackCredentials(userInfo, req.Credential, req.credentials(), presetsProvider)
 		if err != nil {
 			return nil, fmt.Errorf("error getting OpenStack credentials: %v", err)
 		}
-		return getOpenstackSecurityGroups(userInfo, seedsGetter, username, password, tenant, tenantID, domain, req.DatacenterName)
+		return getOpenstackSecurityGroups(userInfo, seedsGetter, creds, req.DatacenterName)
 	}
 }
 
@@ -319,17 +320,17 @@ func OpenstackSecurityGroupWithClusterCredentialsEndpoint(projectProvider provid
 		if err != nil {
 			return nil, err
 		}
-		return getOpenstackSecurityGroups(userInfo, seedsGetter, creds.Username, creds.Password, creds.Tenant, creds.TenantID, creds.Domain, datacenterName)
+		return getOpenstackSecurityGroups(userInfo, seedsGetter, creds, datacenterName)
 	}
 }
 
-func getOpenstackSecurityGroups(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, tenant, tenantID, domain, datacenterName string) ([]apiv1.OpenstackSecurityGroup, error) {
+func getOpenstackSecurityGroups(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, creds resources.OpenstackCredentials, datacenterName string) ([]apiv1.OpenstackSecurityGroup, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	securityGroups, err := openstack.GetSecurityGroups(username, password, domain, tenant, tenantID, authURL, region)
+	securityGroups, err := openstack.GetSecurityGroups(creds, authURL, region)
 	if err != nil {
 		return nil, err
 	}
@@ -357,11 +358,11 @@ func OpenstackSubnetsEndpoint(seedsGetter provider.SeedsGetter, presetsProvider
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
-		username, password, domain, tenant, tenantID, err := getOpenstackCredentials(userInfo, req.Credential, req.Username, req.Password, req.Domain, req.Tenant, req.TenantID, presetsProvider)
+		creds, err := getOpenstackCredentials(userInfo, req.Credential, req.credentials(), presetsProvider)
 		if err != nil {
 			return nil, fmt.Errorf("error getting OpenStack credentials: %v", err)
 		}
-		return getOpenstackSubnets(userInfo, seedsGetter, username, password, domain, tenant, tenantID, req.NetworkID, req.DatacenterName)
+		return getOpenstackSubnets(userInfo, seedsGetter, creds, req.NetworkID, req.DatacenterName)
 	}
 }
 
@@ -391,17 +392,17 @@ func OpenstackSubnetsWithClusterCredentialsEndpoint(projectProvider provider.Pro
 		if err != nil {
 			return nil, err
 		}
-		return getOpenstackSubnets(userInfo, seedsGetter, creds.Username, creds.Password, creds.Domain, creds.Tenant, creds.TenantID, req.NetworkID, datacenterName)
+		return getOpenstackSubnets(userInfo, seedsGetter, creds, req.NetworkID, datacenterName)
 	}
 }
 
-func getOpenstackSubnets(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, username, password, domain, tenant, tenantID, networkID, datacenterName string) ([]apiv1.OpenstackSubnet, error) {
+func getOpenstackSubnets(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, creds resources.OpenstackCredentials, networkID, datacenterName string) ([]apiv1.OpenstackSubnet, error) {
 	authURL, region, err := getOpenstackAuthURLAndRegion(userInfo, seedsGetter, datacenterName)
 	if err != nil {
 		return nil, err
 	}
 
-	subnets, err := openstack.GetSubnets(username, password, domain, tenant, tenantID, networkID, authURL, region)
+	subnets, err := openstack.GetSubnets(creds, networkID, authURL, region)
 	if err != nil {
 		return nil, err
 	}
@@ -434,11 +435,11 @@ func OpenstackAvailabilityZoneEndpoint(seedsGetter provider.SeedsGetter, presets
 			return nil, fmt.Errorf("error getting dc: %v", err)
 		}
 
-		username, password, domain, tenant, tenantID, err := getOpenstackCredentials(userInfo, req.Credential, req.Username, req.Password, req.Domain, req.Tenant, req.TenantID, presetsProvider)
+		creds, err := getOpenstackCredentials(userInfo, req.Credential, req.credentials(), presetsProvider)
 		if err != nil {
 			return nil, fmt.Errorf("error getting OpenStack credentials: %v", err)
 		}
-		return getOpenstackAvailabilityZones(username, password, tenant, tenantID, domain, datacenterName, datacenter)
+		return getOpenstackAvailabilityZones(creds, datacenter)
 	}
 }
 
@@ -474,12 +475,12 @@ func OpenstackAvailabilityZoneWithClusterCredentialsEndpoint(projectProvider pro
 			return nil, err
 		}
 
-		return getOpenstackAvailabilityZones(creds.Username, creds.Password, creds.Tenant, creds.TenantID, creds.Domain, datacenterName, datacenter)
+		return getOpenstackAvailabilityZones(creds, datacenter)
 	}
 }
 
-func getOpenstackAvailabilityZones(username, password, tenant, tenantID, domain, datacenterName string, datacenter *kubermaticv1.Datacenter) ([]apiv1.OpenstackAvailabilityZone, error) {
-	availabilityZones, err := openstack.GetAvailabilityZones(username, password, domain, tenant, tenantID, datacenter.Spec.Openstack.AuthURL, datacenter.Spec.Openstack.Region)
+func getOpenstackAvailabilityZones(creds resources.OpenstackCredentials, datacenter *kubermaticv1.Datacenter) ([]apiv1.OpenstackAvailabilityZone, error) {
+	availabilityZones, err := openstack.GetAvailabilityZones(creds, datacenter.Spec.Openstack.AuthURL, datacenter.Spec.Openstack.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -524,6 +525,12 @@ type OpenstackReq struct {
 	// TenantID OpenStack tenant ID
 	TenantID string
 	// in: header
+	// ApplicationCredentialID OpenStack application credential ID, used instead of the user name and password
+	ApplicationCredentialID string
+	// in: header
+	// ApplicationCredentialSecret OpenStack application credential secret
+	ApplicationCredentialSecret string
+	// in: header
 	// DatacenterName Openstack datacenter name
 	DatacenterName string
 	// in: header
@@ -531,6 +538,18 @@ type OpenstackReq struct {
 	Credential string
 }
 
+func (r OpenstackReq) credentials() resources.OpenstackCredentials {
+	return resources.OpenstackCredentials{
+		Username:                    r.Username,
+		Password:                    r.Password,
+		Tenant:                      r.Tenant,
+		TenantID:                    r.TenantID,
+		Domain:                      r.Domain,
+		ApplicationCredentialID:     r.ApplicationCredentialID,
+		ApplicationCredentialSecret: r.ApplicationCredentialSecret,
+	}
+}
+
 func DecodeOpenstackReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req OpenstackReq
 
@@ -539,6 +558,8 @@ func DecodeOpenstackReq(c context.Context, r *http.Request) (interface{}, error)
 	req.Tenant = r.Header.Get("Tenant")
 	req.TenantID = r.Header.Get("TenantID")
 	req.Domain = r.Header.Get("Domain")
+	req.ApplicationCredentialID = r.Header.Get("ApplicationCredentialID")
+	req.ApplicationCredentialSecret = r.Header.Get("ApplicationCredentialSecret")
 	req.DatacenterName = r.Header.Get("DatacenterName")
 	req.Credential = r.Header.Get("Credential")
 	return req, nil
@@ -576,6 +597,8 @@ func DecodeOpenstackSubnetReq(c context.Context, r *http.Request) (interface{},
 	req.Password = r.Header.Get("Password")
 	req.Domain = r.Header.Get("Domain")
 	req.Tenant = r.Header.Get("Tenant")
+	req.ApplicationCredentialID = r.Header.Get("ApplicationCredentialID")
+	req.ApplicationCredentialSecret = r.Header.Get("ApplicationCredentialSecret")
 	req.DatacenterName = r.Header.Get("DatacenterName")
 	req.NetworkID = r.URL.Query().Get("network_id")
 	if req.NetworkID == "" {
@@ -622,6 +645,12 @@ type OpenstackTenantReq struct {
 	// Domain OpenStack domain name
 	Domain string
 	// in: header
+	// ApplicationCredentialID OpenStack application credential ID, used instead of the user name and password
+	ApplicationCredentialID string
+	// in: header
+	// ApplicationCredentialSecret OpenStack application credential secret
+	ApplicationCredentialSecret string
+	// in: header
 	// DatacenterName Openstack datacenter na
 	DatacenterName string
 	// in: header
@@ -629,33 +658,49 @@ type OpenstackTenantReq struct {
 	Credential string
 }
 
+func (r OpenstackTenantReq) credentials() resources.OpenstackCredentials {
+	return resources.OpenstackCredentials{
+		Username:                    r.Username,
+		Password:                    r.Password,
+		Domain:                      r.Domain,
+		ApplicationCredentialID:     r.ApplicationCredentialID,
+		ApplicationCredentialSecret: r.ApplicationCredentialSecret,
+	}
+}
+
 func DecodeOpenstackTenantReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req OpenstackTenantReq
 
 	req.Username = r.Header.Get("Username")
 	req.Password = r.Header.Get("Password")
 	req.Domain = r.Header.Get("Domain")
+	req.ApplicationCredentialID = r.Header.Get("ApplicationCredentialID")
+	req.ApplicationCredentialSecret = r.Header.Get("ApplicationCredentialSecret")
 	req.DatacenterName = r.Header.Get("DatacenterName")
 	req.Credential = r.Header.Get("Credential")
 
 	return req, nil
 }
 
-func getOpenstackCredentials(userInfo *provider.UserInfo, credentialName, username, password, domain, tenant, tenantID string, presetProvider provider.PresetProvider) (string, string, string, string, string, error) {
+func getOpenstackCredentials(userInfo *provider.UserInfo, credentialName string, creds resources.OpenstackCredentials, presetProvider provider.PresetProvider) (resources.OpenstackCredentials, error) {
 	if len(credentialName) > 0 {
 		preset, err := presetProvider.GetPreset(userInfo, credentialName)
 		if err != nil {
-			return "", "", "", "", "", fmt.Errorf("can not get preset %s for the user %s", credentialName, userInfo.Email)
+			return resources.OpenstackCredentials{}, fmt.Errorf("can not get preset %s for the user %s", credentialName, userInfo.Email)
 		}
 		if credentials := preset.Spec.Openstack; credentials != nil {
-			username = credentials.Username
-			password = credentials.Password
-			tenant = credentials.Tenant
-			tenantID = credentials.TenantID
-			domain = credentials.Domain
-		}
-	}
-	return username, password, domain, tenant, tenantID, nil
+			creds = resources.OpenstackCredentials{
+				Username:                    credentials.Username,
+				Password:                    credentials.Password,
+				Tenant:                      credentials.Tenant,
+				TenantID:                    credentials.TenantID,
+				Domain:                      credentials.Domain,
+				ApplicationCredentialID:     credentials.ApplicationCredentialID,
+				ApplicationCredentialSecret: credentials.ApplicationCredentialSecret,
+			}
+		}
+	}
+	return creds, nil
 }
 
 func getOpenstackAuthURLAndRegion(userInfo *provider.UserInfo, seedsGetter provider.SeedsGetter, datacenterName string) (string, string, error) {
@@ -24,6 +24,7 @@ import (
 	"github.com/go-kit/kit/endpoint"
 
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
 	"k8c.io/kubermatic/v2/pkg/handler/middleware"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
@@ -288,3 +289,255 @@ func DecodeVSphereFoldersNoCredentialsReq(c context.Context, r *http.Request) (i
 	req.GetClusterReq = lr.(common.GetClusterReq)
 	return req, nil
 }
+
+func VsphereDatastoresEndpoint(seedsGetter provider.SeedsGetter, presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereRequestCredentials(ctx, request, seedsGetter, presetsProvider, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereDatastores(datacenter, username, password)
+	}
+}
+
+func VsphereDatastoresWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereClusterCredentials(ctx, request, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereDatastores(datacenter, username, password)
+	}
+}
+
+func getVsphereDatastores(datacenter *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]apiv1.VSphereDatastore, error) {
+	datastores, err := vsphere.GetDatastores(datacenter, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datastores: %v", err)
+	}
+
+	apiDatastores := []apiv1.VSphereDatastore{}
+	for _, datastore := range datastores {
+		apiDatastores = append(apiDatastores, apiv1.VSphereDatastore{Name: datastore.Name, Path: datastore.Path})
+	}
+
+	return apiDatastores, nil
+}
+
+func VsphereDatastoreClustersEndpoint(seedsGetter provider.SeedsGetter, presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereRequestCredentials(ctx, request, seedsGetter, presetsProvider, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereDatastoreClusters(datacenter, username, password)
+	}
+}
+
+func VsphereDatastoreClustersWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereClusterCredentials(ctx, request, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereDatastoreClusters(datacenter, username, password)
+	}
+}
+
+func getVsphereDatastoreClusters(datacenter *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]apiv1.VSphereDatastoreCluster, error) {
+	datastoreClusters, err := vsphere.GetDatastoreClusters(datacenter, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datastore clusters: %v", err)
+	}
+
+	apiDatastoreClusters := []apiv1.VSphereDatastoreCluster{}
+	for _, datastoreCluster := range datastoreClusters {
+		apiDatastoreClusters = append(apiDatastoreClusters, apiv1.VSphereDatastoreCluster{Name: datastoreCluster.Name, Path: datastoreCluster.Path})
+	}
+
+	return apiDatastoreClusters, nil
+}
+
+func VsphereResourcePoolsEndpoint(seedsGetter provider.SeedsGetter, presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereRequestCredentials(ctx, request, seedsGetter, presetsProvider, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereResourcePools(datacenter, username, password)
+	}
+}
+
+func VsphereResourcePoolsWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereClusterCredentials(ctx, request, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereResourcePools(datacenter, username, password)
+	}
+}
+
+func getVsphereResourcePools(datacenter *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]apiv1.VSphereResourcePool, error) {
+	resourcePools, err := vsphere.GetResourcePools(datacenter, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource pools: %v", err)
+	}
+
+	apiResourcePools := []apiv1.VSphereResourcePool{}
+	for _, resourcePool := range resourcePools {
+		apiResourcePools = append(apiResourcePools, apiv1.VSphereResourcePool{Name: resourcePool.Name, Path: resourcePool.Path})
+	}
+
+	return apiResourcePools, nil
+}
+
+func VsphereStoragePoliciesEndpoint(seedsGetter provider.SeedsGetter, presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereRequestCredentials(ctx, request, seedsGetter, presetsProvider, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereStoragePolicies(datacenter, username, password)
+	}
+}
+
+func VsphereStoragePoliciesWithClusterCredentialsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		datacenter, username, password, err := getVsphereClusterCredentials(ctx, request, projectProvider, privilegedProjectProvider, seedsGetter, userInfoGetter)
+		if err != nil {
+			return nil, err
+		}
+		return getVsphereStoragePolicies(datacenter, username, password)
+	}
+}
+
+func getVsphereStoragePolicies(datacenter *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]apiv1.VSphereStoragePolicy, error) {
+	storagePolicies, err := vsphere.GetStoragePolicies(datacenter, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage policies: %v", err)
+	}
+
+	apiStoragePolicies := []apiv1.VSphereStoragePolicy{}
+	for _, storagePolicy := range storagePolicies {
+		apiStoragePolicies = append(apiStoragePolicies, apiv1.VSphereStoragePolicy{ID: storagePolicy.ID, Name: storagePolicy.Name})
+	}
+
+	return apiStoragePolicies, nil
+}
+
+// getVsphereRequestCredentials returns the datacenter and the credentials
+// passed in the request headers or the referenced preset.
+func getVsphereRequestCredentials(ctx context.Context, request interface{}, seedsGetter provider.SeedsGetter, presetsProvider provider.PresetProvider, userInfoGetter provider.UserInfoGetter) (*kubermaticv1.DatacenterSpecVSphere, string, string, error) {
+	req, ok := request.(VSphereReq)
+	if !ok {
+		return nil, "", "", fmt.Errorf("incorrect type of request, expected = VSphereReq, got = %T", request)
+	}
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, "", "", common.KubernetesErrorToHTTPError(err)
+	}
+	username := req.Username
+	password := req.Password
+
+	if len(req.Credential) > 0 {
+		preset, err := presetsProvider.GetPreset(userInfo, req.Credential)
+		if err != nil {
+			return nil, "", "", errors.New(http.StatusInternalServerError, fmt.Sprintf("can not get preset %s for user %s", req.Credential, userInfo.Email))
+		}
+		if credentials := preset.Spec.VSphere; credentials != nil {
+			username = credentials.Username
+			password = credentials.Password
+		}
+	}
+
+	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, req.DatacenterName)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to find Datacenter %q: %v", req.DatacenterName, err)
+	}
+	if datacenter.Spec.VSphere == nil {
+		return nil, "", "", errors.NewNotFound("cloud spec (dc) for ", req.DatacenterName)
+	}
+
+	return datacenter.Spec.VSphere, username, password, nil
+}
+
+// getVsphereClusterCredentials returns the datacenter and the credentials of the cluster.
+func getVsphereClusterCredentials(ctx context.Context, request interface{}, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) (*kubermaticv1.DatacenterSpecVSphere, string, string, error) {
+	req := request.(VSphereNoCredentialsReq)
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, &provider.ClusterGetOptions{CheckInitStatus: true})
+	if err != nil {
+		return nil, "", "", err
+	}
+	if cluster.Spec.Cloud.VSphere == nil {
+		return nil, "", "", errors.NewNotFound("cloud spec for ", req.ClusterID)
+	}
+
+	datacenterName := cluster.Spec.Cloud.DatacenterName
+	assertedClusterProvider, ok := clusterProvider.(*kubernetesprovider.ClusterProvider)
+	if !ok {
+		return nil, "", "", errors.New(http.StatusInternalServerError, "failed to assert clusterProvider")
+	}
+	secretKeySelector := provider.SecretKeySelectorValueFuncFactory(ctx, assertedClusterProvider.GetSeedClusterAdminRuntimeClient())
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, "", "", common.KubernetesErrorToHTTPError(err)
+	}
+	_, datacenter, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, datacenterName)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to find Datacenter %q: %v", datacenterName, err)
+	}
+	if datacenter.Spec.VSphere == nil {
+		return nil, "", "", errors.NewNotFound("cloud spec (dc) for ", datacenterName)
+	}
+
+	username, password, err := vsphere.GetCredentialsForCluster(cluster.Spec.Cloud, secretKeySelector, datacenter.Spec.VSphere)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return datacenter.Spec.VSphere, username, password, nil
+}
+
+// VSphereReq represent a request for vsphere datastores, datastore clusters, resource pools and storage policies
+// swagger:parameters listVSphereDatastores listVSphereDatastoreClusters listVSphereResourcePools listVSphereStoragePolicies
+type VSphereReq struct {
+	// in: header
+	Username string
+	// in: header
+	Password string
+	// in: header
+	DatacenterName string
+	// in: header
+	// Credential predefined Kubermatic credential name from the presets
+	Credential string
+}
+
+func DecodeVSphereReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req VSphereReq
+
+	req.Username = r.Header.Get("Username")
+	req.Password = r.Header.Get("Password")
+	req.DatacenterName = r.Header.Get("DatacenterName")
+	req.Credential = r.Header.Get("Credential")
+
+	return req, nil
+}
+
+// VSphereNoCredentialsReq represent a request for vsphere datastores, datastore clusters, resource pools and storage policies
+// swagger:parameters listVSphereDatastoresNoCredentials listVSphereDatastoreClustersNoCredentials listVSphereResourcePoolsNoCredentials listVSphereStoragePoliciesNoCredentials
+type VSphereNoCredentialsReq struct {
+	common.GetClusterReq
+}
+
+func DecodeVSphereNoCredentialsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req VSphereNoCredentialsReq
+	lr, err := common.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = lr.(common.GetClusterReq)
+	return req, nil
+}
@@ -75,6 +75,21 @@ func TestVsphereEndpoint(t *testing.T) {
 			URL:              "/api/v1/providers/vsphere/folders",
 			ExpectedResponse: `[{"path":"/ha-datacenter/vm"}]`,
 		},
+		{
+			Name:             "test datastores endpoint",
+			URL:              "/api/v1/providers/vsphere/datastores",
+			ExpectedResponse: `[{"name":"LocalDS_0","path":"/ha-datacenter/datastore/LocalDS_0"}]`,
+		},
+		{
+			Name:             "test datastore clusters endpoint",
+			URL:              "/api/v1/providers/vsphere/datastoreclusters",
+			ExpectedResponse: `[]`,
+		},
+		{
+			Name:             "test resource pools endpoint",
+			URL:              "/api/v1/providers/vsphere/resourcepools",
+			ExpectedResponse: `[{"name":"Resources","path":"/ha-datacenter/host/localhost.localdomain/Resources"}]`,
+		},
 	}
 
 	mock := &vSphereMock{}
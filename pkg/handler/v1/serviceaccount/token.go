@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 	"unicode/utf8"
 
 	"github.com/go-kit/kit/endpoint"
@@ -31,6 +32,7 @@ import (
 	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/serviceaccount"
 	"k8c.io/kubermatic/v2/pkg/util/errors"
 
@@ -40,8 +42,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
+// defaultTokenRotationOverlap is used when the admin didn't configure a rotation overlap
+const defaultTokenRotationOverlap = 24 * time.Hour
+
 // CreateTokenEndpoint creates a token for the given service account
-func CreateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, tokenAuthenticator serviceaccount.TokenAuthenticator, tokenGenerator serviceaccount.TokenGenerator, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func CreateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, settingsProvider provider.SettingsProvider, tokenAuthenticator serviceaccount.TokenAuthenticator, tokenGenerator serviceaccount.TokenGenerator, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(addTokenReq)
 		err := req.Validate()
@@ -67,9 +72,18 @@ func CreateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedPro
 			return nil, errors.NewAlreadyExists("token", req.Body.Name)
 		}
 
+		settings, err := settingsProvider.GetGlobalSettings()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		expiry, err := getTokenExpiry(settings, req.Body.Expiry.Time)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+
 		tokenID := rand.String(10)
 
-		token, err := tokenGenerator.Generate(serviceaccount.Claims(sa.Spec.Email, project.Name, tokenID))
+		token, err := tokenGenerator.Generate(serviceaccount.ClaimsWithExpiry(sa.Spec.Email, project.Name, tokenID, expiry))
 		if err != nil {
 			return nil, errors.New(http.StatusInternalServerError, "can not generate token data")
 		}
@@ -180,7 +194,7 @@ func ListTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProje
 }
 
 // UpdateTokenEndpoint updates and regenerates the token for the given service account
-func UpdateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, tokenAuthenticator serviceaccount.TokenAuthenticator, tokenGenerator serviceaccount.TokenGenerator, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func UpdateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, settingsProvider provider.SettingsProvider, tokenAuthenticator serviceaccount.TokenAuthenticator, tokenGenerator serviceaccount.TokenGenerator, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(updateTokenReq)
 		err := req.Validate()
@@ -188,7 +202,16 @@ func UpdateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedPro
 			return nil, errors.NewBadRequest(err.Error())
 		}
 
-		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, req.Body.Name, true)
+		settings, err := settingsProvider.GetGlobalSettings()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		expiry, err := getTokenExpiry(settings, time.Time{})
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+
+		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, req.Body.Name, true, expiry)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -219,7 +242,7 @@ func PatchTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProj
 			return nil, errors.NewBadRequest("new name can not be empty")
 		}
 
-		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, tokenReq.Name, false)
+		secret, err := updateEndpoint(ctx, projectProvider, privilegedProjectProvider, serviceAccountProvider, privilegedServiceAccount, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, userInfoGetter, tokenGenerator, req.ProjectID, req.ServiceAccountID, req.TokenID, tokenReq.Name, false, time.Time{})
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -233,6 +256,100 @@ func PatchTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProj
 	}
 }
 
+// RotateTokenEndpoint issues a new token for the given service account token.
+// The previous token stays valid for the rotation overlap period configured by the admin.
+func RotateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, settingsProvider provider.SettingsProvider, tokenAuthenticator serviceaccount.TokenAuthenticator, tokenGenerator serviceaccount.TokenGenerator, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(rotateTokenReq)
+		err := req.Validate()
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		sa, err := getSA(ctx, serviceAccountProvider, privilegedServiceAccount, userInfoGetter, project, req.ServiceAccountID, &provider.ServiceAccountGetOptions{RemovePrefix: false})
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		existingSecret, err := getSAToken(ctx, userInfoGetter, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, req.ProjectID, req.TokenID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		existingToken, ok := existingSecret.Data["token"]
+		if !ok {
+			return nil, errors.New(http.StatusInternalServerError, fmt.Sprintf("can not find token data in secret %s", existingSecret.Name))
+		}
+		// expired tokens can be rotated as well, so they only need a valid signature
+		publicClaim, _, err := tokenAuthenticator.ParseClaims(string(existingToken))
+		if err != nil {
+			return nil, errors.NewBadRequest("the token %s can not be rotated: %v", existingSecret.Name, err)
+		}
+
+		settings, err := settingsProvider.GetGlobalSettings()
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		// the new token gets the same lifetime as the one it replaces, bounded by the current maximum
+		lifetime := publicClaim.Expiry.Time().Sub(publicClaim.IssuedAt.Time())
+		if maxLifetime := settings.Spec.ServiceAccountTokenOptions.MaxLifetime.Duration; maxLifetime > 0 && lifetime > maxLifetime {
+			lifetime = maxLifetime
+		}
+		expiry := serviceaccount.Now().Add(lifetime)
+
+		token, err := tokenGenerator.Generate(serviceaccount.ClaimsWithExpiry(sa.Spec.Email, project.Name, existingSecret.Name, expiry))
+		if err != nil {
+			return nil, errors.New(http.StatusInternalServerError, "can not generate token data")
+		}
+
+		overlap := defaultTokenRotationOverlap
+		if rotationOverlap := settings.Spec.ServiceAccountTokenOptions.RotationOverlap; rotationOverlap != nil {
+			overlap = rotationOverlap.Duration
+		}
+		kubernetesprovider.RotateToken(existingSecret, token, overlap, serviceaccount.Now())
+
+		secret, err := updateSAToken(ctx, userInfoGetter, serviceAccountTokenProvider, privilegedServiceAccountTokenProvider, existingSecret, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		externalToken, err := convertInternalTokenToPrivateExternal(secret, tokenAuthenticator)
+		if err != nil {
+			return nil, errors.New(http.StatusInternalServerError, err.Error())
+		}
+
+		return externalToken, nil
+	}
+}
+
+// getTokenExpiry returns the expiry for a token that is issued now. The requested expiry is used if set,
+// otherwise the token gets the default lifetime. In both cases the expiry is bounded by the maximum
+// lifetime configured in the global settings.
+func getTokenExpiry(settings *kubermaticapiv1.KubermaticSetting, requested time.Time) (time.Time, error) {
+	now := serviceaccount.Now()
+	maxLifetime := settings.Spec.ServiceAccountTokenOptions.MaxLifetime.Duration
+
+	if requested.IsZero() {
+		expiry := serviceaccount.DefaultExpiry()
+		if maxLifetime > 0 && expiry.After(now.Add(maxLifetime)) {
+			expiry = now.Add(maxLifetime)
+		}
+		return expiry, nil
+	}
+
+	if !requested.After(now) {
+		return time.Time{}, fmt.Errorf("the token expiry must be in the future")
+	}
+	if maxLifetime > 0 && requested.After(now.Add(maxLifetime)) {
+		return time.Time{}, fmt.Errorf("the token expiry exceeds the maximum token lifetime of %v", maxLifetime)
+	}
+	return requested, nil
+}
+
 // DeleteTokenEndpoint deletes the token from service account
 func DeleteTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider, privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -293,7 +410,7 @@ func getSAToken(ctx context.Context, userInfoGetter provider.UserInfoGetter, ser
 
 func updateEndpoint(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, serviceAccountProvider provider.ServiceAccountProvider,
 	privilegedServiceAccount provider.PrivilegedServiceAccountProvider, serviceAccountTokenProvider provider.ServiceAccountTokenProvider, privilegedServiceAccountTokenProvider provider.PrivilegedServiceAccountTokenProvider, userInfoGetter provider.UserInfoGetter, tokenGenerator serviceaccount.TokenGenerator,
-	projectID, saID, tokenID, newName string, regenerateToken bool) (*v1.Secret, error) {
+	projectID, saID, tokenID, newName string, regenerateToken bool, expiry time.Time) (*v1.Secret, error) {
 
 	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
 	if err != nil {
@@ -331,7 +448,7 @@ func updateEndpoint(ctx context.Context, projectProvider provider.ProjectProvide
 	}
 
 	if regenerateToken {
-		token, err := tokenGenerator.Generate(serviceaccount.Claims(sa.Spec.Email, project.Name, existingSecret.Name))
+		token, err := tokenGenerator.Generate(serviceaccount.ClaimsWithExpiry(sa.Spec.Email, project.Name, existingSecret.Name, expiry))
 		if err != nil {
 			return nil, fmt.Errorf("can not generate token data")
 		}
@@ -402,6 +519,13 @@ type patchTokenReq struct {
 	Body []byte
 }
 
+// rotateTokenReq defines HTTP request for rotateServiceAccountToken
+// swagger:parameters rotateServiceAccountToken
+type rotateTokenReq struct {
+	commonTokenReq
+	tokenIDReq
+}
+
 // deleteTokenReq defines HTTP request for deleteServiceAccountToken
 // swagger:parameters deleteServiceAccountToken
 type deleteTokenReq struct {
@@ -463,6 +587,18 @@ func (r patchTokenReq) Validate() error {
 	return nil
 }
 
+// Validate validates rotateTokenReq request
+func (r rotateTokenReq) Validate() error {
+	if err := r.commonTokenReq.Validate(); err != nil {
+		return err
+	}
+	if len(r.TokenID) == 0 {
+		return fmt.Errorf("token ID cannot be empty")
+	}
+
+	return nil
+}
+
 // Validate validates updateTokenReq request
 func (r deleteTokenReq) Validate() error {
 	if err := r.commonTokenReq.Validate(); err != nil {
@@ -567,6 +703,28 @@ func DecodePatchTokenReq(c context.Context, r *http.Request) (interface{}, error
 	return req, nil
 }
 
+// DecodeRotateTokenReq  decodes an HTTP request into rotateTokenReq
+func DecodeRotateTokenReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req rotateTokenReq
+
+	rawReq, err := DecodeTokenReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	tokenReq := rawReq.(commonTokenReq)
+	req.ServiceAccountID = tokenReq.ServiceAccountID
+	req.ProjectID = tokenReq.ProjectID
+
+	tokenID, err := decodeTokenIDReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.TokenID = tokenID.TokenID
+
+	return req, nil
+}
+
 // DecodeDeleteTokenReq  decodes an HTTP request into deleteTokenReq
 func DecodeDeleteTokenReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req deleteTokenReq
@@ -637,5 +795,9 @@ func convertInternalTokenToPublicExternal(internal *v1.Secret, authenticator ser
 	externalToken.Name = name
 
 	externalToken.CreationTimestamp = apiv1.NewTime(internal.CreationTimestamp.Time)
+	if lastUsed, ok := kubernetesprovider.GetTokenLastUsed(internal); ok {
+		lastUsedTime := apiv1.NewTime(lastUsed)
+		externalToken.LastUsed = &lastUsedTime
+	}
 	return externalToken, nil
 }
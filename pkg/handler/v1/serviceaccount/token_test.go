@@ -24,11 +24,16 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"gopkg.in/square/go-jose.v2/jwt"
 
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler/test"
 	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/serviceaccount"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -123,6 +128,48 @@ func TestCreateTokenProject(t *testing.T) {
 			saToSync:               "1",
 			expectedName:           "test",
 		},
+		{
+			name:       "scenario 4: the token expiry can not exceed the maximum token lifetime",
+			body:       `{"name":"test","expiry":"2100-01-01T00:00:00Z"}`,
+			httpStatus: http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenServiceAccount("1", "test-1", "editors", "plan9-ID"),
+				/*add settings*/
+				genTokenSettings(30*24*time.Hour, nil),
+			},
+			existingKubernetesObjs: []runtime.Object{},
+			existingAPIUser:        *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:          "plan9-ID",
+			saToSync:               "1",
+			expectedErrorResponse:  `{"error":{"code":400,"message":"the token expiry exceeds the maximum token lifetime of 720h0m0s"}}`,
+		},
+		{
+			name:       "scenario 5: the token expiry can not be in the past",
+			body:       `{"name":"test","expiry":"2000-01-01T00:00:00Z"}`,
+			httpStatus: http.StatusBadRequest,
+			existingKubermaticObjs: []runtime.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenServiceAccount("1", "test-1", "editors", "plan9-ID"),
+			},
+			existingKubernetesObjs: []runtime.Object{},
+			existingAPIUser:        *test.GenAPIUser("john", "john@acme.com"),
+			projectToSync:          "plan9-ID",
+			saToSync:               "1",
+			expectedErrorResponse:  `{"error":{"code":400,"message":"the token expiry must be in the future"}}`,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -795,6 +842,158 @@ func TestDeleteToken(t *testing.T) {
 	}
 }
 
+func TestRotateToken(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                   string
+		tokenAge               time.Duration
+		tokenLifetime          time.Duration
+		maxLifetime            time.Duration
+		rotationOverlap        *metav1.Duration
+		expectedLifetime       time.Duration
+		expectedOverlap        time.Duration
+		existingKubermaticObjs []runtime.Object
+		existingAPIUser        apiv1.User
+	}{
+		{
+			name:             "scenario 1: the rotated token keeps its lifetime",
+			tokenLifetime:    365 * 24 * time.Hour,
+			rotationOverlap:  &metav1.Duration{Duration: time.Hour},
+			expectedLifetime: 365 * 24 * time.Hour,
+			expectedOverlap:  time.Hour,
+			existingAPIUser:  *test.GenAPIUser("john", "john@acme.com"),
+		},
+		{
+			name:             "scenario 2: the lifetime of the rotated token is bounded by the maximum lifetime",
+			tokenLifetime:    365 * 24 * time.Hour,
+			maxLifetime:      30 * 24 * time.Hour,
+			rotationOverlap:  &metav1.Duration{Duration: time.Hour},
+			expectedLifetime: 30 * 24 * time.Hour,
+			expectedOverlap:  time.Hour,
+			existingAPIUser:  *test.GenAPIUser("john", "john@acme.com"),
+		},
+		{
+			name:             "scenario 3: the admin can rotate any token",
+			tokenLifetime:    365 * 24 * time.Hour,
+			rotationOverlap:  &metav1.Duration{Duration: time.Hour},
+			expectedLifetime: 365 * 24 * time.Hour,
+			expectedOverlap:  time.Hour,
+			existingKubermaticObjs: []runtime.Object{
+				genUser("bob", "bob@acme.com", true),
+			},
+			existingAPIUser: *test.GenAPIUser("bob", "bob@acme.com"),
+		},
+		{
+			name:             "scenario 4: an expired token can be rotated",
+			tokenAge:         60 * 24 * time.Hour,
+			tokenLifetime:    30 * 24 * time.Hour,
+			rotationOverlap:  &metav1.Duration{Duration: time.Hour},
+			expectedLifetime: 30 * 24 * time.Hour,
+			expectedOverlap:  time.Hour,
+			existingAPIUser:  *test.GenAPIUser("john", "john@acme.com"),
+		},
+		{
+			name:             "scenario 5: the previous token is accepted for 24 hours if no overlap is configured",
+			tokenLifetime:    365 * 24 * time.Hour,
+			expectedLifetime: 365 * 24 * time.Hour,
+			expectedOverlap:  24 * time.Hour,
+			existingAPIUser:  *test.GenAPIUser("john", "john@acme.com"),
+		},
+		{
+			name:             "scenario 6: the previous token is rejected right away if the overlap is zero",
+			tokenLifetime:    365 * 24 * time.Hour,
+			rotationOverlap:  &metav1.Duration{},
+			expectedLifetime: 365 * 24 * time.Hour,
+			existingAPIUser:  *test.GenAPIUser("john", "john@acme.com"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			tokenGenerator, err := serviceaccount.JWTTokenGenerator([]byte(test.TestServiceAccountHashKey))
+			if err != nil {
+				t.Fatal(err)
+			}
+			issuedAt := serviceaccount.Now().Add(-tc.tokenAge)
+			claims, customClaims := serviceaccount.ClaimsWithExpiry("serviceaccount-1@sa.kubermatic.io", "plan9-ID", "sa-token-1", issuedAt.Add(tc.tokenLifetime))
+			claims.IssuedAt = jwt.NewNumericDate(issuedAt)
+			claims.NotBefore = jwt.NewNumericDate(issuedAt)
+			oldToken, err := tokenGenerator.Generate(claims, customClaims)
+			if err != nil {
+				t.Fatal(err)
+			}
+			existingToken := test.GenDefaultSaToken("plan9-ID", "serviceaccount-1", "test-1", "1")
+			existingToken.Data["token"] = []byte(oldToken)
+
+			kubermaticObjs := []runtime.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "serviceaccount-1@sa.kubermatic.io", "editors"),
+				/*add users*/
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenServiceAccount("1", "test-1", "editors", "plan9-ID"),
+				/*add settings*/
+				genTokenSettings(tc.maxLifetime, tc.rotationOverlap),
+			}
+			kubermaticObjs = append(kubermaticObjs, tc.existingKubermaticObjs...)
+
+			req := httptest.NewRequest("POST", "/api/v1/projects/plan9-ID/serviceaccounts/1/tokens/1/rotate", nil)
+			res := httptest.NewRecorder()
+
+			ep, fakeClients, err := test.CreateTestEndpointAndGetClients(tc.existingAPIUser, nil, []runtime.Object{existingToken}, []runtime.Object{}, kubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusOK {
+				t.Fatalf("expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+			}
+
+			var saToken apiv1.ServiceAccountToken
+			if err := json.Unmarshal(res.Body.Bytes(), &saToken); err != nil {
+				t.Fatal(err)
+			}
+			if saToken.Token == oldToken {
+				t.Fatal("expected a new token to be issued")
+			}
+			publicClaim, _, err := fakeClients.TokenAuthenticator.Authenticate(saToken.Token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the claims have a precision of one second
+			lifetime := publicClaim.Expiry.Time().Sub(publicClaim.IssuedAt.Time())
+			if diff := lifetime - tc.expectedLifetime; diff < -time.Second || diff > time.Second {
+				t.Fatalf("expected token lifetime %v got %v", tc.expectedLifetime, lifetime)
+			}
+
+			secret := &corev1.Secret{}
+			if err := fakeClients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Namespace: "kubermatic", Name: "sa-token-1"}, secret); err != nil {
+				t.Fatal(err)
+			}
+			if tc.expectedOverlap > 0 && !kubernetesprovider.IsTokenAccepted(secret, oldToken, serviceaccount.Now().Add(tc.expectedOverlap-time.Minute)) {
+				t.Fatal("expected the previous token to be accepted during the overlap period")
+			}
+			if kubernetesprovider.IsTokenAccepted(secret, oldToken, serviceaccount.Now().Add(tc.expectedOverlap+time.Minute)) {
+				t.Fatal("expected the previous token to be rejected after the overlap period")
+			}
+		})
+	}
+}
+
+func genTokenSettings(maxLifetime time.Duration, rotationOverlap *metav1.Duration) *kubermaticapiv1.KubermaticSetting {
+	settings := test.GenDefaultSettings()
+	settings.Spec.ServiceAccountTokenOptions = kubermaticapiv1.ServiceAccountTokenOptions{
+		MaxLifetime:     metav1.Duration{Duration: maxLifetime},
+		RotationOverlap: rotationOverlap,
+	}
+	return settings
+}
+
 func genPublicServiceAccountToken(id, name string, expiry apiv1.Time) apiv1.PublicServiceAccountToken {
 	token := apiv1.PublicServiceAccountToken{}
 	token.ID = id
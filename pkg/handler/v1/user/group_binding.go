@@ -0,0 +1,254 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// ListGroupBindingsEndpoint returns the groups of the identity provider bound to the given project
+func ListGroupBindingsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, groupBindingProvider provider.GroupProjectBindingProvider, privilegedGroupBindingProvider provider.PrivilegedGroupProjectBindingProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(common.GetProjectRq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if len(req.ProjectID) == 0 {
+			return nil, k8cerrors.NewBadRequest("the name of the project cannot be empty")
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		groupBindings, err := getGroupBindingList(ctx, userInfoGetter, groupBindingProvider, privilegedGroupBindingProvider, project)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		externalGroupBindings := []*apiv1.GroupProjectBinding{}
+		for _, groupBinding := range groupBindings {
+			externalGroupBindings = append(externalGroupBindings, convertInternalGroupBindingToExternal(groupBinding))
+		}
+
+		return externalGroupBindings, nil
+	}
+}
+
+// AddGroupBindingEndpoint binds the given group of the identity provider to the given role within the given project
+func AddGroupBindingEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, groupBindingProvider provider.GroupProjectBindingProvider, privilegedGroupBindingProvider provider.PrivilegedGroupProjectBindingProvider, userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(AddGroupBindingReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := req.Validate(userInfo, projectRoleProvider); err != nil {
+			return nil, err
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		groupBindings, err := getGroupBindingList(ctx, userInfoGetter, groupBindingProvider, privilegedGroupBindingProvider, project)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		for _, groupBinding := range groupBindings {
+			if groupBinding.Spec.Group == req.Body.Spec.Group {
+				return nil, k8cerrors.NewBadRequest("cannot bind the group = %s to the project %s because the group is already bound to the project", req.Body.Spec.Group, req.ProjectID)
+			}
+		}
+
+		var groupBinding *kubermaticapiv1.GroupProjectBinding
+		if userInfo.IsAdmin {
+			groupBinding, err = privilegedGroupBindingProvider.CreateUnsecured(project, req.Body.Spec.Group, req.Body.Spec.Role)
+		} else {
+			userInfo, err = userInfoGetter(ctx, project.Name)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			groupBinding, err = groupBindingProvider.Create(userInfo, project, req.Body.Spec.Group, req.Body.Spec.Role)
+		}
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return convertInternalGroupBindingToExternal(groupBinding), nil
+	}
+}
+
+// DeleteGroupBindingEndpoint removes the given group binding from the given project
+func DeleteGroupBindingEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, groupBindingProvider provider.GroupProjectBindingProvider, privilegedGroupBindingProvider provider.PrivilegedGroupProjectBindingProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(DeleteGroupBindingReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		groupBindings, err := getGroupBindingList(ctx, userInfoGetter, groupBindingProvider, privilegedGroupBindingProvider, project)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		found := false
+		for _, groupBinding := range groupBindings {
+			if groupBinding.Name == req.BindingName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, k8cerrors.NewNotFound("GroupProjectBinding", req.BindingName)
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if userInfo.IsAdmin {
+			err = privilegedGroupBindingProvider.DeleteUnsecured(req.BindingName)
+		} else {
+			userInfo, err = userInfoGetter(ctx, project.Name)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			err = groupBindingProvider.Delete(userInfo, req.BindingName)
+		}
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+func getGroupBindingList(ctx context.Context, userInfoGetter provider.UserInfoGetter, groupBindingProvider provider.GroupProjectBindingProvider, privilegedGroupBindingProvider provider.PrivilegedGroupProjectBindingProvider, project *kubermaticapiv1.Project) ([]*kubermaticapiv1.GroupProjectBinding, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if userInfo.IsAdmin {
+		return privilegedGroupBindingProvider.ListUnsecured(project)
+	}
+
+	userInfo, err = userInfoGetter(ctx, project.Name)
+	if err != nil {
+		return nil, err
+	}
+	return groupBindingProvider.List(userInfo, project)
+}
+
+func convertInternalGroupBindingToExternal(groupBinding *kubermaticapiv1.GroupProjectBinding) *apiv1.GroupProjectBinding {
+	return &apiv1.GroupProjectBinding{
+		ObjectMeta: apiv1.ObjectMeta{
+			ID:                groupBinding.Name,
+			Name:              groupBinding.Name,
+			CreationTimestamp: apiv1.NewTime(groupBinding.CreationTimestamp.Time),
+		},
+		Spec: groupBinding.Spec,
+	}
+}
+
+// AddGroupBindingReq defines HTTP request for addGroupBindingToProject
+// swagger:parameters addGroupBindingToProject
+type AddGroupBindingReq struct {
+	common.ProjectReq
+	// in: body
+	Body apiv1.GroupProjectBinding
+}
+
+// Validate validates AddGroupBindingReq request
+func (r AddGroupBindingReq) Validate(authenticatesUserInfo *provider.UserInfo, projectRoleProvider provider.ProjectRoleProvider) error {
+	if len(r.ProjectID) == 0 {
+		return k8cerrors.NewBadRequest("the name of the project cannot be empty")
+	}
+	if len(r.Body.Spec.Group) == 0 || len(r.Body.Spec.Role) == 0 {
+		return k8cerrors.NewBadRequest("both the group and the role fields are required")
+	}
+	if len(r.Body.Spec.ProjectID) > 0 && r.Body.Spec.ProjectID != r.ProjectID {
+		return k8cerrors.New(http.StatusForbidden, fmt.Sprintf("you can only bind the group to %s project", r.ProjectID))
+	}
+	return validateGroupPrefix(authenticatesUserInfo, projectRoleProvider, r.Body.Spec.Role)
+}
+
+// DecodeAddGroupBindingReq decodes an HTTP request into AddGroupBindingReq
+func DecodeAddGroupBindingReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req AddGroupBindingReq
+
+	prjReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = prjReq.(common.ProjectReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, k8cerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
+
+// DeleteGroupBindingReq defines HTTP request for deleteGroupBindingFromProject
+// swagger:parameters deleteGroupBindingFromProject
+type DeleteGroupBindingReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	BindingName string `json:"binding_name"`
+}
+
+// DecodeDeleteGroupBindingReq decodes an HTTP request into DeleteGroupBindingReq
+func DecodeDeleteGroupBindingReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req DeleteGroupBindingReq
+
+	prjReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = prjReq.(common.ProjectReq)
+
+	bindingName, ok := mux.Vars(r)["binding_name"]
+	if !ok {
+		return nil, fmt.Errorf("'binding_name' parameter is required")
+	}
+	req.BindingName = bindingName
+
+	return req, nil
+}
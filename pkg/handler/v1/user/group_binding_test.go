@@ -0,0 +1,258 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetGroupBindingsForProject(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		ExpectedResponse       string
+		ProjectToSync          string
+		HTTPStatus             int
+		ExistingAPIUser        apiv1.User
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:          "scenario 1: john the owner of the plan9 project gets the groups bound to the project",
+			HTTPStatus:    http.StatusOK,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				/*add projects*/
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				/*add bindings*/
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genGroupBinding("developersBinding", "plan9-ID", "developers", "editors"),
+				genGroupBinding("auditorsBinding", "planX-ID", "auditors", "viewers"),
+				/*add users*/
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `[{"id":"developersBinding","name":"developersBinding","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"group":"developers","projectId":"plan9-ID","role":"editors"}}]`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", fmt.Sprintf("/api/v1/projects/%s/groupbindings", tc.ProjectToSync), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(tc.ExistingAPIUser, nil, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestAddGroupBindingToProject(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		Body                   string
+		ExpectedResponse       string
+		ExpectedSpec           *kubermaticapiv1.GroupProjectBindingSpec
+		ProjectToSync          string
+		HTTPStatus             int
+		ExistingAPIUser        apiv1.User
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:          "scenario 1: john the owner of the plan9 project binds the developers group as editors",
+			Body:          `{"spec":{"group":"developers","role":"editors"}}`,
+			HTTPStatus:    http.StatusCreated,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser: *genAPIUser("john", "john@acme.com"),
+			ExpectedSpec:    &kubermaticapiv1.GroupProjectBindingSpec{Group: "developers", ProjectID: "plan9-ID", Role: "editors"},
+		},
+		{
+			Name:          "scenario 2: the group can't be bound to a role that doesn't exist",
+			Body:          `{"spec":{"group":"developers","role":"operators"}}`,
+			HTTPStatus:    http.StatusBadRequest,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid group name operators"}}`,
+		},
+		{
+			Name:          "scenario 3: the group can't be bound twice to the same project",
+			Body:          `{"spec":{"group":"developers","role":"viewers"}}`,
+			HTTPStatus:    http.StatusBadRequest,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genGroupBinding("developersBinding", "plan9-ID", "developers", "editors"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":400,"message":"cannot bind the group = developers to the project plan9-ID because the group is already bound to the project"}}`,
+		},
+		{
+			Name:          "scenario 4: the group and the role are required",
+			Body:          `{"spec":{"role":"viewers"}}`,
+			HTTPStatus:    http.StatusBadRequest,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":400,"message":"both the group and the role fields are required"}}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/v1/projects/%s/groupbindings", tc.ProjectToSync), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(tc.ExistingAPIUser, nil, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedSpec == nil {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+			// the name of the binding is generated
+			groupBinding := &apiv1.GroupProjectBinding{}
+			if err := json.Unmarshal(res.Body.Bytes(), groupBinding); err != nil {
+				t.Fatal(err)
+			}
+			if !equality.Semantic.DeepEqual(groupBinding.Spec, *tc.ExpectedSpec) {
+				t.Fatalf("unexpected group binding returned, expected %v, got %v", *tc.ExpectedSpec, groupBinding.Spec)
+			}
+		})
+	}
+}
+
+func TestDeleteGroupBindingFromProject(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		ExpectedResponse       string
+		ProjectToSync          string
+		BindingToDelete        string
+		HTTPStatus             int
+		ExistingAPIUser        apiv1.User
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:            "scenario 1: john the owner of the plan9 project removes the developers group from the project",
+			HTTPStatus:      http.StatusOK,
+			ProjectToSync:   "plan9-ID",
+			BindingToDelete: "developersBinding",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genGroupBinding("developersBinding", "plan9-ID", "developers", "editors"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{}`,
+		},
+		{
+			Name:            "scenario 2: john can't remove the binding of a different project",
+			HTTPStatus:      http.StatusNotFound,
+			ProjectToSync:   "plan9-ID",
+			BindingToDelete: "auditorsBinding",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genGroupBinding("developersBinding", "plan9-ID", "developers", "editors"),
+				genGroupBinding("auditorsBinding", "planX-ID", "auditors", "viewers"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":404,"message":"GroupProjectBinding \"auditorsBinding\" not found"}}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/v1/projects/%s/groupbindings/%s", tc.ProjectToSync, tc.BindingToDelete), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(tc.ExistingAPIUser, nil, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func genGroupBinding(name, projectID, group, role string) *kubermaticapiv1.GroupProjectBinding {
+	return &kubermaticapiv1.GroupProjectBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticapiv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticapiv1.ProjectKindName,
+					Name:       projectID,
+				},
+			},
+		},
+		Spec: kubermaticapiv1.GroupProjectBindingSpec{
+			Group:     group,
+			ProjectID: projectID,
+			Role:      role,
+		},
+	}
+}
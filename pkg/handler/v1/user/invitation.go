@@ -0,0 +1,443 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/middleware"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultInvitationTTL is the lifetime of an invitation when no expiry date was requested
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// CreateInvitationEndpoint invites the given user to the given group within the given project
+func CreateInvitationEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, memberProvider provider.ProjectMemberProvider, invitationProvider provider.ProjectInvitationProvider, privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider, invitationNotifier provider.InvitationNotifier, userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(CreateInvitationReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := req.Validate(userInfo, projectRoleProvider); err != nil {
+			return nil, err
+		}
+		expiresAt := time.Now().Add(defaultInvitationTTL)
+		if !req.Body.ExpiresAt.IsZero() {
+			expiresAt = req.Body.ExpiresAt.Time
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		memberList, err := getMemberList(ctx, userInfoGetter, memberProvider, project, req.Body.Email)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if len(memberList) > 0 {
+			return nil, k8cerrors.NewBadRequest("cannot invite the user = %s to the project %s because user is already in the project", req.Body.Email, req.ProjectID)
+		}
+
+		invitations, err := getInvitationList(ctx, userInfoGetter, invitationProvider, privilegedInvitationProvider, project)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		for _, invitation := range invitations {
+			if strings.EqualFold(invitation.Spec.UserEmail, req.Body.Email) && !invitation.IsExpired(metav1.Now()) {
+				return nil, k8cerrors.New(http.StatusConflict, fmt.Sprintf("the user = %s has already been invited to the project %s", req.Body.Email, req.ProjectID))
+			}
+		}
+
+		group := rbac.GenerateActualGroupNameFor(project.Name, req.Body.Group)
+		var invitation *kubermaticapiv1.ProjectInvitation
+		if userInfo.IsAdmin {
+			invitation, err = privilegedInvitationProvider.CreateUnsecured(project, req.Body.Email, group, userInfo.Email, expiresAt)
+		} else {
+			userInfo, err = userInfoGetter(ctx, project.Name)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			invitation, err = invitationProvider.Create(userInfo, project, req.Body.Email, group, expiresAt)
+		}
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		// the invitation stays valid even if the notification couldn't be delivered,
+		// the invitee can still find it under /me/invitations
+		if invitationNotifier != nil {
+			if err := invitationNotifier.NotifyInvitation(invitation, project); err != nil {
+				kubermaticlog.Logger.Warnw("failed to send the invitation notification", "invitation", invitation.Name, "project", project.Name, "error", err)
+			}
+		}
+
+		return convertInternalInvitationToExternal(invitation, project), nil
+	}
+}
+
+// ListInvitationsEndpoint returns the pending invitations of the given project
+func ListInvitationsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, invitationProvider provider.ProjectInvitationProvider, privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(common.GetProjectRq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		if len(req.ProjectID) == 0 {
+			return nil, k8cerrors.NewBadRequest("the name of the project cannot be empty")
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		invitations, err := getInvitationList(ctx, userInfoGetter, invitationProvider, privilegedInvitationProvider, project)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		externalInvitations := []*apiv1.ProjectInvitation{}
+		for _, invitation := range invitations {
+			externalInvitations = append(externalInvitations, convertInternalInvitationToExternal(invitation, project))
+		}
+
+		return externalInvitations, nil
+	}
+}
+
+// RevokeInvitationEndpoint removes the given invitation from the given project
+func RevokeInvitationEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, invitationProvider provider.ProjectInvitationProvider, privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(RevokeInvitationReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+
+		project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		invitations, err := getInvitationList(ctx, userInfoGetter, invitationProvider, privilegedInvitationProvider, project)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		found := false
+		for _, invitation := range invitations {
+			if invitation.Name == req.InvitationID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, k8cerrors.NewNotFound("ProjectInvitation", req.InvitationID)
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if userInfo.IsAdmin {
+			err = privilegedInvitationProvider.DeleteUnsecured(req.InvitationID)
+		} else {
+			userInfo, err = userInfoGetter(ctx, project.Name)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			err = invitationProvider.Delete(userInfo, req.InvitationID)
+		}
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+// ListCurrentUserInvitationsEndpoint returns the pending invitations addressed to the current user
+func ListCurrentUserInvitationsEndpoint(privilegedProjectProvider provider.PrivilegedProjectProvider, privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		authenticatedUser := ctx.Value(middleware.UserCRContextKey).(*kubermaticapiv1.User)
+
+		invitations, err := privilegedInvitationProvider.ListForEmailUnsecured(authenticatedUser.Spec.Email)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		now := metav1.Now()
+		externalInvitations := []*apiv1.ProjectInvitation{}
+		for _, invitation := range invitations {
+			if invitation.IsExpired(now) {
+				continue
+			}
+			project, err := privilegedProjectProvider.GetUnsecured(invitation.Spec.ProjectID, nil)
+			if err != nil {
+				if kerrors.IsNotFound(err) {
+					continue
+				}
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			externalInvitations = append(externalInvitations, convertInternalInvitationToExternal(invitation, project))
+		}
+
+		return externalInvitations, nil
+	}
+}
+
+// AcceptInvitationEndpoint adds the current user to the project of the given invitation
+func AcceptInvitationEndpoint(privilegedProjectProvider provider.PrivilegedProjectProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider, memberMapper provider.ProjectMemberMapper) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(InvitationIDReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		authenticatedUser := ctx.Value(middleware.UserCRContextKey).(*kubermaticapiv1.User)
+
+		invitation, err := getCurrentUserInvitation(privilegedInvitationProvider, authenticatedUser, req.InvitationID)
+		if err != nil {
+			return nil, err
+		}
+		if invitation.IsExpired(metav1.Now()) {
+			if err := privilegedInvitationProvider.DeleteUnsecured(invitation.Name); err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			return nil, k8cerrors.New(http.StatusGone, fmt.Sprintf("the invitation %s has expired", invitation.Name))
+		}
+
+		project, err := privilegedProjectProvider.GetUnsecured(invitation.Spec.ProjectID, nil)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		bindings, err := memberMapper.MappingsFor(authenticatedUser.Spec.Email)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		for _, binding := range bindings {
+			if binding.Spec.ProjectID == project.Name {
+				if err := privilegedInvitationProvider.DeleteUnsecured(invitation.Name); err != nil {
+					return nil, common.KubernetesErrorToHTTPError(err)
+				}
+				return nil, k8cerrors.New(http.StatusConflict, fmt.Sprintf("you are already a member of the project %s", project.Name))
+			}
+		}
+
+		binding, err := privilegedMemberProvider.CreateUnsecured(project, authenticatedUser.Spec.Email, invitation.Spec.Group)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if err := privilegedInvitationProvider.DeleteUnsecured(invitation.Name); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		externalUser := apiv1.ConvertInternalUserToExternal(authenticatedUser, false, binding)
+		return filterExternalUser(externalUser, project.Name), nil
+	}
+}
+
+// DeclineInvitationEndpoint removes the given invitation of the current user
+func DeclineInvitationEndpoint(privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(InvitationIDReq)
+		if !ok {
+			return nil, k8cerrors.NewBadRequest("invalid request")
+		}
+		authenticatedUser := ctx.Value(middleware.UserCRContextKey).(*kubermaticapiv1.User)
+
+		invitation, err := getCurrentUserInvitation(privilegedInvitationProvider, authenticatedUser, req.InvitationID)
+		if err != nil {
+			return nil, err
+		}
+		if err := privilegedInvitationProvider.DeleteUnsecured(invitation.Name); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+
+		return nil, nil
+	}
+}
+
+// getCurrentUserInvitation gets the given invitation and makes sure it is addressed to the given user
+func getCurrentUserInvitation(privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider, user *kubermaticapiv1.User, invitationID string) (*kubermaticapiv1.ProjectInvitation, error) {
+	invitation, err := privilegedInvitationProvider.GetUnsecured(invitationID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if !strings.EqualFold(invitation.Spec.UserEmail, user.Spec.Email) {
+		return nil, k8cerrors.New(http.StatusForbidden, fmt.Sprintf("the invitation %s is not addressed to you", invitationID))
+	}
+	return invitation, nil
+}
+
+func getInvitationList(ctx context.Context, userInfoGetter provider.UserInfoGetter, invitationProvider provider.ProjectInvitationProvider, privilegedInvitationProvider provider.PrivilegedProjectInvitationProvider, project *kubermaticapiv1.Project) ([]*kubermaticapiv1.ProjectInvitation, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if userInfo.IsAdmin {
+		return privilegedInvitationProvider.ListUnsecured(project)
+	}
+
+	userInfo, err = userInfoGetter(ctx, project.Name)
+	if err != nil {
+		return nil, err
+	}
+	return invitationProvider.List(userInfo, project)
+}
+
+func convertInternalInvitationToExternal(invitation *kubermaticapiv1.ProjectInvitation, project *kubermaticapiv1.Project) *apiv1.ProjectInvitation {
+	return &apiv1.ProjectInvitation{
+		ObjectMeta: apiv1.ObjectMeta{
+			ID:                invitation.Name,
+			Name:              invitation.Name,
+			CreationTimestamp: apiv1.NewTime(invitation.CreationTimestamp.Time),
+		},
+		ProjectID:   invitation.Spec.ProjectID,
+		ProjectName: project.Spec.Name,
+		Email:       invitation.Spec.UserEmail,
+		Group:       rbac.ExtractGroupPrefix(invitation.Spec.Group),
+		InvitedBy:   invitation.Spec.InvitedBy,
+		ExpiresAt:   apiv1.NewTime(invitation.Spec.ExpiresAt.Time),
+	}
+}
+
+// CreateInvitationReq defines HTTP request for createProjectInvitation
+// swagger:parameters createProjectInvitation
+type CreateInvitationReq struct {
+	common.ProjectReq
+	// in: body
+	Body apiv1.ProjectInvitation
+}
+
+// Validate validates CreateInvitationReq request
+// The email is normalized to the bare address, e.g. "Bob <bob@acme.com>" becomes "bob@acme.com"
+func (r *CreateInvitationReq) Validate(authenticatesUserInfo *provider.UserInfo, projectRoleProvider provider.ProjectRoleProvider) error {
+	if len(r.ProjectID) == 0 {
+		return k8cerrors.NewBadRequest("the name of the project cannot be empty")
+	}
+	if len(r.Body.Email) == 0 || len(r.Body.Group) == 0 {
+		return k8cerrors.NewBadRequest("both the email and the group fields are required")
+	}
+	addr, err := mail.ParseAddress(r.Body.Email)
+	if err != nil {
+		return k8cerrors.NewBadRequest("incorrect email format: %v", err)
+	}
+	r.Body.Email = addr.Address
+	if len(r.Body.ProjectID) > 0 && r.Body.ProjectID != r.ProjectID {
+		return k8cerrors.New(http.StatusForbidden, fmt.Sprintf("you can only invite the user to %s project", r.ProjectID))
+	}
+	if strings.EqualFold(r.Body.Email, authenticatesUserInfo.Email) {
+		return k8cerrors.New(http.StatusForbidden, "you cannot invite yourself")
+	}
+	if !r.Body.ExpiresAt.IsZero() && r.Body.ExpiresAt.Time.Before(time.Now()) {
+		return k8cerrors.NewBadRequest("the expiry date of the invitation must be in the future")
+	}
+	return validateGroupPrefix(authenticatesUserInfo, projectRoleProvider, r.Body.Group)
+}
+
+// DecodeCreateInvitationReq decodes an HTTP request into CreateInvitationReq
+func DecodeCreateInvitationReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req CreateInvitationReq
+
+	prjReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = prjReq.(common.ProjectReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, k8cerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
+
+// RevokeInvitationReq defines HTTP request for revokeProjectInvitation
+// swagger:parameters revokeProjectInvitation
+type RevokeInvitationReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	InvitationID string `json:"invitation_id"`
+}
+
+// DecodeRevokeInvitationReq decodes an HTTP request into RevokeInvitationReq
+func DecodeRevokeInvitationReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req RevokeInvitationReq
+
+	prjReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = prjReq.(common.ProjectReq)
+
+	invitationID, err := decodeInvitationID(r)
+	if err != nil {
+		return nil, err
+	}
+	req.InvitationID = invitationID
+
+	return req, nil
+}
+
+// InvitationIDReq defines HTTP request for acceptInvitation and declineInvitation
+// swagger:parameters acceptInvitation declineInvitation
+type InvitationIDReq struct {
+	// in: path
+	// required: true
+	InvitationID string `json:"invitation_id"`
+}
+
+// DecodeInvitationIDReq decodes an HTTP request into InvitationIDReq
+func DecodeInvitationIDReq(c context.Context, r *http.Request) (interface{}, error) {
+	invitationID, err := decodeInvitationID(r)
+	if err != nil {
+		return nil, err
+	}
+	return InvitationIDReq{InvitationID: invitationID}, nil
+}
+
+func decodeInvitationID(r *http.Request) (string, error) {
+	invitationID, ok := mux.Vars(r)["invitation_id"]
+	if !ok {
+		return "", fmt.Errorf("'invitation_id' parameter is required")
+	}
+	return invitationID, nil
+}
@@ -0,0 +1,445 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	pendingInvitationExpiry = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiredInvitationExpiry = time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestCreateProjectInvitation(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		Body                   string
+		ExpectedResponse       string
+		ExpectedInvitation     *kubermaticapiv1.ProjectInvitationSpec
+		ProjectToSync          string
+		HTTPStatus             int
+		ExistingAPIUser        apiv1.User
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:          "scenario 1: john the owner of the plan9 project invites bob as an editor",
+			Body:          `{"email":"bob@acme.com","group":"editors","expiresAt":"2100-01-01T00:00:00Z"}`,
+			HTTPStatus:    http.StatusCreated,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser: *genAPIUser("john", "john@acme.com"),
+			ExpectedInvitation: &kubermaticapiv1.ProjectInvitationSpec{
+				ProjectID: "plan9-ID",
+				UserEmail: "bob@acme.com",
+				Group:     "editors-plan9-ID",
+				InvitedBy: "john@acme.com",
+				ExpiresAt: metav1.NewTime(pendingInvitationExpiry),
+			},
+		},
+		{
+			Name:          "scenario 2: a member of the project can't be invited",
+			Body:          `{"email":"bob@acme.com","group":"editors"}`,
+			HTTPStatus:    http.StatusBadRequest,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				test.GenBinding("plan9-ID", "bob@acme.com", "viewers"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":400,"message":"cannot invite the user = bob@acme.com to the project plan9-ID because user is already in the project"}}`,
+		},
+		{
+			Name:          "scenario 3: the user can't be invited twice to the same project",
+			Body:          `{"email":"bob@acme.com","group":"viewers"}`,
+			HTTPStatus:    http.StatusConflict,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":409,"message":"the user = bob@acme.com has already been invited to the project plan9-ID"}}`,
+		},
+		{
+			Name:          "scenario 4: the user can't be invited to a group that doesn't exist",
+			Body:          `{"email":"bob@acme.com","group":"operators"}`,
+			HTTPStatus:    http.StatusBadRequest,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":400,"message":"invalid group name operators"}}`,
+		},
+		{
+			Name:          "scenario 5: the expiry date must be in the future",
+			Body:          `{"email":"bob@acme.com","group":"editors","expiresAt":"2000-01-01T00:00:00Z"}`,
+			HTTPStatus:    http.StatusBadRequest,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser:  *genAPIUser("john", "john@acme.com"),
+			ExpectedResponse: `{"error":{"code":400,"message":"the expiry date of the invitation must be in the future"}}`,
+		},
+		{
+			Name:          "scenario 6: the invitation is stored with the bare email address",
+			Body:          `{"email":"Bob <bob@acme.com>","group":"editors","expiresAt":"2100-01-01T00:00:00Z"}`,
+			HTTPStatus:    http.StatusCreated,
+			ProjectToSync: "plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genUser("", "john", "john@acme.com"),
+			},
+			ExistingAPIUser: *genAPIUser("john", "john@acme.com"),
+			ExpectedInvitation: &kubermaticapiv1.ProjectInvitationSpec{
+				ProjectID: "plan9-ID",
+				UserEmail: "bob@acme.com",
+				Group:     "editors-plan9-ID",
+				InvitedBy: "john@acme.com",
+				ExpiresAt: metav1.NewTime(pendingInvitationExpiry),
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/v1/projects/%s/invitations", tc.ProjectToSync), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(tc.ExistingAPIUser, nil, nil, nil, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if tc.ExpectedInvitation == nil {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+				return
+			}
+
+			invitation := &apiv1.ProjectInvitation{}
+			if err := json.Unmarshal(res.Body.Bytes(), invitation); err != nil {
+				t.Fatalf("failed to decode the response: %v", err)
+			}
+			if invitation.Email != tc.ExpectedInvitation.UserEmail || invitation.Group != "editors" || invitation.ProjectName != "plan9" {
+				t.Fatalf("unexpected invitation in the response: %+v", invitation)
+			}
+
+			invitations := &kubermaticapiv1.ProjectInvitationList{}
+			if err := clients.FakeClient.List(context.Background(), invitations); err != nil {
+				t.Fatalf("failed to list the invitations: %v", err)
+			}
+			if len(invitations.Items) != 1 {
+				t.Fatalf("expected exactly one invitation, got %d", len(invitations.Items))
+			}
+			if spec := invitations.Items[0].Spec; spec.ProjectID != tc.ExpectedInvitation.ProjectID || spec.UserEmail != tc.ExpectedInvitation.UserEmail ||
+				spec.Group != tc.ExpectedInvitation.Group || spec.InvitedBy != tc.ExpectedInvitation.InvitedBy || !spec.ExpiresAt.Equal(&tc.ExpectedInvitation.ExpiresAt) {
+				t.Fatalf("expected the invitation %+v, got %+v", *tc.ExpectedInvitation, spec)
+			}
+		})
+	}
+}
+
+func TestListProjectInvitations(t *testing.T) {
+	t.Parallel()
+	existingKubermaticObjs := []runtime.Object{
+		test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+		test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+		genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+		genInvitation("aliceInvitation", "planX-ID", "alice@acme.com", "viewers", pendingInvitationExpiry),
+		genUser("", "john", "john@acme.com"),
+	}
+	expectedResponse := `[{"id":"bobInvitation","name":"bobInvitation","creationTimestamp":"0001-01-01T00:00:00Z","projectID":"plan9-ID","projectName":"plan9","email":"bob@acme.com","group":"editors","invitedBy":"john@acme.com","expiresAt":"2100-01-01T00:00:00Z"}]`
+
+	req := httptest.NewRequest("GET", "/api/v1/projects/plan9-ID/invitations", nil)
+	res := httptest.NewRecorder()
+	ep, err := test.CreateTestEndpoint(*genAPIUser("john", "john@acme.com"), nil, existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint due to %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	test.CompareWithResult(t, res, expectedResponse)
+}
+
+func TestRevokeProjectInvitation(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name               string
+		ExpectedResponse   string
+		InvitationToRevoke string
+		HTTPStatus         int
+	}{
+		{
+			Name:               "scenario 1: john the owner of the plan9 project revokes the invitation of bob",
+			InvitationToRevoke: "bobInvitation",
+			HTTPStatus:         http.StatusOK,
+			ExpectedResponse:   `{}`,
+		},
+		{
+			Name:               "scenario 2: john can't revoke the invitation to a different project",
+			InvitationToRevoke: "aliceInvitation",
+			HTTPStatus:         http.StatusNotFound,
+			ExpectedResponse:   `{"error":{"code":404,"message":"ProjectInvitation \"aliceInvitation\" not found"}}`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			existingKubermaticObjs := []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "john@acme.com", "owners"),
+				genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+				genInvitation("aliceInvitation", "planX-ID", "alice@acme.com", "viewers", pendingInvitationExpiry),
+				genUser("", "john", "john@acme.com"),
+			}
+			req := httptest.NewRequest("DELETE", fmt.Sprintf("/api/v1/projects/plan9-ID/invitations/%s", tc.InvitationToRevoke), nil)
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*genAPIUser("john", "john@acme.com"), nil, existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestListCurrentUserInvitations(t *testing.T) {
+	t.Parallel()
+	existingKubermaticObjs := []runtime.Object{
+		test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+		test.GenProject("planX", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+		genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+		genInvitation("bobExpiredInvitation", "planX-ID", "bob@acme.com", "viewers", expiredInvitationExpiry),
+		genInvitation("aliceInvitation", "planX-ID", "alice@acme.com", "viewers", pendingInvitationExpiry),
+		genUser("", "bob", "bob@acme.com"),
+	}
+	expectedResponse := `[{"id":"bobInvitation","name":"bobInvitation","creationTimestamp":"0001-01-01T00:00:00Z","projectID":"plan9-ID","projectName":"plan9","email":"bob@acme.com","group":"editors","invitedBy":"john@acme.com","expiresAt":"2100-01-01T00:00:00Z"}]`
+
+	req := httptest.NewRequest("GET", "/api/v1/me/invitations", nil)
+	res := httptest.NewRecorder()
+	ep, err := test.CreateTestEndpoint(*genAPIUser("bob", "bob@acme.com"), nil, existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint due to %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	test.CompareWithResult(t, res, expectedResponse)
+}
+
+func TestAcceptInvitation(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		InvitationToAccept     string
+		HTTPStatus             int
+		ExpectedResponse       string
+		ExpectedGroup          string
+		ExpectedInvitations    int
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:               "scenario 1: bob accepts the invitation and becomes an editor of the plan9 project",
+			InvitationToAccept: "bobInvitation",
+			HTTPStatus:         http.StatusOK,
+			ExpectedGroup:      "editors-plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+				genUser("", "bob", "bob@acme.com"),
+			},
+		},
+		{
+			Name:                "scenario 2: bob can't accept the invitation of a different user",
+			InvitationToAccept:  "aliceInvitation",
+			HTTPStatus:          http.StatusForbidden,
+			ExpectedResponse:    `{"error":{"code":403,"message":"the invitation aliceInvitation is not addressed to you"}}`,
+			ExpectedInvitations: 1,
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				genInvitation("aliceInvitation", "plan9-ID", "alice@acme.com", "editors", pendingInvitationExpiry),
+				genUser("", "bob", "bob@acme.com"),
+			},
+		},
+		{
+			Name:               "scenario 3: an expired invitation can't be accepted and is removed",
+			InvitationToAccept: "bobInvitation",
+			HTTPStatus:         http.StatusGone,
+			ExpectedResponse:   `{"error":{"code":410,"message":"the invitation bobInvitation has expired"}}`,
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", expiredInvitationExpiry),
+				genUser("", "bob", "bob@acme.com"),
+			},
+		},
+		{
+			Name:               "scenario 4: the invitation of a member of the project is removed",
+			InvitationToAccept: "bobInvitation",
+			HTTPStatus:         http.StatusConflict,
+			ExpectedResponse:   `{"error":{"code":409,"message":"you are already a member of the project plan9-ID"}}`,
+			ExpectedGroup:      "viewers-plan9-ID",
+			ExistingKubermaticObjs: []runtime.Object{
+				test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("plan9-ID", "bob@acme.com", "viewers"),
+				genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+				genUser("", "bob", "bob@acme.com"),
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/v1/me/invitations/%s/accept", tc.InvitationToAccept), nil)
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*genAPIUser("bob", "bob@acme.com"), nil, nil, nil, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if len(tc.ExpectedResponse) > 0 {
+				test.CompareWithResult(t, res, tc.ExpectedResponse)
+			}
+
+			invitations := &kubermaticapiv1.ProjectInvitationList{}
+			if err := clients.FakeClient.List(context.Background(), invitations); err != nil {
+				t.Fatalf("failed to list the invitations: %v", err)
+			}
+			if len(invitations.Items) != tc.ExpectedInvitations {
+				t.Fatalf("expected %d invitations to be left, got %d", tc.ExpectedInvitations, len(invitations.Items))
+			}
+
+			bindings := &kubermaticapiv1.UserProjectBindingList{}
+			if err := clients.FakeClient.List(context.Background(), bindings); err != nil {
+				t.Fatalf("failed to list the bindings: %v", err)
+			}
+			groups := []string{}
+			for _, binding := range bindings.Items {
+				if binding.Spec.UserEmail == "bob@acme.com" {
+					groups = append(groups, binding.Spec.Group)
+				}
+			}
+			if len(tc.ExpectedGroup) == 0 && len(groups) > 0 {
+				t.Fatalf("expected bob not to be a member of the project, got %v", groups)
+			}
+			if len(tc.ExpectedGroup) > 0 && (len(groups) != 1 || groups[0] != tc.ExpectedGroup) {
+				t.Fatalf("expected bob to be a member of the %s group, got %v", tc.ExpectedGroup, groups)
+			}
+		})
+	}
+}
+
+func TestDeclineInvitation(t *testing.T) {
+	t.Parallel()
+	existingKubermaticObjs := []runtime.Object{
+		test.GenProject("plan9", kubermaticapiv1.ProjectActive, test.DefaultCreationTimestamp()),
+		genInvitation("bobInvitation", "plan9-ID", "bob@acme.com", "editors", pendingInvitationExpiry),
+		genUser("", "bob", "bob@acme.com"),
+	}
+
+	req := httptest.NewRequest("POST", "/api/v1/me/invitations/bobInvitation/decline", nil)
+	res := httptest.NewRecorder()
+	ep, clients, err := test.CreateTestEndpointAndGetClients(*genAPIUser("bob", "bob@acme.com"), nil, nil, nil, existingKubermaticObjs, nil, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint due to %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+
+	invitations := &kubermaticapiv1.ProjectInvitationList{}
+	if err := clients.FakeClient.List(context.Background(), invitations); err != nil {
+		t.Fatalf("failed to list the invitations: %v", err)
+	}
+	if len(invitations.Items) != 0 {
+		t.Fatalf("expected the invitation to be removed, got %d invitations", len(invitations.Items))
+	}
+}
+
+func genInvitation(name, projectID, email, group string, expiresAt time.Time) *kubermaticapiv1.ProjectInvitation {
+	return &kubermaticapiv1.ProjectInvitation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticapiv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticapiv1.ProjectKindName,
+					Name:       projectID,
+				},
+			},
+		},
+		Spec: kubermaticapiv1.ProjectInvitationSpec{
+			ProjectID: projectID,
+			UserEmail: email,
+			Group:     fmt.Sprintf("%s-%s", group, projectID),
+			InvitedBy: "john@acme.com",
+			ExpiresAt: metav1.NewTime(expiresAt),
+		},
+	}
+}
@@ -130,7 +130,7 @@ func getMemberList(ctx context.Context, userInfoGetter provider.UserInfoGetter,
 }
 
 // EditEndpoint changes the group the given user/member belongs in the given project
-func EditEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userProvider provider.UserProvider, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func EditEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userProvider provider.UserProvider, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(EditReq)
 		if !ok {
@@ -140,7 +140,7 @@ func EditEndpoint(projectProvider provider.ProjectProvider, privilegedProjectPro
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
-		err = req.Validate(userInfo)
+		err = req.Validate(userInfo, projectRoleProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -236,14 +236,14 @@ func ListEndpoint(projectProvider provider.ProjectProvider, privilegedProjectPro
 }
 
 // AddEndpoint adds the given user to the given group within the given project
-func AddEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userProvider provider.UserProvider, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func AddEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userProvider provider.UserProvider, memberProvider provider.ProjectMemberProvider, privilegedMemberProvider provider.PrivilegedProjectMemberProvider, userInfoGetter provider.UserInfoGetter, projectRoleProvider provider.ProjectRoleProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(AddReq)
 		userInfo, err := userInfoGetter(ctx, "")
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
-		err = req.Validate(userInfo)
+		err = req.Validate(userInfo, projectRoleProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -321,7 +321,7 @@ func GetEndpoint(memberMapper provider.ProjectMemberMapper) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		authenticatedUser := ctx.Value(middleware.UserCRContextKey).(*kubermaticapiv1.User)
 
-		bindings, err := memberMapper.MappingsFor(authenticatedUser.Spec.Email)
+		bindings, err := common.GetUserProjectMappings(ctx, memberMapper, authenticatedUser.Spec.Email)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
@@ -396,7 +396,7 @@ type AddReq struct {
 }
 
 // Validate validates AddReq request
-func (r AddReq) Validate(authenticatesUserInfo *provider.UserInfo) error {
+func (r AddReq) Validate(authenticatesUserInfo *provider.UserInfo, projectRoleProvider provider.ProjectRoleProvider) error {
 	if len(r.ProjectID) == 0 {
 		return k8cerrors.NewBadRequest("the name of the project cannot be empty")
 	}
@@ -420,15 +420,20 @@ func (r AddReq) Validate(authenticatesUserInfo *provider.UserInfo) error {
 	if strings.EqualFold(apiUserFromRequest.Email, authenticatesUserInfo.Email) {
 		return k8cerrors.New(http.StatusForbidden, "you cannot assign yourself to a different group")
 	}
-	isRequestedGroupPrefixValid := false
-	for _, existingGroupPrefix := range rbac.AllGroupsPrefixes {
-		if existingGroupPrefix == projectFromRequest.GroupPrefix {
-			isRequestedGroupPrefixValid = true
-			break
-		}
+	return validateGroupPrefix(authenticatesUserInfo, projectRoleProvider, projectFromRequest.GroupPrefix)
+}
+
+// validateGroupPrefix checks if the members of a project can be assigned to the given group
+func validateGroupPrefix(authenticatesUserInfo *provider.UserInfo, projectRoleProvider provider.ProjectRoleProvider, groupPrefix string) error {
+	if rbac.IsBuiltInGroupPrefix(groupPrefix) {
+		return nil
 	}
-	if !isRequestedGroupPrefixValid {
-		return k8cerrors.NewBadRequest("invalid group name %s", projectFromRequest.GroupPrefix)
+	// apart from the built-in groups the members can be assigned to a custom project role
+	if _, err := projectRoleProvider.Get(authenticatesUserInfo, groupPrefix); err != nil {
+		if errors.IsNotFound(err) {
+			return k8cerrors.NewBadRequest("invalid group name %s", groupPrefix)
+		}
+		return common.KubernetesErrorToHTTPError(err)
 	}
 	return nil
 }
@@ -477,8 +482,8 @@ type EditReq struct {
 }
 
 // Validate validates EditUserToProject request
-func (r EditReq) Validate(authenticatesUserInfo *provider.UserInfo) error {
-	err := r.AddReq.Validate(authenticatesUserInfo)
+func (r EditReq) Validate(authenticatesUserInfo *provider.UserInfo, projectRoleProvider provider.ProjectRoleProvider) error {
+	err := r.AddReq.Validate(authenticatesUserInfo, projectRoleProvider)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,163 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webterminal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ExpiryAnnotation holds the RFC 3339 time after which the service account of a terminal session
+	// gets removed, even if the API could not remove it when the session ended
+	ExpiryAnnotation = "kubermatic.io/web-terminal-expiry"
+
+	// sessionNamespace is the namespace of the user cluster which holds the pods and the service accounts of the sessions
+	sessionNamespace = metav1.NamespaceSystem
+)
+
+// sessionClusterRole returns the cluster role of a session opened by the given user. The shell runs inside
+// of the user cluster, hence the terminal requires at least editor rights.
+func sessionClusterRole(userInfo *provider.UserInfo) (string, error) {
+	if userInfo.IsAdmin {
+		return "cluster-admin", nil
+	}
+	switch rbac.ExtractGroupPrefix(userInfo.Group) {
+	case rbac.OwnerGroupNamePrefix, rbac.EditorGroupNamePrefix:
+		return "cluster-admin", nil
+	default:
+		return "", fmt.Errorf("user group %s not supported", userInfo.Group)
+	}
+}
+
+// createSessionCredentials creates a service account in the user cluster that is used by a single terminal session.
+// It waits for the token of the service account, which is revoked by deleteSessionCredentials.
+func createSessionCredentials(ctx context.Context, client ctrlruntimeclient.Client, name, clusterRole string, userInfo *provider.UserInfo, expiry time.Time) (*corev1.ServiceAccount, error) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: sessionNamespace,
+			Labels: map[string]string{
+				resources.AppLabelKey: AppLabel,
+			},
+			Annotations: map[string]string{
+				userEmailAnnotation: userInfo.Email,
+				ExpiryAnnotation:    expiry.UTC().Format(time.RFC3339),
+			},
+		},
+	}
+	if err := client.Create(ctx, serviceAccount); err != nil {
+		return nil, fmt.Errorf("failed to create service account: %v", err)
+	}
+
+	// the binding and the token are owned by the service account, so they go away together with it
+	ownerRef := serviceAccountOwnerRef(serviceAccount)
+	if err := client.Create(ctx, genClusterRoleBinding(serviceAccount, ownerRef, clusterRole)); err != nil {
+		return nil, fmt.Errorf("failed to create cluster role binding: %v", err)
+	}
+	if err := client.Create(ctx, genTokenSecret(serviceAccount, ownerRef)); err != nil {
+		return nil, fmt.Errorf("failed to create token secret: %v", err)
+	}
+
+	// the token gets filled in by the token controller of the user cluster
+	err := wait.PollImmediate(time.Second, startupTimeout, func() (bool, error) {
+		secret := &corev1.Secret{}
+		if err := client.Get(ctx, types.NamespacedName{Namespace: serviceAccount.Namespace, Name: serviceAccount.Name}, secret); err != nil {
+			return false, err
+		}
+		return len(secret.Data[corev1.ServiceAccountTokenKey]) > 0, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for the service account token: %v", err)
+	}
+
+	return serviceAccount, nil
+}
+
+// deleteSessionCredentials removes the service account of a terminal session, which revokes its token.
+// The pod, the binding and the token secret of the session are owned by the service account and get garbage collected.
+func deleteSessionCredentials(client ctrlruntimeclient.Client, name string) error {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: sessionNamespace,
+		},
+	}
+	return ctrlruntimeclient.IgnoreNotFound(client.Delete(context.Background(), serviceAccount, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground)))
+}
+
+func serviceAccountOwnerRef(serviceAccount *corev1.ServiceAccount) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "ServiceAccount",
+		Name:       serviceAccount.Name,
+		UID:        serviceAccount.UID,
+	}
+}
+
+func genClusterRoleBinding(serviceAccount *corev1.ServiceAccount, ownerRef metav1.OwnerReference, clusterRole string) *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s:%s", serviceAccount.Name, clusterRole),
+			Labels: map[string]string{
+				resources.AppLabelKey: AppLabel,
+			},
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     clusterRole,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccount.Name,
+				Namespace: serviceAccount.Namespace,
+			},
+		},
+	}
+}
+
+func genTokenSecret(serviceAccount *corev1.ServiceAccount, ownerRef metav1.OwnerReference) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAccount.Name,
+			Namespace: serviceAccount.Namespace,
+			Labels: map[string]string{
+				resources.AppLabelKey: AppLabel,
+			},
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey: serviceAccount.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+}
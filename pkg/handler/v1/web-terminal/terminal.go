@@ -0,0 +1,369 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webterminal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	transporthttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
+	"k8c.io/kubermatic/v2/pkg/handler/middleware"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	wsh "k8c.io/kubermatic/v2/pkg/handler/websocket"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	kubermaticerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/utils/pointer"
+)
+
+const (
+	// AppLabel is the label value of the web terminal pods
+	AppLabel = "web-terminal"
+
+	containerName       = "terminal"
+	tokenVolume         = "token"
+	userEmailAnnotation = "kubermatic.io/web-terminal-user"
+
+	// tokenDir is where the in-cluster config of kubectl and helm expects the service account token
+	tokenDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+	// maxSessionDuration is enforced by the pod itself and by the expiry of the session credentials, so both go away
+	// even if the API could not clean them up
+	maxSessionDuration = time.Hour
+	startupTimeout     = 2 * time.Minute
+)
+
+// shellCommand prefers bash and falls back to sh for minimal images
+var shellCommand = []string{"/bin/sh", "-c", "if command -v bash >/dev/null; then exec bash; else exec sh; fi"}
+
+// TerminalReq defines HTTP request for the web terminal endpoint
+type TerminalReq struct {
+	common.ProjectReq
+	ClusterID string
+}
+
+// GetSeedCluster returns the SeedCluster object
+func (req TerminalReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeTerminalReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req TerminalReq
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	return req, nil
+}
+
+// TerminalEndpoint starts a short-lived pod with kubectl and helm in the user cluster and proxies an interactive
+// shell of the pod over a websocket. The pod runs with a service account that only exists for this session.
+// As the shell has cluster-admin access to the user cluster, only owners and editors of the project can open it.
+func TerminalEndpoint(
+	log *zap.SugaredLogger,
+	extractor transporthttp.RequestFunc,
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	userInfoGetter provider.UserInfoGetter,
+	settingsProvider provider.SettingsProvider,
+	upgrader websocket.Upgrader,
+	image string,
+	middlewares endpoint.Middleware) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		log := log.With("endpoint", "web-terminal", "uri", r.URL.Path)
+		ctx := extractor(r.Context(), r)
+
+		settings, err := settingsProvider.GetGlobalSettings()
+		if err != nil {
+			common.WriteHTTPError(log, w, kubermaticerrors.New(http.StatusInternalServerError, "could not read global settings"))
+			return
+		}
+
+		if !settings.Spec.EnableWebTerminal {
+			common.WriteHTTPError(log, w, kubermaticerrors.New(http.StatusForbidden, "Web terminal access is disabled by the global settings"))
+			return
+		}
+
+		request, err := DecodeTerminalReq(ctx, r)
+		if err != nil {
+			common.WriteHTTPError(log, w, kubermaticerrors.New(http.StatusBadRequest, err.Error()))
+			return
+		}
+
+		// The endpoint the middleware is called with is the innermost one, hence we must
+		// define it as closure and pass it to the middleware() call below.
+		ep := func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(TerminalReq)
+
+			userCluster, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+			if err != nil {
+				return nil, err
+			}
+			clusterProvider, ok := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(*kubernetesprovider.ClusterProvider)
+			if !ok {
+				return nil, kubermaticerrors.New(http.StatusInternalServerError, "no clusterProvider in request")
+			}
+			userInfo, err := getUserInfo(ctx, userInfoGetter, req.ProjectID)
+			if err != nil {
+				return nil, kubermaticerrors.New(http.StatusInternalServerError, "couldn't get userInfo")
+			}
+			clusterRole, err := sessionClusterRole(userInfo)
+			if err != nil {
+				return nil, kubermaticerrors.New(http.StatusForbidden, fmt.Sprintf("web terminal is not available for user %q: %v", userInfo.Email, err))
+			}
+
+			log = log.With("cluster", userCluster.Name, "user", userInfo.Email)
+
+			// the pod and the credentials of the session share the name
+			name := fmt.Sprintf("%s-%s", AppLabel, rand.String(10))
+
+			userClusterClient, err := clusterProvider.GetAdminClientForCustomerCluster(userCluster)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			// the pod is owned by the service account, removing the credentials removes the whole session
+			defer func() {
+				if err := deleteSessionCredentials(userClusterClient, name); err != nil {
+					log.Errorw("Failed to remove web terminal session", zap.Error(err))
+				}
+			}()
+
+			serviceAccount, err := createSessionCredentials(ctx, userClusterClient, name, clusterRole, userInfo, time.Now().Add(maxSessionDuration))
+			if err != nil {
+				return nil, err
+			}
+
+			userClusterConfig, err := getUserClusterConfig(clusterProvider, userCluster)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			userClusterClientset, err := kubernetes.NewForConfig(userClusterConfig)
+			if err != nil {
+				return nil, err
+			}
+
+			pod, err := startPod(ctx, userClusterClientset, serviceAccount, userInfo, image)
+			if err != nil {
+				return nil, err
+			}
+
+			ws, err := upgrader.Upgrade(w, r, nil)
+			if err != nil {
+				// the upgrader has already replied to the client
+				log.Debugw("Failed to upgrade connection", zap.Error(err))
+				return nil, nil
+			}
+
+			session := wsh.NewTerminalSession(ws)
+			if err := stream(userClusterConfig, userClusterClientset, pod, session); err != nil {
+				log.Debugw("Web terminal session failed", zap.Error(err))
+				if err := session.Close(websocket.CloseInternalServerErr, err.Error()); err != nil {
+					log.Debugw("Failed to close web terminal session", zap.Error(err))
+				}
+				return nil, nil
+			}
+			if err := session.Close(websocket.CloseNormalClosure, ""); err != nil {
+				log.Debugw("Failed to close web terminal session", zap.Error(err))
+			}
+			return nil, nil
+		}
+
+		if _, err := middlewares(ep)(ctx, request); err != nil {
+			common.WriteHTTPError(log, w, err)
+			return
+		}
+	})
+}
+
+// getUserInfo returns the user info for the given project, admins don't have to be members of the project
+func getUserInfo(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string) (*provider.UserInfo, error) {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if adminUserInfo.IsAdmin {
+		return adminUserInfo, nil
+	}
+	return userInfoGetter(ctx, projectID)
+}
+
+// getUserClusterConfig returns the admin client config of the user cluster, it is needed to exec into the pod
+func getUserClusterConfig(clusterProvider *kubernetesprovider.ClusterProvider, cluster *kubermaticv1.Cluster) (*restclient.Config, error) {
+	adminKubeconfig, err := clusterProvider.GetAdminKubeconfigForCustomerCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	return clientcmd.NewDefaultClientConfig(*adminKubeconfig, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+func startPod(ctx context.Context, client kubernetes.Interface, serviceAccount *corev1.ServiceAccount, userInfo *provider.UserInfo, image string) (*corev1.Pod, error) {
+	pod, err := client.CoreV1().Pods(serviceAccount.Namespace).Create(ctx, genPod(serviceAccount, userInfo, image), metav1.CreateOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	err = wait.PollImmediate(time.Second, startupTimeout, func() (bool, error) {
+		current, err := client.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		switch current.Status.Phase {
+		case corev1.PodRunning:
+			return true, nil
+		case corev1.PodFailed, corev1.PodSucceeded:
+			return false, fmt.Errorf("web terminal pod terminated with phase %s", current.Status.Phase)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, kubermaticerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to start web terminal: %v", err))
+	}
+
+	return pod, nil
+}
+
+func stream(config *restclient.Config, client kubernetes.Interface, pod *corev1.Pod, session *wsh.TerminalSession) error {
+	req := client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: containerName,
+			Command:   shellCommand,
+			Stdin:     true,
+			Stdout:    true,
+			TTY:       true,
+		}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(config, http.MethodPost, req.URL())
+	if err != nil {
+		return fmt.Errorf("failed to create executor: %v", err)
+	}
+
+	return executor.Stream(remotecommand.StreamOptions{
+		Stdin:             session,
+		Stdout:            session,
+		Tty:               true,
+		TerminalSizeQueue: session,
+	})
+}
+
+// genPod returns the pod of a terminal session. It uses the token of the session's service account
+// and is owned by the service account, which makes it go away together with the credentials.
+func genPod(serviceAccount *corev1.ServiceAccount, userInfo *provider.UserInfo, image string) *corev1.Pod {
+	deadline := int64(maxSessionDuration.Seconds())
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      serviceAccount.Name,
+			Namespace: serviceAccount.Namespace,
+			Labels: map[string]string{
+				resources.AppLabelKey: AppLabel,
+			},
+			Annotations: map[string]string{
+				userEmailAnnotation: userInfo.Email,
+			},
+			OwnerReferences: []metav1.OwnerReference{serviceAccountOwnerRef(serviceAccount)},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy:         corev1.RestartPolicyNever,
+			ActiveDeadlineSeconds: &deadline,
+			ServiceAccountName:    serviceAccount.Name,
+			// the token secret of the session is mounted explicitly, this way the pod doesn't depend on the
+			// token controller having linked the secret to the service account yet
+			AutomountServiceAccountToken:  pointer.BoolPtr(false),
+			TerminationGracePeriodSeconds: pointer.Int64Ptr(0),
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot: pointer.BoolPtr(true),
+				RunAsUser:    pointer.Int64Ptr(65534),
+			},
+			Containers: []corev1.Container{
+				{
+					Name:    containerName,
+					Image:   image,
+					Command: []string{"sleep", strconv.FormatInt(deadline, 10)},
+					Env: []corev1.EnvVar{
+						{Name: "HOME", Value: "/tmp"},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      tokenVolume,
+							MountPath: tokenDir,
+							ReadOnly:  true,
+						},
+					},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("50m"),
+							corev1.ResourceMemory: resource.MustParse("64Mi"),
+						},
+						Limits: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("500m"),
+							corev1.ResourceMemory: resource.MustParse("256Mi"),
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.BoolPtr(false),
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: tokenVolume,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: serviceAccount.Name,
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webterminal
+
+import (
+	"testing"
+
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGenPod(t *testing.T) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "web-terminal-abcd",
+			Namespace: sessionNamespace,
+			UID:       "1234",
+		},
+	}
+
+	pod := genPod(serviceAccount, &provider.UserInfo{Email: "bob@acme.com", Group: "editors-my-first-project-ID"}, "kubectl:latest")
+
+	if pod.Namespace != sessionNamespace || pod.Name != serviceAccount.Name {
+		t.Fatalf("expected the pod to be named after the service account of the session, got %s/%s", pod.Namespace, pod.Name)
+	}
+	if len(pod.OwnerReferences) != 1 || pod.OwnerReferences[0].UID != serviceAccount.UID {
+		t.Fatalf("expected the pod to be owned by the service account of the session, got %v", pod.OwnerReferences)
+	}
+	if pod.Spec.ServiceAccountName != serviceAccount.Name {
+		t.Fatalf("expected the pod to run with the service account of the session, got %q", pod.Spec.ServiceAccountName)
+	}
+	if pod.Spec.ActiveDeadlineSeconds == nil || *pod.Spec.ActiveDeadlineSeconds != int64(maxSessionDuration.Seconds()) {
+		t.Fatalf("expected the pod to be limited to the max session duration, got %v", pod.Spec.ActiveDeadlineSeconds)
+	}
+	if pod.Spec.Volumes[0].Secret.SecretName != serviceAccount.Name || pod.Spec.Containers[0].VolumeMounts[0].MountPath != tokenDir {
+		t.Fatalf("expected the token of the session to be mounted, got %v", pod.Spec.Volumes)
+	}
+}
+
+func TestSessionClusterRole(t *testing.T) {
+	testCases := []struct {
+		name          string
+		userInfo      *provider.UserInfo
+		expectedRole  string
+		expectedError bool
+	}{
+		{name: "owners", userInfo: &provider.UserInfo{Group: "owners-my-first-project-ID"}, expectedRole: "cluster-admin"},
+		{name: "editors", userInfo: &provider.UserInfo{Group: "editors-my-first-project-ID"}, expectedRole: "cluster-admin"},
+		{name: "admins", userInfo: &provider.UserInfo{IsAdmin: true}, expectedRole: "cluster-admin"},
+		{name: "viewers", userInfo: &provider.UserInfo{Group: "viewers-my-first-project-ID"}, expectedError: true},
+		{name: "custom project roles", userInfo: &provider.UserInfo{Group: "projectmanagers-my-first-project-ID"}, expectedError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			role, err := sessionClusterRole(tc.userInfo)
+			if (err != nil) != tc.expectedError {
+				t.Fatalf("expected error to be %t, got %v", tc.expectedError, err)
+			}
+			if role != tc.expectedRole {
+				t.Fatalf("expected cluster role %q, got %q", tc.expectedRole, role)
+			}
+		})
+	}
+}
@@ -41,7 +41,7 @@ import (
 
 func CreateEndpoint(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
 	initNodeDeploymentFailures *prometheus.CounterVec, eventRecorderProvider provider.EventRecorderProvider, credentialManager provider.PresetProvider,
-	exposeStrategy corev1.ServiceType, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, updateManager common.UpdateManager) endpoint.Endpoint {
+	exposeStrategy corev1.ServiceType, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, updateManager common.UpdateManager, operationProvider provider.OperationProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(CreateClusterReq)
 		globalSettings, err := settingsProvider.GetGlobalSettings()
@@ -53,7 +53,7 @@ func CreateEndpoint(sshKeyProvider provider.SSHKeyProvider, projectProvider prov
 			return nil, errors.NewBadRequest(err.Error())
 		}
 
-		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, sshKeyProvider, projectProvider, privilegedProjectProvider, seedsGetter, initNodeDeploymentFailures, eventRecorderProvider, credentialManager, exposeStrategy, userInfoGetter)
+		return handlercommon.CreateEndpoint(ctx, req.ProjectID, req.Body, sshKeyProvider, projectProvider, privilegedProjectProvider, seedsGetter, initNodeDeploymentFailures, eventRecorderProvider, credentialManager, exposeStrategy, userInfoGetter, operationProvider)
 
 	}
 }
@@ -94,17 +94,17 @@ func GetEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProv
 	}
 }
 
-func DeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func DeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, operationProvider provider.OperationProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(DeleteReq)
-		return handlercommon.DeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider)
+		return handlercommon.DeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.DeleteVolumes, req.DeleteLoadBalancers, req.SkipGracePeriod, req.retention, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider, settingsProvider, operationProvider)
 	}
 }
 
-func PatchEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+func PatchEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, operationProvider provider.OperationProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(PatchReq)
-		return handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter, projectProvider, privilegedProjectProvider)
+		return handlercommon.PatchEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Patch, seedsGetter, projectProvider, privilegedProjectProvider, operationProvider)
 	}
 }
 
@@ -122,6 +122,41 @@ func HealthEndpoint(projectProvider provider.ProjectProvider, privilegedProjectP
 	}
 }
 
+func HibernateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		return handlercommon.HibernateEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, true, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func ResumeEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		return handlercommon.HibernateEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, false, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func UndeleteEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		return handlercommon.UndeleteEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func MoveEndpoint(sshKeyProvider provider.SSHKeyProvider, privilegedSSHKeyProvider provider.PrivilegedSSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(MoveReq)
+		return handlercommon.MoveEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Body, sshKeyProvider, privilegedSSHKeyProvider, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func GetDeletionPreviewEndpoint(seedsGetter provider.SeedsGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(GetClusterReq)
+		return handlercommon.GetDeletionPreviewEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, seedsGetter, projectProvider, privilegedProjectProvider)
+	}
+}
+
 func GetMetricsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(GetClusterReq)
@@ -312,6 +347,46 @@ func (req PatchReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
+// MoveReq defines HTTP request for moveCluster endpoint
+// swagger:parameters moveClusterV2
+type MoveReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+
+	// in: body
+	Body apiv1.ClusterMove
+}
+
+func DecodeMoveReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req MoveReq
+
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, errors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+
+	return req, nil
+}
+
+// GetSeedCluster returns the SeedCluster object
+func (req MoveReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
 // DeleteReq defines HTTP request for deleteCluster endpoint
 // swagger:parameters deleteClusterV2
 type DeleteReq struct {
@@ -325,6 +400,20 @@ type DeleteReq struct {
 	// in: header
 	// DeleteLoadBalancers if true all load balancers will be deleted from cluster
 	DeleteLoadBalancers bool
+	// in: header
+	// RetainLoadBalancers is a comma-separated list of load balancers in the form "namespace/name" which are kept when DeleteLoadBalancers is set
+	RetainLoadBalancers string
+	// in: header
+	// RetainVolumes is a comma-separated list of PV names which are kept together with their PVC's when DeleteVolumes is set
+	RetainVolumes string
+	// in: header
+	// RetainMachines is a comma-separated list of Machine names in the kube-system namespace whose instances are kept
+	RetainMachines string
+	// in: header
+	// SkipGracePeriod if true the cluster is deleted right away, even if a deletion grace period is configured. Only admins can skip it.
+	SkipGracePeriod bool
+
+	retention *kubermaticv1.ClusterDeletionRetention
 }
 
 // GetSeedCluster returns the SeedCluster object
@@ -367,11 +456,28 @@ func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 		req.DeleteLoadBalancers = deleteLB
 	}
 
+	headerValue = r.Header.Get("SkipGracePeriod")
+	if len(headerValue) > 0 {
+		skipGracePeriod, err := strconv.ParseBool(headerValue)
+		if err != nil {
+			return nil, err
+		}
+		req.SkipGracePeriod = skipGracePeriod
+	}
+
+	req.RetainLoadBalancers = r.Header.Get("RetainLoadBalancers")
+	req.RetainVolumes = r.Header.Get("RetainVolumes")
+	req.RetainMachines = r.Header.Get("RetainMachines")
+	req.retention, err = handlercommon.DeletionRetention(req.RetainLoadBalancers, req.RetainVolumes, req.RetainMachines)
+	if err != nil {
+		return nil, err
+	}
+
 	return req, nil
 }
 
 // GetClusterReq defines HTTP request for getCluster endpoint.
-// swagger:parameters getClusterV2 getClusterHealthV2 getOidcClusterKubeconfigV2 getClusterKubeconfigV2 getClusterMetricsV2 listNamespaceV2 getClusterUpgradesV2
+// swagger:parameters getClusterV2 getClusterHealthV2 getOidcClusterKubeconfigV2 getClusterKubeconfigV2 getClusterMetricsV2 listNamespaceV2 getClusterUpgradesV2 hibernateClusterV2 resumeClusterV2 undeleteClusterV2 getClusterDeletionPreviewV2
 type GetClusterReq struct {
 	common.ProjectReq
 	// in: path
@@ -17,6 +17,7 @@ limitations under the License.
 package cluster_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -33,10 +34,13 @@ import (
 	"k8c.io/kubermatic/v2/pkg/semver"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestCreateClusterEndpoint(t *testing.T) {
@@ -1146,6 +1150,526 @@ func TestGetClusterHealth(t *testing.T) {
 	}
 }
 
+func TestHibernateCluster(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name                   string
+		Action                 string
+		ExpectedResponse       string
+		HTTPStatus             int
+		ExpectedHibernated     bool
+		ExistingAPIUser        *apiv1.User
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		// scenario 1
+		{
+			Name:               "scenario 1: hibernate a running cluster",
+			Action:             "hibernate",
+			ExpectedResponse:   `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885"}}`,
+			HTTPStatus:         http.StatusOK,
+			ExpectedHibernated: true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		// scenario 2
+		{
+			Name:             "scenario 2: resume a hibernated cluster",
+			Action:           "resume",
+			ExpectedResponse: `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885","phase":"Hibernated"}}`,
+			HTTPStatus:       http.StatusOK,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenDefaultCluster()
+					cluster.Spec.Hibernated = true
+					cluster.Status.Phase = kubermaticv1.ClusterPhaseHibernated
+					return cluster
+				}(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+		},
+		// scenario 3
+		{
+			Name:             "scenario 3: the regular user John can not hibernate Bob's cluster",
+			Action:           "hibernate",
+			ExpectedResponse: `{"error":{"code":403,"message":"forbidden: \"john@acme.com\" doesn't belong to the given project = my-first-project-ID"}}`,
+			HTTPStatus:       http.StatusForbidden,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				genUser("John", "john@acme.com", false),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenAPIUser("John", "john@acme.com"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", fmt.Sprintf("/api/v2/projects/%s/clusters/%s/%s", test.ProjectName, test.GenDefaultCluster().Name, tc.Action), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			var kubermaticObj []runtime.Object
+			kubermaticObj = append(kubermaticObj, tc.ExistingKubermaticObjs...)
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*tc.ExistingAPIUser, nil, []runtime.Object{}, []runtime.Object{}, kubermaticObj, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			if res.Code == http.StatusOK {
+				cluster := &kubermaticv1.Cluster{}
+				if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: test.GenDefaultCluster().Name}, cluster); err != nil {
+					t.Fatalf("failed to get cluster: %v", err)
+				}
+				if cluster.Spec.Hibernated != tc.ExpectedHibernated {
+					t.Fatalf("expected hibernated to be %v, got %v", tc.ExpectedHibernated, cluster.Spec.Hibernated)
+				}
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionAndUndelete(t *testing.T) {
+	t.Parallel()
+	softDeletedCluster := func(hibernated bool) *kubermaticv1.Cluster {
+		cluster := test.GenDefaultCluster()
+		cluster.Spec.Hibernated = true
+		cluster.Spec.SoftDeletion = &kubermaticv1.ClusterSoftDeletion{
+			DeleteAt:   metav1.NewTime(time.Now().Add(time.Hour)),
+			Hibernated: hibernated,
+		}
+		return cluster
+	}
+	sshKey := func(clusters ...string) *kubermaticv1.UserSSHKey {
+		return &kubermaticv1.UserSSHKey{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "key-abc-yafn",
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: "kubermatic.k8s.io/v1",
+						Kind:       "Project",
+						Name:       test.GenDefaultProject().Name,
+					},
+				},
+			},
+			Spec: kubermaticv1.SSHKeySpec{Clusters: clusters},
+		}
+	}
+	settingsWithGracePeriod := func() *kubermaticv1.KubermaticSetting {
+		settings := test.GenDefaultGlobalSettings()
+		settings.Spec.ClusterDeletionGracePeriod = metav1.Duration{Duration: time.Hour}
+		return settings
+	}
+
+	testcases := []struct {
+		Name                   string
+		Method                 string
+		Path                   string
+		ExpectedResponse       string
+		HTTPStatus             int
+		Headers                map[string]string
+		APIUser                *apiv1.User
+		ExpectedSoftDeleted    bool
+		ExpectedHibernated     bool
+		ExpectedDeleted        bool
+		ExpectedDetachedKeys   []string
+		ExpectedKeyClusters    []string
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:             "scenario 1: a protected cluster can not be deleted",
+			Method:           http.MethodDelete,
+			ExpectedResponse: `{"error":{"code":409,"message":"cluster defClusterID is protected against deletion, disable the deletion protection first"}}`,
+			HTTPStatus:       http.StatusConflict,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenDefaultCluster()
+					cluster.Spec.DeletionProtection = true
+					return cluster
+				}(),
+			),
+		},
+		{
+			Name:                 "scenario 2: a cluster is hibernated and scheduled for deletion if a grace period is configured, its ssh keys get detached",
+			Method:               http.MethodDelete,
+			ExpectedResponse:     `{}`,
+			HTTPStatus:           http.StatusOK,
+			ExpectedSoftDeleted:  true,
+			ExpectedHibernated:   true,
+			ExpectedDetachedKeys: []string{"key-abc-yafn"},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenDefaultCluster(),
+				settingsWithGracePeriod(),
+				sshKey(test.GenDefaultCluster().Name),
+			),
+		},
+		{
+			Name:                "scenario 3: undelete a cluster, its ssh keys get assigned again",
+			Method:              http.MethodPost,
+			Path:                "/undelete",
+			ExpectedResponse:    `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885"}}`,
+			HTTPStatus:          http.StatusOK,
+			ExpectedKeyClusters: []string{test.GenDefaultCluster().Name},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				func() *kubermaticv1.Cluster {
+					cluster := softDeletedCluster(false)
+					cluster.Spec.SoftDeletion.SSHKeys = []string{"key-abc-yafn"}
+					return cluster
+				}(),
+				sshKey(),
+			),
+		},
+		{
+			Name:               "scenario 4: an undeleted cluster stays hibernated if it was hibernated before",
+			Method:             http.MethodPost,
+			Path:               "/undelete",
+			ExpectedResponse:   `{"id":"defClusterID","name":"defClusterName","creationTimestamp":"2013-02-03T19:54:00Z","type":"kubernetes","spec":{"cloud":{"dc":"FakeDatacenter","fake":{}},"version":"9.9.9","oidc":{}},"status":{"version":"9.9.9","url":"https://w225mx4z66.asia-east1-a-1.cloud.kubermatic.io:31885"}}`,
+			HTTPStatus:         http.StatusOK,
+			ExpectedHibernated: true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				softDeletedCluster(true),
+			),
+		},
+		{
+			Name:             "scenario 5: a cluster which is not scheduled for deletion can not be undeleted",
+			Method:           http.MethodPost,
+			Path:             "/undelete",
+			ExpectedResponse: `{"error":{"code":409,"message":"cluster defClusterID is not scheduled for deletion"}}`,
+			HTTPStatus:       http.StatusConflict,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenDefaultCluster(),
+			),
+		},
+		{
+			Name:                "scenario 6: a cluster which is scheduled for deletion can not be resumed",
+			Method:              http.MethodPost,
+			Path:                "/resume",
+			ExpectedResponse:    `{"error":{"code":409,"message":"cluster defClusterID is scheduled for deletion, undelete it first"}}`,
+			HTTPStatus:          http.StatusConflict,
+			ExpectedSoftDeleted: true,
+			ExpectedHibernated:  true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				softDeletedCluster(false),
+			),
+		},
+		{
+			Name:                "scenario 7: a cluster which is scheduled for deletion can not be deleted again",
+			Method:              http.MethodDelete,
+			ExpectedResponse:    `{"error":{"code":409,"message":"cluster defClusterID is already scheduled for deletion"}}`,
+			HTTPStatus:          http.StatusConflict,
+			ExpectedSoftDeleted: true,
+			ExpectedHibernated:  true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				softDeletedCluster(false),
+				settingsWithGracePeriod(),
+			),
+		},
+		{
+			Name:                "scenario 8: only admins can skip the deletion grace period",
+			Method:              http.MethodDelete,
+			Headers:             map[string]string{"SkipGracePeriod": "true"},
+			ExpectedResponse:    `{"error":{"code":403,"message":"only admins can skip the deletion grace period"}}`,
+			HTTPStatus:          http.StatusForbidden,
+			ExpectedSoftDeleted: true,
+			ExpectedHibernated:  true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				softDeletedCluster(false),
+				settingsWithGracePeriod(),
+			),
+		},
+		{
+			Name:             "scenario 9: an admin can delete a cluster which is scheduled for deletion right away",
+			Method:           http.MethodDelete,
+			Headers:          map[string]string{"SkipGracePeriod": "true"},
+			APIUser:          test.GenAPIUser("John", "john@acme.com"),
+			ExpectedResponse: `{}`,
+			HTTPStatus:       http.StatusOK,
+			ExpectedDeleted:  true,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				genUser("John", "john@acme.com", true),
+				softDeletedCluster(false),
+				settingsWithGracePeriod(),
+				sshKey(test.GenDefaultCluster().Name),
+			),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.Method, fmt.Sprintf("/api/v2/projects/%s/clusters/%s%s", test.ProjectName, test.GenDefaultCluster().Name, tc.Path), strings.NewReader(""))
+			for name, value := range tc.Headers {
+				req.Header.Set(name, value)
+			}
+			res := httptest.NewRecorder()
+			apiUser := test.GenDefaultAPIUser()
+			if tc.APIUser != nil {
+				apiUser = tc.APIUser
+			}
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*apiUser, nil, []runtime.Object{}, []runtime.Object{}, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+
+			key := &kubermaticv1.UserSSHKey{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: "key-abc-yafn"}, key); err == nil {
+				if !equality.Semantic.DeepEqual(key.Spec.Clusters, tc.ExpectedKeyClusters) {
+					t.Fatalf("expected ssh key to be assigned to %v, got %v", tc.ExpectedKeyClusters, key.Spec.Clusters)
+				}
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: test.GenDefaultCluster().Name}, cluster); err != nil {
+				if tc.ExpectedDeleted && kerrors.IsNotFound(err) {
+					return
+				}
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if deleted := cluster.DeletionTimestamp != nil; deleted != tc.ExpectedDeleted {
+				t.Fatalf("expected deleted to be %v, got %v", tc.ExpectedDeleted, deleted)
+			}
+			if tc.ExpectedDeleted {
+				return
+			}
+			if tc.ExpectedSoftDeleted && !equality.Semantic.DeepEqual(cluster.Spec.SoftDeletion.SSHKeys, tc.ExpectedDetachedKeys) {
+				t.Fatalf("expected detached ssh keys %v, got %v", tc.ExpectedDetachedKeys, cluster.Spec.SoftDeletion.SSHKeys)
+			}
+			if cluster.IsSoftDeleted() != tc.ExpectedSoftDeleted {
+				t.Fatalf("expected soft-deleted to be %v, got %v", tc.ExpectedSoftDeleted, cluster.IsSoftDeleted())
+			}
+			if cluster.Spec.Hibernated != tc.ExpectedHibernated {
+				t.Fatalf("expected hibernated to be %v, got %v", tc.ExpectedHibernated, cluster.Spec.Hibernated)
+			}
+		})
+	}
+}
+
+func TestGetClusterDeletionPreview(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		SoftDeleted      bool
+		Viewer           bool
+		Phase            kubermaticv1.ClusterPhase
+		ExpectedCode     int
+		ExpectedResponse string
+	}{
+		{
+			Name:             "scenario 1: the preview lists the resources of the cluster",
+			ExpectedCode:     http.StatusOK,
+			ExpectedResponse: `{"loadBalancers":[{"name":"ingress","namespace":"default"}],"persistentVolumes":[{"name":"pv-1"}],"persistentVolumeClaims":[{"name":"data","namespace":"default"}],"machines":[{"name":"venus","namespace":"kube-system"}],"cloudResources":[],"addons":[{"name":"canal"}]}`,
+		},
+		{
+			Name:             "scenario 2: the in-cluster resources of a hibernated soft-deleted cluster are unavailable",
+			SoftDeleted:      true,
+			Phase:            kubermaticv1.ClusterPhaseHibernating,
+			ExpectedCode:     http.StatusOK,
+			ExpectedResponse: `{"loadBalancers":[],"persistentVolumes":[],"persistentVolumeClaims":[],"machines":[],"cloudResources":[],"addons":[{"name":"canal"}],"inClusterResourcesUnavailable":true}`,
+		},
+		{
+			Name:             "scenario 3: the preview lists the resources of a soft-deleted cluster which is not hibernated yet",
+			SoftDeleted:      true,
+			ExpectedCode:     http.StatusOK,
+			ExpectedResponse: `{"loadBalancers":[{"name":"ingress","namespace":"default"}],"persistentVolumes":[{"name":"pv-1"}],"persistentVolumeClaims":[{"name":"data","namespace":"default"}],"machines":[{"name":"venus","namespace":"kube-system"}],"cloudResources":[],"addons":[{"name":"canal"}]}`,
+		},
+		{
+			Name:             "scenario 4: viewers of the project can't preview the deletion",
+			Viewer:           true,
+			ExpectedCode:     http.StatusForbidden,
+			ExpectedResponse: `{"error":{"code":403,"message":"only owners and editors of project my-first-project-ID can preview the deletion of clusters"}}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			cluster := test.GenDefaultCluster()
+			cluster.Spec.Cloud.DatacenterName = "fake-dc"
+			cluster.Finalizers = []string{apiv1.NodeDeletionFinalizer}
+			cluster.Status.Phase = tc.Phase
+			if tc.SoftDeleted {
+				cluster.Spec.Hibernated = true
+				cluster.Spec.SoftDeletion = &kubermaticv1.ClusterSoftDeletion{DeleteAt: metav1.NewTime(time.Now().Add(time.Hour))}
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/deletionpreview", test.ProjectName, cluster.Name), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			kubeObjs := []runtime.Object{
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ingress"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer}},
+				&corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "kubernetes"}, Spec: corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP}},
+				&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: "pv-1"}},
+				&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data"}},
+			}
+			machineObjs := []runtime.Object{test.GenTestMachine("venus", `{"cloudProvider":"fake"}`, nil, nil)}
+			kubermaticObjs := test.GenDefaultKubermaticObjects(cluster, test.GenTestAddon("canal", nil, cluster, time.Now()))
+			apiUser := test.GenDefaultAPIUser()
+			if tc.Viewer {
+				apiUser = test.GenAPIUser("John", "john@acme.com")
+				kubermaticObjs = append(kubermaticObjs, test.GenBinding(test.GenDefaultProject().Name, "john@acme.com", "viewers"))
+			}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*apiUser, nil, kubeObjs, machineObjs, kubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.ExpectedCode {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedCode, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestMoveCluster(t *testing.T) {
+	t.Parallel()
+	genSSHKey := func(name, projectID string, clusters ...string) *kubermaticv1.UserSSHKey {
+		return &kubermaticv1.UserSSHKey{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+						Kind:       kubermaticv1.ProjectKindName,
+						Name:       projectID,
+					},
+				},
+			},
+			Spec: kubermaticv1.SSHKeySpec{Clusters: clusters},
+		}
+	}
+	genTargetProject := func() *kubermaticv1.Project {
+		project := test.GenProject("target", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp())
+		project.Labels = map[string]string{"team": "b"}
+		return project
+	}
+	genCluster := func() *kubermaticv1.Cluster {
+		cluster := test.GenDefaultCluster()
+		cluster.Labels["team"] = "a"
+		cluster.Labels["env"] = "dev"
+		cluster.Status.InheritedLabels = map[string]string{"team": "a"}
+		return cluster
+	}
+	targetProjectID := genTargetProject().Name
+
+	testcases := []struct {
+		Name                   string
+		Body                   string
+		HTTPStatus             int
+		ExpectedProjectID      string
+		ExpectedLabels         map[string]string
+		ExistingKubermaticObjs []runtime.Object
+	}{
+		{
+			Name:              "scenario 1: the owner of both projects moves a cluster",
+			Body:              fmt.Sprintf(`{"projectID":%q,"sshKeys":["key-target"]}`, targetProjectID),
+			HTTPStatus:        http.StatusOK,
+			ExpectedProjectID: targetProjectID,
+			ExpectedLabels:    map[string]string{"env": "dev", "team": "b"},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				genCluster(),
+				genTargetProject(),
+				test.GenBinding(targetProjectID, test.GenDefaultUser().Spec.Email, "owners"),
+				genSSHKey("key-source", test.GenDefaultProject().Name, test.GenDefaultCluster().Name),
+				genSSHKey("key-target", targetProjectID),
+			),
+		},
+		{
+			Name:              "scenario 2: an editor of the target project can not move a cluster",
+			Body:              fmt.Sprintf(`{"projectID":%q}`, targetProjectID),
+			HTTPStatus:        http.StatusForbidden,
+			ExpectedProjectID: test.GenDefaultProject().Name,
+			ExpectedLabels:    map[string]string{"env": "dev", "team": "a"},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				genCluster(),
+				genTargetProject(),
+				test.GenBinding(targetProjectID, test.GenDefaultUser().Spec.Email, "editors"),
+			),
+		},
+		{
+			Name:              "scenario 3: the SSH keys have to belong to the target project",
+			Body:              fmt.Sprintf(`{"projectID":%q,"sshKeys":["key-source"]}`, targetProjectID),
+			HTTPStatus:        http.StatusBadRequest,
+			ExpectedProjectID: test.GenDefaultProject().Name,
+			ExpectedLabels:    map[string]string{"env": "dev", "team": "a"},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				genCluster(),
+				genTargetProject(),
+				test.GenBinding(targetProjectID, test.GenDefaultUser().Spec.Email, "owners"),
+				genSSHKey("key-source", test.GenDefaultProject().Name, test.GenDefaultCluster().Name),
+			),
+		},
+		{
+			Name:              "scenario 4: a cluster can not be moved to its own project",
+			Body:              fmt.Sprintf(`{"projectID":%q}`, test.GenDefaultProject().Name),
+			HTTPStatus:        http.StatusBadRequest,
+			ExpectedProjectID: test.GenDefaultProject().Name,
+			ExpectedLabels:    map[string]string{"env": "dev", "team": "a"},
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				genCluster(),
+			),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/move", test.ProjectName, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, clients, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []runtime.Object{}, []runtime.Object{}, tc.ExistingKubermaticObjs, nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+
+			cluster := &kubermaticv1.Cluster{}
+			if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: test.GenDefaultCluster().Name}, cluster); err != nil {
+				t.Fatalf("failed to get cluster: %v", err)
+			}
+			if projectID := cluster.Labels[kubermaticv1.ProjectIDLabelKey]; projectID != tc.ExpectedProjectID {
+				t.Fatalf("expected cluster to belong to project %q, got %q", tc.ExpectedProjectID, projectID)
+			}
+			for key, value := range tc.ExpectedLabels {
+				if cluster.Labels[key] != value {
+					t.Errorf("expected label %q to be %q, got %q", key, value, cluster.Labels[key])
+				}
+			}
+			if tc.HTTPStatus != http.StatusOK {
+				return
+			}
+
+			if cluster.Status.InheritedLabels["team"] != "b" {
+				t.Errorf("expected the labels of the target project to be inherited, got %v", cluster.Status.InheritedLabels)
+			}
+			for name, expectedUsed := range map[string]bool{"key-source": false, "key-target": true} {
+				key := &kubermaticv1.UserSSHKey{}
+				if err := clients.FakeClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: name}, key); err != nil {
+					t.Fatalf("failed to get SSH key: %v", err)
+				}
+				if key.IsUsedByCluster(cluster.Name) != expectedUsed {
+					t.Errorf("expected SSH key %q to be assigned to the cluster=%t", name, expectedUsed)
+				}
+			}
+		})
+	}
+}
+
 func TestGetClusterMetrics(t *testing.T) {
 	t.Parallel()
 	cpuQuantity, err := resource.ParseQuantity("290")
@@ -0,0 +1,148 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
+	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func EstimateClusterCostEndpoint(seedsGetter provider.SeedsGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, priceListProvider provider.PriceListProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(EstimateClusterCostReq)
+		return handlercommon.EstimateClusterCostEndpoint(ctx, userInfoGetter, req.ProjectID, req.Body, seedsGetter, projectProvider, privilegedProjectProvider, priceListProvider)
+	}
+}
+
+func EstimateMachineDeploymentCostEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, priceListProvider provider.PriceListProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(EstimateMachineDeploymentCostReq)
+		return handlercommon.EstimateMachineDeploymentCostEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Body, projectProvider, privilegedProjectProvider, priceListProvider)
+	}
+}
+
+func ListUsageReportsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, usageReportProvider provider.UsageReportProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(ListUsageReportsReq)
+		return handlercommon.ListUsageReportsEndpoint(ctx, userInfoGetter, req.ProjectID, req.Period, req.Format, projectProvider, privilegedProjectProvider, usageReportProvider)
+	}
+}
+
+// EstimateClusterCostReq defines HTTP request for estimateClusterCost
+// swagger:parameters estimateClusterCost
+type EstimateClusterCostReq struct {
+	common.ProjectReq
+	// in: body
+	Body apiv1.CreateClusterSpec
+}
+
+func DecodeEstimateClusterCostReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req EstimateClusterCostReq
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, k8cerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+	if req.Body.Cluster.Spec.Cloud.DatacenterName == "" {
+		return nil, k8cerrors.NewBadRequest("the datacenter of the cluster is required")
+	}
+
+	return req, nil
+}
+
+// EstimateMachineDeploymentCostReq defines HTTP request for estimateMachineDeploymentCost
+// swagger:parameters estimateMachineDeploymentCost
+type EstimateMachineDeploymentCostReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+	// in: body
+	Body apiv1.NodeDeployment
+}
+
+// GetSeedCluster returns the SeedCluster object
+func (req EstimateMachineDeploymentCostReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeEstimateMachineDeploymentCostReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req EstimateMachineDeploymentCostReq
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, k8cerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
+
+// ListUsageReportsReq defines HTTP request for listUsageReports
+// swagger:parameters listUsageReports
+type ListUsageReportsReq struct {
+	common.ProjectReq
+	// Period restricts the reports to a month, for example "2020-10"
+	// in: query
+	Period string `json:"period,omitempty"`
+	// Format of the response, either "json" or "csv", defaults to "json"
+	// in: query
+	Format string `json:"format,omitempty"`
+}
+
+func DecodeListUsageReportsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req ListUsageReportsReq
+
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = pr.(common.ProjectReq)
+
+	req.Period, req.Format, err = handlercommon.DecodeUsageReportQuery(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
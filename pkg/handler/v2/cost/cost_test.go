@@ -0,0 +1,209 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/handler/test"
+	"k8c.io/kubermatic/v2/pkg/handler/test/hack"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func genPriceList(datacenter string, sizes map[string]float64) *kubermaticv1.PriceList {
+	return &kubermaticv1.PriceList{
+		ObjectMeta: metav1.ObjectMeta{Name: datacenter},
+		Spec: kubermaticv1.PriceListSpec{
+			Currency:     "EUR",
+			ControlPlane: 0.25,
+			Sizes:        sizes,
+		},
+	}
+}
+
+func TestEstimateClusterCost(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name             string
+		Body             string
+		ExistingObjects  []runtime.Object
+		ExpectedResponse string
+		HTTPStatus       int
+	}{
+		{
+			Name:             "scenario 1: cluster and node deployment are estimated",
+			Body:             `{"cluster":{"name":"keen-snyder","spec":{"cloud":{"dc":"regular-do1","digitalocean":{"token":"dummy_token"}},"version":"1.18.8"}},"nodeDeployment":{"name":"workers","spec":{"replicas":3,"template":{"cloud":{"digitalocean":{"size":"s-2vcpu-4gb"}},"operatingSystem":{"ubuntu":{}}}}}}`,
+			ExistingObjects:  []runtime.Object{genPriceList("regular-do1", map[string]float64{"s-2vcpu-4gb": 0.5})},
+			ExpectedResponse: `{"datacenter":"regular-do1","currency":"EUR","controlPlane":182.5,"nodeDeployments":[{"name":"workers","size":"s-2vcpu-4gb","replicas":3,"hourly":1.5,"monthly":1095}],"hourly":1.75,"monthly":1277.5}`,
+			HTTPStatus:       http.StatusOK,
+		},
+		{
+			Name:             "scenario 2: sizes without a price are reported",
+			Body:             `{"cluster":{"name":"keen-snyder","spec":{"cloud":{"dc":"regular-do1","digitalocean":{"token":"dummy_token"}},"version":"1.18.8"}},"nodeDeployment":{"name":"workers","spec":{"replicas":3,"template":{"cloud":{"digitalocean":{"size":"s-8vcpu-16gb"}},"operatingSystem":{"ubuntu":{}}}}}}`,
+			ExistingObjects:  []runtime.Object{genPriceList("regular-do1", map[string]float64{"s-2vcpu-4gb": 0.5})},
+			ExpectedResponse: `{"datacenter":"regular-do1","currency":"EUR","controlPlane":182.5,"nodeDeployments":[{"name":"workers","size":"s-8vcpu-16gb","replicas":3,"hourly":0,"monthly":0}],"hourly":0.25,"monthly":182.5,"missingPrices":["s-8vcpu-16gb"]}`,
+			HTTPStatus:       http.StatusOK,
+		},
+		{
+			Name:             "scenario 3: the datacenter has no price list",
+			Body:             `{"cluster":{"name":"keen-snyder","spec":{"cloud":{"dc":"regular-do1","digitalocean":{"token":"dummy_token"}},"version":"1.18.8"}}}`,
+			ExpectedResponse: `{"error":{"code":404,"message":"price list \"regular-do1\" not found"}}`,
+			HTTPStatus:       http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/costestimate", test.GenDefaultProject().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []runtime.Object{}, test.GenDefaultKubermaticObjects(tc.ExistingObjects...), nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
+
+func TestEstimateMachineDeploymentCost(t *testing.T) {
+	t.Parallel()
+	cluster := test.GenDefaultCluster()
+	body := `{"name":"workers","spec":{"replicas":2,"template":{"cloud":{"aws":{"instanceType":"t3.medium","volumeSize":25}},"operatingSystem":{"ubuntu":{}}}}}`
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/machinedeployments/costestimate", test.ProjectName, cluster.Name), strings.NewReader(body))
+	res := httptest.NewRecorder()
+	kubermaticObjs := test.GenDefaultKubermaticObjects(cluster, genPriceList(cluster.Spec.Cloud.DatacenterName, map[string]float64{"t3.medium": 0.5}))
+	ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []runtime.Object{}, kubermaticObjs, nil, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint due to %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusOK {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusOK, res.Code, res.Body.String())
+	}
+	test.CompareWithResult(t, res, `{"datacenter":"FakeDatacenter","currency":"EUR","nodeDeployments":[{"name":"workers","size":"t3.medium","replicas":2,"hourly":1,"monthly":730}],"hourly":1,"monthly":730}`)
+}
+
+func TestListUsageReports(t *testing.T) {
+	t.Parallel()
+	genReport := func(projectID, period string) *kubermaticv1.UsageReport {
+		return &kubermaticv1.UsageReport{
+			ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%s", projectID, period)},
+			Spec:       kubermaticv1.UsageReportSpec{ProjectID: projectID, Period: period},
+			Status: kubermaticv1.UsageReportStatus{Clusters: []kubermaticv1.ClusterUsage{{
+				ClusterID:         "defClusterID",
+				ClusterName:       "defClusterName",
+				Datacenter:        "regular-do1",
+				Currency:          "EUR",
+				ControlPlaneHours: 10,
+				ControlPlaneCost:  2.5,
+				Nodes:             []kubermaticv1.NodeUsage{{Size: "s-2vcpu-4gb", NodeHours: 30, Cost: 15}},
+			}}},
+		}
+	}
+	formulaReport := genReport(test.GenDefaultProject().Name, "2020-08")
+	formulaReport.Status.Clusters[0].ClusterName = `=HYPERLINK("http://evil.com")`
+	reports := []runtime.Object{
+		genReport(test.GenDefaultProject().Name, "2020-10"),
+		genReport(test.GenDefaultProject().Name, "2020-09"),
+		genReport("other-project", "2020-10"),
+	}
+
+	testcases := []struct {
+		Name                string
+		Query               string
+		ExtraReports        []runtime.Object
+		ExpectedContentType string
+		ExpectedResponse    string
+		HTTPStatus          int
+	}{
+		{
+			Name:                "scenario 1: all reports of the project are listed",
+			ExpectedContentType: "application/json",
+			ExpectedResponse:    `[{"projectID":"my-first-project-ID","period":"2020-09","clusters":[{"clusterID":"defClusterID","clusterName":"defClusterName","datacenter":"regular-do1","currency":"EUR","controlPlaneHours":10,"controlPlaneCost":2.5,"nodes":[{"size":"s-2vcpu-4gb","nodeHours":30,"cost":15}]}]},{"projectID":"my-first-project-ID","period":"2020-10","clusters":[{"clusterID":"defClusterID","clusterName":"defClusterName","datacenter":"regular-do1","currency":"EUR","controlPlaneHours":10,"controlPlaneCost":2.5,"nodes":[{"size":"s-2vcpu-4gb","nodeHours":30,"cost":15}]}]}]`,
+			HTTPStatus:          http.StatusOK,
+		},
+		{
+			Name:                "scenario 2: a single month is exported as CSV",
+			Query:               "?period=2020-10&format=csv",
+			ExpectedContentType: "text/csv",
+			ExpectedResponse: "period,project_id,cluster_id,cluster_name,datacenter,item,hours,cost,currency\n" +
+				"2020-10,my-first-project-ID,defClusterID,defClusterName,regular-do1,control-plane,10.00,2.50,EUR\n" +
+				"2020-10,my-first-project-ID,defClusterID,defClusterName,regular-do1,node:s-2vcpu-4gb,30.00,15.00,EUR\n",
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:                "scenario 3: cells which would be evaluated as a formula are escaped",
+			Query:               "?period=2020-08&format=csv",
+			ExtraReports:        []runtime.Object{formulaReport},
+			ExpectedContentType: "text/csv",
+			ExpectedResponse: "period,project_id,cluster_id,cluster_name,datacenter,item,hours,cost,currency\n" +
+				"2020-08,my-first-project-ID,defClusterID,\"'=HYPERLINK(\"\"http://evil.com\"\")\",regular-do1,control-plane,10.00,2.50,EUR\n" +
+				"2020-08,my-first-project-ID,defClusterID,\"'=HYPERLINK(\"\"http://evil.com\"\")\",regular-do1,node:s-2vcpu-4gb,30.00,15.00,EUR\n",
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:                "scenario 4: an invalid period is rejected",
+			Query:               "?period=october",
+			ExpectedContentType: "application/json",
+			ExpectedResponse:    `{"error":{"code":400,"message":"invalid period \"october\", the expected format is YYYY-MM"}}`,
+			HTTPStatus:          http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/usagereports%s", test.GenDefaultProject().Name, tc.Query), strings.NewReader(""))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenDefaultAPIUser(), []runtime.Object{}, test.GenDefaultKubermaticObjects(append(tc.ExtraReports, reports...)...), nil, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint due to %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+			if contentType := res.Header().Get("Content-Type"); !strings.HasPrefix(contentType, tc.ExpectedContentType) {
+				t.Errorf("Expected content type %q, got %q", tc.ExpectedContentType, contentType)
+			}
+			if tc.ExpectedContentType == "text/csv" {
+				if res.Body.String() != tc.ExpectedResponse {
+					t.Fatalf("Expected CSV\n%s\ngot\n%s", tc.ExpectedResponse, res.Body.String())
+				}
+				return
+			}
+			test.CompareWithResult(t, res, tc.ExpectedResponse)
+		})
+	}
+}
@@ -24,16 +24,20 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
 
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/handler"
+	handlercommon "k8c.io/kubermatic/v2/pkg/handler/common"
 	"k8c.io/kubermatic/v2/pkg/handler/middleware"
 	"k8c.io/kubermatic/v2/pkg/handler/v1/common"
 	"k8c.io/kubermatic/v2/pkg/handler/v2/cluster"
 	constrainttemplate "k8c.io/kubermatic/v2/pkg/handler/v2/constraint_template"
+	"k8c.io/kubermatic/v2/pkg/handler/v2/cost"
 	externalcluster "k8c.io/kubermatic/v2/pkg/handler/v2/external_cluster"
 )
 
 // RegisterV2 declares all router paths for v2
 func (r Routing) RegisterV2(mux *mux.Router, metrics common.ServerMetrics) {
+	mux.Use(handler.MaintenanceMode(r.settingsProvider, r.userProvider, r.tokenVerifiers, r.tokenExtractors))
 
 	// Defines a set of HTTP endpoints for cluster that belong to a project.
 	mux.Methods(http.MethodPost).
@@ -64,6 +68,38 @@ func (r Routing) RegisterV2(mux *mux.Router, metrics common.ServerMetrics) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/health").
 		Handler(r.getClusterHealth())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/hibernate").
+		Handler(r.hibernateCluster())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/resume").
+		Handler(r.resumeCluster())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/undelete").
+		Handler(r.undeleteCluster())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/deletionpreview").
+		Handler(r.getClusterDeletionPreview())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/move").
+		Handler(r.moveCluster())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/costestimate").
+		Handler(r.estimateClusterCost())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate").
+		Handler(r.estimateMachineDeploymentCost())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/usagereports").
+		Handler(r.listUsageReports())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/kubeconfig").
 		Handler(r.getClusterKubeconfig())
@@ -184,11 +220,12 @@ func (r Routing) createCluster(initNodeDeploymentFailures *prometheus.CounterVec
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "create"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.CreateEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, initNodeDeploymentFailures, r.eventRecorderProvider, r.presetsProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.updateManager)),
+		)(cluster.CreateEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, initNodeDeploymentFailures, r.eventRecorderProvider, r.presetsProvider, r.exposeStrategy, r.userInfoGetter, r.settingsProvider, r.updateManager, r.operationProvider)),
 		cluster.DecodeCreateReq,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		handlercommon.EncodeOperation(handler.SetStatusCreatedHeader(handler.EncodeJSON)),
 		r.defaultServerOptions()...,
 	)
 }
@@ -246,7 +283,8 @@ func (r Routing) getCluster() http.Handler {
 // Delete the cluster
 // swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id} project deleteClusterV2
 //
-//     Deletes the specified cluster
+//     Deletes the specified cluster. If a deletion grace period is configured, the cluster is hibernated
+//     and can be undeleted until the grace period is over.
 //
 //     Produces:
 //     - application/json
@@ -256,6 +294,7 @@ func (r Routing) getCluster() http.Handler {
 //       200: empty
 //       401: empty
 //       403: empty
+//       409: empty
 func (r Routing) deleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
@@ -263,9 +302,9 @@ func (r Routing) deleteCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.settingsProvider, r.operationProvider)),
 		cluster.DecodeDeleteReq,
-		handler.EncodeJSON,
+		handlercommon.EncodeOperation(handler.EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
@@ -287,11 +326,12 @@ func (r Routing) patchCluster() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter)),
+		)(cluster.PatchEndpoint(r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.operationProvider)),
 		cluster.DecodePatchReq,
-		handler.EncodeJSON,
+		handlercommon.EncodeOperation(handler.EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
@@ -349,6 +389,230 @@ func (r Routing) getClusterHealth() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate project hibernateClusterV2
+//
+//     Scales the worker nodes and the control plane of the cluster to zero.
+//     The progress is reported in the phase of the cluster status.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: Cluster
+//       401: empty
+//       403: empty
+//       409: empty
+func (r Routing) hibernateCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.HibernateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/resume project resumeClusterV2
+//
+//     Scales the control plane and the worker nodes of a hibernated cluster up again.
+//     The progress is reported in the phase of the cluster status.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: Cluster
+//       401: empty
+//       403: empty
+//       409: empty
+func (r Routing) resumeCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ResumeEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/undelete project undeleteClusterV2
+//
+//     Cancels the deletion of a cluster which is kept for the deletion grace period.
+//     The cluster is resumed unless it was hibernated before it got deleted.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: Cluster
+//       401: empty
+//       403: empty
+//       409: empty
+func (r Routing) undeleteCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UndeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/deletionpreview project getClusterDeletionPreviewV2
+//
+//     Lists the resources which are removed when the cluster gets deleted.
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: ClusterDeletionPreview
+//       401: empty
+//       403: empty
+//       409: empty
+func (r Routing) getClusterDeletionPreview() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetDeletionPreviewEndpoint(r.seedsGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/move project moveClusterV2
+//
+//     Moves the cluster to another project. The caller has to be an owner of both projects.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: Cluster
+//       401: empty
+//       403: empty
+//       409: empty
+func (r Routing) moveCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.MoveEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeMoveReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/costestimate project estimateClusterCost
+//
+//     Estimates the monthly cost of a cluster and its initial node deployment before it gets created.
+//     The prices are taken from the price list of the datacenter of the cluster.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: CostEstimate
+//       401: empty
+//       403: empty
+func (r Routing) estimateClusterCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(cost.EstimateClusterCostEndpoint(r.seedsGetter, r.projectProvider, r.privilegedProjectProvider, r.priceListProvider, r.userInfoGetter)),
+		cost.DecodeEstimateClusterCostReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate project estimateMachineDeploymentCost
+//
+//     Estimates the monthly cost of a machine deployment of the cluster before it gets created.
+//
+//     Consumes:
+//     - application/json
+//
+//     Produces:
+//     - application/json
+//
+//     Responses:
+//       default: errorResponse
+//       200: CostEstimate
+//       401: empty
+//       403: empty
+func (r Routing) estimateMachineDeploymentCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cost.EstimateMachineDeploymentCostEndpoint(r.projectProvider, r.privilegedProjectProvider, r.priceListProvider, r.userInfoGetter)),
+		cost.DecodeEstimateMachineDeploymentCostReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/usagereports project listUsageReports
+//
+//     Lists the monthly usage reports of the project, they contain the node-hours and the costs of every cluster.
+//
+//     Produces:
+//     - application/json
+//     - text/csv
+//
+//     Responses:
+//       default: errorResponse
+//       200: []UsageReport
+//       401: empty
+//       403: empty
+func (r Routing) listUsageReports() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(cost.ListUsageReportsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.usageReportProvider, r.userInfoGetter)),
+		cost.DecodeListUsageReportsReq,
+		handlercommon.EncodeUsageReports,
+		r.defaultServerOptions()...,
+	)
+}
+
 // getClusterKubeconfig returns the kubeconfig for the cluster.
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/kubeconfig project getClusterKubeconfigV2
 //
@@ -420,6 +684,7 @@ func (r Routing) getClusterMetrics() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.GetMetricsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -446,6 +711,7 @@ func (r Routing) listNamespace() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.ListNamespaceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -472,6 +738,7 @@ func (r Routing) getClusterUpgrades() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.ClusterKindName, "get"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.GetUpgradesEndpoint(r.updateManager, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
@@ -498,6 +765,7 @@ func (r Routing) upgradeClusterNodeDeployments() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectRoles(r.userInfoGetter, r.projectRoleProvider, kubermaticv1.NodeDeploymentResourceKind, "update"),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
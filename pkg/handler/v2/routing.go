@@ -77,6 +77,10 @@ type Routing struct {
 	externalClusterProvider               provider.ExternalClusterProvider
 	privilegedExternalClusterProvider     provider.PrivilegedExternalClusterProvider
 	constraintTemplateProvider            provider.ConstraintTemplateProvider
+	projectRoleProvider                   provider.ProjectRoleProvider
+	priceListProvider                     provider.PriceListProvider
+	usageReportProvider                   provider.UsageReportProvider
+	operationProvider                     provider.OperationProvider
 }
 
 // NewV2Routing creates a new Routing.
@@ -121,6 +125,10 @@ func NewV2Routing(routingParams handler.RoutingParams) Routing {
 		externalClusterProvider:               routingParams.ExternalClusterProvider,
 		privilegedExternalClusterProvider:     routingParams.PrivilegedExternalClusterProvider,
 		constraintTemplateProvider:            routingParams.ConstraintTemplateProvider,
+		projectRoleProvider:                   routingParams.ProjectRoleProvider,
+		priceListProvider:                     routingParams.PriceListProvider,
+		usageReportProvider:                   routingParams.UsageReportProvider,
+		operationProvider:                     routingParams.OperationProvider,
 	}
 }
 
@@ -0,0 +1,193 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8c.io/kubermatic/v2/pkg/log"
+	k8cerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	"k8c.io/kubermatic/v2/pkg/watcher"
+
+	"code.cloudfoundry.org/go-pubsub"
+	"github.com/gorilla/websocket"
+)
+
+// ClusterResourceGetter returns the current state of the streamed resource. It is expected to
+// run the same authorization checks as the equivalent REST endpoint, so that every message sent
+// to the client is checked against the current RBAC rules.
+type ClusterResourceGetter func() (interface{}, error)
+
+// streamFinished returns true if the given state of the streamed resource is final, the stream is
+// closed after it was sent.
+type streamFinished func(data interface{}) bool
+
+// WriteClusters writes the clusters of the given project and rewrites them each time any of them changes.
+// The stream is stopped when the given context is cancelled.
+func WriteClusters(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter ClusterResourceGetter, projectID string) {
+	projectHash, err := providers.ClusterWatcher.CalculateHash(projectID)
+	if err != nil {
+		log.Logger.Debug(err)
+		return
+	}
+
+	writeClusterStream(ctx, providers, ws, getter, nil, []uint64{projectHash}, 0)
+}
+
+// WriteClusterResource writes a resource that belongs to the given cluster and rewrites it each time the cluster
+// changes. Resources that are not part of the cluster object, like node deployments or events, are additionally
+// refreshed every resyncPeriod. A zero resyncPeriod disables the refresh. The stream is stopped when the given
+// context is cancelled.
+func WriteClusterResource(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter ClusterResourceGetter, projectID, clusterID string, resyncPeriod time.Duration) {
+	projectHash, err := providers.ClusterWatcher.CalculateHash(projectID)
+	if err != nil {
+		log.Logger.Debug(err)
+		return
+	}
+	clusterHash, err := providers.ClusterWatcher.CalculateHash(clusterID)
+	if err != nil {
+		log.Logger.Debug(err)
+		return
+	}
+
+	writeClusterStream(ctx, providers, ws, getter, nil, []uint64{projectHash, clusterHash}, resyncPeriod)
+}
+
+// clusterStream serializes the writes coming from the cluster watcher and the resync ticker,
+// identical consecutive responses are sent only once.
+type clusterStream struct {
+	ws       *websocket.Conn
+	getter   ClusterResourceGetter
+	finished streamFinished
+
+	lock         sync.Mutex
+	lastResponse []byte
+	ctx          context.Context
+	stop         context.CancelFunc
+}
+
+func writeClusterStream(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter ClusterResourceGetter, finished streamFinished, path []uint64, resyncPeriod time.Duration) {
+	ctx, stop := context.WithCancel(ctx)
+	stream := &clusterStream{
+		ws:       ws,
+		getter:   getter,
+		finished: finished,
+		ctx:      ctx,
+		stop:     stop,
+	}
+
+	// There can be a race here if the resource changes between getting the initial data and setting up the subscription
+	if !stream.write() {
+		return
+	}
+
+	unSub := providers.ClusterWatcher.Subscribe(func(_ interface{}) {
+		stream.write()
+	}, pubsub.WithPath(path))
+	go func() {
+		<-ctx.Done()
+		unSub()
+	}()
+
+	if resyncPeriod > 0 {
+		go stream.resync(resyncPeriod)
+	}
+}
+
+// write gets the current state of the resource and sends it to the client. It returns false and stops the stream
+// if the resource cannot be read anymore, e.g. because it was deleted or the user lost access to it, or if the
+// sent state is final.
+func (stream *clusterStream) write() bool {
+	stream.lock.Lock()
+	defer stream.lock.Unlock()
+
+	if stream.ctx.Err() != nil {
+		return false
+	}
+
+	data, err := stream.getter()
+	if err != nil {
+		log.Logger.Debug(err)
+		stream.stop()
+		if err := writeErrorCloseMessage(stream.ws, err); err != nil {
+			log.Logger.Debug(err)
+		}
+		return false
+	}
+
+	response, err := json.Marshal(data)
+	if err != nil {
+		log.Logger.Debug(err)
+		return true
+	}
+	if bytes.Equal(response, stream.lastResponse) {
+		return true
+	}
+
+	if err := stream.ws.WriteMessage(websocket.TextMessage, response); err != nil {
+		log.Logger.Debug(err)
+		stream.stop()
+		return false
+	}
+	stream.lastResponse = response
+
+	if stream.finished != nil && stream.finished(data) {
+		stream.stop()
+		if err := writeCloseMessage(stream.ws, websocket.CloseNormalClosure); err != nil {
+			log.Logger.Debug(err)
+		}
+		return false
+	}
+	return true
+}
+
+func (stream *clusterStream) resync(period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.ctx.Done():
+			return
+		case <-ticker.C:
+			if !stream.write() {
+				return
+			}
+		}
+	}
+}
+
+// writes close control message with the reason why the stream has been terminated.
+func writeErrorCloseMessage(ws *websocket.Conn, err error) error {
+	code := websocket.CloseInternalServerErr
+	if httpErr, ok := err.(k8cerrors.HTTPError); ok {
+		switch httpErr.StatusCode() {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			code = websocket.ClosePolicyViolation
+		case http.StatusNotFound:
+			code = websocket.CloseNormalClosure
+		}
+	}
+
+	message := websocket.FormatCloseMessage(code, err.Error())
+	return ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
+}
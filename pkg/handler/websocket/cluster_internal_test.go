@@ -0,0 +1,137 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	"k8c.io/kubermatic/v2/pkg/watcher"
+
+	"code.cloudfoundry.org/go-pubsub"
+	"github.com/gorilla/websocket"
+)
+
+// fakeClusterWatcher records whether the subscription is still active
+type fakeClusterWatcher struct {
+	lock       sync.Mutex
+	subscribed bool
+}
+
+func (w *fakeClusterWatcher) Subscribe(_ pubsub.Subscription, _ ...pubsub.SubscribeOption) pubsub.Unsubscriber {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.subscribed = true
+	return func() {
+		w.lock.Lock()
+		defer w.lock.Unlock()
+		w.subscribed = false
+	}
+}
+
+func (w *fakeClusterWatcher) CalculateHash(id string) (uint64, error) {
+	return uint64(len(id)), nil
+}
+
+func (w *fakeClusterWatcher) isSubscribed() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.subscribed
+}
+
+func TestWriteClustersStopsWhenContextIsCancelled(t *testing.T) {
+	clusterWatcher := &fakeClusterWatcher{}
+	providers := watcher.Providers{ClusterWatcher: clusterWatcher}
+	getter := func() (interface{}, error) {
+		return []string{"cluster"}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection: %v", err)
+			return
+		}
+		WriteClusters(ctx, providers, ws, getter, "my-project")
+		close(started)
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	if _, message, err := conn.ReadMessage(); err != nil || string(message) != `["cluster"]` {
+		t.Fatalf("expected the initial message, got %q: %v", message, err)
+	}
+
+	<-started
+	if !clusterWatcher.isSubscribed() {
+		t.Fatal("expected the stream to subscribe to the cluster watcher")
+	}
+
+	cancel()
+	for i := 0; i < 100 && clusterWatcher.isSubscribed(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if clusterWatcher.isSubscribed() {
+		t.Error("expected the subscription to be removed after the context was cancelled")
+	}
+}
+
+func TestWriteOperationStopsWhenOperationIsFinished(t *testing.T) {
+	clusterWatcher := &fakeClusterWatcher{}
+	providers := watcher.Providers{ClusterWatcher: clusterWatcher}
+	getter := func() (interface{}, error) {
+		return apiv1.Operation{Phase: "Succeeded"}, nil
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade connection: %v", err)
+			return
+		}
+		WriteOperation(context.Background(), providers, ws, getter, "my-project")
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+	if _, _, err := conn.ReadMessage(); err != nil {
+		t.Fatalf("expected the operation, got: %v", err)
+	}
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		t.Fatalf("expected the stream to be closed after the finished operation, got: %v", err)
+	}
+	if clusterWatcher.isSubscribed() {
+		t.Error("expected no subscription for a finished operation")
+	}
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"context"
+	"time"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/watcher"
+
+	"github.com/gorilla/websocket"
+)
+
+// operationResyncPeriod is the interval in which the operation is refreshed. The operation status
+// is updated by a controller in the master cluster which is not covered by the cluster watcher.
+const operationResyncPeriod = 5 * time.Second
+
+// WriteOperation writes the given operation and rewrites it each time it changes. The stream is closed
+// once the operation succeeded or failed, or once it cannot be read anymore, e.g. after it was garbage
+// collected. It is also stopped when the given context is cancelled.
+func WriteOperation(ctx context.Context, providers watcher.Providers, ws *websocket.Conn, getter ClusterResourceGetter, projectID string) {
+	projectHash, err := providers.ClusterWatcher.CalculateHash(projectID)
+	if err != nil {
+		log.Logger.Debug(err)
+		return
+	}
+
+	writeClusterStream(ctx, providers, ws, getter, operationFinished, []uint64{projectHash}, operationResyncPeriod)
+}
+
+func operationFinished(data interface{}) bool {
+	operation, ok := data.(apiv1.Operation)
+	if !ok {
+		return false
+	}
+	phase := kubermaticv1.OperationPhase(operation.Phase)
+	return phase == kubermaticv1.OperationPhaseSucceeded || phase == kubermaticv1.OperationPhaseFailed
+}
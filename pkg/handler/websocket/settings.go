@@ -17,7 +17,11 @@ limitations under the License.
 package websocket
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
 
 	api "k8c.io/kubermatic/v2/pkg/api/v1"
 	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
@@ -27,7 +31,13 @@ import (
 	"github.com/gorilla/websocket"
 )
 
-func WriteSettings(providers watcher.Providers, ws *websocket.Conn) {
+// scheduleResyncPeriod is the interval in which the settings are checked for announcements
+// and maintenance windows that started or ended since the last message
+const scheduleResyncPeriod = 30 * time.Second
+
+// WriteSettings writes the global settings and rewrites them each time they change. The stream is stopped
+// when the given context is cancelled.
+func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websocket.Conn) {
 	// There can be a race here if the settings change between getting the initial data and setting up the subscription
 	initialSettings, err := providers.SettingsProvider.GetGlobalSettings()
 	if err != nil {
@@ -46,13 +56,23 @@ func WriteSettings(providers watcher.Providers, ws *websocket.Conn) {
 		return
 	}
 
+	// The connection supports only one concurrent writer, the lock also guards the last sent settings.
+	var lock sync.Mutex
+	lastSettings := &initialSettings.Spec
+	lastSchedule := scheduleState(lastSettings, time.Now())
+
 	unSub := providers.SettingsWatcher.Subscribe(func(settings interface{}) {
+		lock.Lock()
+		defer lock.Unlock()
+
 		var response []byte
 		if settings != nil {
 			var externalSettings api.GlobalSettings
 			internalSettings, ok := settings.(*v1.KubermaticSetting)
 			if ok {
 				externalSettings = api.GlobalSettings(internalSettings.Spec)
+				lastSettings = &internalSettings.Spec
+				lastSchedule = scheduleState(lastSettings, time.Now())
 			} else {
 				log.Logger.Debug("cannot convert settings: %v", settings)
 			}
@@ -78,8 +98,41 @@ func WriteSettings(providers watcher.Providers, ws *websocket.Conn) {
 		}
 	})
 
-	ws.SetCloseHandler(func(code int, text string) error {
-		unSub()
-		return writeCloseMessage(ws, code)
-	})
+	// Resend the settings when a scheduled announcement or maintenance starts or ends. Clients get the
+	// schedules with every message, the resend makes sure that clients which only react to messages
+	// notice the change within the resync period.
+	go func() {
+		ticker := time.NewTicker(scheduleResyncPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				unSub()
+				return
+			case now := <-ticker.C:
+				lock.Lock()
+				if schedule := scheduleState(lastSettings, now); schedule != lastSchedule {
+					lastSchedule = schedule
+					response, err := json.Marshal(api.GlobalSettings(*lastSettings))
+					if err != nil {
+						log.Logger.Debug(err)
+					} else if err := ws.WriteMessage(websocket.TextMessage, response); err != nil {
+						log.Logger.Debug(err)
+					}
+				}
+				lock.Unlock()
+			}
+		}
+	}()
+}
+
+// scheduleState describes which announcements and whether the maintenance mode are active at the given time
+func scheduleState(settings *v1.SettingSpec, now time.Time) string {
+	state := fmt.Sprintf("maintenance=%t", settings.MaintenanceMode.IsActive(now))
+	for _, announcement := range settings.Announcements {
+		if announcement.IsActive(now) {
+			state += "," + announcement.ID
+		}
+	}
+	return state
 }
@@ -0,0 +1,136 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+const (
+	// TerminalOpStdin is sent by the client and carries the user input in the Data field
+	TerminalOpStdin = "stdin"
+	// TerminalOpStdout is sent by the server and carries the terminal output in the Data field
+	TerminalOpStdout = "stdout"
+	// TerminalOpResize is sent by the client whenever the terminal size changes, it uses the Rows and Cols fields
+	TerminalOpResize = "resize"
+)
+
+// TerminalMessage is the message exchanged between the browser and the API during a web terminal session
+type TerminalMessage struct {
+	Op   string `json:"op"`
+	Data string `json:"data,omitempty"`
+	Rows uint16 `json:"rows,omitempty"`
+	Cols uint16 `json:"cols,omitempty"`
+}
+
+// TerminalSession connects a websocket to the streams of an interactive remote command.
+// It implements io.Reader for stdin, io.Writer for stdout and remotecommand.TerminalSizeQueue.
+type TerminalSession struct {
+	ws       *websocket.Conn
+	stdin    bytes.Buffer
+	sizeChan chan remotecommand.TerminalSize
+	doneChan chan struct{}
+	doneOnce sync.Once
+}
+
+// NewTerminalSession returns a new terminal session for the given websocket connection
+func NewTerminalSession(ws *websocket.Conn) *TerminalSession {
+	return &TerminalSession{
+		ws:       ws,
+		sizeChan: make(chan remotecommand.TerminalSize, 1),
+		doneChan: make(chan struct{}),
+	}
+}
+
+// Read reads the user input from the websocket. Resize messages are passed to the size queue.
+func (s *TerminalSession) Read(p []byte) (int, error) {
+	for s.stdin.Len() == 0 {
+		_, rawMessage, err := s.ws.ReadMessage()
+		if err != nil {
+			// the client went away, closing stdin ends the remote shell
+			return 0, io.EOF
+		}
+
+		message := TerminalMessage{}
+		if err := json.Unmarshal(rawMessage, &message); err != nil {
+			return 0, fmt.Errorf("failed to decode terminal message: %v", err)
+		}
+
+		switch message.Op {
+		case TerminalOpStdin:
+			s.stdin.WriteString(message.Data)
+		case TerminalOpResize:
+			s.resize(remotecommand.TerminalSize{Width: message.Cols, Height: message.Rows})
+		default:
+			return 0, fmt.Errorf("unknown terminal message type %q", message.Op)
+		}
+	}
+
+	return s.stdin.Read(p)
+}
+
+// Write sends the terminal output to the websocket
+func (s *TerminalSession) Write(p []byte) (int, error) {
+	message, err := json.Marshal(TerminalMessage{Op: TerminalOpStdout, Data: string(p)})
+	if err != nil {
+		return 0, err
+	}
+	if err := s.ws.WriteMessage(websocket.TextMessage, message); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Next returns the latest terminal size, it blocks until the size changes or the session is closed
+func (s *TerminalSession) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-s.sizeChan:
+		return &size
+	case <-s.doneChan:
+		return nil
+	}
+}
+
+// Close stops the size queue and closes the websocket connection with the given reason
+func (s *TerminalSession) Close(code int, reason string) error {
+	s.doneOnce.Do(func() {
+		close(s.doneChan)
+	})
+
+	message := websocket.FormatCloseMessage(code, reason)
+	if err := s.ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second)); err != nil {
+		return err
+	}
+	return s.ws.Close()
+}
+
+// resize replaces a pending size which was not consumed yet, only the latest size matters
+func (s *TerminalSession) resize(size remotecommand.TerminalSize) {
+	select {
+	case <-s.sizeChan:
+	default:
+	}
+	s.sizeChan <- size
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+var invitationTemplate = template.Must(template.New("invitation").Parse(`{{ .InvitedBy }} invited you to join the project "{{ .ProjectName }}" ({{ .ProjectID }}) as a member of the {{ .Group }} group.
+
+Log in to {{ .DashboardURL }} to accept or decline the invitation.
+The invitation expires on {{ .ExpiresAt }}.
+`))
+
+// defaultSendTimeout bounds the whole conversation with the SMTP server, the invitations are sent
+// while the API request is being served
+const defaultSendTimeout = 10 * time.Second
+
+// SMTPOptions holds the configuration of the SMTP server the notifications are sent through
+type SMTPOptions struct {
+	// Address is the host:port of the SMTP server
+	Address string
+	// Username and Password are used for PLAIN authentication, no authentication is done if Username is empty
+	Username string
+	Password string
+	// From is the sender address of the notifications
+	From string
+	// DashboardURL is the address of the dashboard linked in the notifications
+	DashboardURL string
+}
+
+// SMTPInvitationNotifier sends the project invitations by email
+type SMTPInvitationNotifier struct {
+	options     SMTPOptions
+	sendTimeout time.Duration
+}
+
+var _ provider.InvitationNotifier = &SMTPInvitationNotifier{}
+
+// NewSMTPInvitationNotifier returns a notifier sending the invitations through the given SMTP server
+func NewSMTPInvitationNotifier(options SMTPOptions) (*SMTPInvitationNotifier, error) {
+	if _, _, err := net.SplitHostPort(options.Address); err != nil {
+		return nil, fmt.Errorf("invalid SMTP server address %q: %v", options.Address, err)
+	}
+	if options.From == "" {
+		return nil, fmt.Errorf("the sender address of the notifications cannot be empty")
+	}
+	return &SMTPInvitationNotifier{options: options, sendTimeout: defaultSendTimeout}, nil
+}
+
+// NotifyInvitation sends an email about the given invitation to the invited user
+func (n *SMTPInvitationNotifier) NotifyInvitation(invitation *kubermaticv1.ProjectInvitation, project *kubermaticv1.Project) error {
+	msg, err := n.invitationMessage(invitation, project)
+	if err != nil {
+		return err
+	}
+	if err := n.sendMail(invitation.Spec.UserEmail, msg); err != nil {
+		return fmt.Errorf("failed to send the invitation to %s: %v", invitation.Spec.UserEmail, err)
+	}
+	return nil
+}
+
+// sendMail does the same as smtp.SendMail, but gives up once the send timeout has passed
+func (n *SMTPInvitationNotifier) sendMail(to string, msg []byte) error {
+	host, _, err := net.SplitHostPort(n.options.Address)
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialTimeout("tcp", n.options.Address, n.sendTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(n.sendTimeout)); err != nil {
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if n.options.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", n.options.Username, n.options.Password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(n.options.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (n *SMTPInvitationNotifier) invitationMessage(invitation *kubermaticv1.ProjectInvitation, project *kubermaticv1.Project) ([]byte, error) {
+	body := &bytes.Buffer{}
+	err := invitationTemplate.Execute(body, struct {
+		InvitedBy    string
+		ProjectName  string
+		ProjectID    string
+		Group        string
+		DashboardURL string
+		ExpiresAt    string
+	}{
+		InvitedBy:    invitation.Spec.InvitedBy,
+		ProjectName:  project.Spec.Name,
+		ProjectID:    project.Name,
+		Group:        rbac.ExtractGroupPrefix(invitation.Spec.Group),
+		DashboardURL: n.options.DashboardURL,
+		ExpiresAt:    invitation.Spec.ExpiresAt.UTC().Format(time.RFC1123),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to render the invitation: %v", err)
+	}
+
+	msg := &strings.Builder{}
+	fmt.Fprintf(msg, "From: %s\r\n", n.options.From)
+	fmt.Fprintf(msg, "To: %s\r\n", invitation.Spec.UserEmail)
+	// the project name is user input, it must not be able to add headers
+	subject := "You have been invited to the project " + stripLineBreaks(project.Spec.Name)
+	fmt.Fprintf(msg, "Subject: %s\r\n", mime.QEncoding.Encode("UTF-8", subject))
+	fmt.Fprintf(msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body.String(), "\n", "\r\n"))
+
+	return []byte(msg.String()), nil
+}
+
+func stripLineBreaks(s string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(s)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package notification
+
+import (
+	"bufio"
+	"bytes"
+	"mime"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// receivedMail is a message accepted by fakeSMTPServer
+type receivedMail struct {
+	from string
+	to   []string
+	data string
+}
+
+// fakeSMTPServer accepts a single message on a local port and hands it over through the returned channel
+func fakeSMTPServer(t *testing.T) (string, <-chan receivedMail) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	mails := make(chan receivedMail, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		tp := textproto.NewConn(conn)
+		mail := receivedMail{}
+		_ = tp.PrintfLine("220 localhost ESMTP")
+		for {
+			line, err := tp.ReadLine()
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(line)
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				_ = tp.PrintfLine("250 localhost")
+			case strings.HasPrefix(cmd, "MAIL FROM:"):
+				mail.from = strings.Trim(line[len("MAIL FROM:"):], "<> ")
+				_ = tp.PrintfLine("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO:"):
+				mail.to = append(mail.to, strings.Trim(line[len("RCPT TO:"):], "<> "))
+				_ = tp.PrintfLine("250 OK")
+			case cmd == "DATA":
+				_ = tp.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+				data, err := tp.ReadDotBytes()
+				if err != nil {
+					return
+				}
+				mail.data = string(data)
+				_ = tp.PrintfLine("250 OK")
+				mails <- mail
+			case cmd == "QUIT":
+				_ = tp.PrintfLine("221 Bye")
+				return
+			default:
+				_ = tp.PrintfLine("502 Command not implemented")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), mails
+}
+
+func TestNotifyInvitation(t *testing.T) {
+	address, mails := fakeSMTPServer(t)
+
+	notifier, err := NewSMTPInvitationNotifier(SMTPOptions{
+		Address:      address,
+		From:         "kubermatic@acme.com",
+		DashboardURL: "https://kubermatic.acme.com",
+	})
+	if err != nil {
+		t.Fatalf("failed to create the notifier: %v", err)
+	}
+
+	project := &kubermaticv1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "plan9-ID"},
+		Spec:       kubermaticv1.ProjectSpec{Name: "plan9"},
+	}
+	invitation := &kubermaticv1.ProjectInvitation{
+		ObjectMeta: metav1.ObjectMeta{Name: "invitation"},
+		Spec: kubermaticv1.ProjectInvitationSpec{
+			ProjectID: "plan9-ID",
+			UserEmail: "bob@acme.com",
+			Group:     "editors-plan9-ID",
+			InvitedBy: "john@acme.com",
+			ExpiresAt: metav1.NewTime(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)),
+		},
+	}
+
+	if err := notifier.NotifyInvitation(invitation, project); err != nil {
+		t.Fatalf("failed to send the invitation: %v", err)
+	}
+
+	var mail receivedMail
+	select {
+	case mail = <-mails:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the invitation to be received")
+	}
+
+	if mail.from != "kubermatic@acme.com" {
+		t.Errorf("expected the sender kubermatic@acme.com, got %s", mail.from)
+	}
+	if len(mail.to) != 1 || mail.to[0] != "bob@acme.com" {
+		t.Errorf("expected the recipient bob@acme.com, got %v", mail.to)
+	}
+
+	msg, err := textproto.NewReader(bufio.NewReader(strings.NewReader(mail.data))).ReadMIMEHeader()
+	if err != nil {
+		t.Fatalf("failed to parse the message headers: %v", err)
+	}
+	if subject := msg.Get("Subject"); subject != "You have been invited to the project plan9" {
+		t.Errorf("unexpected subject %q", subject)
+	}
+	for _, expected := range []string{
+		`john@acme.com invited you to join the project "plan9" (plan9-ID) as a member of the editors group.`,
+		"Log in to https://kubermatic.acme.com to accept or decline the invitation.",
+		"The invitation expires on Wed, 02 Jan 2030 03:04:05 UTC.",
+	} {
+		if !strings.Contains(mail.data, expected) {
+			t.Errorf("expected the message to contain %q, got:\n%s", expected, mail.data)
+		}
+	}
+}
+
+func TestInvitationSubjectCannotInjectHeaders(t *testing.T) {
+	notifier, err := NewSMTPInvitationNotifier(SMTPOptions{Address: "localhost:25", From: "kubermatic@acme.com"})
+	if err != nil {
+		t.Fatalf("failed to create the notifier: %v", err)
+	}
+	invitation := &kubermaticv1.ProjectInvitation{Spec: kubermaticv1.ProjectInvitationSpec{UserEmail: "bob@acme.com"}}
+
+	testCases := []struct {
+		projectName     string
+		expectedSubject string
+	}{
+		{
+			projectName:     "plan9\r\nBcc: eve@evil.com",
+			expectedSubject: "You have been invited to the project plan9Bcc: eve@evil.com",
+		},
+		{
+			projectName:     "Zürich",
+			expectedSubject: "You have been invited to the project Zürich",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.projectName, func(t *testing.T) {
+			project := &kubermaticv1.Project{Spec: kubermaticv1.ProjectSpec{Name: tc.projectName}}
+			data, err := notifier.invitationMessage(invitation, project)
+			if err != nil {
+				t.Fatalf("failed to render the invitation: %v", err)
+			}
+
+			header, err := textproto.NewReader(bufio.NewReader(bytes.NewReader(data))).ReadMIMEHeader()
+			if err != nil {
+				t.Fatalf("failed to parse the message headers: %v", err)
+			}
+			if bcc := header.Get("Bcc"); bcc != "" {
+				t.Errorf("expected no Bcc header, got %q", bcc)
+			}
+			subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+			if err != nil {
+				t.Fatalf("failed to decode the subject: %v", err)
+			}
+			if subject != tc.expectedSubject {
+				t.Errorf("expected the subject %q, got %q", tc.expectedSubject, subject)
+			}
+		})
+	}
+}
+
+func TestNotifyInvitationTimeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+	// the server accepts the connection but never greets the client
+	go func() {
+		conn, err := listener.Accept()
+		if err == nil {
+			defer conn.Close()
+			time.Sleep(5 * time.Second)
+		}
+	}()
+
+	notifier, err := NewSMTPInvitationNotifier(SMTPOptions{Address: listener.Addr().String(), From: "kubermatic@acme.com"})
+	if err != nil {
+		t.Fatalf("failed to create the notifier: %v", err)
+	}
+	notifier.sendTimeout = 100 * time.Millisecond
+
+	invitation := &kubermaticv1.ProjectInvitation{Spec: kubermaticv1.ProjectInvitationSpec{UserEmail: "bob@acme.com"}}
+	project := &kubermaticv1.Project{Spec: kubermaticv1.ProjectSpec{Name: "plan9"}}
+	start := time.Now()
+	if err := notifier.NotifyInvitation(invitation, project); err == nil {
+		t.Fatal("expected the notification to fail")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("expected the notification to give up after the send timeout, took %v", elapsed)
+	}
+}
+
+func TestNewSMTPInvitationNotifierValidation(t *testing.T) {
+	if _, err := NewSMTPInvitationNotifier(SMTPOptions{Address: "localhost", From: "kubermatic@acme.com"}); err == nil {
+		t.Error("expected an error for an address without a port")
+	}
+	if _, err := NewSMTPInvitationNotifier(SMTPOptions{Address: "localhost:25"}); err == nil {
+		t.Error("expected an error for an empty sender address")
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package pricing determines the instance sizes of nodes and looks up their prices in the
+price lists maintained by the admins. It is shared by the cost estimation of the API and
+the controller which records the usage of the projects.
+*/
+package pricing
+
+import (
+	"fmt"
+
+	clusterv1alpha1 "github.com/kubermatic/machine-controller/pkg/apis/cluster/v1alpha1"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/machine"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// HoursPerMonth is the average number of hours in a month, it turns hourly prices into monthly ones
+const HoursPerMonth = 730
+
+// NodeSize returns the instance size of a node, which is the key of its price in a price list.
+// Providers without predefined sizes use the number of CPUs and the memory, for example "2cpu-4096mb".
+func NodeSize(spec apiv1.NodeCloudSpec) (string, error) {
+	switch {
+	case spec.AWS != nil:
+		return spec.AWS.InstanceType, nil
+	case spec.Azure != nil:
+		return spec.Azure.Size, nil
+	case spec.Digitalocean != nil:
+		return spec.Digitalocean.Size, nil
+	case spec.GCP != nil:
+		return spec.GCP.MachineType, nil
+	case spec.Hetzner != nil:
+		return spec.Hetzner.Type, nil
+	case spec.Openstack != nil:
+		return spec.Openstack.Flavor, nil
+	case spec.Packet != nil:
+		return spec.Packet.InstanceType, nil
+	case spec.Alibaba != nil:
+		return spec.Alibaba.InstanceType, nil
+	case spec.VSphere != nil:
+		return resourceSize(fmt.Sprint(spec.VSphere.CPUs), int64(spec.VSphere.Memory)), nil
+	case spec.Kubevirt != nil:
+		memory, err := resource.ParseQuantity(spec.Kubevirt.Memory)
+		if err != nil {
+			return "", fmt.Errorf("invalid memory %q: %v", spec.Kubevirt.Memory, err)
+		}
+		return resourceSize(spec.Kubevirt.CPUs, memory.Value()/(1024*1024)), nil
+	}
+	return "", fmt.Errorf("unsupported cloud provider")
+}
+
+// MachineSize returns the instance size of the given machine
+func MachineSize(machineSpec clusterv1alpha1.MachineSpec) (string, error) {
+	spec, err := machine.GetAPIV2NodeCloudSpec(machineSpec)
+	if err != nil {
+		return "", err
+	}
+	return NodeSize(*spec)
+}
+
+// NodePrice returns the hourly price of a node of the given size, it returns false if
+// the price list has no price for the size
+func NodePrice(priceList *kubermaticv1.PriceList, size string) (float64, bool) {
+	if priceList == nil {
+		return 0, false
+	}
+	price, ok := priceList.Spec.Sizes[size]
+	return price, ok
+}
+
+func resourceSize(cpus string, memoryMB int64) string {
+	return fmt.Sprintf("%scpu-%dmb", cpus, memoryMB)
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"testing"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+)
+
+func TestNodeSize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		spec         apiv1.NodeCloudSpec
+		expectedSize string
+		expectError  bool
+	}{
+		{
+			name:         "aws instance type",
+			spec:         apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}},
+			expectedSize: "t3.medium",
+		},
+		{
+			name:         "gcp machine type",
+			spec:         apiv1.NodeCloudSpec{GCP: &apiv1.GCPNodeSpec{MachineType: "n1-standard-2"}},
+			expectedSize: "n1-standard-2",
+		},
+		{
+			name:         "openstack flavor",
+			spec:         apiv1.NodeCloudSpec{Openstack: &apiv1.OpenstackNodeSpec{Flavor: "m1.small"}},
+			expectedSize: "m1.small",
+		},
+		{
+			name:         "vsphere cpus and memory",
+			spec:         apiv1.NodeCloudSpec{VSphere: &apiv1.VSphereNodeSpec{CPUs: 2, Memory: 4096}},
+			expectedSize: "2cpu-4096mb",
+		},
+		{
+			name:         "kubevirt cpus and memory",
+			spec:         apiv1.NodeCloudSpec{Kubevirt: &apiv1.KubevirtNodeSpec{CPUs: "2", Memory: "4Gi"}},
+			expectedSize: "2cpu-4096mb",
+		},
+		{
+			name:        "kubevirt with invalid memory",
+			spec:        apiv1.NodeCloudSpec{Kubevirt: &apiv1.KubevirtNodeSpec{CPUs: "2", Memory: "a lot"}},
+			expectError: true,
+		},
+		{
+			name:        "no cloud provider",
+			spec:        apiv1.NodeCloudSpec{},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			size, err := NodeSize(tc.spec)
+			if tc.expectError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if size != tc.expectedSize {
+				t.Errorf("expected size %q, got %q", tc.expectedSize, size)
+			}
+		})
+	}
+}
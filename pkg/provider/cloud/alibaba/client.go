@@ -0,0 +1,99 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alibaba
+
+import (
+	"strings"
+
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+)
+
+const (
+	requestScheme = "https"
+	// pageSize is the maximum page size of the describe requests
+	pageSize = 50
+)
+
+// ECSClient is the part of the Alibaba ECS API used by KKP, it is implemented by *ecs.Client.
+type ECSClient interface {
+	DescribeZones(request *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error)
+
+	DescribeVpcs(request *ecs.DescribeVpcsRequest) (*ecs.DescribeVpcsResponse, error)
+	CreateVpc(request *ecs.CreateVpcRequest) (*ecs.CreateVpcResponse, error)
+	DeleteVpc(request *ecs.DeleteVpcRequest) (*ecs.DeleteVpcResponse, error)
+
+	DescribeVSwitches(request *ecs.DescribeVSwitchesRequest) (*ecs.DescribeVSwitchesResponse, error)
+	CreateVSwitch(request *ecs.CreateVSwitchRequest) (*ecs.CreateVSwitchResponse, error)
+	DeleteVSwitch(request *ecs.DeleteVSwitchRequest) (*ecs.DeleteVSwitchResponse, error)
+}
+
+var _ ECSClient = &ecs.Client{}
+
+// NewECSClient returns a client for the ECS API in the given region.
+func NewECSClient(region, accessKeyID, accessKeySecret string) (ECSClient, error) {
+	return ecs.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+}
+
+// ListVPCs returns all VPCs in the region of the client.
+func ListVPCs(client ECSClient) ([]ecs.Vpc, error) {
+	var vpcs []ecs.Vpc
+	for page := 1; ; page++ {
+		request := ecs.CreateDescribeVpcsRequest()
+		request.Scheme = requestScheme
+		request.PageNumber = requests.NewInteger(page)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := client.DescribeVpcs(request)
+		if err != nil {
+			return nil, err
+		}
+		vpcs = append(vpcs, response.Vpcs.Vpc...)
+		if len(response.Vpcs.Vpc) == 0 || len(vpcs) >= response.TotalCount {
+			return vpcs, nil
+		}
+	}
+}
+
+// ListVSwitches returns the vSwitches in the region of the client. If vpcID is set, only
+// the vSwitches of this VPC are returned.
+func ListVSwitches(client ECSClient, vpcID string) ([]ecs.VSwitch, error) {
+	var vSwitches []ecs.VSwitch
+	for page := 1; ; page++ {
+		request := ecs.CreateDescribeVSwitchesRequest()
+		request.Scheme = requestScheme
+		request.VpcId = vpcID
+		request.PageNumber = requests.NewInteger(page)
+		request.PageSize = requests.NewInteger(pageSize)
+
+		response, err := client.DescribeVSwitches(request)
+		if err != nil {
+			return nil, err
+		}
+		vSwitches = append(vSwitches, response.VSwitches.VSwitch...)
+		if len(response.VSwitches.VSwitch) == 0 || len(vSwitches) >= response.TotalCount {
+			return vSwitches, nil
+		}
+	}
+}
+
+// isNotFound returns true if the error is returned by the API because a resource does not exist.
+func isNotFound(err error) bool {
+	serverErr, ok := err.(*sdkerrors.ServerError)
+	return ok && strings.Contains(serverErr.ErrorCode(), "NotFound")
+}
@@ -19,17 +19,33 @@ package alibaba
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
 )
 
+const (
+	vpcCleanupFinalizer     = "kubermatic.io/cleanup-alibaba-vpc"
+	vSwitchCleanupFinalizer = "kubermatic.io/cleanup-alibaba-vswitch"
+
+	// vpcCidrBlock is the IP range of the VPCs created for the clusters, the nodes get their IPs from
+	// the vSwitch. Both must not overlap with the pod and the service CIDRs.
+	vpcCidrBlock     = "192.168.0.0/16"
+	vSwitchCidrBlock = "192.168.0.0/17"
+
+	vpcStatusAvailable = "Available"
+)
+
 type Alibaba struct {
 	dc                *kubermaticv1.DatacenterSpecAlibaba
 	secretKeySelector provider.SecretKeySelectorValueFunc
+	// clientGetter creates the ECS client, it is a field to be able to replace it in tests
+	clientGetter func(region, accessKeyID, accessKeySecret string) (ECSClient, error)
 }
 
 func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.SecretKeySelectorValueFunc) (*Alibaba, error) {
@@ -39,38 +55,297 @@ func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.Secr
 	return &Alibaba{
 		dc:                dc.Spec.Alibaba,
 		secretKeySelector: secretKeyGetter,
+		clientGetter:      NewECSClient,
 	}, nil
 }
 
+var _ provider.CloudResourceLister = &Alibaba{}
+
+func (a *Alibaba) getClient(spec kubermaticv1.CloudSpec) (ECSClient, error) {
+	accessKeyID, accessKeySecret, err := GetCredentialsForCluster(spec, a.secretKeySelector, a.dc)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := a.clientGetter(a.dc.Region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Alibaba cloud client: %v", err)
+	}
+	return client, nil
+}
+
 func (a *Alibaba) DefaultCloudSpec(spec *kubermaticv1.CloudSpec) error {
 	return nil
 }
 
 func (a *Alibaba) ValidateCloudSpec(spec kubermaticv1.CloudSpec) error {
-	accessKeyID, accessKeySecret, err := GetCredentialsForCluster(spec, a.secretKeySelector, a.dc)
+	client, err := a.getClient(spec)
 	if err != nil {
 		return err
 	}
 
-	_, err = ecs.NewClientWithAccessKey(a.dc.Region, accessKeyID, accessKeySecret)
-	if err != nil {
-		return fmt.Errorf("failed to get Alibaba cloud client: %v", err)
+	if spec.Alibaba.VPCID == "" {
+		if spec.Alibaba.VSwitchID != "" {
+			return errors.New("a VPC must be specified when a vSwitch is specified")
+		}
+		return nil
+	}
+
+	if spec.Alibaba.VSwitchID != "" {
+		vSwitch, err := getVSwitch(client, spec.Alibaba.VSwitchID)
+		if err != nil {
+			return err
+		}
+		if vSwitch.VpcId != spec.Alibaba.VPCID {
+			return fmt.Errorf("vSwitch %s does not belong to VPC %s", spec.Alibaba.VSwitchID, spec.Alibaba.VPCID)
+		}
 	}
 	return nil
 }
 
-func (a *Alibaba) InitializeCloudProvider(c *kubermaticv1.Cluster, p provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
-	return c, nil
+// InitializeCloudProvider creates the VPC and the vSwitch of the cluster unless
+// existing ones were specified. Each resource is stored in the cluster right after it was created.
+// No security group is created, the machine-controller can't attach one to the instances of the nodes.
+func (a *Alibaba) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	spec := cluster.Spec.Cloud.Alibaba
+	if spec.VPCID != "" && spec.VSwitchID != "" && spec.ZoneID != "" {
+		return cluster, nil
+	}
+
+	client, err := a.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	if cluster.Spec.Cloud.Alibaba.VPCID == "" {
+		vpcID, err := ensureVPC(client, cluster)
+		if err != nil {
+			return nil, err
+		}
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			cluster.Spec.Cloud.Alibaba.VPCID = vpcID
+			kuberneteshelper.AddFinalizer(cluster, vpcCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add VPC %s to the cluster: %v", vpcID, err)
+		}
+	}
+
+	if cluster.Spec.Cloud.Alibaba.VSwitchID == "" {
+		vSwitch, err := ensureVSwitch(client, cluster)
+		if err != nil {
+			return nil, err
+		}
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			cluster.Spec.Cloud.Alibaba.VSwitchID = vSwitch.VSwitchId
+			cluster.Spec.Cloud.Alibaba.ZoneID = vSwitch.ZoneId
+			kuberneteshelper.AddFinalizer(cluster, vSwitchCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add vSwitch %s to the cluster: %v", vSwitch.VSwitchId, err)
+		}
+	}
+
+	// The zone of an existing vSwitch is not specified by the user
+	if cluster.Spec.Cloud.Alibaba.ZoneID == "" {
+		vSwitch, err := getVSwitch(client, cluster.Spec.Cloud.Alibaba.VSwitchID)
+		if err != nil {
+			return nil, err
+		}
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			cluster.Spec.Cloud.Alibaba.ZoneID = vSwitch.ZoneId
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add zone %s to the cluster: %v", vSwitch.ZoneId, err)
+		}
+	}
+
+	return cluster, nil
+}
+
+// ensureVPC returns the ID of the VPC of the cluster and creates it if it does not exist yet. The VPC is looked
+// up by its name first, in case it was created before but the cluster could not be updated afterwards.
+func ensureVPC(client ECSClient, cluster *kubermaticv1.Cluster) (string, error) {
+	name := resourceName(cluster)
+
+	vpcs, err := ListVPCs(client)
+	if err != nil {
+		return "", fmt.Errorf("failed to list VPCs: %v", err)
+	}
+	for _, vpc := range vpcs {
+		if vpc.VpcName == name {
+			return vpc.VpcId, nil
+		}
+	}
+
+	request := ecs.CreateCreateVpcRequest()
+	request.Scheme = requestScheme
+	request.VpcName = name
+	request.CidrBlock = vpcCidrBlock
+	request.Description = fmt.Sprintf("VPC of the Kubernetes cluster %s", cluster.Name)
+	response, err := client.CreateVpc(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to create VPC %s: %v", name, err)
+	}
+	return response.VpcId, nil
+}
+
+// ensureVSwitch returns the vSwitch of the cluster and creates it if it does not exist yet. The vSwitch is
+// created in the first zone of the region once the VPC is available.
+func ensureVSwitch(client ECSClient, cluster *kubermaticv1.Cluster) (*ecs.VSwitch, error) {
+	name := resourceName(cluster)
+	vpcID := cluster.Spec.Cloud.Alibaba.VPCID
+
+	vSwitches, err := ListVSwitches(client, vpcID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list vSwitches: %v", err)
+	}
+	for i := range vSwitches {
+		if vSwitches[i].VSwitchName == name {
+			return &vSwitches[i], nil
+		}
+	}
+
+	vpcRequest := ecs.CreateDescribeVpcsRequest()
+	vpcRequest.Scheme = requestScheme
+	vpcRequest.VpcId = vpcID
+	vpcResponse, err := client.DescribeVpcs(vpcRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VPC %s: %v", vpcID, err)
+	}
+	if len(vpcResponse.Vpcs.Vpc) == 0 {
+		return nil, fmt.Errorf("VPC %s does not exist", vpcID)
+	}
+	if status := vpcResponse.Vpcs.Vpc[0].Status; status != vpcStatusAvailable {
+		return nil, fmt.Errorf("VPC %s is not available yet, its status is %s", vpcID, status)
+	}
+
+	zoneID, err := firstZone(client)
+	if err != nil {
+		return nil, err
+	}
+
+	request := ecs.CreateCreateVSwitchRequest()
+	request.Scheme = requestScheme
+	request.VpcId = vpcID
+	request.VSwitchName = name
+	request.CidrBlock = vSwitchCidrBlock
+	request.ZoneId = zoneID
+	request.Description = fmt.Sprintf("vSwitch of the Kubernetes cluster %s", cluster.Name)
+	response, err := client.CreateVSwitch(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vSwitch %s: %v", name, err)
+	}
+	return &ecs.VSwitch{VSwitchId: response.VSwitchId, VpcId: vpcID, ZoneId: zoneID}, nil
+}
+
+func firstZone(client ECSClient) (string, error) {
+	request := ecs.CreateDescribeZonesRequest()
+	request.Scheme = requestScheme
+	response, err := client.DescribeZones(request)
+	if err != nil {
+		return "", fmt.Errorf("failed to list zones: %v", err)
+	}
+
+	var zones []string
+	for _, zone := range response.Zones.Zone {
+		zones = append(zones, zone.ZoneId)
+	}
+	if len(zones) == 0 {
+		return "", errors.New("the region has no zones")
+	}
+	sort.Strings(zones)
+	return zones[0], nil
+}
+
+func getVSwitch(client ECSClient, id string) (*ecs.VSwitch, error) {
+	request := ecs.CreateDescribeVSwitchesRequest()
+	request.Scheme = requestScheme
+	request.VSwitchId = id
+	response, err := client.DescribeVSwitches(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vSwitch %s: %v", id, err)
+	}
+	if len(response.VSwitches.VSwitch) == 0 {
+		return nil, fmt.Errorf("vSwitch %s does not exist", id)
+	}
+	return &response.VSwitches.VSwitch[0], nil
 }
 
-func (a *Alibaba) CleanUpCloudProvider(c *kubermaticv1.Cluster, p provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
-	return c, nil
+// CleanUpCloudProvider deletes the vSwitch and the VPC created for the cluster. The vSwitch
+// and the VPC can only be deleted once all instances in them are gone, failed deletions are retried.
+func (a *Alibaba) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	if !kuberneteshelper.HasAnyFinalizer(cluster, vSwitchCleanupFinalizer, vpcCleanupFinalizer) {
+		return cluster, nil
+	}
+
+	client, err := a.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	if kuberneteshelper.HasFinalizer(cluster, vSwitchCleanupFinalizer) {
+		request := ecs.CreateDeleteVSwitchRequest()
+		request.Scheme = requestScheme
+		request.VSwitchId = cluster.Spec.Cloud.Alibaba.VSwitchID
+		if _, err := client.DeleteVSwitch(request); err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to delete vSwitch %s: %v", request.VSwitchId, err)
+		}
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.RemoveFinalizer(cluster, vSwitchCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove %s finalizer: %v", vSwitchCleanupFinalizer, err)
+		}
+	}
+
+	if kuberneteshelper.HasFinalizer(cluster, vpcCleanupFinalizer) {
+		request := ecs.CreateDeleteVpcRequest()
+		request.Scheme = requestScheme
+		request.VpcId = cluster.Spec.Cloud.Alibaba.VPCID
+		if _, err := client.DeleteVpc(request); err != nil && !isNotFound(err) {
+			return nil, fmt.Errorf("failed to delete VPC %s: %v", request.VpcId, err)
+		}
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.RemoveFinalizer(cluster, vpcCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove %s finalizer: %v", vpcCleanupFinalizer, err)
+		}
+	}
+
+	return cluster, nil
+}
+
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (a *Alibaba) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, vSwitchCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "VSwitch", Name: resourceName(cluster)})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, vpcCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "VPC", Name: resourceName(cluster)})
+	}
+	return resources
 }
 
 func (a *Alibaba) ValidateCloudSpecUpdate(oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
+	if oldSpec.Alibaba == nil || newSpec.Alibaba == nil {
+		return nil
+	}
+	if oldSpec.Alibaba.VPCID != "" && oldSpec.Alibaba.VPCID != newSpec.Alibaba.VPCID {
+		return fmt.Errorf("updating Alibaba VPC is not supported (was %s, updated to %s)", oldSpec.Alibaba.VPCID, newSpec.Alibaba.VPCID)
+	}
+	if oldSpec.Alibaba.VSwitchID != "" && oldSpec.Alibaba.VSwitchID != newSpec.Alibaba.VSwitchID {
+		return fmt.Errorf("updating Alibaba vSwitch is not supported (was %s, updated to %s)", oldSpec.Alibaba.VSwitchID, newSpec.Alibaba.VSwitchID)
+	}
 	return nil
 }
 
+func resourceName(cluster *kubermaticv1.Cluster) string {
+	return "kubernetes-" + cluster.Name
+}
+
 // GetCredentialsForCluster returns the credentials for the passed in cloud spec or an error
 func GetCredentialsForCluster(cloud kubermaticv1.CloudSpec, secretKeySelector provider.SecretKeySelectorValueFunc, dc *kubermaticv1.DatacenterSpecAlibaba) (accessKeyID string, accessKeySecret string, err error) {
 	accessKeyID = cloud.Alibaba.AccessKeyID
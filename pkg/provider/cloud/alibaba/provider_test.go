@@ -0,0 +1,319 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alibaba
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeClient is an ECSClient which stores VPCs and vSwitches in memory
+type fakeClient struct {
+	lock      sync.Mutex
+	nextID    int
+	vpcs      map[string]ecs.Vpc
+	vSwitches map[string]ecs.VSwitch
+}
+
+var _ ECSClient = &fakeClient{}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		nextID:    100,
+		vpcs:      map[string]ecs.Vpc{},
+		vSwitches: map[string]ecs.VSwitch{},
+	}
+}
+
+func (c *fakeClient) newID(prefix string) string {
+	c.nextID++
+	return fmt.Sprintf("%s-%d", prefix, c.nextID)
+}
+
+func notFound(code string) error {
+	return sdkerrors.NewServerError(404, fmt.Sprintf(`{"Code": %q}`, code), "")
+}
+
+func (c *fakeClient) DescribeZones(request *ecs.DescribeZonesRequest) (*ecs.DescribeZonesResponse, error) {
+	response := ecs.CreateDescribeZonesResponse()
+	response.Zones.Zone = []ecs.Zone{{ZoneId: "cn-beijing-c"}, {ZoneId: "cn-beijing-a"}}
+	return response, nil
+}
+
+func (c *fakeClient) DescribeVpcs(request *ecs.DescribeVpcsRequest) (*ecs.DescribeVpcsResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	response := ecs.CreateDescribeVpcsResponse()
+	for id, vpc := range c.vpcs {
+		if request.VpcId == "" || request.VpcId == id {
+			response.Vpcs.Vpc = append(response.Vpcs.Vpc, vpc)
+		}
+	}
+	response.TotalCount = len(response.Vpcs.Vpc)
+	return response, nil
+}
+
+func (c *fakeClient) CreateVpc(request *ecs.CreateVpcRequest) (*ecs.CreateVpcResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	id := c.newID("vpc")
+	c.vpcs[id] = ecs.Vpc{VpcId: id, VpcName: request.VpcName, CidrBlock: request.CidrBlock, Status: vpcStatusAvailable}
+	response := ecs.CreateCreateVpcResponse()
+	response.VpcId = id
+	return response, nil
+}
+
+func (c *fakeClient) DeleteVpc(request *ecs.DeleteVpcRequest) (*ecs.DeleteVpcResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, exists := c.vpcs[request.VpcId]; !exists {
+		return nil, notFound("InvalidVpcId.NotFound")
+	}
+	for _, vSwitch := range c.vSwitches {
+		if vSwitch.VpcId == request.VpcId {
+			return nil, fmt.Errorf("VPC %s still has vSwitches", request.VpcId)
+		}
+	}
+	delete(c.vpcs, request.VpcId)
+	return ecs.CreateDeleteVpcResponse(), nil
+}
+
+func (c *fakeClient) DescribeVSwitches(request *ecs.DescribeVSwitchesRequest) (*ecs.DescribeVSwitchesResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	response := ecs.CreateDescribeVSwitchesResponse()
+	for id, vSwitch := range c.vSwitches {
+		if (request.VSwitchId == "" || request.VSwitchId == id) && (request.VpcId == "" || request.VpcId == vSwitch.VpcId) {
+			response.VSwitches.VSwitch = append(response.VSwitches.VSwitch, vSwitch)
+		}
+	}
+	response.TotalCount = len(response.VSwitches.VSwitch)
+	return response, nil
+}
+
+func (c *fakeClient) CreateVSwitch(request *ecs.CreateVSwitchRequest) (*ecs.CreateVSwitchResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	id := c.newID("vsw")
+	c.vSwitches[id] = ecs.VSwitch{VSwitchId: id, VSwitchName: request.VSwitchName, VpcId: request.VpcId, ZoneId: request.ZoneId, CidrBlock: request.CidrBlock}
+	response := ecs.CreateCreateVSwitchResponse()
+	response.VSwitchId = id
+	return response, nil
+}
+
+func (c *fakeClient) DeleteVSwitch(request *ecs.DeleteVSwitchRequest) (*ecs.DeleteVSwitchResponse, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, exists := c.vSwitches[request.VSwitchId]; !exists {
+		return nil, notFound("InvalidVSwitchId.NotFound")
+	}
+	delete(c.vSwitches, request.VSwitchId)
+	return ecs.CreateDeleteVSwitchResponse(), nil
+}
+
+func newTestProvider(client *fakeClient) *Alibaba {
+	return &Alibaba{
+		dc: &kubermaticv1.DatacenterSpecAlibaba{Region: "cn-beijing"},
+		clientGetter: func(_, _, _ string) (ECSClient, error) {
+			return client, nil
+		},
+	}
+}
+
+func genCluster(spec kubermaticv1.AlibabaCloudSpec, finalizers ...string) *kubermaticv1.Cluster {
+	spec.AccessKeyID = "id"
+	spec.AccessKeySecret = "secret"
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abcd", Finalizers: finalizers},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				Alibaba: &spec,
+			},
+		},
+	}
+}
+
+// testUpdater applies the modifications to the given cluster, as the cluster provider would do
+func testUpdater(cluster *kubermaticv1.Cluster) func(string, func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+	return func(_ string, modify func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+		modify(cluster)
+		return cluster, nil
+	}
+}
+
+func TestInitializeCloudProvider(t *testing.T) {
+	testCases := []struct {
+		name               string
+		setup              func(client *fakeClient)
+		spec               kubermaticv1.AlibabaCloudSpec
+		expectedSpec       kubermaticv1.AlibabaCloudSpec
+		expectedFinalizers []string
+		expectedVPCs       int
+		expectedVSwitches  int
+		expectErr          bool
+	}{
+		{
+			name: "all resources are created",
+			expectedSpec: kubermaticv1.AlibabaCloudSpec{
+				VPCID: "vpc-101", VSwitchID: "vsw-102", ZoneID: "cn-beijing-a",
+			},
+			expectedFinalizers: []string{vpcCleanupFinalizer, vSwitchCleanupFinalizer},
+			expectedVPCs:       1,
+			expectedVSwitches:  1,
+		},
+		{
+			name: "resources created before are reused",
+			setup: func(client *fakeClient) {
+				client.vpcs["vpc-1"] = ecs.Vpc{VpcId: "vpc-1", VpcName: "kubernetes-abcd", Status: vpcStatusAvailable}
+				client.vSwitches["vsw-1"] = ecs.VSwitch{VSwitchId: "vsw-1", VSwitchName: "kubernetes-abcd", VpcId: "vpc-1", ZoneId: "cn-beijing-c"}
+			},
+			expectedSpec: kubermaticv1.AlibabaCloudSpec{
+				VPCID: "vpc-1", VSwitchID: "vsw-1", ZoneID: "cn-beijing-c",
+			},
+			expectedFinalizers: []string{vpcCleanupFinalizer, vSwitchCleanupFinalizer},
+			expectedVPCs:       1,
+			expectedVSwitches:  1,
+		},
+		{
+			name: "existing VPC and vSwitch are used as is",
+			setup: func(client *fakeClient) {
+				client.vpcs["vpc-1"] = ecs.Vpc{VpcId: "vpc-1", VpcName: "my-vpc", Status: vpcStatusAvailable}
+				client.vSwitches["vsw-1"] = ecs.VSwitch{VSwitchId: "vsw-1", VSwitchName: "my-vswitch", VpcId: "vpc-1", ZoneId: "cn-beijing-c"}
+			},
+			spec: kubermaticv1.AlibabaCloudSpec{VPCID: "vpc-1", VSwitchID: "vsw-1"},
+			expectedSpec: kubermaticv1.AlibabaCloudSpec{
+				VPCID: "vpc-1", VSwitchID: "vsw-1", ZoneID: "cn-beijing-c",
+			},
+			expectedVPCs:      1,
+			expectedVSwitches: 1,
+		},
+		{
+			name: "vSwitch is not created before the VPC is available",
+			setup: func(client *fakeClient) {
+				client.vpcs["vpc-1"] = ecs.Vpc{VpcId: "vpc-1", VpcName: "kubernetes-abcd", Status: "Pending"}
+			},
+			expectedVPCs: 1,
+			expectErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newFakeClient()
+			if tc.setup != nil {
+				tc.setup(client)
+			}
+			cluster := genCluster(tc.spec)
+
+			cluster, err := newTestProvider(client).InitializeCloudProvider(cluster, testUpdater(cluster))
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("expected error: %v, got %v", tc.expectErr, err)
+			}
+			if len(client.vpcs) != tc.expectedVPCs {
+				t.Errorf("expected %d VPCs, got %d", tc.expectedVPCs, len(client.vpcs))
+			}
+			if len(client.vSwitches) != tc.expectedVSwitches {
+				t.Errorf("expected %d vSwitches, got %d", tc.expectedVSwitches, len(client.vSwitches))
+			}
+			if tc.expectErr {
+				return
+			}
+
+			spec := cluster.Spec.Cloud.Alibaba
+			if spec.VPCID != tc.expectedSpec.VPCID || spec.VSwitchID != tc.expectedSpec.VSwitchID || spec.ZoneID != tc.expectedSpec.ZoneID {
+				t.Errorf("expected spec %+v, got %+v", tc.expectedSpec, *spec)
+			}
+			if len(cluster.Finalizers) != len(tc.expectedFinalizers) || !kuberneteshelper.HasFinalizer(cluster, tc.expectedFinalizers...) {
+				t.Errorf("expected finalizers %v, got %v", tc.expectedFinalizers, cluster.Finalizers)
+			}
+		})
+	}
+}
+
+func TestCleanUpCloudProvider(t *testing.T) {
+	allFinalizers := []string{vpcCleanupFinalizer, vSwitchCleanupFinalizer}
+	spec := kubermaticv1.AlibabaCloudSpec{VPCID: "vpc-1", VSwitchID: "vsw-1"}
+
+	testCases := []struct {
+		name              string
+		setup             func(client *fakeClient)
+		cluster           *kubermaticv1.Cluster
+		expectedVPCs      int
+		expectedVSwitches int
+	}{
+		{
+			name: "resources of the cluster are deleted",
+			setup: func(client *fakeClient) {
+				client.vpcs["vpc-1"] = ecs.Vpc{VpcId: "vpc-1"}
+				client.vSwitches["vsw-1"] = ecs.VSwitch{VSwitchId: "vsw-1", VpcId: "vpc-1"}
+			},
+			cluster: genCluster(spec, allFinalizers...),
+		},
+		{
+			name:    "resources which were deleted manually are ignored",
+			cluster: genCluster(spec, allFinalizers...),
+		},
+		{
+			name: "resources which were not created for the cluster are kept",
+			setup: func(client *fakeClient) {
+				client.vpcs["vpc-1"] = ecs.Vpc{VpcId: "vpc-1"}
+				client.vSwitches["vsw-1"] = ecs.VSwitch{VSwitchId: "vsw-1", VpcId: "vpc-1"}
+			},
+			cluster:           genCluster(spec),
+			expectedVPCs:      1,
+			expectedVSwitches: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newFakeClient()
+			if tc.setup != nil {
+				tc.setup(client)
+			}
+
+			cluster, err := newTestProvider(client).CleanUpCloudProvider(tc.cluster, testUpdater(tc.cluster))
+			if err != nil {
+				t.Fatalf("failed to clean up: %v", err)
+			}
+			if kuberneteshelper.HasAnyFinalizer(cluster, allFinalizers...) {
+				t.Errorf("expected the cleanup finalizers to be removed, got %v", cluster.Finalizers)
+			}
+			if len(client.vpcs) != tc.expectedVPCs {
+				t.Errorf("expected %d VPCs, got %d", tc.expectedVPCs, len(client.vpcs))
+			}
+			if len(client.vSwitches) != tc.expectedVSwitches {
+				t.Errorf("expected %d vSwitches, got %d", tc.expectedVSwitches, len(client.vSwitches))
+			}
+		})
+	}
+}
@@ -26,11 +26,14 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
 )
 
 type ClientSet struct {
-	EC2 ec2iface.EC2API
-	IAM iamiface.IAMAPI
+	EC2           ec2iface.EC2API
+	IAM           iamiface.IAMAPI
+	ServiceQuotas servicequotasiface.ServiceQuotasAPI
 }
 
 func GetClientSet(accessKeyID, secretAccessKey, region string) (*ClientSet, error) {
@@ -45,7 +48,8 @@ func GetClientSet(accessKeyID, secretAccessKey, region string) (*ClientSet, erro
 	}
 
 	return &ClientSet{
-		EC2: ec2.New(sess),
-		IAM: iam.New(sess),
+		EC2:           ec2.New(sess),
+		IAM:           iam.New(sess),
+		ServiceQuotas: servicequotas.New(sess),
 	}, nil
 }
@@ -0,0 +1,69 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+)
+
+// fakeEC2Client serves the EC2 calls of the tests from the objects it was created with
+type fakeEC2Client struct {
+	ec2iface.EC2API
+	subnets        []*ec2.Subnet
+	instances      []*ec2.Instance
+	securityGroups []*ec2.SecurityGroup
+	tags           []*ec2.TagDescription
+}
+
+// DescribeSubnets returns the subnets of the VPC given in the first filter
+func (c *fakeEC2Client) DescribeSubnets(input *ec2.DescribeSubnetsInput) (*ec2.DescribeSubnetsOutput, error) {
+	vpcID := aws.StringValue(input.Filters[0].Values[0])
+	out := &ec2.DescribeSubnetsOutput{}
+	for _, subnet := range c.subnets {
+		if aws.StringValue(subnet.VpcId) == vpcID {
+			out.Subnets = append(out.Subnets, subnet)
+		}
+	}
+	return out, nil
+}
+
+func (c *fakeEC2Client) DescribeInstancesPages(_ *ec2.DescribeInstancesInput, fn func(*ec2.DescribeInstancesOutput, bool) bool) error {
+	fn(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: c.instances}}}, true)
+	return nil
+}
+
+func (c *fakeEC2Client) DescribeSecurityGroupsPages(_ *ec2.DescribeSecurityGroupsInput, fn func(*ec2.DescribeSecurityGroupsOutput, bool) bool) error {
+	fn(&ec2.DescribeSecurityGroupsOutput{SecurityGroups: c.securityGroups}, true)
+	return nil
+}
+
+// DescribeTagsPages returns the tags whose key starts with the prefix given in the first filter
+func (c *fakeEC2Client) DescribeTagsPages(input *ec2.DescribeTagsInput, fn func(*ec2.DescribeTagsOutput, bool) bool) error {
+	prefix := strings.TrimSuffix(aws.StringValue(input.Filters[0].Values[0]), "*")
+	out := &ec2.DescribeTagsOutput{}
+	for _, tag := range c.tags {
+		if strings.HasPrefix(aws.StringValue(tag.Key), prefix) {
+			out.Tags = append(out.Tags, tag)
+		}
+	}
+	fn(out, true)
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const (
+	// securityGroupsQuotaCode is the code of the "VPC security groups per Region" quota
+	securityGroupsQuotaCode = "L-E79EC296"
+	// standardVCPUsQuotaCode is the code of the "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances" quota
+	standardVCPUsQuotaCode = "L-1216C47A"
+	// standardInstanceFamilies are the first letters of the instance types counted by the standardVCPUsQuotaCode quota
+	standardInstanceFamilies = "acdhimrtz"
+)
+
+var _ provider.PreflightChecker = &AmazonEC2{}
+
+// PreflightCheckCluster checks the security group quota if a security group is going to be created for the cluster
+func (a *AmazonEC2) PreflightCheckCluster(spec kubermaticv1.CloudSpec) ([]provider.PreflightCheckResult, error) {
+	if spec.AWS == nil || spec.AWS.SecurityGroupID != "" {
+		return nil, nil
+	}
+	client, err := a.getClientSet(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API client: %v", err)
+	}
+
+	limit, err := getServiceQuota(client.ServiceQuotas, "vpc", securityGroupsQuotaCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the security group quota: %v", err)
+	}
+	var used int64
+	err = client.EC2.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{}, func(page *ec2.DescribeSecurityGroupsOutput, lastPage bool) bool {
+		used += int64(len(page.SecurityGroups))
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security groups: %v", err)
+	}
+
+	var results []provider.PreflightCheckResult
+	if result := provider.CheckQuota("security groups", limit, used, 1); result != nil {
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// PreflightCheckNodes checks the vCPU quota of the standard on-demand instances. Other instance families
+// have quotas of their own which are not checked.
+func (a *AmazonEC2) PreflightCheckNodes(spec kubermaticv1.CloudSpec, nodeSpec apiv1.NodeCloudSpec, replicas int) ([]provider.PreflightCheckResult, error) {
+	if nodeSpec.AWS == nil || !isStandardInstanceType(nodeSpec.AWS.InstanceType) {
+		return nil, nil
+	}
+	client, err := a.getClientSet(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API client: %v", err)
+	}
+
+	instanceTypes, err := client.EC2.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{nodeSpec.AWS.InstanceType}),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "InvalidInstanceType" {
+			return []provider.PreflightCheckResult{{
+				Error:   true,
+				Message: fmt.Sprintf("instance type %q does not exist", nodeSpec.AWS.InstanceType),
+			}}, nil
+		}
+		return nil, fmt.Errorf("failed to get instance type %q: %v", nodeSpec.AWS.InstanceType, err)
+	}
+	if len(instanceTypes.InstanceTypes) == 0 || instanceTypes.InstanceTypes[0].VCpuInfo == nil {
+		return nil, fmt.Errorf("instance type %q has no vCPU information", nodeSpec.AWS.InstanceType)
+	}
+	vCPUs := aws.Int64Value(instanceTypes.InstanceTypes[0].VCpuInfo.DefaultVCpus)
+
+	limit, err := getServiceQuota(client.ServiceQuotas, "ec2", standardVCPUsQuotaCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the vCPU quota: %v", err)
+	}
+	used, err := standardVCPUsInUse(client.EC2)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []provider.PreflightCheckResult
+	if result := provider.CheckQuota("vCPUs of standard on-demand instances", limit, used, int64(replicas)*vCPUs); result != nil {
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// getServiceQuota returns the value of the quota applied to the account, or the default value if none was applied
+func getServiceQuota(client servicequotasiface.ServiceQuotasAPI, serviceCode, quotaCode string) (int64, error) {
+	output, err := client.GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+			return 0, err
+		}
+		defaultOutput, err := client.GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+			ServiceCode: aws.String(serviceCode),
+			QuotaCode:   aws.String(quotaCode),
+		})
+		if err != nil {
+			return 0, err
+		}
+		return int64(aws.Float64Value(defaultOutput.Quota.Value)), nil
+	}
+	return int64(aws.Float64Value(output.Quota.Value)), nil
+}
+
+// standardVCPUsInUse sums up the vCPUs of the pending and running standard on-demand instances
+func standardVCPUsInUse(client ec2iface.EC2API) (int64, error) {
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("instance-state-name"),
+			Values: aws.StringSlice([]string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning}),
+		}},
+	}
+	var vCPUs int64
+	err := client.DescribeInstancesPages(input, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+		for _, reservation := range page.Reservations {
+			for _, instance := range reservation.Instances {
+				// Spot and scheduled instances are counted by quotas of their own
+				if instance.InstanceLifecycle != nil || !isStandardInstanceType(aws.StringValue(instance.InstanceType)) {
+					continue
+				}
+				if instance.CpuOptions != nil {
+					vCPUs += aws.Int64Value(instance.CpuOptions.CoreCount) * aws.Int64Value(instance.CpuOptions.ThreadsPerCore)
+				}
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list instances: %v", err)
+	}
+	return vCPUs, nil
+}
+
+func isStandardInstanceType(instanceType string) bool {
+	return instanceType != "" && strings.ContainsRune(standardInstanceFamilies, rune(instanceType[0]))
+}
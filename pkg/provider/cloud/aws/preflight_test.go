@@ -0,0 +1,51 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestStandardVCPUsInUse(t *testing.T) {
+	instance := func(instanceType string, cores int64, lifecycle *string) *ec2.Instance {
+		return &ec2.Instance{
+			InstanceType:      aws.String(instanceType),
+			InstanceLifecycle: lifecycle,
+			CpuOptions:        &ec2.CpuOptions{CoreCount: aws.Int64(cores), ThreadsPerCore: aws.Int64(2)},
+		}
+	}
+	client := &fakeEC2Client{
+		instances: []*ec2.Instance{
+			instance("t3.medium", 1, nil),
+			instance("m5.xlarge", 2, nil),
+			// not counted as they use other quotas
+			instance("m5.xlarge", 2, aws.String(ec2.InstanceLifecycleTypeSpot)),
+			instance("p3.2xlarge", 4, nil),
+		},
+	}
+
+	vCPUs, err := standardVCPUsInUse(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vCPUs != 6 {
+		t.Errorf("expected 6 vCPUs in use, got %d", vCPUs)
+	}
+}
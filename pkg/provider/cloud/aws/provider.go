@@ -25,6 +25,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
@@ -46,6 +47,8 @@ const (
 	tagCleanupFinalizer              = "kubermatic.io/cleanup-aws-tags"
 
 	tagNameKubernetesClusterPrefix = "kubernetes.io/cluster/"
+	// tagNameKubermaticClusterPrefix marks the resources tagged or created by kubermatic, the value is the project of the cluster
+	tagNameKubermaticClusterPrefix = "kubermatic.io/cluster/"
 
 	authFailure = "AuthFailure"
 )
@@ -273,6 +276,13 @@ func clusterTag(clusterName string) *ec2.Tag {
 	}
 }
 
+func ownerTag(cluster *kubermaticv1.Cluster) *ec2.Tag {
+	return &ec2.Tag{
+		Key:   aws.String(tagNameKubermaticClusterPrefix + cluster.Name),
+		Value: aws.String(cluster.Labels[kubermaticv1.ProjectIDLabelKey]),
+	}
+}
+
 func tagResources(cluster *kubermaticv1.Cluster, client ec2iface.EC2API) error {
 	sOut, err := client.DescribeSubnets(&ec2.DescribeSubnetsInput{
 		Filters: []*ec2.Filter{
@@ -294,7 +304,7 @@ func tagResources(cluster *kubermaticv1.Cluster, client ec2iface.EC2API) error {
 
 	_, err = client.CreateTags(&ec2.CreateTagsInput{
 		Resources: resourceIDs,
-		Tags:      []*ec2.Tag{clusterTag(cluster.Name)},
+		Tags:      []*ec2.Tag{clusterTag(cluster.Name), ownerTag(cluster)},
 	})
 	if err != nil {
 		return fmt.Errorf("failed to tag securityGroup(id=%s), routeTable(id=%s) and subnets (ids=%v): %v",
@@ -322,11 +332,26 @@ func removeTags(cluster *kubermaticv1.Cluster, client ec2iface.EC2API) error {
 
 	_, err = client.DeleteTags(&ec2.DeleteTagsInput{
 		Resources: resourceIDs,
-		Tags:      []*ec2.Tag{clusterTag(cluster.Name)},
+		Tags:      []*ec2.Tag{clusterTag(cluster.Name), ownerTag(cluster)},
 	})
 	return err
 }
 
+// tagRoles marks the roles created for the cluster with the owner tag
+func tagRoles(cluster *kubermaticv1.Cluster, client iamiface.IAMAPI) error {
+	tag := ownerTag(cluster)
+	for _, roleName := range []string{controlPlaneRoleName(cluster.Name), workerRoleName(cluster.Name)} {
+		_, err := client.TagRole(&iam.TagRoleInput{
+			RoleName: aws.String(roleName),
+			Tags:     []*iam.Tag{{Key: tag.Key, Value: tag.Value}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to tag role %s: %v", roleName, err)
+		}
+	}
+	return nil
+}
+
 // Get security group by aws generated id string (sg-xxxxx).
 // Error is returned in case no such group exists.
 func getSecurityGroupByID(client ec2iface.EC2API, vpc *ec2.Vpc, id string) (*ec2.SecurityGroup, error) {
@@ -513,6 +538,9 @@ func (a *AmazonEC2) InitializeCloudProvider(cluster *kubermaticv1.Cluster, updat
 		if err := tagResources(cluster, client.EC2); err != nil {
 			return nil, err
 		}
+		if err := tagRoles(cluster, client.IAM); err != nil {
+			return nil, err
+		}
 		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
 			kuberneteshelper.AddFinalizer(cluster, tagCleanupFinalizer)
 		})
@@ -654,6 +682,30 @@ func (a *AmazonEC2) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, updater
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (a *AmazonEC2) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, securityGroupCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "SecurityGroup", Name: cluster.Spec.Cloud.AWS.SecurityGroupID})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, instanceProfileCleanupFinalizer) {
+		resources = append(resources,
+			provider.CloudResource{Kind: "InstanceProfile", Name: cluster.Spec.Cloud.AWS.InstanceProfileName},
+			provider.CloudResource{Kind: "Role", Name: workerRoleName(cluster.Name)},
+		)
+		if cluster.Spec.Cloud.AWS.RoleName != "" {
+			resources = append(resources, provider.CloudResource{Kind: "Role", Name: cluster.Spec.Cloud.AWS.RoleName})
+		}
+	}
+	if kuberneteshelper.HasFinalizer(cluster, controlPlaneRoleCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Role", Name: controlPlaneRoleName(cluster.Name)})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Tag", Name: tagNameKubernetesClusterPrefix + cluster.Name})
+	}
+	return resources
+}
+
 func isEntityAlreadyExists(err error) bool {
 	aerr, ok := err.(awserr.Error)
 	if !ok {
@@ -683,11 +735,15 @@ func GetSubnets(accessKeyID, secretAccessKey, region, vpcID string) ([]*ec2.Subn
 		return nil, err
 	}
 
+	return getSubnets(client.EC2, vpcID)
+}
+
+func getSubnets(client ec2iface.EC2API, vpcID string) ([]*ec2.Subnet, error) {
 	filters := []*ec2.Filter{
 		{Name: aws.String("vpc-id"), Values: []*string{aws.String(vpcID)}},
 	}
 	subnetsInput := &ec2.DescribeSubnetsInput{Filters: filters}
-	out, err := client.EC2.DescribeSubnets(subnetsInput)
+	out, err := client.DescribeSubnets(subnetsInput)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list subnets: %v", err)
 	}
@@ -0,0 +1,257 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const (
+	securityGroupKind   = "SecurityGroup"
+	instanceProfileKind = "InstanceProfile"
+	roleKind            = "Role"
+	tagKind             = "Tag"
+)
+
+var _ provider.CloudResourceSweeper = &AmazonEC2{}
+
+// ListClusterResources returns the security groups, instance profiles, roles and tags which were created for clusters
+func (a *AmazonEC2) ListClusterResources(spec kubermaticv1.CloudSpec) ([]provider.ClusterCloudResource, error) {
+	client, err := a.getClientSet(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API client: %v", err)
+	}
+
+	ec2Resources, err := listClusterEC2Resources(client.EC2)
+	if err != nil {
+		return nil, err
+	}
+	iamResources, err := listClusterIAMResources(client.IAM)
+	if err != nil {
+		return nil, err
+	}
+	return append(ec2Resources, iamResources...), nil
+}
+
+// DeleteClusterResource deletes a resource returned by ListClusterResources
+func (a *AmazonEC2) DeleteClusterResource(spec kubermaticv1.CloudSpec, resource provider.ClusterCloudResource) error {
+	client, err := a.getClientSet(spec)
+	if err != nil {
+		return fmt.Errorf("failed to get API client: %v", err)
+	}
+
+	switch resource.Kind {
+	case securityGroupKind:
+		_, err := client.EC2.DeleteSecurityGroup(&ec2.DeleteSecurityGroupInput{GroupId: aws.String(resource.ID)})
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "InvalidGroup.NotFound" {
+			return nil
+		}
+		return err
+	case instanceProfileKind:
+		return deleteInstanceProfile(client.IAM, resource.ID)
+	case roleKind:
+		return deleteRole(client.IAM, resource.ID)
+	case tagKind:
+		_, err := client.EC2.DeleteTags(&ec2.DeleteTagsInput{
+			Resources: aws.StringSlice([]string{resource.ID}),
+			Tags: []*ec2.Tag{
+				{Key: aws.String(tagNameKubernetesClusterPrefix + resource.ClusterName)},
+				{Key: aws.String(tagNameKubermaticClusterPrefix + resource.ClusterName)},
+			},
+		})
+		return err
+	default:
+		return fmt.Errorf("unknown resource kind %q", resource.Kind)
+	}
+}
+
+// listClusterEC2Resources returns the security groups created by createSecurityGroup and the cluster tags
+// tagResources added to subnets, route tables and security groups which were not created for the cluster.
+// Only resources carrying the owner tag are returned, the cluster tags of other tools and the resources of
+// clusters created before the owner tag was introduced are left alone.
+func listClusterEC2Resources(client ec2iface.EC2API) ([]provider.ClusterCloudResource, error) {
+	var resources []provider.ClusterCloudResource
+
+	clusterSecurityGroups := map[string]bool{}
+	err := client.DescribeSecurityGroupsPages(&ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("group-name"),
+			Values: aws.StringSlice([]string{resourceNamePrefix + "*"}),
+		}},
+	}, func(out *ec2.DescribeSecurityGroupsOutput, _ bool) bool {
+		for _, group := range out.SecurityGroups {
+			clusterName := strings.TrimPrefix(aws.StringValue(group.GroupName), resourceNamePrefix)
+			projectID, owned := tagValue(group.Tags, tagNameKubermaticClusterPrefix+clusterName)
+			if !owned || !hasTag(group.Tags, tagNameKubernetesClusterPrefix+clusterName) {
+				continue
+			}
+			clusterSecurityGroups[aws.StringValue(group.GroupId)] = true
+			resources = append(resources, provider.ClusterCloudResource{
+				CloudResource: provider.CloudResource{Kind: securityGroupKind, Name: aws.StringValue(group.GroupName)},
+				ID:            aws.StringValue(group.GroupId),
+				ClusterName:   clusterName,
+				ProjectID:     projectID,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list security groups: %v", err)
+	}
+
+	err = client.DescribeTagsPages(&ec2.DescribeTagsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("key"),
+				Values: aws.StringSlice([]string{tagNameKubermaticClusterPrefix + "*"}),
+			},
+			{
+				Name:   aws.String("resource-type"),
+				Values: aws.StringSlice([]string{"subnet", "route-table", "security-group"}),
+			},
+		},
+	}, func(out *ec2.DescribeTagsOutput, _ bool) bool {
+		for _, tag := range out.Tags {
+			// The security groups of the clusters are deleted as a whole
+			if clusterSecurityGroups[aws.StringValue(tag.ResourceId)] {
+				continue
+			}
+			clusterName := strings.TrimPrefix(aws.StringValue(tag.Key), tagNameKubermaticClusterPrefix)
+			resources = append(resources, provider.ClusterCloudResource{
+				CloudResource: provider.CloudResource{Kind: tagKind, Name: tagNameKubernetesClusterPrefix + clusterName},
+				ID:            aws.StringValue(tag.ResourceId),
+				ClusterName:   clusterName,
+				ProjectID:     aws.StringValue(tag.Value),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %v", err)
+	}
+
+	return resources, nil
+}
+
+// listClusterIAMResources returns the roles and instance profiles created by createWorkerInstanceProfile and createControlPlaneRole.
+// Only roles carrying the owner tag and the instance profiles of these worker roles are returned.
+func listClusterIAMResources(client iamiface.IAMAPI) ([]provider.ClusterCloudResource, error) {
+	var resources []provider.ClusterCloudResource
+
+	var candidates []provider.ClusterCloudResource
+	err := client.ListRolesPages(&iam.ListRolesInput{}, func(out *iam.ListRolesOutput, _ bool) bool {
+		for _, role := range out.Roles {
+			name := aws.StringValue(role.RoleName)
+			if !strings.HasPrefix(name, resourceNamePrefix) {
+				continue
+			}
+			var clusterName string
+			switch {
+			case strings.HasSuffix(name, "-worker"):
+				clusterName = strings.TrimSuffix(strings.TrimPrefix(name, resourceNamePrefix), "-worker")
+			case strings.HasSuffix(name, "-control-plane"):
+				clusterName = strings.TrimSuffix(strings.TrimPrefix(name, resourceNamePrefix), "-control-plane")
+			default:
+				continue
+			}
+			candidates = append(candidates, provider.ClusterCloudResource{
+				CloudResource: provider.CloudResource{Kind: roleKind, Name: name},
+				ID:            name,
+				ClusterName:   clusterName,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %v", err)
+	}
+
+	// ListRoles does not return the tags of the roles
+	workerRoles := map[string]string{}
+	for _, candidate := range candidates {
+		out, err := client.ListRoleTags(&iam.ListRoleTagsInput{RoleName: aws.String(candidate.ID)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tags of role %s: %v", candidate.ID, err)
+		}
+		for _, tag := range out.Tags {
+			if aws.StringValue(tag.Key) != tagNameKubermaticClusterPrefix+candidate.ClusterName {
+				continue
+			}
+			candidate.ProjectID = aws.StringValue(tag.Value)
+			if candidate.ID == workerRoleName(candidate.ClusterName) {
+				workerRoles[candidate.ID] = candidate.ProjectID
+			}
+			resources = append(resources, candidate)
+			break
+		}
+	}
+
+	err = client.ListInstanceProfilesPages(&iam.ListInstanceProfilesInput{}, func(out *iam.ListInstanceProfilesOutput, _ bool) bool {
+		for _, profile := range out.InstanceProfiles {
+			name := aws.StringValue(profile.InstanceProfileName)
+			if !strings.HasPrefix(name, resourceNamePrefix) {
+				continue
+			}
+			clusterName := strings.TrimPrefix(name, resourceNamePrefix)
+			projectID, owned := workerRoles[workerRoleName(clusterName)]
+			if !owned {
+				continue
+			}
+			resources = append(resources, provider.ClusterCloudResource{
+				CloudResource: provider.CloudResource{Kind: instanceProfileKind, Name: name},
+				ID:            name,
+				ClusterName:   clusterName,
+				ProjectID:     projectID,
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instance profiles: %v", err)
+	}
+
+	return resources, nil
+}
+
+func hasTag(tags []*ec2.Tag, key string) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+func tagValue(tags []*ec2.Tag, key string) (string, bool) {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return aws.StringValue(tag.Value), true
+		}
+	}
+	return "", false
+}
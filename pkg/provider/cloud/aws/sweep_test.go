@@ -0,0 +1,152 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/go-test/deep"
+
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+type fakeSweepIAMClient struct {
+	iamiface.IAMAPI
+	roles            []*iam.Role
+	roleTags         map[string][]*iam.Tag
+	instanceProfiles []*iam.InstanceProfile
+}
+
+func (c *fakeSweepIAMClient) ListRoleTags(input *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	return &iam.ListRoleTagsOutput{Tags: c.roleTags[aws.StringValue(input.RoleName)]}, nil
+}
+
+func (c *fakeSweepIAMClient) ListRolesPages(_ *iam.ListRolesInput, fn func(*iam.ListRolesOutput, bool) bool) error {
+	fn(&iam.ListRolesOutput{Roles: c.roles}, true)
+	return nil
+}
+
+func (c *fakeSweepIAMClient) ListInstanceProfilesPages(_ *iam.ListInstanceProfilesInput, fn func(*iam.ListInstanceProfilesOutput, bool) bool) error {
+	fn(&iam.ListInstanceProfilesOutput{InstanceProfiles: c.instanceProfiles}, true)
+	return nil
+}
+
+func TestListClusterEC2Resources(t *testing.T) {
+	owner := func(clusterName, projectID string) *ec2.Tag {
+		return &ec2.Tag{Key: aws.String(tagNameKubermaticClusterPrefix + clusterName), Value: aws.String(projectID)}
+	}
+	client := &fakeEC2Client{
+		securityGroups: []*ec2.SecurityGroup{
+			{
+				GroupId:   aws.String("sg-1"),
+				GroupName: aws.String("kubernetes-abc"),
+				Tags:      []*ec2.Tag{clusterTag("abc"), owner("abc", "project")},
+			},
+			{
+				// not created by us as the cluster tag is missing
+				GroupId:   aws.String("sg-2"),
+				GroupName: aws.String("kubernetes-workers"),
+			},
+			{
+				// created by another tool or before the owner tag was introduced
+				GroupId:   aws.String("sg-4"),
+				GroupName: aws.String("kubernetes-ghi"),
+				Tags:      []*ec2.Tag{clusterTag("ghi")},
+			},
+		},
+		tags: []*ec2.TagDescription{
+			{Key: aws.String("kubernetes.io/cluster/abc"), ResourceId: aws.String("sg-1"), ResourceType: aws.String("security-group")},
+			{Key: aws.String("kubermatic.io/cluster/abc"), Value: aws.String("project"), ResourceId: aws.String("sg-1"), ResourceType: aws.String("security-group")},
+			{Key: aws.String("kubernetes.io/cluster/abc"), ResourceId: aws.String("subnet-1"), ResourceType: aws.String("subnet")},
+			{Key: aws.String("kubermatic.io/cluster/abc"), Value: aws.String("project"), ResourceId: aws.String("subnet-1"), ResourceType: aws.String("subnet")},
+			{Key: aws.String("kubernetes.io/cluster/def"), ResourceId: aws.String("sg-3"), ResourceType: aws.String("security-group")},
+			{Key: aws.String("kubermatic.io/cluster/def"), Value: aws.String("project"), ResourceId: aws.String("sg-3"), ResourceType: aws.String("security-group")},
+			// the cluster tag of another tool
+			{Key: aws.String("kubernetes.io/cluster/eks-prod"), Value: aws.String("shared"), ResourceId: aws.String("subnet-1"), ResourceType: aws.String("subnet")},
+		},
+	}
+
+	resources, err := listClusterEC2Resources(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []provider.ClusterCloudResource{
+		{CloudResource: provider.CloudResource{Kind: securityGroupKind, Name: "kubernetes-abc"}, ID: "sg-1", ClusterName: "abc", ProjectID: "project"},
+		{CloudResource: provider.CloudResource{Kind: tagKind, Name: "kubernetes.io/cluster/abc"}, ID: "subnet-1", ClusterName: "abc", ProjectID: "project"},
+		{CloudResource: provider.CloudResource{Kind: tagKind, Name: "kubernetes.io/cluster/def"}, ID: "sg-3", ClusterName: "def", ProjectID: "project"},
+	}
+	if diff := deep.Equal(resources, expected); diff != nil {
+		t.Errorf("unexpected resources: %v", diff)
+	}
+}
+
+func TestListClusterIAMResources(t *testing.T) {
+	owner := func(clusterName string) []*iam.Tag {
+		return []*iam.Tag{{Key: aws.String(tagNameKubermaticClusterPrefix + clusterName), Value: aws.String("project")}}
+	}
+	client := &fakeSweepIAMClient{
+		roles: []*iam.Role{
+			{RoleName: aws.String("kubernetes-abc-worker")},
+			{RoleName: aws.String("kubernetes-abc-control-plane")},
+			{RoleName: aws.String("kubernetes-def-worker")},
+			// not owned by us as the owner tag is missing
+			{RoleName: aws.String("kubernetes-ghi-worker")},
+			{RoleName: aws.String("kubernetes-admin")},
+			{RoleName: aws.String("ci-worker")},
+		},
+		roleTags: map[string][]*iam.Tag{
+			"kubernetes-abc-worker":        owner("abc"),
+			"kubernetes-abc-control-plane": owner("abc"),
+			"kubernetes-def-worker":        owner("def"),
+		},
+		instanceProfiles: []*iam.InstanceProfile{
+			{InstanceProfileName: aws.String("kubernetes-abc")},
+			{
+				InstanceProfileName: aws.String("kubernetes-def"),
+				Roles:               []*iam.Role{{RoleName: aws.String("kubernetes-def-worker")}},
+			},
+			{
+				InstanceProfileName: aws.String("kubernetes-ghi"),
+				Roles:               []*iam.Role{{RoleName: aws.String("kubernetes-ghi-worker")}},
+			},
+			{
+				// not created by us as there is no matching worker role
+				InstanceProfileName: aws.String("kubernetes-nodes"),
+				Roles:               []*iam.Role{{RoleName: aws.String("nodes")}},
+			},
+		},
+	}
+
+	resources, err := listClusterIAMResources(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []provider.ClusterCloudResource{
+		{CloudResource: provider.CloudResource{Kind: roleKind, Name: "kubernetes-abc-worker"}, ID: "kubernetes-abc-worker", ClusterName: "abc", ProjectID: "project"},
+		{CloudResource: provider.CloudResource{Kind: roleKind, Name: "kubernetes-abc-control-plane"}, ID: "kubernetes-abc-control-plane", ClusterName: "abc", ProjectID: "project"},
+		{CloudResource: provider.CloudResource{Kind: roleKind, Name: "kubernetes-def-worker"}, ID: "kubernetes-def-worker", ClusterName: "def", ProjectID: "project"},
+		{CloudResource: provider.CloudResource{Kind: instanceProfileKind, Name: "kubernetes-abc"}, ID: "kubernetes-abc", ClusterName: "abc", ProjectID: "project"},
+		{CloudResource: provider.CloudResource{Kind: instanceProfileKind, Name: "kubernetes-def"}, ID: "kubernetes-def", ClusterName: "def", ProjectID: "project"},
+	}
+	if diff := deep.Equal(resources, expected); diff != nil {
+		t.Errorf("unexpected resources: %v", diff)
+	}
+}
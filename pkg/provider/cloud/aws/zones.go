@@ -0,0 +1,113 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+)
+
+// ZoneReplicas is the share of the replicas of a node deployment placed into an availability zone
+type ZoneReplicas struct {
+	AvailabilityZone string
+	SubnetID         string
+	Replicas         int32
+}
+
+// DistributeReplicas spreads the replicas evenly across the availability zones of the cluster VPC,
+// using one subnet per zone. Zones which would get no replicas are omitted, only the first zone is
+// kept if there are no replicas at all.
+func (a *AmazonEC2) DistributeReplicas(spec kubermaticv1.CloudSpec, replicas int32) ([]ZoneReplicas, error) {
+	client, err := a.getClientSet(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API client: %v", err)
+	}
+
+	vpcID := spec.AWS.VPCID
+	if vpcID == "" {
+		vpc, err := getDefaultVpc(client.EC2)
+		if err != nil {
+			return nil, err
+		}
+		vpcID = aws.StringValue(vpc.VpcId)
+	}
+
+	return distributeReplicas(client.EC2, vpcID, replicas)
+}
+
+func distributeReplicas(client ec2iface.EC2API, vpcID string, replicas int32) ([]ZoneReplicas, error) {
+	subnets, err := getSubnets(client, vpcID)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetsByZone := map[string]*ec2.Subnet{}
+	for _, subnet := range subnets {
+		if aws.StringValue(subnet.State) != ec2.SubnetStateAvailable {
+			continue
+		}
+		zone := aws.StringValue(subnet.AvailabilityZone)
+		if current, ok := subnetsByZone[zone]; !ok || preferSubnet(subnet, current) {
+			subnetsByZone[zone] = subnet
+		}
+	}
+	if len(subnetsByZone) == 0 {
+		return nil, fmt.Errorf("no available subnets in vpc %s", vpcID)
+	}
+
+	zones := make([]string, 0, len(subnetsByZone))
+	for zone := range subnetsByZone {
+		zones = append(zones, zone)
+	}
+	sort.Strings(zones)
+
+	var distribution []ZoneReplicas
+	for i, zone := range zones {
+		// The first zones get one more replica if the replicas can not be spread evenly
+		zoneReplicas := replicas / int32(len(zones))
+		if int32(i) < replicas%int32(len(zones)) {
+			zoneReplicas++
+		}
+		if zoneReplicas == 0 && i > 0 {
+			continue
+		}
+		distribution = append(distribution, ZoneReplicas{
+			AvailabilityZone: zone,
+			SubnetID:         aws.StringValue(subnetsByZone[zone].SubnetId),
+			Replicas:         zoneReplicas,
+		})
+	}
+	return distribution, nil
+}
+
+// preferSubnet returns true if the subnet is a better choice for the nodes of its zone than the current one.
+// The default subnet of the zone is preferred, then the subnet with the most free IP addresses.
+func preferSubnet(subnet, current *ec2.Subnet) bool {
+	if aws.BoolValue(subnet.DefaultForAz) != aws.BoolValue(current.DefaultForAz) {
+		return aws.BoolValue(subnet.DefaultForAz)
+	}
+	if aws.Int64Value(subnet.AvailableIpAddressCount) != aws.Int64Value(current.AvailableIpAddressCount) {
+		return aws.Int64Value(subnet.AvailableIpAddressCount) > aws.Int64Value(current.AvailableIpAddressCount)
+	}
+	return aws.StringValue(subnet.SubnetId) < aws.StringValue(current.SubnetId)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+func TestDistributeReplicas(t *testing.T) {
+	subnet := func(id, zone string, defaultForAz bool, freeIPs int64, state string) *ec2.Subnet {
+		return &ec2.Subnet{
+			SubnetId:                aws.String(id),
+			VpcId:                   aws.String("vpc-1"),
+			AvailabilityZone:        aws.String(zone),
+			DefaultForAz:            aws.Bool(defaultForAz),
+			AvailableIpAddressCount: aws.Int64(freeIPs),
+			State:                   aws.String(state),
+		}
+	}
+	client := &fakeEC2Client{
+		subnets: []*ec2.Subnet{
+			subnet("subnet-c", "eu-central-1c", true, 100, ec2.SubnetStateAvailable),
+			subnet("subnet-a-small", "eu-central-1a", false, 10, ec2.SubnetStateAvailable),
+			subnet("subnet-a-large", "eu-central-1a", false, 200, ec2.SubnetStateAvailable),
+			subnet("subnet-b", "eu-central-1b", false, 300, ec2.SubnetStateAvailable),
+			subnet("subnet-b-default", "eu-central-1b", true, 50, ec2.SubnetStateAvailable),
+			subnet("subnet-d", "eu-central-1d", true, 100, ec2.SubnetStatePending),
+			{SubnetId: aws.String("subnet-other"), VpcId: aws.String("vpc-2"), AvailabilityZone: aws.String("eu-central-1d"), State: aws.String(ec2.SubnetStateAvailable)},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		vpcID                string
+		replicas             int32
+		expectedDistribution []ZoneReplicas
+		expectedError        string
+	}{
+		{
+			name:     "replicas are spread evenly",
+			vpcID:    "vpc-1",
+			replicas: 6,
+			expectedDistribution: []ZoneReplicas{
+				{AvailabilityZone: "eu-central-1a", SubnetID: "subnet-a-large", Replicas: 2},
+				{AvailabilityZone: "eu-central-1b", SubnetID: "subnet-b-default", Replicas: 2},
+				{AvailabilityZone: "eu-central-1c", SubnetID: "subnet-c", Replicas: 2},
+			},
+		},
+		{
+			name:     "the first zones get the remaining replicas",
+			vpcID:    "vpc-1",
+			replicas: 5,
+			expectedDistribution: []ZoneReplicas{
+				{AvailabilityZone: "eu-central-1a", SubnetID: "subnet-a-large", Replicas: 2},
+				{AvailabilityZone: "eu-central-1b", SubnetID: "subnet-b-default", Replicas: 2},
+				{AvailabilityZone: "eu-central-1c", SubnetID: "subnet-c", Replicas: 1},
+			},
+		},
+		{
+			name:     "zones without replicas are omitted",
+			vpcID:    "vpc-1",
+			replicas: 1,
+			expectedDistribution: []ZoneReplicas{
+				{AvailabilityZone: "eu-central-1a", SubnetID: "subnet-a-large", Replicas: 1},
+			},
+		},
+		{
+			name:     "the first zone is used without replicas",
+			vpcID:    "vpc-1",
+			replicas: 0,
+			expectedDistribution: []ZoneReplicas{
+				{AvailabilityZone: "eu-central-1a", SubnetID: "subnet-a-large", Replicas: 0},
+			},
+		},
+		{
+			name:          "vpc without subnets",
+			vpcID:         "vpc-3",
+			replicas:      3,
+			expectedError: "no available subnets in vpc vpc-3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			distribution, err := distributeReplicas(client, tc.vpcID, tc.replicas)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(distribution, tc.expectedDistribution) {
+				t.Errorf("expected distribution %+v, got %+v", tc.expectedDistribution, distribution)
+			}
+		})
+	}
+}
@@ -42,6 +42,9 @@ const (
 	resourceNamePrefix = "kubernetes-"
 
 	clusterTagKey = "cluster"
+	// projectTagKey marks the resource groups created for the clusters, its value is the project of the cluster.
+	// Only resource groups carrying it are removed as orphans.
+	projectTagKey = "kubermatic-project"
 
 	// FinalizerSecurityGroup will instruct the deletion of the security group
 	FinalizerSecurityGroup = "kubermatic.io/cleanup-azure-security-group"
@@ -326,8 +329,32 @@ func (a *Azure) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update provi
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (a *Azure) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, FinalizerSecurityGroup) {
+		resources = append(resources, provider.CloudResource{Kind: "SecurityGroup", Name: cluster.Spec.Cloud.Azure.SecurityGroup})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, FinalizerRouteTable) {
+		resources = append(resources, provider.CloudResource{Kind: "RouteTable", Name: cluster.Spec.Cloud.Azure.RouteTableName})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, FinalizerSubnet) {
+		resources = append(resources, provider.CloudResource{Kind: "Subnet", Name: cluster.Spec.Cloud.Azure.SubnetName})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, FinalizerVNet) {
+		resources = append(resources, provider.CloudResource{Kind: "VirtualNetwork", Name: cluster.Spec.Cloud.Azure.VNetName})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, FinalizerResourceGroup) {
+		resources = append(resources, provider.CloudResource{Kind: "ResourceGroup", Name: cluster.Spec.Cloud.Azure.ResourceGroup})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, FinalizerAvailabilitySet) {
+		resources = append(resources, provider.CloudResource{Kind: "AvailabilitySet", Name: cluster.Spec.Cloud.Azure.AvailabilitySet})
+	}
+	return resources
+}
+
 // ensureResourceGroup will create or update an Azure resource group. The call is idempotent.
-func ensureResourceGroup(ctx context.Context, cloud kubermaticv1.CloudSpec, location string, clusterName string, credentials Credentials) error {
+func ensureResourceGroup(ctx context.Context, cloud kubermaticv1.CloudSpec, location string, cluster *kubermaticv1.Cluster, credentials Credentials) error {
 	groupsClient, err := getGroupsClient(cloud, credentials)
 	if err != nil {
 		return err
@@ -337,7 +364,8 @@ func ensureResourceGroup(ctx context.Context, cloud kubermaticv1.CloudSpec, loca
 		Name:     to.StringPtr(cloud.Azure.ResourceGroup),
 		Location: to.StringPtr(location),
 		Tags: map[string]*string{
-			clusterTagKey: to.StringPtr(clusterName),
+			clusterTagKey: to.StringPtr(cluster.Name),
+			projectTagKey: to.StringPtr(cluster.Labels[kubermaticv1.ProjectIDLabelKey]),
 		},
 	}
 	if _, err = groupsClient.CreateOrUpdate(ctx, cloud.Azure.ResourceGroup, parameters); err != nil {
@@ -538,7 +566,7 @@ func (a *Azure) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update pr
 		cluster.Spec.Cloud.Azure.ResourceGroup = resourceNamePrefix + cluster.Name
 
 		logger.Infow("ensuring resource group", "resourceGroup", cluster.Spec.Cloud.Azure.ResourceGroup)
-		if err = ensureResourceGroup(a.ctx, cluster.Spec.Cloud, location, cluster.Name, credentials); err != nil {
+		if err = ensureResourceGroup(a.ctx, cluster.Spec.Cloud, location, cluster, credentials); err != nil {
 			return cluster, err
 		}
 
@@ -0,0 +1,93 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/to"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const resourceGroupKind = "ResourceGroup"
+
+var _ provider.CloudResourceSweeper = &Azure{}
+
+// ListClusterResources returns the resource groups of the datacenter location which were created for clusters.
+// All other resources are created within the resource group and vanish together with it. Resource groups created
+// before the project tag was introduced are left alone.
+func (a *Azure) ListClusterResources(spec kubermaticv1.CloudSpec) ([]provider.ClusterCloudResource, error) {
+	credentials, err := GetCredentialsForCluster(spec, a.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	groupsClient, err := getGroupsClient(spec, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := groupsClient.ListComplete(a.ctx, fmt.Sprintf("tagName eq '%s'", projectTagKey), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource groups: %v", err)
+	}
+
+	var resources []provider.ClusterCloudResource
+	for groups.NotDone() {
+		group := groups.Value()
+		name := to.String(group.Name)
+		clusterName := to.String(group.Tags[clusterTagKey])
+		// Resource groups which were passed in by the user are not tagged, so this is merely a safeguard
+		if clusterName != "" && name == resourceNamePrefix+clusterName && strings.EqualFold(to.String(group.Location), a.dc.Location) {
+			resources = append(resources, provider.ClusterCloudResource{
+				CloudResource: provider.CloudResource{Kind: resourceGroupKind, Name: name},
+				ID:            name,
+				ClusterName:   clusterName,
+				ProjectID:     to.String(group.Tags[projectTagKey]),
+			})
+		}
+		if err := groups.NextWithContext(a.ctx); err != nil {
+			return nil, fmt.Errorf("failed to list resource groups: %v", err)
+		}
+	}
+	return resources, nil
+}
+
+// DeleteClusterResource deletes a resource returned by ListClusterResources
+func (a *Azure) DeleteClusterResource(spec kubermaticv1.CloudSpec, resource provider.ClusterCloudResource) error {
+	if resource.Kind != resourceGroupKind {
+		return fmt.Errorf("unknown resource kind %q", resource.Kind)
+	}
+
+	credentials, err := GetCredentialsForCluster(spec, a.secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	spec.Azure = spec.Azure.DeepCopy()
+	spec.Azure.ResourceGroup = resource.ID
+	if err := deleteResourceGroup(a.ctx, spec, credentials); err != nil {
+		if detErr, ok := err.(autorest.DetailedError); !ok || detErr.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+	return nil
+}
@@ -19,24 +19,61 @@ package digitalocean
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
+	"sort"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
 
 	"github.com/digitalocean/godo"
 	"golang.org/x/oauth2"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	firewallCleanupFinalizer = "kubermatic.io/cleanup-digitalocean-firewall"
+
+	// nodePortRange is the port range of NodePort services, DigitalOcean load balancers forward to them as well
+	nodePortRange = "30000-32767"
+	kubeletPort   = "10250"
 )
 
 type digitalocean struct {
+	dc                *kubermaticv1.DatacenterSpecDigitalocean
 	secretKeySelector provider.SecretKeySelectorValueFunc
+	// baseURL is the URL of the DigitalOcean API, it is a field to be able to replace it in tests
+	baseURL string
 }
 
 // NewCloudProvider creates a new digitalocean provider.
-func NewCloudProvider(secretKeyGetter provider.SecretKeySelectorValueFunc) provider.CloudProvider {
+func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.SecretKeySelectorValueFunc) (provider.CloudProvider, error) {
+	if dc.Spec.Digitalocean == nil {
+		return nil, errors.New("datacenter is not a DigitalOcean datacenter")
+	}
 	return &digitalocean{
+		dc:                dc.Spec.Digitalocean,
 		secretKeySelector: secretKeyGetter,
+	}, nil
+}
+
+var _ provider.CloudResourceLister = &digitalocean{}
+
+func (do *digitalocean) getClient(spec kubermaticv1.CloudSpec) (*godo.Client, error) {
+	token, err := GetCredentialsForCluster(spec, do.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+
+	static := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+	httpClient := oauth2.NewClient(context.Background(), static)
+	if do.baseURL != "" {
+		return godo.New(httpClient, godo.SetBaseURL(do.baseURL))
 	}
+	return godo.NewClient(httpClient), nil
 }
 
 func (do *digitalocean) DefaultCloudSpec(spec *kubermaticv1.CloudSpec) error {
@@ -44,31 +81,264 @@ func (do *digitalocean) DefaultCloudSpec(spec *kubermaticv1.CloudSpec) error {
 }
 
 func (do *digitalocean) ValidateCloudSpec(spec kubermaticv1.CloudSpec) error {
-	token, err := GetCredentialsForCluster(spec, do.secretKeySelector)
+	client, err := do.getClient(spec)
 	if err != nil {
 		return err
 	}
 
-	static := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
-	client := godo.NewClient(oauth2.NewClient(context.Background(), static))
-
 	_, _, err = client.Regions.List(context.Background(), nil)
 	return err
 }
 
+// InitializeCloudProvider creates a cloud firewall for the nodes of the cluster and keeps its rules up to date.
+// No VPC is created for the cluster, the machine-controller can't place droplets into one.
 func (do *digitalocean) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	client, err := do.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+
+	loadBalancers, err := loadBalancerUIDs(ctx, client, clusterTag(cluster))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list load balancers: %v", err)
+	}
+	request := firewallRequest(resourceName(cluster), clusterTag(cluster), do.dc.SeedEgressAddresses, loadBalancers)
+
+	if cluster.Spec.Cloud.Digitalocean.FirewallID != "" {
+		if err := reconcileFirewall(ctx, client, cluster.Spec.Cloud.Digitalocean.FirewallID, request); err != nil {
+			return nil, err
+		}
+		return cluster, nil
+	}
+
+	firewall, err := ensureFirewall(ctx, client, cluster, request)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+		cluster.Spec.Cloud.Digitalocean.FirewallID = firewall.ID
+		kuberneteshelper.AddFinalizer(cluster, firewallCleanupFinalizer)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add firewall %s to the cluster: %v", firewall.ID, err)
+	}
+
 	return cluster, nil
 }
 
-func (do *digitalocean) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, _ provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+// ensureFirewall returns the firewall of the cluster and creates it if it does not exist yet
+func ensureFirewall(ctx context.Context, client *godo.Client, cluster *kubermaticv1.Cluster, request *godo.FirewallRequest) (*godo.Firewall, error) {
+	firewall, err := getFirewallByName(ctx, client, request.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewalls: %v", err)
+	}
+	if firewall != nil {
+		if err := reconcileFirewall(ctx, client, firewall.ID, request); err != nil {
+			return nil, err
+		}
+		return firewall, nil
+	}
+
+	// The firewall can only reference existing tags, creating an already existing tag is a no-op
+	tag := clusterTag(cluster)
+	if _, _, err := client.Tags.Create(ctx, &godo.TagCreateRequest{Name: tag}); err != nil {
+		return nil, fmt.Errorf("failed to create tag %s: %v", tag, err)
+	}
+
+	firewall, _, err = client.Firewalls.Create(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create firewall %s: %v", request.Name, err)
+	}
+	return firewall, nil
+}
+
+// reconcileFirewall updates the firewall if its tags or rules differ from the requested ones, e.g. because a
+// load balancer was added to the cluster
+func reconcileFirewall(ctx context.Context, client *godo.Client, id string, request *godo.FirewallRequest) error {
+	firewall, _, err := client.Firewalls.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get firewall %s: %v", id, err)
+	}
+	if sets.NewString(firewall.Tags...).Equal(sets.NewString(request.Tags...)) && inboundRulesEqual(firewall.InboundRules, request.InboundRules) {
+		return nil
+	}
+	if _, _, err := client.Firewalls.Update(ctx, id, request); err != nil {
+		return fmt.Errorf("failed to update firewall %s: %v", id, err)
+	}
+	return nil
+}
+
+// getFirewallByName returns the firewall with the given name or nil if there is none
+func getFirewallByName(ctx context.Context, client *godo.Client, name string) (*godo.Firewall, error) {
+	opts := &godo.ListOptions{PerPage: 200}
+	for {
+		firewalls, resp, err := client.Firewalls.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for i := range firewalls {
+			if firewalls[i].Name == name {
+				return &firewalls[i], nil
+			}
+		}
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			return nil, nil
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opts.Page = page + 1
+	}
+}
+
+// loadBalancerUIDs returns the IDs of the load balancers of the cluster, which are the ones forwarding to the
+// droplets with the cluster tag or carrying the tag themselves
+func loadBalancerUIDs(ctx context.Context, client *godo.Client, tag string) ([]string, error) {
+	var uids []string
+	opts := &godo.ListOptions{PerPage: 200}
+	for {
+		loadBalancers, resp, err := client.LoadBalancers.List(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, lb := range loadBalancers {
+			if lb.Tag == tag || sets.NewString(lb.Tags...).Has(tag) {
+				uids = append(uids, lb.ID)
+			}
+		}
+		if resp.Links == nil || resp.Links.IsLastPage() {
+			sort.Strings(uids)
+			return uids, nil
+		}
+		page, err := resp.Links.CurrentPage()
+		if err != nil {
+			return nil, err
+		}
+		opts.Page = page + 1
+	}
+}
+
+// firewallRequest returns the firewall for the droplets with the given tag. It allows all traffic between the
+// nodes, kubelet and NodePort traffic from the seed and NodePort traffic from the load balancers of the cluster.
+// Nothing else is reachable from outside of the cluster, the seed reaches the kubelets through the OpenVPN
+// tunnel, which the nodes open, unless seed addresses are configured.
+func firewallRequest(name, tag string, seedAddresses, loadBalancerUIDs []string) *godo.FirewallRequest {
+	nodes := &godo.Sources{Tags: []string{tag}}
+	everywhere := &godo.Destinations{Addresses: []string{"0.0.0.0/0", "::/0"}}
+
+	inboundRules := []godo.InboundRule{
+		{Protocol: "tcp", PortRange: "all", Sources: nodes},
+		{Protocol: "udp", PortRange: "all", Sources: nodes},
+		{Protocol: "icmp", Sources: nodes},
+	}
+	if len(seedAddresses) > 0 {
+		seed := &godo.Sources{Addresses: seedAddresses}
+		inboundRules = append(inboundRules,
+			godo.InboundRule{Protocol: "tcp", PortRange: kubeletPort, Sources: seed},
+			godo.InboundRule{Protocol: "udp", PortRange: nodePortRange, Sources: seed},
+		)
+	}
+	// DigitalOcean load balancers only forward TCP traffic
+	if len(seedAddresses) > 0 || len(loadBalancerUIDs) > 0 {
+		inboundRules = append(inboundRules, godo.InboundRule{
+			Protocol:  "tcp",
+			PortRange: nodePortRange,
+			Sources:   &godo.Sources{Addresses: seedAddresses, LoadBalancerUIDs: loadBalancerUIDs},
+		})
+	}
+
+	return &godo.FirewallRequest{
+		Name:         name,
+		Tags:         []string{tag},
+		InboundRules: inboundRules,
+		OutboundRules: []godo.OutboundRule{
+			{Protocol: "tcp", PortRange: "all", Destinations: everywhere},
+			{Protocol: "udp", PortRange: "all", Destinations: everywhere},
+			{Protocol: "icmp", Destinations: everywhere},
+		},
+	}
+}
+
+// inboundRulesEqual compares the given rules regardless of their order and of the order of their sources
+func inboundRulesEqual(a, b []godo.InboundRule) bool {
+	key := func(rules []godo.InboundRule) sets.String {
+		keys := sets.NewString()
+		for _, rule := range rules {
+			sources := rule.Sources
+			if sources == nil {
+				sources = &godo.Sources{}
+			}
+			keys.Insert(fmt.Sprintf("%s/%s addresses=%v tags=%v lbs=%v", rule.Protocol, rule.PortRange,
+				sets.NewString(sources.Addresses...).List(), sets.NewString(sources.Tags...).List(), sets.NewString(sources.LoadBalancerUIDs...).List()))
+		}
+		return keys
+	}
+	return key(a).Equal(key(b))
+}
+
+// CleanUpCloudProvider deletes the firewall created for the cluster
+func (do *digitalocean) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	if !kuberneteshelper.HasFinalizer(cluster, firewallCleanupFinalizer) {
+		return cluster, nil
+	}
+
+	client, err := do.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	// The firewall might have been deleted manually
+	if _, err := client.Firewalls.Delete(context.Background(), cluster.Spec.Cloud.Digitalocean.FirewallID); err != nil && !isNotFound(err) {
+		return nil, fmt.Errorf("failed to delete firewall %s: %v", cluster.Spec.Cloud.Digitalocean.FirewallID, err)
+	}
+
+	cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+		kuberneteshelper.RemoveFinalizer(cluster, firewallCleanupFinalizer)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove %s finalizer: %v", firewallCleanupFinalizer, err)
+	}
+
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (do *digitalocean) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, firewallCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Firewall", Name: resourceName(cluster)})
+	}
+	return resources
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted
 func (do *digitalocean) ValidateCloudSpecUpdate(oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
+	if oldSpec.Digitalocean == nil || newSpec.Digitalocean == nil {
+		return nil
+	}
+	if oldSpec.Digitalocean.FirewallID != "" && oldSpec.Digitalocean.FirewallID != newSpec.Digitalocean.FirewallID {
+		return fmt.Errorf("updating DigitalOcean firewall is not supported (was %s, updated to %s)", oldSpec.Digitalocean.FirewallID, newSpec.Digitalocean.FirewallID)
+	}
 	return nil
 }
 
+func resourceName(cluster *kubermaticv1.Cluster) string {
+	return "kubernetes-" + cluster.Name
+}
+
+func isNotFound(err error) bool {
+	errResponse, ok := err.(*godo.ErrorResponse)
+	return ok && errResponse.Response != nil && errResponse.Response.StatusCode == http.StatusNotFound
+}
+
+// clusterTag returns the tag the machine-controller adds to all droplets of the cluster
+func clusterTag(cluster *kubermaticv1.Cluster) string {
+	return "kubernetes-cluster-" + cluster.Name
+}
+
 // GetCredentialsForCluster returns the credentials for the passed in cloud spec or an error
 func GetCredentialsForCluster(cloud kubermaticv1.CloudSpec, secretKeySelector provider.SecretKeySelectorValueFunc) (accessToken string, err error) {
 	accessToken = cloud.Digitalocean.Token
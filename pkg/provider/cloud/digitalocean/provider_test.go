@@ -0,0 +1,370 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package digitalocean
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/digitalocean/godo"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeAPI is a minimal DigitalOcean API which stores firewalls, load balancers and tags in memory
+type fakeAPI struct {
+	lock          sync.Mutex
+	nextID        int
+	firewalls     map[string]godo.Firewall
+	loadBalancers []godo.LoadBalancer
+	tags          map[string]bool
+}
+
+func newFakeAPI(firewalls []godo.Firewall, loadBalancers ...godo.LoadBalancer) (*fakeAPI, *httptest.Server) {
+	api := &fakeAPI{nextID: 100, firewalls: map[string]godo.Firewall{}, loadBalancers: loadBalancers, tags: map[string]bool{}}
+	for _, firewall := range firewalls {
+		api.firewalls[firewall.ID] = firewall
+	}
+	return api, httptest.NewServer(api)
+}
+
+func (a *fakeAPI) newID() string {
+	a.nextID++
+	return fmt.Sprintf("id-%d", a.nextID-1)
+}
+
+func (a *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "v2/firewalls" && r.Method == http.MethodGet:
+		firewalls := []godo.Firewall{}
+		for _, firewall := range a.firewalls {
+			firewalls = append(firewalls, firewall)
+		}
+		sort.Slice(firewalls, func(i, j int) bool { return firewalls[i].ID < firewalls[j].ID })
+		writeJSON(w, http.StatusOK, paginate(r, firewalls))
+
+	case path == "v2/firewalls" && r.Method == http.MethodPost:
+		req := godo.FirewallRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, nil)
+			return
+		}
+		for _, tag := range req.Tags {
+			if !a.tags[tag] {
+				writeJSON(w, http.StatusUnprocessableEntity, map[string]string{"message": "tag not found"})
+				return
+			}
+		}
+		firewall := godo.Firewall{ID: a.newID(), Name: req.Name, Tags: req.Tags, InboundRules: req.InboundRules, OutboundRules: req.OutboundRules}
+		a.firewalls[firewall.ID] = firewall
+		writeJSON(w, http.StatusAccepted, map[string]interface{}{"firewall": firewall})
+
+	case strings.HasPrefix(path, "v2/firewalls/") && r.Method == http.MethodGet:
+		firewall, exists := a.firewalls[strings.TrimPrefix(path, "v2/firewalls/")]
+		if !exists {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "firewall not found"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"firewall": firewall})
+
+	case strings.HasPrefix(path, "v2/firewalls/") && r.Method == http.MethodPut:
+		firewall, exists := a.firewalls[strings.TrimPrefix(path, "v2/firewalls/")]
+		if !exists {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "firewall not found"})
+			return
+		}
+		req := godo.FirewallRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, nil)
+			return
+		}
+		firewall.Name, firewall.Tags, firewall.InboundRules, firewall.OutboundRules = req.Name, req.Tags, req.InboundRules, req.OutboundRules
+		a.firewalls[firewall.ID] = firewall
+		writeJSON(w, http.StatusOK, map[string]interface{}{"firewall": firewall})
+
+	case path == "v2/load_balancers" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"load_balancers": a.loadBalancers, "links": godo.Links{}})
+
+	case strings.HasPrefix(path, "v2/firewalls/") && r.Method == http.MethodDelete:
+		id := strings.TrimPrefix(path, "v2/firewalls/")
+		if _, exists := a.firewalls[id]; !exists {
+			writeJSON(w, http.StatusNotFound, map[string]string{"message": "firewall not found"})
+			return
+		}
+		delete(a.firewalls, id)
+		w.WriteHeader(http.StatusNoContent)
+
+	case path == "v2/tags" && r.Method == http.MethodPost:
+		req := godo.TagCreateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, nil)
+			return
+		}
+		a.tags[req.Name] = true
+		writeJSON(w, http.StatusCreated, map[string]interface{}{"tag": godo.Tag{Name: req.Name}})
+
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "unknown path"})
+	}
+}
+
+// paginate returns the requested page of the firewalls with the links to the other pages
+func paginate(r *http.Request, firewalls []godo.Firewall) map[string]interface{} {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 {
+		perPage = len(firewalls) + 1
+	}
+	lastPage := (len(firewalls) + perPage - 1) / perPage
+
+	pageURL := func(page int) string {
+		return fmt.Sprintf("http://%s/v2/firewalls?page=%d&per_page=%d", r.Host, page, perPage)
+	}
+	pages := &godo.Pages{}
+	if page > 1 {
+		pages.First = pageURL(1)
+		pages.Prev = pageURL(page - 1)
+	}
+	if page < lastPage {
+		pages.Next = pageURL(page + 1)
+		pages.Last = pageURL(lastPage)
+	}
+
+	start := (page - 1) * perPage
+	end := start + perPage
+	if start > len(firewalls) {
+		start = len(firewalls)
+	}
+	if end > len(firewalls) {
+		end = len(firewalls)
+	}
+	return map[string]interface{}{"firewalls": firewalls[start:end], "links": godo.Links{Pages: pages}}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func newTestProvider(baseURL string, seedAddresses ...string) *digitalocean {
+	return &digitalocean{
+		dc:      &kubermaticv1.DatacenterSpecDigitalocean{SeedEgressAddresses: seedAddresses},
+		baseURL: baseURL,
+	}
+}
+
+func genCluster(firewallID string, finalizers ...string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abcd", Finalizers: finalizers},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				Digitalocean: &kubermaticv1.DigitaloceanCloudSpec{Token: "token", FirewallID: firewallID},
+			},
+		},
+	}
+}
+
+// testUpdater applies the modifications to the given cluster, as the cluster provider would do
+func testUpdater(cluster *kubermaticv1.Cluster) func(string, func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+	return func(_ string, modify func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+		modify(cluster)
+		return cluster, nil
+	}
+}
+
+// genFirewalls returns the given number of firewalls of other clusters, to have more than one page of firewalls
+func genFirewalls(count int) []godo.Firewall {
+	var firewalls []godo.Firewall
+	for i := 0; i < count; i++ {
+		firewalls = append(firewalls, godo.Firewall{ID: fmt.Sprintf("fw-%03d", i), Name: fmt.Sprintf("other-%03d", i)})
+	}
+	return firewalls
+}
+
+func TestInitializeCloudProvider(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		existingFirewalls     []godo.Firewall
+		loadBalancers         []godo.LoadBalancer
+		seedAddresses         []string
+		cluster               *kubermaticv1.Cluster
+		expectedFirewall      string
+		expectedFinalizers    []string
+		expectedFirewalls     int
+		expectedExternalRules map[string][]string
+	}{
+		{
+			name:               "firewall is created",
+			cluster:            genCluster(""),
+			expectedFirewall:   "id-100",
+			expectedFinalizers: []string{firewallCleanupFinalizer},
+			expectedFirewalls:  1,
+		},
+		{
+			name:               "firewall only allows the seed",
+			seedAddresses:      []string{"192.0.2.10/32"},
+			cluster:            genCluster(""),
+			expectedFirewall:   "id-100",
+			expectedFinalizers: []string{firewallCleanupFinalizer},
+			expectedFirewalls:  1,
+			expectedExternalRules: map[string][]string{
+				"tcp/" + kubeletPort:   {"192.0.2.10/32"},
+				"tcp/" + nodePortRange: {"192.0.2.10/32"},
+				"udp/" + nodePortRange: {"192.0.2.10/32"},
+			},
+		},
+		{
+			name:               "firewall created before is reused",
+			existingFirewalls:  append(genFirewalls(250), godo.Firewall{ID: "x", Name: "kubernetes-abcd"}),
+			cluster:            genCluster(""),
+			expectedFirewall:   "x",
+			expectedFinalizers: []string{firewallCleanupFinalizer},
+			expectedFirewalls:  251,
+		},
+		{
+			name:              "load balancers of the cluster are added to the existing firewall",
+			existingFirewalls: []godo.Firewall{{ID: "fw", Name: "kubernetes-abcd"}},
+			loadBalancers: []godo.LoadBalancer{
+				{ID: "lb-tag", Tag: "kubernetes-cluster-abcd"},
+				{ID: "lb-tags", Tags: []string{"kubernetes-cluster-abcd"}},
+				{ID: "lb-other", Tag: "kubernetes-cluster-other"},
+			},
+			seedAddresses:     []string{"192.0.2.10/32"},
+			cluster:           genCluster("fw"),
+			expectedFirewall:  "fw",
+			expectedFirewalls: 1,
+			expectedExternalRules: map[string][]string{
+				"tcp/" + kubeletPort:   {"192.0.2.10/32"},
+				"tcp/" + nodePortRange: {"192.0.2.10/32", "lb-tag", "lb-tags"},
+				"udp/" + nodePortRange: {"192.0.2.10/32"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, server := newFakeAPI(tc.existingFirewalls, tc.loadBalancers...)
+			defer server.Close()
+
+			cluster, err := newTestProvider(server.URL, tc.seedAddresses...).InitializeCloudProvider(tc.cluster, testUpdater(tc.cluster))
+			if err != nil {
+				t.Fatalf("failed to initialize: %v", err)
+			}
+			if len(api.firewalls) != tc.expectedFirewalls {
+				t.Errorf("expected %d firewalls, got %d", tc.expectedFirewalls, len(api.firewalls))
+			}
+			if cluster.Spec.Cloud.Digitalocean.FirewallID != tc.expectedFirewall {
+				t.Errorf("expected firewall %q, got %q", tc.expectedFirewall, cluster.Spec.Cloud.Digitalocean.FirewallID)
+			}
+			if len(cluster.Finalizers) != len(tc.expectedFinalizers) {
+				t.Errorf("expected finalizers %v, got %v", tc.expectedFinalizers, cluster.Finalizers)
+			}
+			for _, finalizer := range tc.expectedFinalizers {
+				if !kuberneteshelper.HasFinalizer(cluster, finalizer) {
+					t.Errorf("expected finalizer %s, got %v", finalizer, cluster.Finalizers)
+				}
+			}
+
+			firewall := api.firewalls[tc.expectedFirewall]
+			if firewall.Name != "kubernetes-abcd" || len(firewall.Tags) != 1 || firewall.Tags[0] != "kubernetes-cluster-abcd" {
+				t.Errorf("expected the firewall to apply to the droplets of the cluster, got tags %v", firewall.Tags)
+			}
+			externalRules := map[string][]string{}
+			for _, rule := range firewall.InboundRules {
+				if len(rule.Sources.Tags) > 0 {
+					if len(rule.Sources.Addresses) > 0 || len(rule.Sources.LoadBalancerUIDs) > 0 {
+						t.Errorf("expected node to node rules to only allow the cluster, got %+v", rule)
+					}
+					continue
+				}
+				externalRules[rule.Protocol+"/"+rule.PortRange] = append(rule.Sources.Addresses, rule.Sources.LoadBalancerUIDs...)
+			}
+			if len(externalRules) != len(tc.expectedExternalRules) {
+				t.Errorf("expected external rules %v, got %v", tc.expectedExternalRules, externalRules)
+			}
+			for port, sources := range tc.expectedExternalRules {
+				if fmt.Sprint(externalRules[port]) != fmt.Sprint(sources) {
+					t.Errorf("expected %s to be reachable from %v, got %v", port, sources, externalRules[port])
+				}
+			}
+		})
+	}
+}
+
+func TestCleanUpCloudProvider(t *testing.T) {
+	testCases := []struct {
+		name              string
+		existingFirewalls []godo.Firewall
+		cluster           *kubermaticv1.Cluster
+		expectedFirewalls int
+	}{
+		{
+			name:              "firewall of the cluster is deleted",
+			existingFirewalls: []godo.Firewall{{ID: "fw", Name: "kubernetes-abcd"}, {ID: "other", Name: "other"}},
+			cluster:           genCluster("fw", firewallCleanupFinalizer),
+			expectedFirewalls: 1,
+		},
+		{
+			name:    "firewall which was deleted manually is ignored",
+			cluster: genCluster("fw", firewallCleanupFinalizer),
+		},
+		{
+			name:              "firewall without finalizer is kept",
+			existingFirewalls: []godo.Firewall{{ID: "fw", Name: "kubernetes-abcd"}},
+			cluster:           genCluster("fw"),
+			expectedFirewalls: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, server := newFakeAPI(tc.existingFirewalls)
+			defer server.Close()
+
+			cluster, err := newTestProvider(server.URL).CleanUpCloudProvider(tc.cluster, testUpdater(tc.cluster))
+			if err != nil {
+				t.Fatalf("failed to clean up: %v", err)
+			}
+			if len(cluster.Finalizers) != 0 {
+				t.Errorf("expected the cleanup finalizers to be removed, got %v", cluster.Finalizers)
+			}
+			if len(api.firewalls) != tc.expectedFirewalls {
+				t.Errorf("expected %d firewalls, got %d", tc.expectedFirewalls, len(api.firewalls))
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+// clusterFirewallRules is the number of firewall rules InitializeCloudProvider creates for a cluster
+const clusterFirewallRules = 2
+
+var _ provider.PreflightChecker = &gcp{}
+
+// PreflightCheckCluster checks the firewall quota of the project
+func (g *gcp) PreflightCheckCluster(spec kubermaticv1.CloudSpec) ([]provider.PreflightCheckResult, error) {
+	if spec.GCP == nil {
+		return nil, nil
+	}
+	svc, projectID, err := g.getPreflightComputeService(spec)
+	if err != nil {
+		return nil, err
+	}
+	project, err := svc.Projects.Get(projectID).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %v", projectID, err)
+	}
+
+	var results []provider.PreflightCheckResult
+	if result := checkQuota(project.Quotas, "FIREWALLS", "firewall rules", clusterFirewallRules); result != nil {
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// PreflightCheckNodes checks the CPU, instance and external IP address quotas of the region of the nodes
+func (g *gcp) PreflightCheckNodes(spec kubermaticv1.CloudSpec, nodeSpec apiv1.NodeCloudSpec, replicas int) ([]provider.PreflightCheckResult, error) {
+	if nodeSpec.GCP == nil || nodeSpec.GCP.Zone == "" {
+		return nil, nil
+	}
+	svc, projectID, err := g.getPreflightComputeService(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	machineType, err := svc.MachineTypes.Get(projectID, nodeSpec.GCP.Zone, nodeSpec.GCP.MachineType).Do()
+	if err != nil {
+		if isHTTPError(err, http.StatusNotFound) {
+			return []provider.PreflightCheckResult{{
+				Error:   true,
+				Message: fmt.Sprintf("machine type %q does not exist in zone %s", nodeSpec.GCP.MachineType, nodeSpec.GCP.Zone),
+			}}, nil
+		}
+		return nil, fmt.Errorf("failed to get machine type %q: %v", nodeSpec.GCP.MachineType, err)
+	}
+
+	region, err := svc.Regions.Get(projectID, zoneRegion(nodeSpec.GCP.Zone)).Do()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get region of zone %s: %v", nodeSpec.GCP.Zone, err)
+	}
+	return checkRegionQuotas(region.Quotas, machineType.GuestCpus, int64(replicas), nodeSpec.GCP.Preemptible), nil
+}
+
+func checkRegionQuotas(quotas []*compute.Quota, cpus, replicas int64, preemptible bool) []provider.PreflightCheckResult {
+	cpuQuota := "CPUS"
+	// Preemptible instances only have a quota of their own if it was granted, otherwise they count as regular CPUs
+	if preemptible {
+		if quota := findQuota(quotas, "PREEMPTIBLE_CPUS"); quota != nil && quota.Limit > 0 {
+			cpuQuota = "PREEMPTIBLE_CPUS"
+		}
+	}
+
+	var results []provider.PreflightCheckResult
+	checks := []*provider.PreflightCheckResult{
+		checkQuota(quotas, cpuQuota, "CPUs", cpus*replicas),
+		checkQuota(quotas, "INSTANCES", "instances", replicas),
+		// The nodes get an external IP address
+		checkQuota(quotas, "IN_USE_ADDRESSES", "in-use IP addresses", replicas),
+	}
+	for _, check := range checks {
+		if check != nil {
+			results = append(results, *check)
+		}
+	}
+	return results
+}
+
+// checkQuota checks the quota with the given metric, it returns nil if the quota does not exist
+func checkQuota(quotas []*compute.Quota, metric, name string, required int64) *provider.PreflightCheckResult {
+	quota := findQuota(quotas, metric)
+	if quota == nil {
+		return nil
+	}
+	return provider.CheckQuota(name, int64(quota.Limit), int64(quota.Usage), required)
+}
+
+func findQuota(quotas []*compute.Quota, metric string) *compute.Quota {
+	for _, quota := range quotas {
+		if quota.Metric == metric {
+			return quota
+		}
+	}
+	return nil
+}
+
+// zoneRegion returns the region of a zone, e.g. europe-west3 for europe-west3-c
+func zoneRegion(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
+
+func (g *gcp) getPreflightComputeService(spec kubermaticv1.CloudSpec) (*compute.Service, string, error) {
+	serviceAccount, err := GetCredentialsForCluster(spec, g.secretKeySelector)
+	if err != nil {
+		return nil, "", err
+	}
+	return ConnectToComputeService(serviceAccount)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"google.golang.org/api/compute/v1"
+
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+func TestCheckRegionQuotas(t *testing.T) {
+	tests := []struct {
+		name            string
+		quotas          []*compute.Quota
+		cpus            int64
+		replicas        int64
+		preemptible     bool
+		expectedResults []provider.PreflightCheckResult
+	}{
+		{
+			name: "nodes fit into the quotas",
+			quotas: []*compute.Quota{
+				{Metric: "CPUS", Limit: 24, Usage: 4},
+				{Metric: "INSTANCES", Limit: 100, Usage: 2},
+				{Metric: "IN_USE_ADDRESSES", Limit: 8, Usage: 2},
+			},
+			cpus:     2,
+			replicas: 3,
+		},
+		{
+			name: "CPU quota is exceeded",
+			quotas: []*compute.Quota{
+				{Metric: "CPUS", Limit: 24, Usage: 20},
+				{Metric: "IN_USE_ADDRESSES", Limit: 8, Usage: 5},
+			},
+			cpus:     2,
+			replicas: 3,
+			expectedResults: []provider.PreflightCheckResult{
+				{Error: true, Message: "the quota of CPUs is exceeded: 20 of 24 are in use and 6 more are required"},
+				{Message: "the quota of in-use IP addresses is almost exhausted: 8 of 8 are going to be in use"},
+			},
+		},
+		{
+			name: "preemptible nodes use their own quota",
+			quotas: []*compute.Quota{
+				{Metric: "CPUS", Limit: 24, Usage: 24},
+				{Metric: "PREEMPTIBLE_CPUS", Limit: 24, Usage: 0},
+			},
+			cpus:        2,
+			replicas:    3,
+			preemptible: true,
+		},
+		{
+			name: "preemptible nodes use the CPU quota if there is no preemptible quota",
+			quotas: []*compute.Quota{
+				{Metric: "CPUS", Limit: 24, Usage: 24},
+				{Metric: "PREEMPTIBLE_CPUS", Limit: 0, Usage: 0},
+			},
+			cpus:        2,
+			replicas:    3,
+			preemptible: true,
+			expectedResults: []provider.PreflightCheckResult{
+				{Error: true, Message: "the quota of CPUs is exceeded: 24 of 24 are in use and 6 more are required"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			results := checkRegionQuotas(test.quotas, test.cpus, test.replicas, test.preemptible)
+			if diff := deep.Equal(results, test.expectedResults); diff != nil {
+				t.Errorf("unexpected results: %v", diff)
+			}
+		})
+	}
+}
+
+func TestZoneRegion(t *testing.T) {
+	if region := zoneRegion("europe-west3-c"); region != "europe-west3" {
+		t.Errorf("expected region europe-west3, got %s", region)
+	}
+}
@@ -174,6 +174,21 @@ func (g *gcp) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update provide
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (g *gcp) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, firewallSelfCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Firewall", Name: fmt.Sprintf("firewall-%s-self", cluster.Name)})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, firewallICMPCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Firewall", Name: fmt.Sprintf("firewall-%s-icmp", cluster.Name)})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, routesCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Routes", Name: fmt.Sprintf("unused routes of network %s", cluster.Spec.Cloud.GCP.Network)})
+	}
+	return resources
+}
+
 // ConnectToComputeService establishes a service connection to the Compute Engine.
 func ConnectToComputeService(serviceAccount string) (*compute.Service, string, error) {
 	b, err := base64.StdEncoding.DecodeString(serviceAccount)
@@ -222,8 +237,9 @@ func (g *gcp) ensureFirewallRules(cluster *kubermaticv1.Cluster, update provider
 	// allow traffic within the same cluster
 	if !kuberneteshelper.HasFinalizer(cluster, firewallSelfCleanupFinalizer) {
 		_, err = firewallService.Insert(projectID, &compute.Firewall{
-			Name:    selfRuleName,
-			Network: cluster.Spec.Cloud.GCP.Network,
+			Name:        selfRuleName,
+			Description: ownerDescription(cluster),
+			Network:     cluster.Spec.Cloud.GCP.Network,
 			Allowed: []*compute.FirewallAllowed{
 				{
 					IPProtocol: "tcp",
@@ -266,8 +282,9 @@ func (g *gcp) ensureFirewallRules(cluster *kubermaticv1.Cluster, update provider
 	// allow ICMP from everywhere
 	if !kuberneteshelper.HasFinalizer(cluster, firewallICMPCleanupFinalizer) {
 		_, err = firewallService.Insert(projectID, &compute.Firewall{
-			Name:    icmpRuleName,
-			Network: cluster.Spec.Cloud.GCP.Network,
+			Name:        icmpRuleName,
+			Description: ownerDescription(cluster),
+			Network:     cluster.Spec.Cloud.GCP.Network,
 			Allowed: []*compute.FirewallAllowed{
 				{
 					IPProtocol: "icmp",
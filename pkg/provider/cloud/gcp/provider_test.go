@@ -22,6 +22,8 @@ import (
 	"google.golang.org/api/compute/v1"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestIsClusterRoute(t *testing.T) {
@@ -68,3 +70,57 @@ func TestIsClusterRoute(t *testing.T) {
 		})
 	}
 }
+
+func TestFirewallOwner(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "abcd",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "my-project"},
+		},
+	}
+	tests := []struct {
+		name              string
+		firewall          *compute.Firewall
+		expectedCluster   string
+		expectedProjectID string
+		expectedOwned     bool
+	}{
+		{
+			name:              "firewall rule created for the cluster",
+			firewall:          &compute.Firewall{Name: "firewall-abcd-self", Description: ownerDescription(cluster)},
+			expectedCluster:   "abcd",
+			expectedProjectID: "my-project",
+			expectedOwned:     true,
+		},
+		{
+			name:            "firewall rule created before the owner was stored",
+			firewall:        &compute.Firewall{Name: "firewall-abcd-icmp"},
+			expectedCluster: "abcd",
+		},
+		{
+			name:            "firewall rule of another cluster with the same name",
+			firewall:        &compute.Firewall{Name: "firewall-abcd-icmp", Description: "kubermatic.io/cluster/efgh=my-project"},
+			expectedCluster: "abcd",
+		},
+		{
+			name:     "firewall rule not created by kubermatic",
+			firewall: &compute.Firewall{Name: "firewall-abcd-ssh", Description: ownerDescription(cluster)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			clusterName := firewallClusterName(test.firewall.Name)
+			if clusterName != test.expectedCluster {
+				t.Fatalf("expected cluster %q, got %q", test.expectedCluster, clusterName)
+			}
+			if clusterName == "" {
+				return
+			}
+			projectID, owned := firewallOwner(test.firewall, clusterName)
+			if projectID != test.expectedProjectID || owned != test.expectedOwned {
+				t.Fatalf("expected owner %q (%t), got %q (%t)", test.expectedProjectID, test.expectedOwned, projectID, owned)
+			}
+		})
+	}
+}
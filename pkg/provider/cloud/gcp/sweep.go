@@ -0,0 +1,133 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const (
+	firewallKind = "Firewall"
+
+	// firewallOwnerPrefix marks the firewall rules created for the clusters. The description of these rules is
+	// kubermatic.io/cluster/<cluster>=<project>, only rules carrying it are removed as orphans.
+	firewallOwnerPrefix = "kubermatic.io/cluster/"
+)
+
+var _ provider.CloudResourceSweeper = &gcp{}
+
+// ListClusterResources returns the firewall rules created for clusters. The routes created by the cloud
+// provider of the user clusters are not returned as their names do not carry the name of the cluster, they
+// are removed by cleanUnusedRoutes while the cluster exists.
+func (g *gcp) ListClusterResources(spec kubermaticv1.CloudSpec) ([]provider.ClusterCloudResource, error) {
+	serviceAccount, err := GetCredentialsForCluster(spec, g.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	svc, projectID, err := ConnectToComputeService(serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []provider.ClusterCloudResource
+	ctx := context.Background()
+
+	err = svc.Firewalls.List(projectID).Filter(`name eq "firewall-.*-(self|icmp)"`).Pages(ctx, func(list *compute.FirewallList) error {
+		for _, firewall := range list.Items {
+			clusterName := firewallClusterName(firewall.Name)
+			if clusterName == "" {
+				continue
+			}
+			ownerProjectID, owned := firewallOwner(firewall, clusterName)
+			if !owned {
+				continue
+			}
+			resources = append(resources, provider.ClusterCloudResource{
+				CloudResource: provider.CloudResource{Kind: firewallKind, Name: firewall.Name},
+				ID:            firewall.Name,
+				ClusterName:   clusterName,
+				ProjectID:     ownerProjectID,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list firewall rules: %v", err)
+	}
+
+	return resources, nil
+}
+
+// DeleteClusterResource deletes a resource returned by ListClusterResources
+func (g *gcp) DeleteClusterResource(spec kubermaticv1.CloudSpec, resource provider.ClusterCloudResource) error {
+	serviceAccount, err := GetCredentialsForCluster(spec, g.secretKeySelector)
+	if err != nil {
+		return err
+	}
+	svc, projectID, err := ConnectToComputeService(serviceAccount)
+	if err != nil {
+		return err
+	}
+
+	switch resource.Kind {
+	case firewallKind:
+		_, err = svc.Firewalls.Delete(projectID, resource.ID).Do()
+	default:
+		return fmt.Errorf("unknown resource kind %q", resource.Kind)
+	}
+	// we ignore a Google API "not found" error
+	if err != nil && !isHTTPError(err, http.StatusNotFound) {
+		return err
+	}
+	return nil
+}
+
+// firewallClusterName returns the name of the cluster a firewall rule created by ensureFirewallRules belongs to
+func firewallClusterName(name string) string {
+	if !strings.HasPrefix(name, "firewall-") {
+		return ""
+	}
+	for _, suffix := range []string{"-self", "-icmp"} {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(strings.TrimPrefix(name, "firewall-"), suffix)
+		}
+	}
+	return ""
+}
+
+// ownerDescription returns the description of the firewall rules of the cluster
+func ownerDescription(cluster *kubermaticv1.Cluster) string {
+	return firewallOwnerPrefix + cluster.Name + "=" + cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+}
+
+// firewallOwner returns the project of the cluster the firewall rule was created for. Rules without owner were
+// not created for a cluster or were created before the owner was stored in their description.
+func firewallOwner(firewall *compute.Firewall, clusterName string) (projectID string, owned bool) {
+	prefix := firewallOwnerPrefix + clusterName + "="
+	if !strings.HasPrefix(firewall.Description, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(firewall.Description, prefix), true
+}
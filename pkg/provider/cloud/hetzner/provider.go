@@ -19,22 +19,58 @@ package hetzner
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/hetznercloud/hcloud-go/hcloud"
+
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
 )
 
+const (
+	networkCleanupFinalizer = "kubermatic.io/cleanup-hetzner-network"
+
+	// clusterLabelKey marks the resources created for a cluster
+	clusterLabelKey = "kubernetes-cluster"
+
+	defaultNetworkZone = hcloud.NetworkZoneEUCentral
+	// networkIPRange is the IP range of the networks created for the clusters, the nodes get their
+	// private IPs from the subnet. Both must not overlap with the pod and the service CIDRs, see ValidateClusterNetwork.
+	networkIPRange = "192.168.0.0/16"
+	subnetIPRange  = "192.168.0.0/17"
+)
+
 type hetzner struct {
+	dc                *kubermaticv1.DatacenterSpecHetzner
 	secretKeySelector provider.SecretKeySelectorValueFunc
+	// endpoint is the URL of the Hetzner Cloud API, it is a field to be able to replace it in tests
+	endpoint string
 }
 
 // NewCloudProvider creates a new hetzner provider.
-func NewCloudProvider(secretKeyGetter provider.SecretKeySelectorValueFunc) provider.CloudProvider {
+func NewCloudProvider(dc *kubermaticv1.Datacenter, secretKeyGetter provider.SecretKeySelectorValueFunc) (provider.CloudProvider, error) {
+	if dc.Spec.Hetzner == nil {
+		return nil, errors.New("datacenter is not a Hetzner datacenter")
+	}
 	return &hetzner{
+		dc:                dc.Spec.Hetzner,
 		secretKeySelector: secretKeyGetter,
+		endpoint:          hcloud.Endpoint,
+	}, nil
+}
+
+var _ provider.CloudResourceLister = &hetzner{}
+
+func (h *hetzner) getClient(spec kubermaticv1.CloudSpec) (*hcloud.Client, error) {
+	hetznerToken, err := GetCredentialsForCluster(spec, h.secretKeySelector)
+	if err != nil {
+		return nil, err
 	}
+	return hcloud.NewClient(hcloud.WithToken(hetznerToken), hcloud.WithEndpoint(h.endpoint)), nil
 }
 
 // DefaultCloudSpec
@@ -44,35 +80,178 @@ func (h *hetzner) DefaultCloudSpec(spec *kubermaticv1.CloudSpec) error {
 
 // ValidateCloudSpec
 func (h *hetzner) ValidateCloudSpec(spec kubermaticv1.CloudSpec) error {
-	hetznerToken, err := GetCredentialsForCluster(spec, h.secretKeySelector)
+	client, err := h.getClient(spec)
 	if err != nil {
 		return err
 	}
 
-	client := hcloud.NewClient(hcloud.WithToken(hetznerToken))
-	_, _, err = client.ServerType.List(context.Background(), hcloud.ServerTypeListOpts{})
-	return err
+	if _, _, err := client.ServerType.List(context.Background(), hcloud.ServerTypeListOpts{}); err != nil {
+		return err
+	}
+
+	if spec.Hetzner.Network != "" {
+		network, _, err := client.Network.Get(context.Background(), spec.Hetzner.Network)
+		if err != nil {
+			return fmt.Errorf("failed to get network %q: %v", spec.Hetzner.Network, err)
+		}
+		if network == nil {
+			return fmt.Errorf("network %q does not exist", spec.Hetzner.Network)
+		}
+	}
+	return nil
 }
 
-// InitializeCloudProvider
+// InitializeCloudProvider creates a private network for the cluster unless an existing network was specified.
+// No firewall is created, the firewall API is not available in the hcloud-go version used here.
 func (h *hetzner) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	if cluster.Spec.Cloud.Hetzner.Network != "" {
+		return cluster, nil
+	}
+	if err := ValidateClusterNetwork(cluster.Spec.ClusterNetwork); err != nil {
+		return nil, err
+	}
+
+	client, err := h.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	network, err := h.ensureNetwork(context.Background(), client, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+		cluster.Spec.Cloud.Hetzner.Network = strconv.Itoa(network.ID)
+		kuberneteshelper.AddFinalizer(cluster, networkCleanupFinalizer)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add network %d to the cluster: %v", network.ID, err)
+	}
 	return cluster, nil
 }
 
-// CleanUpCloudProvider
-func (h *hetzner) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, _ provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+// ensureNetwork returns the network of the cluster and creates it if it does not exist yet. The network is looked
+// up by its name first, in case it was created before but the cluster could not be updated afterwards.
+func (h *hetzner) ensureNetwork(ctx context.Context, client *hcloud.Client, cluster *kubermaticv1.Cluster) (*hcloud.Network, error) {
+	name := networkName(cluster)
+
+	network, _, err := client.Network.GetByName(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network %s: %v", name, err)
+	}
+	if network != nil {
+		if network.Labels[clusterLabelKey] != cluster.Name {
+			return nil, fmt.Errorf("network %s already exists but does not belong to the cluster", name)
+		}
+		return network, nil
+	}
+
+	_, ipRange, err := net.ParseCIDR(networkIPRange)
+	if err != nil {
+		return nil, err
+	}
+	_, subnetRange, err := net.ParseCIDR(subnetIPRange)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := defaultNetworkZone
+	if h.dc.NetworkZone != "" {
+		zone = hcloud.NetworkZone(h.dc.NetworkZone)
+	}
+
+	network, _, err = client.Network.Create(ctx, hcloud.NetworkCreateOpts{
+		Name:    name,
+		IPRange: ipRange,
+		Subnets: []hcloud.NetworkSubnet{{
+			Type:        hcloud.NetworkSubnetTypeServer,
+			IPRange:     subnetRange,
+			NetworkZone: zone,
+		}},
+		Labels: map[string]string{clusterLabelKey: cluster.Name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create network %s: %v", name, err)
+	}
+	return network, nil
+}
+
+// CleanUpCloudProvider deletes the network created for the cluster
+func (h *hetzner) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
+	if !kuberneteshelper.HasFinalizer(cluster, networkCleanupFinalizer) {
+		return cluster, nil
+	}
+
+	client, err := h.getClient(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	network, _, err := client.Network.Get(ctx, cluster.Spec.Cloud.Hetzner.Network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network %s: %v", cluster.Spec.Cloud.Hetzner.Network, err)
+	}
+	// The network might have been deleted manually
+	if network != nil {
+		if _, err := client.Network.Delete(ctx, network); err != nil && !hcloud.IsError(err, hcloud.ErrorCodeNotFound) {
+			return nil, fmt.Errorf("failed to delete network %s: %v", cluster.Spec.Cloud.Hetzner.Network, err)
+		}
+	}
+
+	cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+		kuberneteshelper.RemoveFinalizer(cluster, networkCleanupFinalizer)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove %s finalizer: %v", networkCleanupFinalizer, err)
+	}
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (h *hetzner) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, networkCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Network", Name: networkName(cluster)})
+	}
+	return resources
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted
 func (h *hetzner) ValidateCloudSpecUpdate(oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
+	if oldSpec.Hetzner != nil && newSpec.Hetzner != nil && oldSpec.Hetzner.Network != "" && oldSpec.Hetzner.Network != newSpec.Hetzner.Network {
+		return fmt.Errorf("updating Hetzner network is not supported (was %s, updated to %s)", oldSpec.Hetzner.Network, newSpec.Hetzner.Network)
+	}
 	return nil
 }
 
+// ValidateClusterNetwork checks that the pod and the service CIDRs of a cluster don't overlap with the IP range
+// of the network created for it
+func ValidateClusterNetwork(network kubermaticv1.ClusterNetworkingConfig) error {
+	_, ipRange, err := net.ParseCIDR(networkIPRange)
+	if err != nil {
+		return err
+	}
+	for _, cidr := range append(append([]string{}, network.Pods.CIDRBlocks...), network.Services.CIDRBlocks...) {
+		_, block, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return fmt.Errorf("invalid CIDR %q: %v", cidr, err)
+		}
+		if block.Contains(ipRange.IP) || ipRange.Contains(block.IP) {
+			return fmt.Errorf("the CIDR %s overlaps with the IP range %s of the Hetzner network created for the cluster, choose another CIDR or an existing network", cidr, networkIPRange)
+		}
+	}
+	return nil
+}
+
+func networkName(cluster *kubermaticv1.Cluster) string {
+	return "kubernetes-" + cluster.Name
+}
+
 // GetCredentialsForCluster returns the credentials for the passed in cloud spec or an error
 func GetCredentialsForCluster(cloud kubermaticv1.CloudSpec, secretKeySelector provider.SecretKeySelectorValueFunc) (hetznerToken string, err error) {
 	hetznerToken = cloud.Hetzner.Token
-
 	if hetznerToken == "" {
 		if cloud.Hetzner.CredentialsReference == nil {
 			return "", errors.New("no credentials provided")
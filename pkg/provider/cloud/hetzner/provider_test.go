@@ -0,0 +1,306 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hetzner
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hetznercloud/hcloud-go/hcloud/schema"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeAPI is a minimal Hetzner Cloud API which stores networks in memory
+type fakeAPI struct {
+	lock     sync.Mutex
+	nextID   int
+	networks map[int]schema.Network
+}
+
+func newFakeAPI(networks ...schema.Network) (*fakeAPI, *httptest.Server) {
+	api := &fakeAPI{nextID: 100, networks: map[int]schema.Network{}}
+	for _, network := range networks {
+		api.networks[network.ID] = network
+	}
+	return api, httptest.NewServer(api)
+}
+
+func (a *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	path := strings.Trim(r.URL.Path, "/")
+	switch {
+	case path == "networks" && r.Method == http.MethodGet:
+		networks := []schema.Network{}
+		for _, network := range a.networks {
+			if name := r.URL.Query().Get("name"); name == "" || name == network.Name {
+				networks = append(networks, network)
+			}
+		}
+		writeJSON(w, http.StatusOK, schema.NetworkListResponse{Networks: networks})
+
+	case path == "networks" && r.Method == http.MethodPost:
+		req := schema.NetworkCreateRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, nil)
+			return
+		}
+		network := schema.Network{ID: a.nextID, Name: req.Name, IPRange: req.IPRange}
+		if req.Labels != nil {
+			network.Labels = *req.Labels
+		}
+		for _, subnet := range req.Subnets {
+			network.Subnets = append(network.Subnets, schema.NetworkSubnet{Type: subnet.Type, IPRange: subnet.IPRange, NetworkZone: subnet.NetworkZone})
+		}
+		a.networks[network.ID] = network
+		a.nextID++
+		writeJSON(w, http.StatusCreated, schema.NetworkCreateResponse{Network: network})
+
+	case strings.HasPrefix(path, "networks/"):
+		id, err := strconv.Atoi(strings.TrimPrefix(path, "networks/"))
+		network, exists := a.networks[id]
+		if err != nil || !exists {
+			writeJSON(w, http.StatusNotFound, schema.ErrorResponse{Error: schema.Error{Code: "not_found", Message: "network not found"}})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, schema.NetworkGetResponse{Network: network})
+		case http.MethodDelete:
+			delete(a.networks, id)
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+	default:
+		writeJSON(w, http.StatusNotFound, schema.ErrorResponse{Error: schema.Error{Code: "not_found", Message: "unknown path"}})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func newTestProvider(endpoint string) *hetzner {
+	return &hetzner{
+		dc:       &kubermaticv1.DatacenterSpecHetzner{Datacenter: "fsn1-dc14", NetworkZone: "eu-central"},
+		endpoint: endpoint,
+	}
+}
+
+func genCluster(network string, finalizers ...string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abcd", Finalizers: finalizers},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				Hetzner: &kubermaticv1.HetznerCloudSpec{Token: "token", Network: network},
+			},
+		},
+	}
+}
+
+// testUpdater applies the modifications to the given cluster, as the cluster provider would do
+func testUpdater(cluster *kubermaticv1.Cluster) func(string, func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+	return func(_ string, modify func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+		modify(cluster)
+		return cluster, nil
+	}
+}
+
+func TestInitializeCloudProvider(t *testing.T) {
+	testCases := []struct {
+		name              string
+		existingNetworks  []schema.Network
+		cluster           *kubermaticv1.Cluster
+		expectedNetwork   string
+		expectedFinalizer bool
+		expectedNetworks  int
+		expectErr         bool
+	}{
+		{
+			name:              "network is created",
+			cluster:           genCluster(""),
+			expectedNetwork:   "100",
+			expectedFinalizer: true,
+			expectedNetworks:  1,
+		},
+		{
+			name:              "network created before is reused",
+			existingNetworks:  []schema.Network{{ID: 7, Name: "kubernetes-abcd", Labels: map[string]string{clusterLabelKey: "abcd"}}},
+			cluster:           genCluster(""),
+			expectedNetwork:   "7",
+			expectedFinalizer: true,
+			expectedNetworks:  1,
+		},
+		{
+			name:             "network with the same name of somebody else is not taken over",
+			existingNetworks: []schema.Network{{ID: 7, Name: "kubernetes-abcd"}},
+			cluster:          genCluster(""),
+			expectedNetworks: 1,
+			expectErr:        true,
+		},
+		{
+			name:             "existing network is used as is",
+			existingNetworks: []schema.Network{{ID: 7, Name: "my-network"}},
+			cluster:          genCluster("my-network"),
+			expectedNetwork:  "my-network",
+			expectedNetworks: 1,
+		},
+		{
+			name: "network is not created if it overlaps with the pod CIDR",
+			cluster: func() *kubermaticv1.Cluster {
+				cluster := genCluster("")
+				cluster.Spec.ClusterNetwork.Pods.CIDRBlocks = []string{"192.168.128.0/18"}
+				return cluster
+			}(),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, server := newFakeAPI(tc.existingNetworks...)
+			defer server.Close()
+
+			cluster, err := newTestProvider(server.URL).InitializeCloudProvider(tc.cluster, testUpdater(tc.cluster))
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("expected error: %v, got %v", tc.expectErr, err)
+			}
+			if len(api.networks) != tc.expectedNetworks {
+				t.Errorf("expected %d networks, got %d", tc.expectedNetworks, len(api.networks))
+			}
+			if tc.expectErr {
+				return
+			}
+
+			if cluster.Spec.Cloud.Hetzner.Network != tc.expectedNetwork {
+				t.Errorf("expected network %q, got %q", tc.expectedNetwork, cluster.Spec.Cloud.Hetzner.Network)
+			}
+			if finalizer := kuberneteshelper.HasFinalizer(cluster, networkCleanupFinalizer); finalizer != tc.expectedFinalizer {
+				t.Errorf("expected cleanup finalizer: %v, got %v", tc.expectedFinalizer, finalizer)
+			}
+			if id, err := strconv.Atoi(tc.expectedNetwork); err == nil && tc.existingNetworks == nil {
+				network := api.networks[id]
+				if network.Name != "kubernetes-abcd" || network.IPRange != networkIPRange || len(network.Subnets) != 1 ||
+					network.Subnets[0].IPRange != subnetIPRange || network.Subnets[0].NetworkZone != "eu-central" || network.Labels[clusterLabelKey] != "abcd" {
+					t.Errorf("unexpected network %+v", network)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateClusterNetwork(t *testing.T) {
+	testCases := []struct {
+		name      string
+		pods      []string
+		services  []string
+		expectErr bool
+	}{
+		{
+			name:     "default CIDRs",
+			pods:     []string{"172.25.0.0/16"},
+			services: []string{"10.240.16.0/20"},
+		},
+		{
+			name:      "pod CIDR within the network",
+			pods:      []string{"192.168.10.0/24"},
+			expectErr: true,
+		},
+		{
+			name:      "service CIDR containing the network",
+			services:  []string{"192.0.0.0/8"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid CIDR",
+			pods:      []string{"192.168.10.0"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			network := kubermaticv1.ClusterNetworkingConfig{
+				Pods:     kubermaticv1.NetworkRanges{CIDRBlocks: tc.pods},
+				Services: kubermaticv1.NetworkRanges{CIDRBlocks: tc.services},
+			}
+			if err := ValidateClusterNetwork(network); (err != nil) != tc.expectErr {
+				t.Errorf("expected error: %v, got %v", tc.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestCleanUpCloudProvider(t *testing.T) {
+	testCases := []struct {
+		name             string
+		existingNetworks []schema.Network
+		cluster          *kubermaticv1.Cluster
+		expectedNetworks int
+	}{
+		{
+			name:             "network of the cluster is deleted",
+			existingNetworks: []schema.Network{{ID: 7, Name: "kubernetes-abcd"}, {ID: 8, Name: "other"}},
+			cluster:          genCluster("7", networkCleanupFinalizer),
+			expectedNetworks: 1,
+		},
+		{
+			name:             "network which was deleted manually is ignored",
+			existingNetworks: []schema.Network{{ID: 8, Name: "other"}},
+			cluster:          genCluster("7", networkCleanupFinalizer),
+			expectedNetworks: 1,
+		},
+		{
+			name:             "network which was not created for the cluster is kept",
+			existingNetworks: []schema.Network{{ID: 7, Name: "my-network"}},
+			cluster:          genCluster("7"),
+			expectedNetworks: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			api, server := newFakeAPI(tc.existingNetworks...)
+			defer server.Close()
+
+			cluster, err := newTestProvider(server.URL).CleanUpCloudProvider(tc.cluster, testUpdater(tc.cluster))
+			if err != nil {
+				t.Fatalf("failed to clean up: %v", err)
+			}
+			if kuberneteshelper.HasFinalizer(cluster, networkCleanupFinalizer) {
+				t.Error("expected the cleanup finalizer to be removed")
+			}
+			if len(api.networks) != tc.expectedNetworks {
+				t.Errorf("expected %d networks, got %d", tc.expectedNetworks, len(api.networks))
+			}
+		})
+	}
+}
@@ -17,43 +17,99 @@ limitations under the License.
 package kubevirt
 
 import (
+	"context"
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"strings"
 
 	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
 
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	namespaceCleanupFinalizer = "kubermatic.io/cleanup-kubevirt-namespace"
+
+	resourceQuotaName            = "cluster-quota"
+	defaultDenyNetworkPolicyName = "default-deny-ingress"
+	allowNamespaceNetworkPolicy  = "allow-same-namespace"
+	allowExternalNetworkPolicy   = "allow-external"
 )
 
 type kubevirt struct {
+	dc                *v1.DatacenterSpecKubevirt
 	secretKeySelector provider.SecretKeySelectorValueFunc
+	// clientGetter creates the client for the KubeVirt infra cluster, it is a field to be able to replace it in tests
+	clientGetter func(kubeconfig string) (ctrlruntimeclient.Client, error)
 }
 
-func NewCloudProvider(secretKeyGetter provider.SecretKeySelectorValueFunc) provider.CloudProvider {
+func NewCloudProvider(dc *v1.Datacenter, secretKeyGetter provider.SecretKeySelectorValueFunc) (provider.CloudProvider, error) {
+	if dc.Spec.Kubevirt == nil {
+		return nil, errors.New("datacenter is not a KubeVirt datacenter")
+	}
 	return &kubevirt{
+		dc:                dc.Spec.Kubevirt,
 		secretKeySelector: secretKeyGetter,
-	}
+		clientGetter:      NewInfraClient,
+	}, nil
 }
 
-func (k *kubevirt) DefaultCloudSpec(spec *v1.CloudSpec) error {
-	return nil
-}
+var _ provider.CloudResourceLister = &kubevirt{}
 
-func (k *kubevirt) ValidateCloudSpec(spec v1.CloudSpec) error {
-	kubeconfig, err := GetCredentialsForCluster(spec, k.secretKeySelector)
+// NewInfraClient returns a client for the KubeVirt infra cluster the given kubeconfig points to.
+func NewInfraClient(kubeconfig string) (ctrlruntimeclient.Client, error) {
+	config, err := restConfig(kubeconfig)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return ctrlruntimeclient.New(config, ctrlruntimeclient.Options{})
+}
+
+func restConfig(kubeconfig string) (*rest.Config, error) {
+	return clientcmd.RESTConfigFromKubeConfig(decodeKubeconfig(kubeconfig))
+}
 
+func decodeKubeconfig(kubeconfig string) []byte {
 	config, err := base64.StdEncoding.DecodeString(kubeconfig)
 	if err != nil {
 		// if the decoding failed, the kubeconfig is sent already decoded without the need of decoding it,
 		// for example the value has been read from Vault during the ci tests, which is saved as json format.
 		config = []byte(kubeconfig)
 	}
+	return config
+}
 
+func (k *kubevirt) getClient(spec v1.CloudSpec) (ctrlruntimeclient.Client, error) {
+	kubeconfig, err := GetCredentialsForCluster(spec, k.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	return k.clientGetter(kubeconfig)
+}
+
+func (k *kubevirt) DefaultCloudSpec(spec *v1.CloudSpec) error {
+	return nil
+}
+
+func (k *kubevirt) ValidateCloudSpec(spec v1.CloudSpec) error {
+	kubeconfig, err := GetCredentialsForCluster(spec, k.secretKeySelector)
+	if err != nil {
+		return err
+	}
+
+	config := decodeKubeconfig(kubeconfig)
 	_, err = clientcmd.RESTConfigFromKubeConfig(config)
 	if err != nil {
 		return err
@@ -64,18 +120,214 @@ func (k *kubevirt) ValidateCloudSpec(spec v1.CloudSpec) error {
 	return nil
 }
 
-func (k *kubevirt) InitializeCloudProvider(c *v1.Cluster, p provider.ClusterUpdater) (*v1.Cluster, error) {
+// InitializeCloudProvider creates a namespace for the cluster in the KubeVirt infra cluster and isolates it with
+// NetworkPolicies and a ResourceQuota. The quota and the policies are reconciled on every call to pick up changes
+// of the datacenter.
+func (k *kubevirt) InitializeCloudProvider(c *v1.Cluster, update provider.ClusterUpdater) (*v1.Cluster, error) {
+	client, err := k.getClient(c.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+
+	namespace := c.Spec.Cloud.Kubevirt.Namespace
+	if namespace == "" {
+		namespace = namespaceName(c)
+		if err := ensureNamespace(ctx, client, namespace, c); err != nil {
+			return nil, err
+		}
+
+		c, err = update(c.Name, func(cluster *v1.Cluster) {
+			cluster.Spec.Cloud.Kubevirt.Namespace = namespace
+			kuberneteshelper.AddFinalizer(cluster, namespaceCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to add namespace %s to the cluster: %v", namespace, err)
+		}
+	}
+
+	if err := reconciling.ReconcileNetworkPolicies(ctx, networkPolicyCreators(k.dc.PodCIDRs), namespace, client); err != nil {
+		return nil, fmt.Errorf("failed to reconcile network policies in namespace %s: %v", namespace, err)
+	}
+	if len(k.dc.PodCIDRs) == 0 {
+		policy := &networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: allowExternalNetworkPolicy, Namespace: namespace}}
+		if err := client.Delete(ctx, policy); err != nil && !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete network policy %s in namespace %s: %v", allowExternalNetworkPolicy, namespace, err)
+		}
+	}
+
+	if len(k.dc.ResourceQuota) == 0 {
+		quota := &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: resourceQuotaName, Namespace: namespace}}
+		if err := client.Delete(ctx, quota); err != nil && !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete resource quota in namespace %s: %v", namespace, err)
+		}
+		return c, nil
+	}
+	if err := reconciling.ReconcileResourceQuotas(ctx, []reconciling.NamedResourceQuotaCreatorGetter{resourceQuotaCreator(k.dc.ResourceQuota)}, namespace, client); err != nil {
+		return nil, fmt.Errorf("failed to reconcile resource quota in namespace %s: %v", namespace, err)
+	}
+
 	return c, nil
 }
 
-func (k *kubevirt) CleanUpCloudProvider(c *v1.Cluster, p provider.ClusterUpdater) (*v1.Cluster, error) {
+// ensureNamespace creates the namespace of the cluster. An existing namespace is only used if it was
+// created for the cluster before but the cluster could not be updated afterwards.
+func ensureNamespace(ctx context.Context, client ctrlruntimeclient.Client, name string, c *v1.Cluster) error {
+	ns := &corev1.Namespace{}
+	err := client.Get(ctx, types.NamespacedName{Name: name}, ns)
+	if err == nil {
+		if ns.Labels[resources.ClusterLabelKey] != c.Name {
+			return fmt.Errorf("namespace %s already exists but does not belong to the cluster", name)
+		}
+		return nil
+	}
+	if !kerrors.IsNotFound(err) {
+		return fmt.Errorf("failed to get namespace %s: %v", name, err)
+	}
+
+	ns = &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{resources.ClusterLabelKey: c.Name},
+		},
+	}
+	if err := client.Create(ctx, ns); err != nil {
+		return fmt.Errorf("failed to create namespace %s: %v", name, err)
+	}
+	return nil
+}
+
+// networkPolicyCreators returns the policies which deny all ingress traffic into the namespace from pods
+// of other namespaces. Traffic between the virtual machines of the cluster is allowed. Traffic from outside the
+// infra cluster, like LoadBalancer traffic, is only allowed if the pod networks are known, as it can't be told
+// apart from traffic of other pods otherwise.
+func networkPolicyCreators(podCIDRs []string) []reconciling.NamedNetworkPolicyCreatorGetter {
+	creators := []reconciling.NamedNetworkPolicyCreatorGetter{
+		func() (string, reconciling.NetworkPolicyCreator) {
+			return defaultDenyNetworkPolicyName, func(np *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+				np.Spec = networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				}
+				return np, nil
+			}
+		},
+		func() (string, reconciling.NetworkPolicyCreator) {
+			return allowNamespaceNetworkPolicy, func(np *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+				np.Spec = networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						From: []networkingv1.NetworkPolicyPeer{{PodSelector: &metav1.LabelSelector{}}},
+					}},
+				}
+				return np, nil
+			}
+		},
+	}
+	if len(podCIDRs) == 0 {
+		return creators
+	}
+
+	return append(creators,
+		func() (string, reconciling.NetworkPolicyCreator) {
+			return allowExternalNetworkPolicy, func(np *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error) {
+				np.Spec = networkingv1.NetworkPolicySpec{
+					PodSelector: metav1.LabelSelector{},
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+					Ingress: []networkingv1.NetworkPolicyIngressRule{{
+						From: externalPeers(podCIDRs),
+					}},
+				}
+				return np, nil
+			}
+		},
+	)
+}
+
+// externalPeers returns the IPv4 and IPv6 address ranges without the given pod networks
+func externalPeers(podCIDRs []string) []networkingv1.NetworkPolicyPeer {
+	ipv4 := &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}
+	ipv6 := &networkingv1.IPBlock{CIDR: "::/0"}
+	for _, cidr := range podCIDRs {
+		if strings.Contains(cidr, ":") {
+			ipv6.Except = append(ipv6.Except, cidr)
+		} else {
+			ipv4.Except = append(ipv4.Except, cidr)
+		}
+	}
+	return []networkingv1.NetworkPolicyPeer{{IPBlock: ipv4}, {IPBlock: ipv6}}
+}
+
+func resourceQuotaCreator(hard corev1.ResourceList) reconciling.NamedResourceQuotaCreatorGetter {
+	return func() (string, reconciling.ResourceQuotaCreator) {
+		return resourceQuotaName, func(rq *corev1.ResourceQuota) (*corev1.ResourceQuota, error) {
+			rq.Spec.Hard = hard.DeepCopy()
+			return rq, nil
+		}
+	}
+}
+
+// CleanUpCloudProvider deletes the namespace of the cluster. Deleting the namespace removes all virtual machines,
+// DataVolumes and Services in it, the finalizer is only removed once the namespace is gone. Until then the cluster
+// is returned unchanged, so that the namespace is still listed by ListCloudResources and the cleanup gets requeued.
+func (k *kubevirt) CleanUpCloudProvider(c *v1.Cluster, update provider.ClusterUpdater) (*v1.Cluster, error) {
+	if !kuberneteshelper.HasFinalizer(c, namespaceCleanupFinalizer) {
+		return c, nil
+	}
+
+	client, err := k.getClient(c.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+
+	namespace := c.Spec.Cloud.Kubevirt.Namespace
+	ns := &corev1.Namespace{}
+	err = client.Get(ctx, types.NamespacedName{Name: namespace}, ns)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get namespace %s: %v", namespace, err)
+	}
+	if err == nil {
+		if ns.DeletionTimestamp == nil {
+			if err := client.Delete(ctx, ns); err != nil && !kerrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to delete namespace %s: %v", namespace, err)
+			}
+		}
+		return c, nil
+	}
+
+	c, err = update(c.Name, func(cluster *v1.Cluster) {
+		kuberneteshelper.RemoveFinalizer(cluster, namespaceCleanupFinalizer)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove %s finalizer: %v", namespaceCleanupFinalizer, err)
+	}
 	return c, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (k *kubevirt) ListCloudResources(c *v1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(c, namespaceCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Namespace", Name: c.Spec.Cloud.Kubevirt.Namespace})
+	}
+	return resources
+}
+
 func (k *kubevirt) ValidateCloudSpecUpdate(oldSpec v1.CloudSpec, newSpec v1.CloudSpec) error {
+	if oldSpec.Kubevirt != nil && newSpec.Kubevirt != nil && oldSpec.Kubevirt.Namespace != "" && oldSpec.Kubevirt.Namespace != newSpec.Kubevirt.Namespace {
+		return fmt.Errorf("updating KubeVirt namespace is not supported (was %s, updated to %s)", oldSpec.Kubevirt.Namespace, newSpec.Kubevirt.Namespace)
+	}
 	return nil
 }
 
+// namespaceName returns the name of the namespace of the cluster, it must not collide with the namespace
+// of the control plane in case the seed is used as KubeVirt infra cluster.
+func namespaceName(c *v1.Cluster) string {
+	return "kubevirt-cluster-" + c.Name
+}
+
 // GetCredentialsForCluster returns the credentials for the passed in cloud spec or an error
 func GetCredentialsForCluster(cloud v1.CloudSpec, secretKeySelector provider.SecretKeySelectorValueFunc) (kubeconfig string, err error) {
 	kubeconfig = cloud.Kubevirt.Kubeconfig
@@ -0,0 +1,233 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubevirt
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestProvider(client ctrlruntimeclient.Client, quota corev1.ResourceList) *kubevirt {
+	return &kubevirt{
+		dc: &v1.DatacenterSpecKubevirt{ResourceQuota: quota},
+		clientGetter: func(string) (ctrlruntimeclient.Client, error) {
+			return client, nil
+		},
+	}
+}
+
+func genCluster(namespace string, finalizers ...string) *v1.Cluster {
+	return &v1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abcd", Finalizers: finalizers},
+		Spec: v1.ClusterSpec{
+			Cloud: v1.CloudSpec{
+				Kubevirt: &v1.KubevirtCloudSpec{Kubeconfig: "kubeconfig", Namespace: namespace},
+			},
+		},
+	}
+}
+
+func genNamespace(name, cluster string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{resources.ClusterLabelKey: cluster}},
+	}
+}
+
+// testUpdater applies the modifications to the given cluster, as the cluster provider would do
+func testUpdater(cluster *v1.Cluster) func(string, func(*v1.Cluster)) (*v1.Cluster, error) {
+	return func(_ string, modify func(*v1.Cluster)) (*v1.Cluster, error) {
+		modify(cluster)
+		return cluster, nil
+	}
+}
+
+func TestInitializeCloudProvider(t *testing.T) {
+	quota := corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("8")}
+
+	testCases := []struct {
+		name              string
+		existingObjects   []runtime.Object
+		cluster           *v1.Cluster
+		quota             corev1.ResourceList
+		podCIDRs          []string
+		expectedNamespace string
+		expectedFinalizer bool
+		expectedQuota     bool
+		expectedPolicies  []string
+		expectErr         bool
+	}{
+		{
+			name:              "namespace is created",
+			cluster:           genCluster(""),
+			quota:             quota,
+			podCIDRs:          []string{"10.244.0.0/16"},
+			expectedNamespace: "kubevirt-cluster-abcd",
+			expectedFinalizer: true,
+			expectedQuota:     true,
+			expectedPolicies:  []string{allowExternalNetworkPolicy, allowNamespaceNetworkPolicy, defaultDenyNetworkPolicyName},
+		},
+		{
+			name:              "namespace created before is reused",
+			existingObjects:   []runtime.Object{genNamespace("kubevirt-cluster-abcd", "abcd")},
+			cluster:           genCluster(""),
+			expectedNamespace: "kubevirt-cluster-abcd",
+			expectedFinalizer: true,
+			expectedPolicies:  []string{allowNamespaceNetworkPolicy, defaultDenyNetworkPolicyName},
+		},
+		{
+			name:            "namespace with the same name of somebody else is not taken over",
+			existingObjects: []runtime.Object{genNamespace("kubevirt-cluster-abcd", "")},
+			cluster:         genCluster(""),
+			expectErr:       true,
+		},
+		{
+			name: "quota is removed when it is no longer configured",
+			existingObjects: []runtime.Object{
+				genNamespace("kubevirt-cluster-abcd", "abcd"),
+				&corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: resourceQuotaName, Namespace: "kubevirt-cluster-abcd"}},
+			},
+			cluster:           genCluster("kubevirt-cluster-abcd", namespaceCleanupFinalizer),
+			expectedNamespace: "kubevirt-cluster-abcd",
+			expectedFinalizer: true,
+			expectedPolicies:  []string{allowNamespaceNetworkPolicy, defaultDenyNetworkPolicyName},
+		},
+		{
+			name: "external traffic is denied when the pod networks are no longer configured",
+			existingObjects: []runtime.Object{
+				genNamespace("kubevirt-cluster-abcd", "abcd"),
+				&networkingv1.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{Name: allowExternalNetworkPolicy, Namespace: "kubevirt-cluster-abcd"}},
+			},
+			cluster:           genCluster("kubevirt-cluster-abcd", namespaceCleanupFinalizer),
+			expectedNamespace: "kubevirt-cluster-abcd",
+			expectedFinalizer: true,
+			expectedPolicies:  []string{allowNamespaceNetworkPolicy, defaultDenyNetworkPolicyName},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := fakectrlruntimeclient.NewFakeClient(tc.existingObjects...)
+
+			p := newTestProvider(client, tc.quota)
+			p.dc.PodCIDRs = tc.podCIDRs
+
+			cluster, err := p.InitializeCloudProvider(tc.cluster, testUpdater(tc.cluster))
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("expected error: %v, got %v", tc.expectErr, err)
+			}
+			if tc.expectErr {
+				return
+			}
+
+			if cluster.Spec.Cloud.Kubevirt.Namespace != tc.expectedNamespace {
+				t.Errorf("expected namespace %q, got %q", tc.expectedNamespace, cluster.Spec.Cloud.Kubevirt.Namespace)
+			}
+			if finalizer := kuberneteshelper.HasFinalizer(cluster, namespaceCleanupFinalizer); finalizer != tc.expectedFinalizer {
+				t.Errorf("expected cleanup finalizer: %v, got %v", tc.expectedFinalizer, finalizer)
+			}
+
+			ns := &corev1.Namespace{}
+			if err := client.Get(ctx, types.NamespacedName{Name: tc.expectedNamespace}, ns); err != nil {
+				t.Fatalf("failed to get namespace: %v", err)
+			}
+			if ns.Labels[resources.ClusterLabelKey] != "abcd" {
+				t.Errorf("expected namespace to be labeled with the cluster, got labels %v", ns.Labels)
+			}
+
+			policies := &networkingv1.NetworkPolicyList{}
+			if err := client.List(ctx, policies, ctrlruntimeclient.InNamespace(tc.expectedNamespace)); err != nil {
+				t.Fatalf("failed to list network policies: %v", err)
+			}
+			var policyNames []string
+			for _, policy := range policies.Items {
+				policyNames = append(policyNames, policy.Name)
+			}
+			sort.Strings(policyNames)
+			if !reflect.DeepEqual(policyNames, tc.expectedPolicies) {
+				t.Errorf("expected network policies %v, got %v", tc.expectedPolicies, policyNames)
+			}
+
+			quotas := &corev1.ResourceQuotaList{}
+			if err := client.List(ctx, quotas, ctrlruntimeclient.InNamespace(tc.expectedNamespace)); err != nil {
+				t.Fatalf("failed to list resource quotas: %v", err)
+			}
+			if (len(quotas.Items) == 1) != tc.expectedQuota {
+				t.Fatalf("expected resource quota: %v, got %d quotas", tc.expectedQuota, len(quotas.Items))
+			}
+			if tc.expectedQuota && !quotas.Items[0].Spec.Hard[corev1.ResourceRequestsCPU].Equal(quota[corev1.ResourceRequestsCPU]) {
+				t.Errorf("expected quota %v, got %v", quota, quotas.Items[0].Spec.Hard)
+			}
+		})
+	}
+}
+
+func TestExternalPeers(t *testing.T) {
+	peers := externalPeers([]string{"10.244.0.0/16", "fd00:10:244::/56"})
+	expected := []networkingv1.NetworkPolicyPeer{
+		{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0", Except: []string{"10.244.0.0/16"}}},
+		{IPBlock: &networkingv1.IPBlock{CIDR: "::/0", Except: []string{"fd00:10:244::/56"}}},
+	}
+	if !reflect.DeepEqual(peers, expected) {
+		t.Errorf("expected peers %v, got %v", expected, peers)
+	}
+}
+
+func TestCleanUpCloudProvider(t *testing.T) {
+	ctx := context.Background()
+	cluster := genCluster("kubevirt-cluster-abcd", namespaceCleanupFinalizer)
+	client := fakectrlruntimeclient.NewFakeClient(genNamespace("kubevirt-cluster-abcd", "abcd"))
+	p := newTestProvider(client, nil)
+
+	// The finalizer is kept until the namespace is gone
+	cluster, err := p.CleanUpCloudProvider(cluster, testUpdater(cluster))
+	if err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if !kuberneteshelper.HasFinalizer(cluster, namespaceCleanupFinalizer) {
+		t.Fatal("expected the cleanup finalizer to be kept")
+	}
+	if len(p.ListCloudResources(cluster)) == 0 {
+		t.Fatal("expected the namespace to be listed until it is gone")
+	}
+	if err := client.Get(ctx, types.NamespacedName{Name: "kubevirt-cluster-abcd"}, &corev1.Namespace{}); err == nil {
+		t.Fatal("expected the namespace to be deleted")
+	}
+
+	cluster, err = p.CleanUpCloudProvider(cluster, testUpdater(cluster))
+	if err != nil {
+		t.Fatalf("failed to clean up: %v", err)
+	}
+	if kuberneteshelper.HasFinalizer(cluster, namespaceCleanupFinalizer) {
+		t.Error("expected the cleanup finalizer to be removed")
+	}
+}
@@ -37,6 +37,8 @@ import (
 	osports "github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	ossubnets "github.com/gophercloud/gophercloud/openstack/networking/v2/subnets"
 	"github.com/gophercloud/gophercloud/pagination"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/provider"
 )
 
@@ -46,8 +48,28 @@ const (
 	subnetLastAddress  = "192.168.1.254"
 
 	resourceNamePrefix = "kubernetes-"
+
+	// ownerDescriptionPrefix marks the networks and routers created for the clusters. Their description is
+	// kubermatic.io/cluster/<cluster>=<project>, only networks and routers carrying it are removed as orphans.
+	ownerDescriptionPrefix = "kubermatic.io/cluster/"
 )
 
+// ownerDescription returns the description of the networks and routers created for the cluster
+func ownerDescription(cluster *kubermaticv1.Cluster) string {
+	return ownerDescriptionPrefix + cluster.Name + "=" + cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+}
+
+// descriptionOwner returns the project of the cluster a network or router was created for. Networks and
+// routers without owner were not created for a cluster or were created before the owner was stored in
+// their description.
+func descriptionOwner(description, clusterName string) (projectID string, owned bool) {
+	prefix := ownerDescriptionPrefix + clusterName + "="
+	if !strings.HasPrefix(description, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(description, prefix), true
+}
+
 func getSecurityGroups(netClient *gophercloud.ServiceClient, opts ossecuritygroups.ListOpts) ([]ossecuritygroups.SecGroup, error) {
 	page, err := ossecuritygroups.List(netClient, opts).AllPages()
 	if err != nil {
@@ -246,10 +268,11 @@ func createKubermaticSecurityGroup(netClient *gophercloud.ServiceClient, cluster
 	return secGroupName, nil
 }
 
-func createKubermaticNetwork(netClient *gophercloud.ServiceClient, clusterName string) (*osnetworks.Network, error) {
+func createKubermaticNetwork(netClient *gophercloud.ServiceClient, cluster *kubermaticv1.Cluster) (*osnetworks.Network, error) {
 	iTrue := true
 	res := osnetworks.Create(netClient, osnetworks.CreateOpts{
-		Name:         resourceNamePrefix + clusterName,
+		Name:         resourceNamePrefix + cluster.Name,
+		Description:  ownerDescription(cluster),
 		AdminStateUp: &iTrue,
 	})
 	if res.Err != nil {
@@ -310,7 +333,7 @@ func createKubermaticSubnet(netClient *gophercloud.ServiceClient, clusterName, n
 	return res.Extract()
 }
 
-func createKubermaticRouter(netClient *gophercloud.ServiceClient, clusterName, extNetworkName string) (*osrouters.Router, error) {
+func createKubermaticRouter(netClient *gophercloud.ServiceClient, cluster *kubermaticv1.Cluster, extNetworkName string) (*osrouters.Router, error) {
 	extNetwork, err := getNetworkByName(netClient, extNetworkName, true)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get external network %q: %v", extNetworkName, err)
@@ -322,7 +345,8 @@ func createKubermaticRouter(netClient *gophercloud.ServiceClient, clusterName, e
 	}
 
 	res := osrouters.Create(netClient, osrouters.CreateOpts{
-		Name:         resourceNamePrefix + clusterName,
+		Name:         resourceNamePrefix + cluster.Name,
+		Description:  ownerDescription(cluster),
 		AdminStateUp: &iTrue,
 		GatewayInfo:  &gwi,
 	})
@@ -0,0 +1,128 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	oslimits "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	osflavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+
+	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+var _ provider.PreflightChecker = &Provider{}
+
+// PreflightCheckCluster checks the security group quota if a security group is going to be created for the cluster
+func (os *Provider) PreflightCheckCluster(spec kubermaticv1.CloudSpec) ([]provider.PreflightCheckResult, error) {
+	if spec.Openstack == nil || spec.Openstack.SecurityGroups != "" {
+		return nil, nil
+	}
+	computeClient, err := os.getPreflightComputeClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := oslimits.Get(computeClient, nil).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limits: %v", err)
+	}
+
+	var results []provider.PreflightCheckResult
+	if result := provider.CheckQuota("security groups", int64(limits.Absolute.MaxSecurityGroups), int64(limits.Absolute.TotalSecurityGroupsUsed), 1); result != nil {
+		results = append(results, *result)
+	}
+	return results, nil
+}
+
+// PreflightCheckNodes checks the instance, core, RAM and floating IP quotas of the project
+func (os *Provider) PreflightCheckNodes(spec kubermaticv1.CloudSpec, nodeSpec apiv1.NodeCloudSpec, replicas int) ([]provider.PreflightCheckResult, error) {
+	if nodeSpec.Openstack == nil {
+		return nil, nil
+	}
+	computeClient, err := os.getPreflightComputeClient(spec)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := oslimits.Get(computeClient, nil).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get limits: %v", err)
+	}
+	flavor, err := getFlavor(computeClient, nodeSpec.Openstack.Flavor)
+	if err != nil {
+		return nil, err
+	}
+	if flavor == nil {
+		return []provider.PreflightCheckResult{{
+			Error:   true,
+			Message: fmt.Sprintf("flavor %q does not exist", nodeSpec.Openstack.Flavor),
+		}}, nil
+	}
+
+	useFloatingIP := nodeSpec.Openstack.UseFloatingIP || os.dc.EnforceFloatingIP
+	return checkNodeLimits(limits.Absolute, *flavor, replicas, useFloatingIP), nil
+}
+
+func checkNodeLimits(limits oslimits.Absolute, flavor osflavors.Flavor, replicas int, useFloatingIP bool) []provider.PreflightCheckResult {
+	var results []provider.PreflightCheckResult
+	checks := []*provider.PreflightCheckResult{
+		provider.CheckQuota("instances", int64(limits.MaxTotalInstances), int64(limits.TotalInstancesUsed), int64(replicas)),
+		provider.CheckQuota("cores", int64(limits.MaxTotalCores), int64(limits.TotalCoresUsed), int64(replicas*flavor.VCPUs)),
+		provider.CheckQuota("RAM in MB", int64(limits.MaxTotalRAMSize), int64(limits.TotalRAMUsed), int64(replicas*flavor.RAM)),
+	}
+	if useFloatingIP {
+		checks = append(checks, provider.CheckQuota("floating IPs", int64(limits.MaxTotalFloatingIps), int64(limits.TotalFloatingIpsUsed), int64(replicas)))
+	}
+	for _, check := range checks {
+		if check != nil {
+			results = append(results, *check)
+		}
+	}
+	return results
+}
+
+// getFlavor returns the flavor with the given name or ID, or nil if it does not exist
+func getFlavor(computeClient *gophercloud.ServiceClient, nameOrID string) (*osflavors.Flavor, error) {
+	allPages, err := osflavors.ListDetail(computeClient, osflavors.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flavors: %v", err)
+	}
+	flavors, err := osflavors.ExtractFlavors(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract flavors: %v", err)
+	}
+	for _, flavor := range flavors {
+		if flavor.Name == nameOrID || flavor.ID == nameOrID {
+			return &flavor, nil
+		}
+	}
+	return nil, nil
+}
+
+func (os *Provider) getPreflightComputeClient(spec kubermaticv1.CloudSpec) (*gophercloud.ServiceClient, error) {
+	creds, err := GetCredentialsForCluster(spec, os.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	computeClient, err := getComputeClient(creds, os.dc.AuthURL, os.dc.Region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a authenticated openstack client: %v", err)
+	}
+	return computeClient, nil
+}
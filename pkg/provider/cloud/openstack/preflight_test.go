@@ -0,0 +1,73 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	oslimits "github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/limits"
+	osflavors "github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+func TestCheckNodeLimits(t *testing.T) {
+	flavor := osflavors.Flavor{Name: "m1.medium", VCPUs: 2, RAM: 4096}
+	testCases := []struct {
+		name            string
+		limits          oslimits.Absolute
+		replicas        int
+		useFloatingIP   bool
+		expectedResults []provider.PreflightCheckResult
+	}{
+		{
+			name: "nodes fit into the limits",
+			limits: oslimits.Absolute{
+				MaxTotalInstances: 10, TotalInstancesUsed: 2,
+				MaxTotalCores: 40, TotalCoresUsed: 4,
+				MaxTotalRAMSize: -1, TotalRAMUsed: 8192,
+				MaxTotalFloatingIps: 1, TotalFloatingIpsUsed: 1,
+			},
+			replicas: 3,
+		},
+		{
+			name: "cores and floating IPs are exceeded",
+			limits: oslimits.Absolute{
+				MaxTotalInstances: 10, TotalInstancesUsed: 2,
+				MaxTotalCores: 8, TotalCoresUsed: 4,
+				MaxTotalRAMSize: -1, TotalRAMUsed: 8192,
+				MaxTotalFloatingIps: 4, TotalFloatingIpsUsed: 2,
+			},
+			replicas:      3,
+			useFloatingIP: true,
+			expectedResults: []provider.PreflightCheckResult{
+				{Error: true, Message: "the quota of cores is exceeded: 4 of 8 are in use and 6 more are required"},
+				{Error: true, Message: "the quota of floating IPs is exceeded: 2 of 4 are in use and 3 more are required"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			results := checkNodeLimits(tc.limits, flavor, tc.replicas, tc.useFloatingIP)
+			if diff := deep.Equal(results, tc.expectedResults); diff != nil {
+				t.Errorf("unexpected results: %v", diff)
+			}
+		})
+	}
+}
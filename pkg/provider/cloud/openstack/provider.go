@@ -87,7 +87,7 @@ func (os *Provider) ValidateCloudSpec(spec kubermaticv1.CloudSpec) error {
 		return err
 	}
 
-	netClient, err := getNetClient(creds.Username, creds.Password, creds.Domain, creds.Tenant, creds.TenantID, os.dc.AuthURL, os.dc.Region)
+	netClient, err := getNetClient(creds, os.dc.AuthURL, os.dc.Region)
 	if err != nil {
 		return fmt.Errorf("failed to create a authenticated openstack client: %v", err)
 	}
@@ -154,14 +154,15 @@ func validateExistingSubnetOverlap(networkID string, netClient *gophercloud.Serv
 }
 
 // InitializeCloudProvider initializes a cluster, in particular
-// creates security group and network configuration
+// creates security group and network configuration. No server group is created, the
+// machine-controller can't schedule the nodes into one.
 func (os *Provider) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	creds, err := GetCredentialsForCluster(cluster.Spec.Cloud, os.secretKeySelector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get credentials: %v", err)
 	}
 
-	netClient, err := getNetClient(creds.Username, creds.Password, creds.Domain, creds.Tenant, creds.TenantID, os.dc.AuthURL, os.dc.Region)
+	netClient, err := getNetClient(creds, os.dc.AuthURL, os.dc.Region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a authenticated openstack client: %v", err)
 	}
@@ -195,7 +196,7 @@ func (os *Provider) InitializeCloudProvider(cluster *kubermaticv1.Cluster, updat
 	}
 
 	if cluster.Spec.Cloud.Openstack.Network == "" {
-		network, err := createKubermaticNetwork(netClient, cluster.Name)
+		network, err := createKubermaticNetwork(netClient, cluster)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create the kubermatic network: %v", err)
 		}
@@ -237,7 +238,7 @@ func (os *Provider) InitializeCloudProvider(cluster *kubermaticv1.Cluster, updat
 
 		if routerID == "" {
 			// No Router exists -> Create a router
-			router, err := createKubermaticRouter(netClient, cluster.Name, cluster.Spec.Cloud.Openstack.FloatingIPPool)
+			router, err := createKubermaticRouter(netClient, cluster, cluster.Spec.Cloud.Openstack.FloatingIPPool)
 			if err != nil {
 				return nil, fmt.Errorf("failed to create the kubermatic router: %v", err)
 			}
@@ -285,7 +286,7 @@ func (os *Provider) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update p
 		return nil, err
 	}
 
-	netClient, err := getNetClient(creds.Username, creds.Password, creds.Domain, creds.Tenant, creds.TenantID, os.dc.AuthURL, os.dc.Region)
+	netClient, err := getNetClient(creds, os.dc.AuthURL, os.dc.Region)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a authenticated openstack client: %v", err)
 	}
@@ -377,9 +378,30 @@ func (os *Provider) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update p
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (os *Provider) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kubernetes.HasFinalizer(cluster, SecurityGroupCleanupFinalizer) {
+		for _, g := range strings.Split(cluster.Spec.Cloud.Openstack.SecurityGroups, ",") {
+			resources = append(resources, provider.CloudResource{Kind: "SecurityGroup", Name: strings.TrimSpace(g)})
+		}
+	}
+	oldNetwork := kubernetes.HasFinalizer(cluster, OldNetworkCleanupFinalizer)
+	if kubernetes.HasFinalizer(cluster, SubnetCleanupFinalizer) || oldNetwork {
+		resources = append(resources, provider.CloudResource{Kind: "Subnet", Name: cluster.Spec.Cloud.Openstack.SubnetID})
+	}
+	if kubernetes.HasFinalizer(cluster, NetworkCleanupFinalizer) || oldNetwork {
+		resources = append(resources, provider.CloudResource{Kind: "Network", Name: cluster.Spec.Cloud.Openstack.Network})
+	}
+	if kubernetes.HasFinalizer(cluster, RouterCleanupFinalizer) || oldNetwork {
+		resources = append(resources, provider.CloudResource{Kind: "Router", Name: cluster.Spec.Cloud.Openstack.RouterID})
+	}
+	return resources
+}
+
 // GetFlavors lists available flavors for the given CloudSpec.DatacenterName and OpenstackSpec.Region
-func GetFlavors(username, password, domain, tenant, tenantID, authURL, region string) ([]osflavors.Flavor, error) {
-	authClient, err := getAuthClient(username, password, domain, tenant, tenantID, authURL)
+func GetFlavors(creds resources.OpenstackCredentials, authURL, region string) ([]osflavors.Flavor, error) {
+	authClient, err := getAuthClient(creds, authURL)
 	if err != nil {
 		return nil, err
 	}
@@ -392,8 +414,8 @@ func GetFlavors(username, password, domain, tenant, tenantID, authURL, region st
 }
 
 // GetTenants lists all available tenents for the given CloudSpec.DatacenterName
-func GetTenants(username, password, domain, tenant, tenantID, authURL, region string) ([]osprojects.Project, error) {
-	authClient, err := getAuthClient(username, password, domain, tenant, tenantID, authURL)
+func GetTenants(creds resources.OpenstackCredentials, authURL, region string) ([]osprojects.Project, error) {
+	authClient, err := getAuthClient(creds, authURL)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get auth client: %v", err)
 	}
@@ -407,8 +429,8 @@ func GetTenants(username, password, domain, tenant, tenantID, authURL, region st
 }
 
 // GetNetworks lists all available networks for the given CloudSpec.DatacenterName
-func GetNetworks(username, password, domain, tenant, tenantID, authURL, region string) ([]NetworkWithExternalExt, error) {
-	authClient, err := getNetClient(username, password, domain, tenant, tenantID, authURL, region)
+func GetNetworks(creds resources.OpenstackCredentials, authURL, region string) ([]NetworkWithExternalExt, error) {
+	authClient, err := getNetClient(creds, authURL, region)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get auth client: %v", err)
 	}
@@ -422,8 +444,8 @@ func GetNetworks(username, password, domain, tenant, tenantID, authURL, region s
 }
 
 // GetSecurityGroups lists all available security groups for the given CloudSpec.DatacenterName
-func GetSecurityGroups(username, password, domain, tenant, tenantID, authURL, region string) ([]ossecuritygroups.SecGroup, error) {
-	netClient, err := getNetClient(username, password, domain, tenant, tenantID, authURL, region)
+func GetSecurityGroups(creds resources.OpenstackCredentials, authURL, region string) ([]ossecuritygroups.SecGroup, error) {
+	netClient, err := getNetClient(creds, authURL, region)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get auth client: %v", err)
 	}
@@ -440,8 +462,8 @@ func GetSecurityGroups(username, password, domain, tenant, tenantID, authURL, re
 }
 
 // GetAvailabilityZones lists availability zones for the given CloudSpec.DatacenterName and OpenstackSpec.Region
-func GetAvailabilityZones(username, password, domain, tenant, tenantID, authURL, region string) ([]osavailabilityzones.AvailabilityZone, error) {
-	computeClient, err := getComputeClient(username, password, domain, tenant, tenantID, authURL, region)
+func GetAvailabilityZones(creds resources.OpenstackCredentials, authURL, region string) ([]osavailabilityzones.AvailabilityZone, error) {
+	computeClient, err := getComputeClient(creds, authURL, region)
 	if err != nil {
 		return nil, err
 	}
@@ -453,14 +475,19 @@ func GetAvailabilityZones(username, password, domain, tenant, tenantID, authURL,
 	return availabilityZones, nil
 }
 
-func getAuthClient(username, password, domain, tenant, tenantID, authURL string) (*gophercloud.ProviderClient, error) {
+func getAuthClient(creds resources.OpenstackCredentials, authURL string) (*gophercloud.ProviderClient, error) {
 	opts := gophercloud.AuthOptions{
 		IdentityEndpoint: authURL,
-		Username:         username,
-		Password:         password,
-		DomainName:       domain,
-		TenantName:       tenant,
-		TenantID:         tenantID,
+	}
+	if creds.ApplicationCredentialID != "" {
+		opts.ApplicationCredentialID = creds.ApplicationCredentialID
+		opts.ApplicationCredentialSecret = creds.ApplicationCredentialSecret
+	} else {
+		opts.Username = creds.Username
+		opts.Password = creds.Password
+		opts.DomainName = creds.Domain
+		opts.TenantName = creds.Tenant
+		opts.TenantID = creds.TenantID
 	}
 
 	client, err := goopenstack.AuthenticatedClient(opts)
@@ -470,8 +497,8 @@ func getAuthClient(username, password, domain, tenant, tenantID, authURL string)
 	return client, nil
 }
 
-func getNetClient(username, password, domain, tenant, tenantID, authURL, region string) (*gophercloud.ServiceClient, error) {
-	authClient, err := getAuthClient(username, password, domain, tenant, tenantID, authURL)
+func getNetClient(creds resources.OpenstackCredentials, authURL, region string) (*gophercloud.ServiceClient, error) {
+	authClient, err := getAuthClient(creds, authURL)
 	if err != nil {
 		return nil, err
 	}
@@ -493,8 +520,8 @@ func getNetClient(username, password, domain, tenant, tenantID, authURL, region
 	return serviceClient, err
 }
 
-func getComputeClient(username, password, domain, tenant, tenantID, authURL, region string) (*gophercloud.ServiceClient, error) {
-	authClient, err := getAuthClient(username, password, domain, tenant, tenantID, authURL)
+func getComputeClient(creds resources.OpenstackCredentials, authURL, region string) (*gophercloud.ServiceClient, error) {
+	authClient, err := getAuthClient(creds, authURL)
 	if err != nil {
 		return nil, err
 	}
@@ -517,8 +544,8 @@ func getComputeClient(username, password, domain, tenant, tenantID, authURL, reg
 }
 
 // GetSubnets list all available subnet ids fot a given CloudSpec
-func GetSubnets(username, password, domain, tenant, tenantID, networkID, authURL, region string) ([]ossubnets.Subnet, error) {
-	serviceClient, err := getNetClient(username, password, domain, tenant, tenantID, authURL, region)
+func GetSubnets(creds resources.OpenstackCredentials, networkID, authURL, region string) ([]ossubnets.Subnet, error) {
+	serviceClient, err := getNetClient(creds, authURL, region)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't get auth client: %v", err)
 	}
@@ -542,7 +569,7 @@ func (os *Provider) AddICMPRulesIfRequired(cluster *kubermaticv1.Cluster) error
 		return err
 	}
 
-	netClient, err := getNetClient(creds.Username, creds.Password, creds.Domain, creds.Tenant, creds.TenantID, os.dc.AuthURL, os.dc.Region)
+	netClient, err := getNetClient(creds, os.dc.AuthURL, os.dc.Region)
 	if err != nil {
 		return fmt.Errorf("failed to create a authenticated openstack client: %v", err)
 	}
@@ -614,6 +641,8 @@ func (os *Provider) ValidateCloudSpecUpdate(oldSpec kubermaticv1.CloudSpec, newS
 
 // GetCredentialsForCluster returns the credentials for the passed in cloud spec or an error
 func GetCredentialsForCluster(cloud kubermaticv1.CloudSpec, secretKeySelector provider.SecretKeySelectorValueFunc) (resources.OpenstackCredentials, error) {
+	applicationCredentialID := cloud.Openstack.ApplicationCredentialID
+	applicationCredentialSecret := cloud.Openstack.ApplicationCredentialSecret
 	username := cloud.Openstack.Username
 	password := cloud.Openstack.Password
 	tenant := cloud.Openstack.Tenant
@@ -622,6 +651,27 @@ func GetCredentialsForCluster(cloud kubermaticv1.CloudSpec, secretKeySelector pr
 
 	var err error
 
+	// Application credentials are used instead of the username and password when they are configured.
+	// Credential secrets created before they were supported have no key for them.
+	if applicationCredentialID == "" && cloud.Openstack.CredentialsReference != nil && cloud.Openstack.CredentialsReference.Name != "" {
+		applicationCredentialID, _ = secretKeySelector(cloud.Openstack.CredentialsReference, resources.OpenstackApplicationCredentialID)
+	}
+	if applicationCredentialID != "" {
+		if applicationCredentialSecret == "" {
+			if cloud.Openstack.CredentialsReference == nil {
+				return resources.OpenstackCredentials{}, errors.New("no credentials provided")
+			}
+			applicationCredentialSecret, err = secretKeySelector(cloud.Openstack.CredentialsReference, resources.OpenstackApplicationCredentialSecret)
+			if err != nil {
+				return resources.OpenstackCredentials{}, err
+			}
+		}
+		return resources.OpenstackCredentials{
+			ApplicationCredentialID:     applicationCredentialID,
+			ApplicationCredentialSecret: applicationCredentialSecret,
+		}, nil
+	}
+
 	if username == "" {
 		if cloud.Openstack.CredentialsReference == nil {
 			return resources.OpenstackCredentials{}, errors.New("no credentials provided")
@@ -17,9 +17,17 @@ limitations under the License.
 package openstack
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/gophercloud/gophercloud"
+	providerconfig "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestIgnoreRouterAlreadyHasPortInSubnetError(t *testing.T) {
@@ -57,3 +65,111 @@ func TestIgnoreRouterAlreadyHasPortInSubnetError(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCredentialsForCluster(t *testing.T) {
+	credentialsReference := &providerconfig.GlobalSecretKeySelector{ObjectReference: corev1.ObjectReference{Name: "credentials", Namespace: "kubermatic"}}
+	secretKeySelector := func(secretData map[string]string) func(*providerconfig.GlobalSecretKeySelector, string) (string, error) {
+		return func(_ *providerconfig.GlobalSecretKeySelector, key string) (string, error) {
+			value, ok := secretData[key]
+			if !ok {
+				return "", errors.New("key not found")
+			}
+			return value, nil
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		spec          kubermaticv1.OpenstackCloudSpec
+		secretData    map[string]string
+		expectedCreds resources.OpenstackCredentials
+	}{
+		{
+			name:          "password from the spec",
+			spec:          kubermaticv1.OpenstackCloudSpec{Username: "user", Password: "pass", Domain: "domain", Tenant: "tenant"},
+			expectedCreds: resources.OpenstackCredentials{Username: "user", Password: "pass", Domain: "domain", Tenant: "tenant"},
+		},
+		{
+			name:          "application credential from the spec",
+			spec:          kubermaticv1.OpenstackCloudSpec{ApplicationCredentialID: "id", ApplicationCredentialSecret: "secret"},
+			expectedCreds: resources.OpenstackCredentials{ApplicationCredentialID: "id", ApplicationCredentialSecret: "secret"},
+		},
+		{
+			name: "application credential from the secret",
+			spec: kubermaticv1.OpenstackCloudSpec{CredentialsReference: credentialsReference},
+			secretData: map[string]string{
+				resources.OpenstackApplicationCredentialID:     "id",
+				resources.OpenstackApplicationCredentialSecret: "secret",
+			},
+			expectedCreds: resources.OpenstackCredentials{ApplicationCredentialID: "id", ApplicationCredentialSecret: "secret"},
+		},
+		{
+			name: "password from a secret without application credential",
+			spec: kubermaticv1.OpenstackCloudSpec{CredentialsReference: credentialsReference},
+			secretData: map[string]string{
+				resources.OpenstackUsername: "user",
+				resources.OpenstackPassword: "pass",
+				resources.OpenstackDomain:   "domain",
+				resources.OpenstackTenant:   "tenant",
+				resources.OpenstackTenantID: "",
+			},
+			expectedCreds: resources.OpenstackCredentials{Username: "user", Password: "pass", Domain: "domain", Tenant: "tenant"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := tc.spec
+			creds, err := GetCredentialsForCluster(kubermaticv1.CloudSpec{Openstack: &spec}, secretKeySelector(tc.secretData))
+			if err != nil {
+				t.Fatalf("failed to get credentials: %v", err)
+			}
+			if creds != tc.expectedCreds {
+				t.Errorf("expected credentials %+v, got %+v", tc.expectedCreds, creds)
+			}
+		})
+	}
+}
+
+func TestDescriptionOwner(t *testing.T) {
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "abcd",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "my-project"},
+		},
+	}
+	testCases := []struct {
+		name              string
+		description       string
+		expectedProjectID string
+		expectedOwned     bool
+	}{
+		{
+			name:              "Created for the cluster",
+			description:       ownerDescription(cluster),
+			expectedProjectID: "my-project",
+			expectedOwned:     true,
+		},
+		{
+			name:        "Created before the owner was stored",
+			description: "",
+		},
+		{
+			name:        "Created for another cluster",
+			description: "kubermatic.io/cluster/abcdef=my-project",
+		},
+		{
+			name:        "Not created by kubermatic",
+			description: "Network of the abcd cluster",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			projectID, owned := descriptionOwner(tc.description, cluster.Name)
+			if projectID != tc.expectedProjectID || owned != tc.expectedOwned {
+				t.Errorf("expected owner %q (%t), got %q (%t)", tc.expectedProjectID, tc.expectedOwned, projectID, owned)
+			}
+		})
+	}
+}
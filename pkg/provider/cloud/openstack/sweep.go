@@ -0,0 +1,156 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	osrouters "github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/routers"
+	osnetworks "github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	osports "github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const (
+	routerKind  = "Router"
+	networkKind = "Network"
+)
+
+var _ provider.CloudResourceSweeper = &Provider{}
+
+// ListClusterResources returns the routers and networks which were created for clusters. The routers are
+// returned first, as a network can only be deleted once it is not attached to a router anymore. Networks and
+// routers created before the owner was stored in their description are left alone.
+func (os *Provider) ListClusterResources(spec kubermaticv1.CloudSpec) ([]provider.ClusterCloudResource, error) {
+	netClient, err := os.getSweepNetClient(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []provider.ClusterCloudResource
+
+	routerPages, err := osrouters.List(netClient, osrouters.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list routers: %v", err)
+	}
+	routers, err := osrouters.ExtractRouters(routerPages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to extract routers: %v", err)
+	}
+	for _, router := range routers {
+		if !strings.HasPrefix(router.Name, resourceNamePrefix) {
+			continue
+		}
+		clusterName := strings.TrimPrefix(router.Name, resourceNamePrefix)
+		projectID, owned := descriptionOwner(router.Description, clusterName)
+		if !owned {
+			continue
+		}
+		resources = append(resources, provider.ClusterCloudResource{
+			CloudResource: provider.CloudResource{Kind: routerKind, Name: router.Name},
+			ID:            router.ID,
+			ClusterName:   clusterName,
+			ProjectID:     projectID,
+		})
+	}
+
+	networks, err := getAllNetworks(netClient, osnetworks.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list networks: %v", err)
+	}
+	for _, network := range networks {
+		if !strings.HasPrefix(network.Name, resourceNamePrefix) || network.External {
+			continue
+		}
+		clusterName := strings.TrimPrefix(network.Name, resourceNamePrefix)
+		projectID, owned := descriptionOwner(network.Description, clusterName)
+		if !owned {
+			continue
+		}
+		resources = append(resources, provider.ClusterCloudResource{
+			CloudResource: provider.CloudResource{Kind: networkKind, Name: network.Name},
+			ID:            network.ID,
+			ClusterName:   clusterName,
+			ProjectID:     projectID,
+		})
+	}
+
+	return resources, nil
+}
+
+// DeleteClusterResource deletes a resource returned by ListClusterResources. Routers get detached from
+// all subnets before they are deleted, the subnets of a network are deleted together with the network.
+func (os *Provider) DeleteClusterResource(spec kubermaticv1.CloudSpec, resource provider.ClusterCloudResource) error {
+	netClient, err := os.getSweepNetClient(spec)
+	if err != nil {
+		return err
+	}
+
+	switch resource.Kind {
+	case routerKind:
+		if err := detachRouterInterfaces(netClient, resource.ID); err != nil {
+			return err
+		}
+		err = deleteRouter(netClient, resource.ID)
+	case networkKind:
+		err = osnetworks.Delete(netClient, resource.ID).ExtractErr()
+	default:
+		return fmt.Errorf("unknown resource kind %q", resource.Kind)
+	}
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+	return nil
+}
+
+func (os *Provider) getSweepNetClient(spec kubermaticv1.CloudSpec) (*gophercloud.ServiceClient, error) {
+	creds, err := GetCredentialsForCluster(spec, os.secretKeySelector)
+	if err != nil {
+		return nil, err
+	}
+	netClient, err := getNetClient(creds, os.dc.AuthURL, os.dc.Region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a authenticated openstack client: %v", err)
+	}
+	return netClient, nil
+}
+
+func detachRouterInterfaces(netClient *gophercloud.ServiceClient, routerID string) error {
+	allPages, err := osports.List(netClient, osports.ListOpts{DeviceID: routerID}).AllPages()
+	if err != nil {
+		return fmt.Errorf("failed to list ports of router %s: %v", routerID, err)
+	}
+	ports, err := osports.ExtractPorts(allPages)
+	if err != nil {
+		return fmt.Errorf("failed to extract ports of router %s: %v", routerID, err)
+	}
+
+	for _, port := range ports {
+		if port.DeviceOwner != "network:router_interface" && port.DeviceOwner != "network:router_interface_distributed" {
+			continue
+		}
+		res := osrouters.RemoveInterface(netClient, routerID, osrouters.RemoveInterfaceOpts{PortID: port.ID})
+		if res.Err != nil && !isNotFoundErr(res.Err) {
+			return fmt.Errorf("failed to detach port %s from router %s: %v", port.ID, routerID, res.Err)
+		}
+	}
+	return nil
+}
@@ -18,9 +18,24 @@ package vsphere
 
 import (
 	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func isNotFound(err error) bool {
 	_, ok := err.(*find.NotFoundError)
 	return ok
 }
+
+// isManagedObjectNotFound returns true if the object referenced in a request does not exist
+func isManagedObjectNotFound(err error) bool {
+	if soap.IsSoapFault(err) {
+		_, ok := soap.ToSoapFault(err).VimFault().(types.ManagedObjectNotFound)
+		return ok
+	}
+	if soap.IsVimFault(err) {
+		_, ok := soap.ToVimFault(err).(*types.ManagedObjectNotFound)
+		return ok
+	}
+	return false
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/govmomi/pbm"
+	pbmtypes "github.com/vmware/govmomi/pbm/types"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+)
+
+// Datastore represents a vsphere datastore.
+type Datastore struct {
+	Name string
+	Path string
+}
+
+// DatastoreCluster represents a vsphere datastore cluster.
+type DatastoreCluster struct {
+	Name string
+	Path string
+}
+
+// ResourcePool represents a vsphere resource pool.
+type ResourcePool struct {
+	Name string
+	Path string
+}
+
+// StoragePolicy represents a vsphere storage policy.
+type StoragePolicy struct {
+	ID   string
+	Name string
+}
+
+// GetDatastores returns a slice of Datastores of the datacenter from the passed cloudspec.
+func GetDatastores(dc *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]Datastore, error) {
+	ctx := context.Background()
+
+	session, err := newSession(ctx, dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter session: %v", err)
+	}
+	defer session.Logout()
+
+	datastoreRefs, err := session.Finder.DatastoreList(ctx, "*")
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("couldn't retrieve datastore list: %v", err)
+	}
+
+	var datastores []Datastore
+	for _, datastoreRef := range datastoreRefs {
+		datastores = append(datastores, Datastore{Name: datastoreRef.Name(), Path: datastoreRef.InventoryPath})
+	}
+	return datastores, nil
+}
+
+// GetDatastoreClusters returns a slice of DatastoreClusters of the datacenter from the passed cloudspec.
+func GetDatastoreClusters(dc *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]DatastoreCluster, error) {
+	ctx := context.Background()
+
+	session, err := newSession(ctx, dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter session: %v", err)
+	}
+	defer session.Logout()
+
+	datastoreClusterRefs, err := session.Finder.DatastoreClusterList(ctx, "*")
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("couldn't retrieve datastore cluster list: %v", err)
+	}
+
+	var datastoreClusters []DatastoreCluster
+	for _, datastoreClusterRef := range datastoreClusterRefs {
+		datastoreClusters = append(datastoreClusters, DatastoreCluster{Name: datastoreClusterRef.Name(), Path: datastoreClusterRef.InventoryPath})
+	}
+	return datastoreClusters, nil
+}
+
+// GetResourcePools returns a slice of ResourcePools of the datacenter from the passed cloudspec.
+func GetResourcePools(dc *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]ResourcePool, error) {
+	ctx := context.Background()
+
+	session, err := newSession(ctx, dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter session: %v", err)
+	}
+	defer session.Logout()
+
+	// Like folders, resource pools are only listed recursively when just specifying "*".
+	resourcePoolRefs, err := session.Finder.ResourcePoolList(ctx, "*")
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("couldn't retrieve resource pool list: %v", err)
+	}
+
+	var resourcePools []ResourcePool
+	for _, resourcePoolRef := range resourcePoolRefs {
+		resourcePools = append(resourcePools, ResourcePool{Name: resourcePoolRef.Name(), Path: resourcePoolRef.InventoryPath})
+	}
+	return resourcePools, nil
+}
+
+// GetStoragePolicies returns a slice of the storage policies which can be used for virtual machine disks.
+func GetStoragePolicies(dc *kubermaticv1.DatacenterSpecVSphere, username, password string) ([]StoragePolicy, error) {
+	ctx := context.Background()
+
+	session, err := newSession(ctx, dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter session: %v", err)
+	}
+	defer session.Logout()
+
+	pbmClient, err := pbm.NewClient(ctx, session.Client.Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create storage policy client: %v", err)
+	}
+
+	profileIDs, err := pbmClient.QueryProfile(ctx, pbmtypes.PbmProfileResourceType{
+		ResourceType: string(pbmtypes.PbmProfileResourceTypeEnumSTORAGE),
+	}, string(pbmtypes.PbmProfileCategoryEnumREQUIREMENT))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query storage policies: %v", err)
+	}
+	if len(profileIDs) == 0 {
+		return nil, nil
+	}
+
+	profiles, err := pbmClient.RetrieveContent(ctx, profileIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve storage policies: %v", err)
+	}
+
+	var storagePolicies []StoragePolicy
+	for _, profile := range profiles {
+		p := profile.GetPbmProfile()
+		storagePolicies = append(storagePolicies, StoragePolicy{ID: p.ProfileId.UniqueId, Name: p.Name})
+	}
+	return storagePolicies, nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vsphere
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+)
+
+func TestGetInventory(t *testing.T) {
+	sim := vSphereSimulator{t: t}
+	sim.setUp()
+	defer sim.tearDown()
+	dc := &kubermaticv1.DatacenterSpecVSphere{}
+	sim.fillClientInfo(dc)
+
+	t.Run("datastores", func(t *testing.T) {
+		datastores, err := GetDatastores(dc, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []Datastore{{Name: "LocalDS_0", Path: "/DC0/datastore/LocalDS_0"}}
+		if diff := deep.Equal(expected, datastores); diff != nil {
+			t.Errorf("Got datastores differ from expected ones. Diff: %v", diff)
+		}
+	})
+
+	t.Run("datastore clusters", func(t *testing.T) {
+		datastoreClusters, err := GetDatastoreClusters(dc, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []DatastoreCluster{{Name: "DC0_POD0", Path: "/DC0/datastore/DC0_POD0"}}
+		if diff := deep.Equal(expected, datastoreClusters); diff != nil {
+			t.Errorf("Got datastore clusters differ from expected ones. Diff: %v", diff)
+		}
+	})
+
+	t.Run("resource pools", func(t *testing.T) {
+		resourcePools, err := GetResourcePools(dc, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := []ResourcePool{
+			{Name: "Resources", Path: "/DC0/host/DC0_H0/Resources"},
+			{Name: "Resources", Path: "/DC0/host/DC0_C0/Resources"},
+			{Name: "Resources", Path: "/DC0/host/DC0_C1/Resources"},
+		}
+		if diff := deep.Equal(expected, resourcePools); diff != nil {
+			t.Errorf("Got resource pools differ from expected ones. Diff: %v", diff)
+		}
+	})
+
+	t.Run("storage policies", func(t *testing.T) {
+		storagePolicies, err := GetStoragePolicies(dc, "", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, storagePolicy := range storagePolicies {
+			if storagePolicy.ID == "" {
+				t.Errorf("storage policy %q has no ID", storagePolicy.Name)
+			}
+			names = append(names, storagePolicy.Name)
+		}
+		expected := []string{"vSAN Default Storage Policy", "VVol No Requirements Policy", "VM Encryption Policy", "Host-local PMem Default Storage Policy"}
+		if diff := deep.Equal(expected, names); diff != nil {
+			t.Errorf("Got storage policies differ from expected ones. Diff: %v", diff)
+		}
+	})
+}
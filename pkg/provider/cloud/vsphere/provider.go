@@ -27,6 +27,7 @@ import (
 	"github.com/vmware/govmomi"
 	"github.com/vmware/govmomi/find"
 	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/rest"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
@@ -37,6 +38,7 @@ import (
 
 const (
 	folderCleanupFinalizer = "kubermatic.io/cleanup-vsphere-folder"
+	tagCleanupFinalizer    = "kubermatic.io/cleanup-vsphere-tag"
 )
 
 // Provider represents the vsphere provider.
@@ -112,6 +114,46 @@ func newSession(ctx context.Context, dc *kubermaticv1.DatacenterSpecVSphere, use
 	}, nil
 }
 
+// RESTSession contains a vSphere API REST client, which is needed for the
+// tagging API.
+type RESTSession struct {
+	Client *rest.Client
+}
+
+// Logout closes the idling vCenter connections
+func (s *RESTSession) Logout() {
+	if err := s.Client.Logout(context.Background()); err != nil {
+		kruntime.HandleError(fmt.Errorf("vSphere REST client failed to logout: %s", err))
+	}
+}
+
+func newRESTSession(ctx context.Context, dc *kubermaticv1.DatacenterSpecVSphere, username, password string) (*RESTSession, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/sdk", dc.Endpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	// The REST client uses the same vim25 client the SOAP API uses,
+	// the login however is a separate one.
+	vimClient, err := govmomi.NewClient(ctx, u, dc.AllowInsecure)
+	if err != nil {
+		return nil, err
+	}
+	client := rest.NewClient(vimClient.Client)
+
+	user := url.UserPassword(username, password)
+	if dc.InfraManagementUser != nil {
+		user = url.UserPassword(dc.InfraManagementUser.Username, dc.InfraManagementUser.Password)
+	}
+	if err = client.Login(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to login: %v", err)
+	}
+
+	return &RESTSession{
+		Client: client,
+	}, nil
+}
+
 // getVMRootPath is a helper func to get the root path for VM's
 // We extracted it because we use it in several places
 func getVMRootPath(dc *kubermaticv1.DatacenterSpecVSphere) string {
@@ -124,7 +166,8 @@ func getVMRootPath(dc *kubermaticv1.DatacenterSpecVSphere) string {
 	return rootPath
 }
 
-// InitializeCloudProvider initializes the vsphere cloud provider by setting up vm folders for the cluster.
+// InitializeCloudProvider initializes the vsphere cloud provider by setting up vm folders and the tag for the cluster.
+// The virtual machines in the folder of the cluster get tagged on every call.
 func (v *Provider) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	ctx := context.Background()
 
@@ -156,6 +199,59 @@ func (v *Provider) InitializeCloudProvider(cluster *kubermaticv1.Cluster, update
 		}
 	}
 
+	// Clusters created before the tag category was introduced only get
+	// it defaulted here, as DefaultCloudSpec is only called on creation.
+	if cluster.Spec.Cloud.VSphere.TagCategory == "" && v.dc.DefaultTagCategory != "" {
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			cluster.Spec.Cloud.VSphere.TagCategory = v.dc.DefaultTagCategory
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	categoryName := cluster.Spec.Cloud.VSphere.TagCategory
+	if categoryName == "" {
+		return cluster, nil
+	}
+
+	restSession, err := newRESTSession(ctx, v.dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter REST session: %v", err)
+	}
+	defer restSession.Logout()
+
+	tag, err := ensureClusterTag(ctx, restSession, categoryName, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tag the folder and its virtual machines if we created it, a folder
+	// specified by the user may be shared with other clusters.
+	if kuberneteshelper.HasFinalizer(cluster, folderCleanupFinalizer) {
+		if !kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+			folder, err := session.Finder.Folder(ctx, cluster.Spec.Cloud.VSphere.Folder)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the VM folder %q: %v", cluster.Spec.Cloud.VSphere.Folder, err)
+			}
+			if err := attachClusterTag(ctx, restSession, tag, folder.Reference()); err != nil {
+				return nil, err
+			}
+		}
+		if err := tagClusterVMs(ctx, session, restSession, tag, cluster.Spec.Cloud.VSphere.Folder); err != nil {
+			return nil, err
+		}
+	}
+
+	if !kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.AddFinalizer(cluster, tagCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return cluster, nil
 }
 
@@ -209,6 +305,9 @@ func GetVMFolders(dc *kubermaticv1.DatacenterSpecVSphere, username, password str
 
 // DefaultCloudSpec adds defaults to the cloud spec
 func (v *Provider) DefaultCloudSpec(cloud *kubermaticv1.CloudSpec) error {
+	if cloud.VSphere.TagCategory == "" {
+		cloud.VSphere.TagCategory = v.dc.DefaultTagCategory
+	}
 	return nil
 }
 
@@ -251,12 +350,18 @@ func (v *Provider) ValidateCloudSpec(spec kubermaticv1.CloudSpec) error {
 			return fmt.Errorf("failed to get datastore cluster provided by cluste spec %q: %v", ds, err)
 		}
 	}
+	if rp := spec.VSphere.ResourcePool; rp != "" {
+		if _, err := session.Finder.ResourcePool(ctx, rp); err != nil {
+			return fmt.Errorf("failed to get resource pool provided by cluster spec %q: %v", rp, err)
+		}
+	}
 	return nil
 }
 
 // CleanUpCloudProvider we always check if the folder is there and remove it if yes because we know its absolute path
 // This covers cases where the finalizer was not added
 // We also remove the finalizer if either the folder is not present or we successfully deleted it
+// Virtual machines still carrying the tag of the cluster are orphaned and get deleted together with the tag
 func (v *Provider) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update provider.ClusterUpdater) (*kubermaticv1.Cluster, error) {
 	ctx := context.TODO()
 	username, password, err := GetCredentialsForCluster(cluster.Spec.Cloud, v.secretKeySelector, v.dc)
@@ -270,6 +375,24 @@ func (v *Provider) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update pr
 	}
 	defer session.Logout()
 
+	if kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+		restSession, err := newRESTSession(ctx, v.dc, username, password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create vCenter REST session: %v", err)
+		}
+		defer restSession.Logout()
+
+		if err := deleteClusterTag(ctx, session, restSession, cluster.Spec.Cloud.VSphere.TagCategory, cluster.Name); err != nil {
+			return nil, err
+		}
+		cluster, err = update(cluster.Name, func(cluster *kubermaticv1.Cluster) {
+			kuberneteshelper.RemoveFinalizer(cluster, tagCleanupFinalizer)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if kuberneteshelper.HasFinalizer(cluster, folderCleanupFinalizer) {
 		if err := deleteVMFolder(ctx, session, cluster.Spec.Cloud.VSphere.Folder); err != nil {
 			return nil, err
@@ -285,8 +408,24 @@ func (v *Provider) CleanUpCloudProvider(cluster *kubermaticv1.Cluster, update pr
 	return cluster, nil
 }
 
+// ListCloudResources returns the resources that get removed by CleanUpCloudProvider
+func (v *Provider) ListCloudResources(cluster *kubermaticv1.Cluster) []provider.CloudResource {
+	var resources []provider.CloudResource
+	if kuberneteshelper.HasFinalizer(cluster, folderCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Folder", Name: cluster.Spec.Cloud.VSphere.Folder})
+	}
+	if kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+		resources = append(resources, provider.CloudResource{Kind: "Tag", Name: path.Join(cluster.Spec.Cloud.VSphere.TagCategory, cluster.Name)})
+	}
+	return resources
+}
+
 // ValidateCloudSpecUpdate verifies whether an update of cloud spec is valid and permitted
 func (v *Provider) ValidateCloudSpecUpdate(oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error {
+	// The tag of the cluster would get orphaned otherwise.
+	if oldSpec.VSphere.TagCategory != "" && oldSpec.VSphere.TagCategory != newSpec.VSphere.TagCategory {
+		return fmt.Errorf("updating vSphere tag category is not supported (was %s, updated to %s)", oldSpec.VSphere.TagCategory, newSpec.VSphere.TagCategory)
+	}
 	return nil
 }
 
@@ -25,7 +25,9 @@ import (
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/resources"
 
+	_ "github.com/vmware/govmomi/pbm/simulator"
 	"github.com/vmware/govmomi/simulator"
+	_ "github.com/vmware/govmomi/vapi/simulator"
 )
 
 func TestGetCredentialsForCluster(t *testing.T) {
@@ -275,6 +277,29 @@ func TestProviderValidateCloudSpec(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Resource pool at cluster level",
+			dc: &kubermaticv1.DatacenterSpecVSphere{
+				DefaultDatastore: "LocalDS_0",
+			},
+			spec: kubermaticv1.CloudSpec{
+				VSphere: &kubermaticv1.VSphereCloudSpec{
+					ResourcePool: "/DC0/host/DC0_C0/Resources",
+				},
+			},
+		},
+		{
+			name: "Non existing resource pool at cluster level",
+			dc: &kubermaticv1.DatacenterSpecVSphere{
+				DefaultDatastore: "LocalDS_0",
+			},
+			spec: kubermaticv1.CloudSpec{
+				VSphere: &kubermaticv1.VSphereCloudSpec{
+					ResourcePool: "i-do-not-exist",
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -295,6 +320,7 @@ func TestProviderValidateCloudSpec(t *testing.T) {
 // The following resources are made available:
 // * Datastore named: LocalDS_0
 // * Datastore cluster named: DC0_POD0
+// * Resource pool of the cluster: /DC0/host/DC0_C0/Resources
 type vSphereSimulator struct {
 	t      *testing.T
 	model  *simulator.Model
@@ -312,6 +338,8 @@ func (v *vSphereSimulator) setUp() {
 		v.t.Fatal(err)
 	}
 
+	// Serves the vAPI and storage policy endpoints as well.
+	v.model.Service.RegisterEndpoints = true
 	v.server = v.model.Service.NewServer()
 }
 
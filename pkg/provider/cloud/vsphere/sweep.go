@@ -0,0 +1,168 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25/types"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+const tagKind = "Tag"
+
+var _ provider.CloudResourceSweeper = &Provider{}
+
+// ListClusterResources returns the tags created for clusters in the default tag category of the datacenter
+// and the virtual machines and folders carrying them. Only tags with an owner are returned, tags of other
+// tools and of clusters created before the owner was stored in the tags are left alone.
+func (v *Provider) ListClusterResources(spec kubermaticv1.CloudSpec) ([]provider.ClusterCloudResource, error) {
+	if v.dc.DefaultTagCategory == "" {
+		return nil, nil
+	}
+
+	ctx := context.Background()
+	username, password, err := GetCredentialsForCluster(spec, v.secretKeySelector, v.dc)
+	if err != nil {
+		return nil, err
+	}
+	session, err := newSession(ctx, v.dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter session: %v", err)
+	}
+	defer session.Logout()
+	restSession, err := newRESTSession(ctx, v.dc, username, password)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create vCenter REST session: %v", err)
+	}
+	defer restSession.Logout()
+
+	tagManager := tags.NewManager(restSession.Client)
+	category, err := getTagCategory(ctx, tagManager, v.dc.DefaultTagCategory)
+	if err != nil || category == nil {
+		return nil, err
+	}
+	categoryTags, err := tagManager.GetTagsForCategory(ctx, category.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags of category %q: %v", category.Name, err)
+	}
+
+	var resources []provider.ClusterCloudResource
+	for i := range categoryTags {
+		tag := &categoryTags[i]
+		projectID, owned := tagOwner(tag)
+		if !owned {
+			continue
+		}
+
+		for _, kind := range []string{virtualMachineType, folderType} {
+			refs, err := getTaggedObjects(ctx, tagManager, tag, kind)
+			if err != nil {
+				return nil, err
+			}
+			for _, ref := range refs {
+				name, err := object.NewCommon(session.Client.Client, ref).ObjectName(ctx)
+				if err != nil {
+					if isManagedObjectNotFound(err) {
+						continue
+					}
+					return nil, fmt.Errorf("failed to get name of %s: %v", ref.String(), err)
+				}
+				resources = append(resources, provider.ClusterCloudResource{
+					CloudResource: provider.CloudResource{Kind: kind, Name: name},
+					ID:            ref.Value,
+					ClusterName:   tag.Name,
+					ProjectID:     projectID,
+				})
+			}
+		}
+
+		resources = append(resources, provider.ClusterCloudResource{
+			CloudResource: provider.CloudResource{Kind: tagKind, Name: path.Join(category.Name, tag.Name)},
+			ID:            tag.ID,
+			ClusterName:   tag.Name,
+			ProjectID:     projectID,
+		})
+	}
+	return resources, nil
+}
+
+// DeleteClusterResource deletes a resource returned by ListClusterResources
+func (v *Provider) DeleteClusterResource(spec kubermaticv1.CloudSpec, resource provider.ClusterCloudResource) error {
+	ctx := context.Background()
+	username, password, err := GetCredentialsForCluster(spec, v.secretKeySelector, v.dc)
+	if err != nil {
+		return err
+	}
+
+	switch resource.Kind {
+	case virtualMachineType, folderType:
+		session, err := newSession(ctx, v.dc, username, password)
+		if err != nil {
+			return fmt.Errorf("failed to create vCenter session: %v", err)
+		}
+		defer session.Logout()
+
+		// The kinds of virtual machines and folders are their types
+		ref := types.ManagedObjectReference{Type: resource.Kind, Value: resource.ID}
+		if resource.Kind == virtualMachineType {
+			return deleteVM(ctx, object.NewVirtualMachine(session.Client.Client, ref))
+		}
+		return deleteFolder(ctx, object.NewFolder(session.Client.Client, ref))
+	case tagKind:
+		restSession, err := newRESTSession(ctx, v.dc, username, password)
+		if err != nil {
+			return fmt.Errorf("failed to create vCenter REST session: %v", err)
+		}
+		defer restSession.Logout()
+
+		tagManager := tags.NewManager(restSession.Client)
+		tagIDs, err := tagManager.ListTags(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to list tags: %v", err)
+		}
+		for _, id := range tagIDs {
+			if id == resource.ID {
+				return tagManager.DeleteTag(ctx, &tags.Tag{ID: id})
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown resource kind %q", resource.Kind)
+	}
+}
+
+// deleteFolder deletes the folder together with its content. It succeeds if the folder is already gone.
+func deleteFolder(ctx context.Context, folder *object.Folder) error {
+	task, err := folder.Destroy(ctx)
+	if err != nil {
+		if isManagedObjectNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to trigger deletion of %s: %v", folder.Reference().String(), err)
+	}
+	if err := task.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait for deletion of %s: %v", folder.Reference().String(), err)
+	}
+	return nil
+}
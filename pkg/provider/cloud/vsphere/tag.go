@@ -0,0 +1,264 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25/types"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// tagCategoryCardinality allows objects to carry the tags of several clusters,
+	// e.g. a shared folder.
+	tagCategoryCardinality = "MULTIPLE"
+	virtualMachineType     = "VirtualMachine"
+	folderType             = "Folder"
+
+	// tagOwnerPrefix marks the tags created for the clusters. The description of these tags is
+	// kubermatic.io/cluster/<cluster>=<project>, only objects carrying such a tag are removed
+	// together with the cluster or as orphans.
+	tagOwnerPrefix = "kubermatic.io/cluster/"
+)
+
+// ownerDescription returns the description of the tag of the cluster
+func ownerDescription(cluster *kubermaticv1.Cluster) string {
+	return tagOwnerPrefix + cluster.Name + "=" + cluster.Labels[kubermaticv1.ProjectIDLabelKey]
+}
+
+// legacyDescription returns the description the tags of the clusters got before the owner was stored in it
+func legacyDescription(clusterName string) string {
+	return fmt.Sprintf("Objects of the Kubermatic cluster %s", clusterName)
+}
+
+// tagOwner returns the project of the cluster the tag was created for. Tags without owner were not
+// created for a cluster or were created before the owner was stored in their description.
+func tagOwner(tag *tags.Tag) (projectID string, owned bool) {
+	prefix := tagOwnerPrefix + tag.Name + "="
+	if !strings.HasPrefix(tag.Description, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(tag.Description, prefix), true
+}
+
+// ensureClusterTag creates the tag category and the tag of the cluster if they do not exist yet. Tags of
+// the cluster created before the owner was stored in their description get it added. A tag with the name of
+// the cluster which was not created for it is not used.
+func ensureClusterTag(ctx context.Context, restSession *RESTSession, categoryName string, cluster *kubermaticv1.Cluster) (*tags.Tag, error) {
+	tagManager := tags.NewManager(restSession.Client)
+
+	category, err := getTagCategory(ctx, tagManager, categoryName)
+	if err != nil {
+		return nil, err
+	}
+	if category == nil {
+		categoryID, err := tagManager.CreateCategory(ctx, &tags.Category{
+			Name:        categoryName,
+			Description: "Kubermatic cluster tags",
+			Cardinality: tagCategoryCardinality,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create tag category %q: %v", categoryName, err)
+		}
+		category = &tags.Category{ID: categoryID, Name: categoryName}
+	}
+
+	tag, err := getClusterTag(ctx, tagManager, category.ID, cluster.Name)
+	if err != nil {
+		return nil, err
+	}
+	if tag != nil {
+		if tag.Description == ownerDescription(cluster) {
+			return tag, nil
+		}
+		if tag.Description != legacyDescription(cluster.Name) {
+			return nil, fmt.Errorf("tag %q in category %q was not created for the cluster", cluster.Name, categoryName)
+		}
+		tag.Description = ownerDescription(cluster)
+		if err := tagManager.UpdateTag(ctx, tag); err != nil {
+			return nil, fmt.Errorf("failed to update tag %q: %v", tag.Name, err)
+		}
+		return tag, nil
+	}
+
+	tag = &tags.Tag{
+		Name:        cluster.Name,
+		Description: ownerDescription(cluster),
+		CategoryID:  category.ID,
+	}
+	if tag.ID, err = tagManager.CreateTag(ctx, tag); err != nil {
+		return nil, fmt.Errorf("failed to create tag %q: %v", cluster.Name, err)
+	}
+	return tag, nil
+}
+
+// attachClusterTag attaches the given tag to the object, it is a no-op if the object already carries the tag.
+func attachClusterTag(ctx context.Context, restSession *RESTSession, tag *tags.Tag, ref types.ManagedObjectReference) error {
+	if err := tags.NewManager(restSession.Client).AttachTag(ctx, tag.ID, ref); err != nil {
+		return fmt.Errorf("failed to attach tag %q to %s: %v", tag.Name, ref.String(), err)
+	}
+	return nil
+}
+
+// tagClusterVMs attaches the tag of the cluster to the virtual machines in the folder of the cluster. The
+// machine-controller does not tag the virtual machines it creates, they are tagged here to find them even
+// if they get moved out of the folder.
+func tagClusterVMs(ctx context.Context, session *Session, restSession *RESTSession, tag *tags.Tag, folder string) error {
+	vms, err := session.Finder.VirtualMachineList(ctx, path.Join(folder, "*"))
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list virtual machines in folder %q: %v", folder, err)
+	}
+
+	refs, err := tags.NewManager(restSession.Client).ListAttachedObjects(ctx, tag.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list objects tagged with %q: %v", tag.Name, err)
+	}
+	tagged := sets.NewString()
+	for _, ref := range refs {
+		tagged.Insert(ref.Reference().Value)
+	}
+
+	for _, vm := range vms {
+		if tagged.Has(vm.Reference().Value) {
+			continue
+		}
+		if err := attachClusterTag(ctx, restSession, tag, vm.Reference()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getTaggedObjects returns the references of the objects of the given type carrying the given tag.
+func getTaggedObjects(ctx context.Context, tagManager *tags.Manager, tag *tags.Tag, objectType string) ([]types.ManagedObjectReference, error) {
+	refs, err := tagManager.ListAttachedObjects(ctx, tag.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects tagged with %q: %v", tag.Name, err)
+	}
+
+	var objects []types.ManagedObjectReference
+	for _, ref := range refs {
+		if ref.Reference().Type == objectType {
+			objects = append(objects, ref.Reference())
+		}
+	}
+	return objects, nil
+}
+
+// deleteClusterTag deletes the virtual machines which still carry the tag of the cluster and the tag itself.
+// Virtual machines are only deleted if the tag was created for the cluster. The tag category is kept, as it
+// is shared between clusters.
+func deleteClusterTag(ctx context.Context, session *Session, restSession *RESTSession, categoryName, clusterName string) error {
+	tagManager := tags.NewManager(restSession.Client)
+
+	category, err := getTagCategory(ctx, tagManager, categoryName)
+	if err != nil || category == nil {
+		return err
+	}
+	tag, err := getClusterTag(ctx, tagManager, category.ID, clusterName)
+	if err != nil || tag == nil {
+		return err
+	}
+
+	// Machines are deleted before the cloud provider gets cleaned up, so any
+	// virtual machine that still carries the tag got orphaned.
+	if _, owned := tagOwner(tag); owned {
+		vms, err := getTaggedObjects(ctx, tagManager, tag, virtualMachineType)
+		if err != nil {
+			return err
+		}
+		for _, vm := range vms {
+			if err := deleteVM(ctx, object.NewVirtualMachine(session.Client.Client, vm)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tagManager.DeleteTag(ctx, tag); err != nil {
+		return fmt.Errorf("failed to delete tag %q: %v", tag.Name, err)
+	}
+	return nil
+}
+
+// getTagCategory returns the tag category with the given name or nil if it does not exist.
+func getTagCategory(ctx context.Context, tagManager *tags.Manager, name string) (*tags.Category, error) {
+	categories, err := tagManager.GetCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tag categories: %v", err)
+	}
+	for i := range categories {
+		if categories[i].Name == name {
+			return &categories[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// getClusterTag returns the tag of the cluster in the given category or nil if it does not exist.
+func getClusterTag(ctx context.Context, tagManager *tags.Manager, categoryID, clusterName string) (*tags.Tag, error) {
+	categoryTags, err := tagManager.GetTagsForCategory(ctx, categoryID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags of category %q: %v", categoryID, err)
+	}
+	for i := range categoryTags {
+		if categoryTags[i].Name == clusterName {
+			return &categoryTags[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// deleteVM powers off the virtual machine if necessary and deletes it. It succeeds if the virtual machine is already gone.
+func deleteVM(ctx context.Context, vm *object.VirtualMachine) error {
+	powerState, err := vm.PowerState(ctx)
+	if err != nil {
+		if isManagedObjectNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get power state of %s: %v", vm.Reference().String(), err)
+	}
+	if powerState == types.VirtualMachinePowerStatePoweredOn {
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to trigger power off of %s: %v", vm.Reference().String(), err)
+		}
+		if err := task.Wait(ctx); err != nil {
+			return fmt.Errorf("failed to wait for power off of %s: %v", vm.Reference().String(), err)
+		}
+	}
+
+	task, err := vm.Destroy(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to trigger deletion of %s: %v", vm.Reference().String(), err)
+	}
+	if err := task.Wait(ctx); err != nil {
+		return fmt.Errorf("failed to wait for deletion of %s: %v", vm.Reference().String(), err)
+	}
+	return nil
+}
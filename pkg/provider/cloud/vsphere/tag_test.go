@@ -0,0 +1,343 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vsphere
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vapi/tags"
+	"github.com/vmware/govmomi/vim25/types"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testTagCategory = "kubermatic-clusters"
+
+// testUpdater applies the modifications to the given cluster, as the cluster provider would do
+func testUpdater(cluster *kubermaticv1.Cluster) func(string, func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+	return func(_ string, modify func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error) {
+		modify(cluster)
+		return cluster, nil
+	}
+}
+
+func TestClusterTagLifecycle(t *testing.T) {
+	sim := vSphereSimulator{t: t}
+	sim.setUp()
+	defer sim.tearDown()
+	dc := &kubermaticv1.DatacenterSpecVSphere{DefaultTagCategory: testTagCategory}
+	sim.fillClientInfo(dc)
+	v := &Provider{dc: dc}
+
+	cluster := genTestCluster("abcd", "my-project")
+	if err := v.DefaultCloudSpec(&cluster.Spec.Cloud); err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Spec.Cloud.VSphere.TagCategory != testTagCategory {
+		t.Fatalf("expected the tag category to be defaulted to %q, got %q", testTagCategory, cluster.Spec.Cloud.VSphere.TagCategory)
+	}
+
+	// Initializing twice must be idempotent
+	for i := 0; i < 2; i++ {
+		var err error
+		if cluster, err = v.InitializeCloudProvider(cluster, testUpdater(cluster)); err != nil {
+			t.Fatalf("failed to initialize the cloud provider: %v", err)
+		}
+	}
+	if !kuberneteshelper.HasFinalizer(cluster, folderCleanupFinalizer, tagCleanupFinalizer) {
+		t.Fatalf("expected the folder and tag finalizers, got %v", cluster.Finalizers)
+	}
+
+	ctx := context.Background()
+	session, err := newSession(ctx, dc, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Logout()
+	restSession, err := newRESTSession(ctx, dc, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restSession.Logout()
+	tagManager := tags.NewManager(restSession.Client)
+
+	tag := getTestClusterTag(ctx, t, tagManager, cluster.Name)
+	if tag == nil {
+		t.Fatal("expected the tag of the cluster to exist")
+	}
+	folder, err := session.Finder.Folder(ctx, cluster.Spec.Cloud.VSphere.Folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attached, err := tagManager.ListAttachedObjects(ctx, tag.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attached) != 1 || attached[0].Reference() != folder.Reference() {
+		t.Fatalf("expected the cluster folder to carry the tag, got %v", attached)
+	}
+	if tag.Description != "kubermatic.io/cluster/abcd=my-project" {
+		t.Errorf("expected the tag to be owned by the cluster, got description %q", tag.Description)
+	}
+
+	// The virtual machines created in the folder of the cluster get tagged
+	vm := moveTestVM(ctx, t, session, "DC0_H0_VM0", folder)
+	if cluster, err = v.InitializeCloudProvider(cluster, testUpdater(cluster)); err != nil {
+		t.Fatalf("failed to initialize the cloud provider: %v", err)
+	}
+	if !isTagged(ctx, t, tagManager, tag, vm.Reference()) {
+		t.Fatal("expected the virtual machine in the cluster folder to carry the tag")
+	}
+
+	if cluster, err = v.CleanUpCloudProvider(cluster, testUpdater(cluster)); err != nil {
+		t.Fatalf("failed to clean up the cloud provider: %v", err)
+	}
+	if len(cluster.Finalizers) != 0 {
+		t.Errorf("expected all finalizers to be removed, got %v", cluster.Finalizers)
+	}
+	if tag := getTestClusterTag(ctx, t, tagManager, cluster.Name); tag != nil {
+		t.Errorf("expected the tag of the cluster to be deleted")
+	}
+	if _, err := vm.PowerState(ctx); !isManagedObjectNotFound(err) {
+		t.Errorf("expected the tagged virtual machine to be deleted, got %v", err)
+	}
+}
+
+// moveTestVM moves the virtual machine of the simulator with the given name into the folder
+func moveTestVM(ctx context.Context, t *testing.T, session *Session, name string, folder *object.Folder) *object.VirtualMachine {
+	vm, err := session.Finder.VirtualMachine(ctx, name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	task, err := folder.MoveInto(ctx, []types.ManagedObjectReference{vm.Reference()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := task.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return vm
+}
+
+func isTagged(ctx context.Context, t *testing.T, tagManager *tags.Manager, tag *tags.Tag, ref types.ManagedObjectReference) bool {
+	attached, err := tagManager.ListAttachedObjects(ctx, tag.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, obj := range attached {
+		if obj.Reference() == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func TestForeignClusterTagIsNotUsed(t *testing.T) {
+	sim := vSphereSimulator{t: t}
+	sim.setUp()
+	defer sim.tearDown()
+	dc := &kubermaticv1.DatacenterSpecVSphere{DefaultTagCategory: testTagCategory}
+	sim.fillClientInfo(dc)
+	v := &Provider{dc: dc}
+
+	ctx := context.Background()
+	restSession, err := newRESTSession(ctx, dc, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restSession.Logout()
+
+	// A cluster with the same name of another installation created the tag
+	other := genTestCluster("abcd", "other-project")
+	if _, err := ensureClusterTag(ctx, restSession, testTagCategory, other); err != nil {
+		t.Fatal(err)
+	}
+
+	cluster := genTestCluster("abcd", "my-project")
+	cluster.Spec.Cloud.VSphere.TagCategory = testTagCategory
+	if _, err := v.InitializeCloudProvider(cluster, testUpdater(cluster)); err == nil {
+		t.Fatal("expected the tag of the other cluster to be rejected")
+	}
+}
+
+func TestSweepClusterResources(t *testing.T) {
+	sim := vSphereSimulator{t: t}
+	sim.setUp()
+	defer sim.tearDown()
+	dc := &kubermaticv1.DatacenterSpecVSphere{DefaultTagCategory: testTagCategory}
+	sim.fillClientInfo(dc)
+	v := &Provider{dc: dc}
+
+	cluster := genTestCluster("abcd", "my-project")
+	cluster.Spec.Cloud.VSphere.TagCategory = testTagCategory
+	cluster, err := v.InitializeCloudProvider(cluster, testUpdater(cluster))
+	if err != nil {
+		t.Fatalf("failed to initialize the cloud provider: %v", err)
+	}
+
+	ctx := context.Background()
+	session, err := newSession(ctx, dc, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer session.Logout()
+	restSession, err := newRESTSession(ctx, dc, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restSession.Logout()
+	tagManager := tags.NewManager(restSession.Client)
+
+	folder, err := session.Finder.Folder(ctx, cluster.Spec.Cloud.VSphere.Folder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm := moveTestVM(ctx, t, session, "DC0_H0_VM0", folder)
+	if cluster, err = v.InitializeCloudProvider(cluster, testUpdater(cluster)); err != nil {
+		t.Fatalf("failed to initialize the cloud provider: %v", err)
+	}
+
+	// Tags without owner, like the ones of clusters created before the owner was stored, are left alone
+	category, err := getTagCategory(ctx, tagManager, testTagCategory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyTagID, err := tagManager.CreateTag(ctx, &tags.Tag{Name: "efgh", Description: legacyDescription("efgh"), CategoryID: category.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyVM, err := session.Finder.VirtualMachine(ctx, "DC0_H0_VM1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tagManager.AttachTag(ctx, legacyTagID, legacyVM.Reference()); err != nil {
+		t.Fatal(err)
+	}
+
+	resources, err := v.ListClusterResources(cluster.Spec.Cloud)
+	if err != nil {
+		t.Fatalf("failed to list cluster resources: %v", err)
+	}
+	found := map[string]string{}
+	for _, resource := range resources {
+		if resource.ClusterName != "abcd" || resource.ProjectID != "my-project" {
+			t.Errorf("expected only resources of the owned cluster, got %+v", resource)
+		}
+		found[resource.Kind] = resource.ID
+	}
+	expected := map[string]string{
+		virtualMachineType: vm.Reference().Value,
+		folderType:         folder.Reference().Value,
+		tagKind:            getTestClusterTag(ctx, t, tagManager, "abcd").ID,
+	}
+	if !reflect.DeepEqual(found, expected) {
+		t.Fatalf("expected resources %v, got %v", expected, found)
+	}
+
+	// Deleting is idempotent
+	for i := 0; i < 2; i++ {
+		for _, resource := range resources {
+			if err := v.DeleteClusterResource(cluster.Spec.Cloud, resource); err != nil {
+				t.Fatalf("failed to delete %s %s: %v", resource.Kind, resource.Name, err)
+			}
+		}
+	}
+	if _, err := vm.PowerState(ctx); !isManagedObjectNotFound(err) {
+		t.Errorf("expected the virtual machine to be deleted, got %v", err)
+	}
+	if _, err := session.Finder.Folder(ctx, cluster.Spec.Cloud.VSphere.Folder); !isNotFound(err) {
+		t.Errorf("expected the folder to be deleted, got %v", err)
+	}
+	if tag := getTestClusterTag(ctx, t, tagManager, "abcd"); tag != nil {
+		t.Error("expected the tag to be deleted")
+	}
+	if _, err := legacyVM.PowerState(ctx); err != nil {
+		t.Errorf("expected the virtual machine of the tag without owner to be kept, got %v", err)
+	}
+}
+
+func genTestCluster(name, projectID string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: projectID}},
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{VSphere: &kubermaticv1.VSphereCloudSpec{}},
+		},
+	}
+}
+
+func getTestClusterTag(ctx context.Context, t *testing.T, tagManager *tags.Manager, clusterName string) *tags.Tag {
+	category, err := getTagCategory(ctx, tagManager, testTagCategory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if category == nil {
+		t.Fatalf("expected the tag category %q to exist", testTagCategory)
+	}
+	tag, err := getClusterTag(ctx, tagManager, category.ID, clusterName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tag
+}
+
+func TestClusterTagCategoryGetsDefaultedForExistingClusters(t *testing.T) {
+	sim := vSphereSimulator{t: t}
+	sim.setUp()
+	defer sim.tearDown()
+	dc := &kubermaticv1.DatacenterSpecVSphere{DefaultTagCategory: testTagCategory}
+	sim.fillClientInfo(dc)
+	v := &Provider{dc: dc}
+
+	ctx := context.Background()
+	restSession, err := newRESTSession(ctx, dc, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restSession.Logout()
+	tagManager := tags.NewManager(restSession.Client)
+
+	// The tag was created before its owner was stored in it
+	categoryID, err := tagManager.CreateCategory(ctx, &tags.Category{Name: testTagCategory, Cardinality: tagCategoryCardinality})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tagManager.CreateTag(ctx, &tags.Tag{Name: "efgh", Description: legacyDescription("efgh"), CategoryID: categoryID}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The cluster was created before the tag category was introduced
+	cluster := genTestCluster("efgh", "my-project")
+	cluster, err = v.InitializeCloudProvider(cluster, testUpdater(cluster))
+	if err != nil {
+		t.Fatalf("failed to initialize the cloud provider: %v", err)
+	}
+	if cluster.Spec.Cloud.VSphere.TagCategory != testTagCategory {
+		t.Errorf("expected the tag category to be defaulted to %q, got %q", testTagCategory, cluster.Spec.Cloud.VSphere.TagCategory)
+	}
+	if !kuberneteshelper.HasFinalizer(cluster, tagCleanupFinalizer) {
+		t.Errorf("expected the tag finalizer, got %v", cluster.Finalizers)
+	}
+	if tag := getTestClusterTag(ctx, t, tagManager, "efgh"); tag == nil || tag.Description != "kubermatic.io/cluster/efgh=my-project" {
+		t.Errorf("expected the owner to be added to the tag, got %+v", tag)
+	}
+}
@@ -21,11 +21,16 @@ import (
 	"fmt"
 	"strings"
 
+	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
+	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -85,3 +90,204 @@ func (a *AdminProvider) SetAdmin(userInfo *provider.UserInfo, email string, isAd
 	}
 	return nil, fmt.Errorf("the given user %s was not found", email)
 }
+
+// SuspendUser suspends or reactivates the given user
+// Suspending a user also revokes the tokens of the service accounts the user created
+func (a *AdminProvider) SuspendUser(userInfo *provider.UserInfo, email string, suspended bool) (*kubermaticv1.User, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	if strings.EqualFold(userInfo.Email, email) {
+		return nil, kerrors.NewBadRequest("can not suspend own account")
+	}
+	user, err := a.userByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.setSuspended(user, suspended); err != nil {
+		return nil, err
+	}
+	if suspended {
+		if _, err := a.revokeServiceAccountTokens(user.Spec.Email); err != nil {
+			return nil, err
+		}
+	}
+	return user, nil
+}
+
+// OffboardUser suspends the given user, transfers the projects the user exclusively owns
+// to the given admin and removes the user from all projects
+func (a *AdminProvider) OffboardUser(userInfo *provider.UserInfo, email, newOwnerEmail string) (*provider.UserOffboarding, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	if strings.EqualFold(userInfo.Email, email) {
+		return nil, kerrors.NewBadRequest("can not offboard own account")
+	}
+	if strings.EqualFold(email, newOwnerEmail) {
+		return nil, kerrors.NewBadRequest("the projects can not be transferred to the offboarded user")
+	}
+	user, err := a.userByEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	newOwner, err := a.userByEmail(newOwnerEmail)
+	if err != nil {
+		return nil, err
+	}
+	if !newOwner.Spec.IsAdmin {
+		return nil, kerrors.NewBadRequest(fmt.Sprintf("the projects can only be transferred to an admin, %s is not an admin", newOwnerEmail))
+	}
+
+	result := &provider.UserOffboarding{
+		User:                user,
+		TransferredProjects: []string{},
+		RemovedBindings:     []string{},
+	}
+	if err := a.setSuspended(user, true); err != nil {
+		return nil, err
+	}
+	if result.RevokedTokens, err = a.revokeServiceAccountTokens(user.Spec.Email); err != nil {
+		return nil, err
+	}
+
+	allBindings := &kubermaticv1.UserProjectBindingList{}
+	if err := a.client.List(context.Background(), allBindings); err != nil {
+		return nil, err
+	}
+	for _, binding := range allBindings.Items {
+		if !strings.EqualFold(binding.Spec.UserEmail, user.Spec.Email) {
+			continue
+		}
+		if rbac.ExtractGroupPrefix(binding.Spec.Group) == rbac.OwnerGroupNamePrefix && !hasOtherOwner(allBindings.Items, binding.Spec.ProjectID, user.Spec.Email) {
+			if err := a.transferProject(binding.Spec.ProjectID, user, newOwner, allBindings.Items); err != nil {
+				return nil, fmt.Errorf("failed to transfer the project %s: %v", binding.Spec.ProjectID, err)
+			}
+			result.TransferredProjects = append(result.TransferredProjects, binding.Spec.ProjectID)
+		}
+
+		if err := a.client.Delete(context.Background(), binding.DeepCopy()); err != nil && !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+		result.RemovedBindings = append(result.RemovedBindings, binding.Name)
+	}
+
+	return result, nil
+}
+
+// transferProject makes the new owner the owner of the given project in place of the given user
+func (a *AdminProvider) transferProject(projectID string, user, newOwner *kubermaticv1.User, bindings []kubermaticv1.UserProjectBinding) error {
+	project := &kubermaticv1.Project{}
+	if err := a.client.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: projectID}, project); err != nil {
+		return err
+	}
+
+	ownerGroup := rbac.GenerateActualGroupNameFor(project.Name, rbac.OwnerGroupNamePrefix)
+	var newOwnerBinding *kubermaticv1.UserProjectBinding
+	for _, binding := range bindings {
+		if binding.Spec.ProjectID == project.Name && strings.EqualFold(binding.Spec.UserEmail, newOwner.Spec.Email) {
+			newOwnerBinding = binding.DeepCopy()
+			break
+		}
+	}
+	if newOwnerBinding == nil {
+		if err := a.client.Create(context.Background(), genBinding(project, newOwner.Spec.Email, ownerGroup)); err != nil {
+			return err
+		}
+	} else {
+		newOwnerBinding.Spec.Group = ownerGroup
+		if !sets.NewString(newOwnerBinding.Finalizers...).Has(rbac.CleanupFinalizerName) {
+			newOwnerBinding.Finalizers = append(newOwnerBinding.Finalizers, rbac.CleanupFinalizerName)
+		}
+		if err := a.client.Update(context.Background(), newOwnerBinding); err != nil {
+			return err
+		}
+	}
+
+	// the project is garbage collected once all its owners are gone
+	ownerRefs := []metav1.OwnerReference{}
+	for _, ref := range project.OwnerReferences {
+		if ref.Kind == kubermaticv1.UserKindName && (ref.Name == user.Name || ref.Name == newOwner.Name) {
+			continue
+		}
+		ownerRefs = append(ownerRefs, ref)
+	}
+	project.OwnerReferences = append(ownerRefs, metav1.OwnerReference{
+		APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+		Kind:       kubermaticv1.UserKindName,
+		UID:        newOwner.GetUID(),
+		Name:       newOwner.Name,
+	})
+	return a.client.Update(context.Background(), project)
+}
+
+// revokeServiceAccountTokens deletes the tokens of the service accounts created by the given user
+func (a *AdminProvider) revokeServiceAccountTokens(email string) ([]string, error) {
+	users := &kubermaticv1.UserList{}
+	if err := a.client.List(context.Background(), users); err != nil {
+		return nil, err
+	}
+	serviceAccounts := sets.NewString()
+	for _, user := range users.Items {
+		if IsServiceAccount(user.Spec.Email) && strings.EqualFold(user.Annotations[ServiceAccountAnnotationCreator], email) {
+			serviceAccounts.Insert(user.Name)
+		}
+	}
+	if serviceAccounts.Len() == 0 {
+		return []string{}, nil
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := a.client.List(context.Background(), secrets, ctrlruntimeclient.InNamespace(resources.KubermaticNamespace)); err != nil {
+		return nil, err
+	}
+	revoked := []string{}
+	for _, secret := range secrets.Items {
+		if !IsToken(&secret) {
+			continue
+		}
+		for _, owner := range secret.OwnerReferences {
+			if owner.Kind == kubermaticv1.UserKindName && serviceAccounts.Has(owner.Name) {
+				if err := a.client.Delete(context.Background(), secret.DeepCopy()); err != nil && !kerrors.IsNotFound(err) {
+					return nil, err
+				}
+				revoked = append(revoked, removeTokenPrefix(secret.Name))
+				break
+			}
+		}
+	}
+	return revoked, nil
+}
+
+func (a *AdminProvider) setSuspended(user *kubermaticv1.User, suspended bool) error {
+	if user.Spec.Suspended == suspended {
+		return nil
+	}
+	user.Spec.Suspended = suspended
+	return a.client.Update(context.Background(), user)
+}
+
+func (a *AdminProvider) userByEmail(email string) (*kubermaticv1.User, error) {
+	userList := &kubermaticv1.UserList{}
+	if err := a.client.List(context.Background(), userList); err != nil {
+		return nil, err
+	}
+	for _, user := range userList.Items {
+		if strings.EqualFold(user.Spec.Email, email) {
+			return user.DeepCopy(), nil
+		}
+	}
+	return nil, kerrors.NewNotFound(kubermaticv1.SchemeGroupVersion.WithResource(kubermaticv1.UserResourceName).GroupResource(), email)
+}
+
+// hasOtherOwner checks if the given project is owned by anybody else than the given user
+func hasOtherOwner(bindings []kubermaticv1.UserProjectBinding, projectID, email string) bool {
+	for _, binding := range bindings {
+		if binding.Spec.ProjectID == projectID && !strings.EqualFold(binding.Spec.UserEmail, email) &&
+			rbac.ExtractGroupPrefix(binding.Spec.Group) == rbac.OwnerGroupNamePrefix {
+			return true
+		}
+	}
+	return false
+}
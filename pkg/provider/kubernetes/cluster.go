@@ -28,6 +28,7 @@ import (
 	k8cuserclusterclient "k8c.io/kubermatic/v2/pkg/cluster/client"
 	"k8c.io/kubermatic/v2/pkg/controller/seed-controller-manager/cloud"
 	openshiftuserclusterresources "k8c.io/kubermatic/v2/pkg/controller/user-cluster-controller-manager/resources/resources/openshift"
+	kubermaticclientset "k8c.io/kubermatic/v2/pkg/crd/client/clientset/versioned"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
@@ -39,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -418,6 +420,20 @@ func (p *ClusterProvider) GetSeedClusterAdminClient() kubernetes.Interface {
 	return p.k8sClient
 }
 
+// WatchUnsecured watches all clusters in the seed.
+//
+// Note that the admin privileges are used to watch clusters
+func (p *ClusterProvider) WatchUnsecured() (watch.Interface, error) {
+	if p.seedKubeconfig == nil {
+		return nil, errors.New("seed kubeconfig is missing but required")
+	}
+	client, err := kubermaticclientset.NewForConfig(p.seedKubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.KubermaticV1().Clusters().Watch(context.Background(), metav1.ListOptions{})
+}
+
 func (p *ClusterProvider) withImpersonation(userInfo *provider.UserInfo) k8cuserclusterclient.ConfigOption {
 	return func(cfg *restclient.Config) *restclient.Config {
 		cfg.Impersonate = restclient.ImpersonationConfig{
@@ -0,0 +1,348 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CredentialsRotatedAtAnnotation is set on the clusters whose credentials were rotated
+const CredentialsRotatedAtAnnotation = "kubermatic.io/credentials-rotated-at"
+
+// errInlineCredentials is reported for the clusters of the provider that still have their credentials in the cluster spec,
+// they are moved into a credential secret by the seed controller and can be rotated afterwards
+var errInlineCredentials = errors.New("the credentials are stored in the cluster instead of a credential secret, they were not rotated")
+
+// credentialSecretKeys are the keys of the credential secrets of the clusters per cloud provider
+var credentialSecretKeys = map[string][]string{
+	provider.AWSCloudProvider:          {resources.AWSAccessKeyID, resources.AWSSecretAccessKey},
+	provider.AzureCloudProvider:        {resources.AzureTenantID, resources.AzureSubscriptionID, resources.AzureClientID, resources.AzureClientSecret},
+	provider.DigitaloceanCloudProvider: {resources.DigitaloceanToken},
+	provider.GCPCloudProvider:          {resources.GCPServiceAccount},
+	provider.HetznerCloudProvider:      {resources.HetznerToken},
+	provider.OpenstackCloudProvider: {resources.OpenstackUsername, resources.OpenstackPassword, resources.OpenstackTenant, resources.OpenstackTenantID,
+		resources.OpenstackDomain, resources.OpenstackApplicationCredentialID, resources.OpenstackApplicationCredentialSecret},
+	provider.PacketCloudProvider:   {resources.PacketAPIKey, resources.PacketProjectID},
+	provider.KubevirtCloudProvider: {resources.KubevirtKubeConfig},
+	provider.VSphereCloudProvider: {resources.VsphereUsername, resources.VspherePassword,
+		resources.VsphereInfraManagementUserUsername, resources.VsphereInfraManagementUserPassword},
+	provider.AlibabaCloudProvider: {resources.AlibabaAccessKeyID, resources.AlibabaAccessKeySecret},
+}
+
+// CredentialRotationProvider rotates the credentials stored in presets and in the credential secrets of the clusters
+type CredentialRotationProvider struct {
+	ctx              context.Context
+	client           ctrlruntimeclient.Client
+	seedsGetter      provider.SeedsGetter
+	seedClientGetter provider.SeedClientGetter
+	now              func() time.Time
+}
+
+var _ provider.CredentialRotationProvider = &CredentialRotationProvider{}
+
+// NewCredentialRotationProvider returns a credential rotation provider
+func NewCredentialRotationProvider(ctx context.Context, client ctrlruntimeclient.Client, seedsGetter provider.SeedsGetter, seedClientGetter provider.SeedClientGetter) *CredentialRotationProvider {
+	return &CredentialRotationProvider{
+		ctx:              ctx,
+		client:           client,
+		seedsGetter:      seedsGetter,
+		seedClientGetter: seedClientGetter,
+		now:              time.Now,
+	}
+}
+
+// Rotate replaces the credentials of all clusters of the provider whose credential secret contains the current
+// credentials and then the credentials of the preset, if one is given. A failure to update a cluster does not stop
+// the rotation, it is reported in the result of the cluster. The preset is only updated if all clusters were rotated,
+// so the clusters which failed are still matched by the credentials of the preset when the rotation is retried.
+func (p *CredentialRotationProvider) Rotate(userInfo *provider.UserInfo, rotation *provider.CredentialRotation) ([]provider.CredentialRotationResult, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	if err := validateCredentialRotation(rotation); err != nil {
+		return nil, kerrors.NewBadRequest(err.Error())
+	}
+
+	currentCredentials := rotation.Credentials
+	var preset *kubermaticv1.Preset
+	if rotation.PresetName != "" {
+		preset = &kubermaticv1.Preset{}
+		if err := p.client.Get(p.ctx, types.NamespacedName{Name: rotation.PresetName}, preset); err != nil {
+			return nil, err
+		}
+		currentCredentials = presetCredentials(preset, rotation.ProviderName)
+		if len(currentCredentials) == 0 {
+			return nil, kerrors.NewBadRequest(fmt.Sprintf("preset %q has no credentials for %s", rotation.PresetName, rotation.ProviderName))
+		}
+	}
+
+	seeds, err := p.seedsGetter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get seeds: %v", err)
+	}
+	seedNames := make([]string, 0, len(seeds))
+	for name := range seeds {
+		seedNames = append(seedNames, name)
+	}
+	sort.Strings(seedNames)
+
+	results := []provider.CredentialRotationResult{}
+	failed := false
+	for _, seedName := range seedNames {
+		seedClient, err := p.seedClientGetter(seeds[seedName])
+		if err != nil {
+			results = append(results, provider.CredentialRotationResult{SeedName: seedName, Error: fmt.Errorf("failed to get seed client: %v", err)})
+			failed = true
+			continue
+		}
+		clusters := &kubermaticv1.ClusterList{}
+		if err := seedClient.List(p.ctx, clusters); err != nil {
+			results = append(results, provider.CredentialRotationResult{SeedName: seedName, Error: fmt.Errorf("failed to list clusters: %v", err)})
+			failed = true
+			continue
+		}
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if cluster.DeletionTimestamp != nil {
+				continue
+			}
+			if providerName, err := provider.ClusterCloudProviderName(cluster.Spec.Cloud); err != nil || providerName != rotation.ProviderName {
+				continue
+			}
+			rotated, err := p.rotateClusterCredentials(seedClient, cluster, currentCredentials, rotation.NewCredentials)
+			if !rotated && err == nil {
+				continue
+			}
+			// Retrying does not help clusters with inline credentials, they must not block the preset
+			if err != nil && err != errInlineCredentials {
+				failed = true
+			}
+			results = append(results, provider.CredentialRotationResult{
+				SeedName:          seedName,
+				ClusterName:       cluster.Name,
+				HumanReadableName: cluster.Spec.HumanReadableName,
+				ProjectID:         cluster.Labels[kubermaticv1.ProjectIDLabelKey],
+				Error:             err,
+			})
+		}
+	}
+
+	if preset != nil && !failed {
+		if err := p.rotatePresetCredentials(preset, rotation, currentCredentials); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// rotatePresetCredentials replaces the current credentials of the preset with the new ones
+func (p *CredentialRotationProvider) rotatePresetCredentials(preset *kubermaticv1.Preset, rotation *provider.CredentialRotation, currentCredentials map[string]string) error {
+	newCredentials := map[string]string{}
+	for key, value := range currentCredentials {
+		newCredentials[key] = value
+	}
+	for key, value := range rotation.NewCredentials {
+		newCredentials[key] = value
+	}
+	setPresetCredentials(preset, rotation.ProviderName, newCredentials)
+	if err := p.client.Update(p.ctx, preset); err != nil {
+		return fmt.Errorf("the clusters were rotated, but the preset %q could not be updated: %v", rotation.PresetName, err)
+	}
+	return nil
+}
+
+// rotateClusterCredentials replaces the credentials in the credential secret of the cluster if it contains the
+// current credentials. The seed controller renders the resources of the cluster again when its credential secret changes.
+func (p *CredentialRotationProvider) rotateClusterCredentials(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, currentCredentials, newCredentials map[string]string) (bool, error) {
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: cluster.GetSecretName()}
+	if err := seedClient.Get(p.ctx, name, secret); err != nil {
+		if kerrors.IsNotFound(err) {
+			return true, errInlineCredentials
+		}
+		return true, fmt.Errorf("failed to get credential secret: %v", err)
+	}
+	for key, value := range currentCredentials {
+		if string(secret.Data[key]) != value {
+			return false, nil
+		}
+	}
+
+	oldSecret := secret.DeepCopy()
+	for key, value := range newCredentials {
+		secret.Data[key] = []byte(value)
+	}
+	if err := seedClient.Patch(p.ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+		return true, fmt.Errorf("failed to update credential secret: %v", err)
+	}
+
+	oldCluster := cluster.DeepCopy()
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[CredentialsRotatedAtAnnotation] = p.now().UTC().Format(time.RFC3339)
+	if err := seedClient.Patch(p.ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+		return true, fmt.Errorf("failed to update cluster: %v", err)
+	}
+	return true, nil
+}
+
+func validateCredentialRotation(rotation *provider.CredentialRotation) error {
+	keys, ok := credentialSecretKeys[rotation.ProviderName]
+	if !ok {
+		return fmt.Errorf("the credentials of provider %q can not be rotated", rotation.ProviderName)
+	}
+	if len(rotation.NewCredentials) == 0 {
+		return fmt.Errorf("the new credentials are required")
+	}
+	if rotation.PresetName == "" && len(rotation.Credentials) == 0 {
+		return fmt.Errorf("either the preset or the current credentials are required")
+	}
+	if rotation.PresetName != "" && len(rotation.Credentials) > 0 {
+		return fmt.Errorf("the current credentials must not be given for a preset")
+	}
+	for _, credentials := range []map[string]string{rotation.Credentials, rotation.NewCredentials} {
+		for key := range credentials {
+			if !sets.NewString(keys...).Has(key) {
+				return fmt.Errorf("unknown credential %q, the credentials of %s are %v", key, rotation.ProviderName, keys)
+			}
+		}
+	}
+	return nil
+}
+
+// presetCredentials returns the credentials of the provider stored in the preset, keyed like in the credential secrets
+func presetCredentials(preset *kubermaticv1.Preset, providerName string) map[string]string {
+	credentials := map[string]string{}
+	switch providerName {
+	case provider.AWSCloudProvider:
+		if spec := preset.Spec.AWS; spec != nil {
+			credentials[resources.AWSAccessKeyID] = spec.AccessKeyID
+			credentials[resources.AWSSecretAccessKey] = spec.SecretAccessKey
+		}
+	case provider.AzureCloudProvider:
+		if spec := preset.Spec.Azure; spec != nil {
+			credentials[resources.AzureTenantID] = spec.TenantID
+			credentials[resources.AzureSubscriptionID] = spec.SubscriptionID
+			credentials[resources.AzureClientID] = spec.ClientID
+			credentials[resources.AzureClientSecret] = spec.ClientSecret
+		}
+	case provider.DigitaloceanCloudProvider:
+		if spec := preset.Spec.Digitalocean; spec != nil {
+			credentials[resources.DigitaloceanToken] = spec.Token
+		}
+	case provider.GCPCloudProvider:
+		if spec := preset.Spec.GCP; spec != nil {
+			credentials[resources.GCPServiceAccount] = spec.ServiceAccount
+		}
+	case provider.HetznerCloudProvider:
+		if spec := preset.Spec.Hetzner; spec != nil {
+			credentials[resources.HetznerToken] = spec.Token
+		}
+	case provider.OpenstackCloudProvider:
+		if spec := preset.Spec.Openstack; spec != nil {
+			credentials[resources.OpenstackUsername] = spec.Username
+			credentials[resources.OpenstackPassword] = spec.Password
+			credentials[resources.OpenstackTenant] = spec.Tenant
+			credentials[resources.OpenstackTenantID] = spec.TenantID
+			credentials[resources.OpenstackDomain] = spec.Domain
+			credentials[resources.OpenstackApplicationCredentialID] = spec.ApplicationCredentialID
+			credentials[resources.OpenstackApplicationCredentialSecret] = spec.ApplicationCredentialSecret
+		}
+	case provider.PacketCloudProvider:
+		if spec := preset.Spec.Packet; spec != nil {
+			credentials[resources.PacketAPIKey] = spec.APIKey
+			credentials[resources.PacketProjectID] = spec.ProjectID
+		}
+	case provider.KubevirtCloudProvider:
+		if spec := preset.Spec.Kubevirt; spec != nil {
+			credentials[resources.KubevirtKubeConfig] = spec.Kubeconfig
+		}
+	case provider.VSphereCloudProvider:
+		if spec := preset.Spec.VSphere; spec != nil {
+			credentials[resources.VsphereUsername] = spec.Username
+			credentials[resources.VspherePassword] = spec.Password
+		}
+	case provider.AlibabaCloudProvider:
+		if spec := preset.Spec.Alibaba; spec != nil {
+			credentials[resources.AlibabaAccessKeyID] = spec.AccessKeyID
+			credentials[resources.AlibabaAccessKeySecret] = spec.AccessKeySecret
+		}
+	}
+
+	// Unset optional credentials would never match the secret of a cluster
+	for key, value := range credentials {
+		if value == "" {
+			delete(credentials, key)
+		}
+	}
+	return credentials
+}
+
+// setPresetCredentials stores the credentials of the provider, keyed like in the credential secrets, in the preset
+func setPresetCredentials(preset *kubermaticv1.Preset, providerName string, credentials map[string]string) {
+	switch providerName {
+	case provider.AWSCloudProvider:
+		preset.Spec.AWS.AccessKeyID = credentials[resources.AWSAccessKeyID]
+		preset.Spec.AWS.SecretAccessKey = credentials[resources.AWSSecretAccessKey]
+	case provider.AzureCloudProvider:
+		preset.Spec.Azure.TenantID = credentials[resources.AzureTenantID]
+		preset.Spec.Azure.SubscriptionID = credentials[resources.AzureSubscriptionID]
+		preset.Spec.Azure.ClientID = credentials[resources.AzureClientID]
+		preset.Spec.Azure.ClientSecret = credentials[resources.AzureClientSecret]
+	case provider.DigitaloceanCloudProvider:
+		preset.Spec.Digitalocean.Token = credentials[resources.DigitaloceanToken]
+	case provider.GCPCloudProvider:
+		preset.Spec.GCP.ServiceAccount = credentials[resources.GCPServiceAccount]
+	case provider.HetznerCloudProvider:
+		preset.Spec.Hetzner.Token = credentials[resources.HetznerToken]
+	case provider.OpenstackCloudProvider:
+		preset.Spec.Openstack.Username = credentials[resources.OpenstackUsername]
+		preset.Spec.Openstack.Password = credentials[resources.OpenstackPassword]
+		preset.Spec.Openstack.Tenant = credentials[resources.OpenstackTenant]
+		preset.Spec.Openstack.TenantID = credentials[resources.OpenstackTenantID]
+		preset.Spec.Openstack.Domain = credentials[resources.OpenstackDomain]
+		preset.Spec.Openstack.ApplicationCredentialID = credentials[resources.OpenstackApplicationCredentialID]
+		preset.Spec.Openstack.ApplicationCredentialSecret = credentials[resources.OpenstackApplicationCredentialSecret]
+	case provider.PacketCloudProvider:
+		preset.Spec.Packet.APIKey = credentials[resources.PacketAPIKey]
+		preset.Spec.Packet.ProjectID = credentials[resources.PacketProjectID]
+	case provider.KubevirtCloudProvider:
+		preset.Spec.Kubevirt.Kubeconfig = credentials[resources.KubevirtKubeConfig]
+	case provider.VSphereCloudProvider:
+		preset.Spec.VSphere.Username = credentials[resources.VsphereUsername]
+		preset.Spec.VSphere.Password = credentials[resources.VspherePassword]
+	case provider.AlibabaCloudProvider:
+		preset.Spec.Alibaba.AccessKeyID = credentials[resources.AlibabaAccessKeyID]
+		preset.Spec.Alibaba.AccessKeySecret = credentials[resources.AlibabaAccessKeySecret]
+	}
+}
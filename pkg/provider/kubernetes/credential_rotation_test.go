@@ -0,0 +1,255 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakectrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestRotateCredentials(t *testing.T) {
+	genCluster := func(name string) *kubermaticv1.Cluster {
+		return &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "project"}},
+			Spec: kubermaticv1.ClusterSpec{
+				HumanReadableName: name,
+				Cloud:             kubermaticv1.CloudSpec{AWS: &kubermaticv1.AWSCloudSpec{}},
+			},
+		}
+	}
+	genSecret := func(clusterName, accessKeyID string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credential-aws-" + clusterName, Namespace: resources.KubermaticNamespace},
+			Data: map[string][]byte{
+				resources.AWSAccessKeyID:     []byte(accessKeyID),
+				resources.AWSSecretAccessKey: []byte("secret-" + accessKeyID),
+			},
+		}
+	}
+	preset := &kubermaticv1.Preset{
+		ObjectMeta: metav1.ObjectMeta{Name: "team"},
+		Spec: kubermaticv1.PresetSpec{
+			AWS: &kubermaticv1.AWS{AccessKeyID: "old", SecretAccessKey: "secret-old", VPCID: "vpc-1"},
+		},
+	}
+	testCases := []struct {
+		name             string
+		userInfo         *provider.UserInfo
+		rotation         *provider.CredentialRotation
+		expectedError    string
+		expectedClusters []string
+	}{
+		{
+			name:          "only admins can rotate credentials",
+			userInfo:      &provider.UserInfo{Email: "bob@acme.com"},
+			rotation:      &provider.CredentialRotation{ProviderName: "aws", PresetName: "team", NewCredentials: map[string]string{resources.AWSAccessKeyID: "new"}},
+			expectedError: `forbidden: "bob@acme.com" doesn't have admin rights`,
+		},
+		{
+			name:          "unknown credentials are rejected",
+			userInfo:      &provider.UserInfo{Email: "bob@acme.com", IsAdmin: true},
+			rotation:      &provider.CredentialRotation{ProviderName: "aws", PresetName: "team", NewCredentials: map[string]string{"token": "new"}},
+			expectedError: `unknown credential "token", the credentials of aws are [accessKeyId secretAccessKey]`,
+		},
+		{
+			name:     "preset and the clusters using its credentials are rotated",
+			userInfo: &provider.UserInfo{Email: "bob@acme.com", IsAdmin: true},
+			rotation: &provider.CredentialRotation{
+				ProviderName:   "aws",
+				PresetName:     "team",
+				NewCredentials: map[string]string{resources.AWSAccessKeyID: "new", resources.AWSSecretAccessKey: "secret-new"},
+			},
+			expectedClusters: []string{"a"},
+		},
+		{
+			name:     "clusters using the given credentials are rotated",
+			userInfo: &provider.UserInfo{Email: "bob@acme.com", IsAdmin: true},
+			rotation: &provider.CredentialRotation{
+				ProviderName:   "aws",
+				Credentials:    map[string]string{resources.AWSAccessKeyID: "other"},
+				NewCredentials: map[string]string{resources.AWSAccessKeyID: "new", resources.AWSSecretAccessKey: "secret-new"},
+			},
+			expectedClusters: []string{"b"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme,
+				preset.DeepCopy(), genCluster("a"), genSecret("a", "old"), genCluster("b"), genSecret("b", "other"))
+			seedsGetter := func() (map[string]*kubermaticv1.Seed, error) {
+				return map[string]*kubermaticv1.Seed{"europe": {ObjectMeta: metav1.ObjectMeta{Name: "europe"}}}, nil
+			}
+			seedClientGetter := func(*kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+				return client, nil
+			}
+			rotationProvider := kubernetes.NewCredentialRotationProvider(context.Background(), client, seedsGetter, seedClientGetter)
+
+			results, err := rotationProvider.Rotate(tc.userInfo, tc.rotation)
+			if tc.expectedError != "" {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("failed to rotate credentials: %v", err)
+			}
+
+			if len(results) != len(tc.expectedClusters) {
+				t.Fatalf("expected results for clusters %v, got %v", tc.expectedClusters, results)
+			}
+			for i, clusterName := range tc.expectedClusters {
+				if results[i].ClusterName != clusterName || results[i].SeedName != "europe" || results[i].Error != nil {
+					t.Fatalf("unexpected result for cluster %s: %+v", clusterName, results[i])
+				}
+
+				secret := &corev1.Secret{}
+				if err := client.Get(context.Background(), types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: "credential-aws-" + clusterName}, secret); err != nil {
+					t.Fatal(err)
+				}
+				if string(secret.Data[resources.AWSAccessKeyID]) != "new" || string(secret.Data[resources.AWSSecretAccessKey]) != "secret-new" {
+					t.Errorf("credentials of cluster %s were not rotated: %v", clusterName, secret.Data)
+				}
+				cluster := &kubermaticv1.Cluster{}
+				if err := client.Get(context.Background(), types.NamespacedName{Name: clusterName}, cluster); err != nil {
+					t.Fatal(err)
+				}
+				if cluster.Annotations[kubernetes.CredentialsRotatedAtAnnotation] == "" {
+					t.Errorf("cluster %s was not updated", clusterName)
+				}
+			}
+
+			if tc.rotation.PresetName != "" {
+				updatedPreset := &kubermaticv1.Preset{}
+				if err := client.Get(context.Background(), types.NamespacedName{Name: "team"}, updatedPreset); err != nil {
+					t.Fatal(err)
+				}
+				if updatedPreset.Spec.AWS.AccessKeyID != "new" || updatedPreset.Spec.AWS.SecretAccessKey != "secret-new" || updatedPreset.Spec.AWS.VPCID != "vpc-1" {
+					t.Errorf("preset was not rotated: %+v", updatedPreset.Spec.AWS)
+				}
+			}
+		})
+	}
+}
+
+// failingSecretClient fails to patch the given secret as long as fail is set
+type failingSecretClient struct {
+	ctrlruntimeclient.Client
+	secretName string
+	fail       bool
+}
+
+func (c *failingSecretClient) Patch(ctx context.Context, obj runtime.Object, patch ctrlruntimeclient.Patch, opts ...ctrlruntimeclient.PatchOption) error {
+	if secret, ok := obj.(*corev1.Secret); ok && c.fail && secret.Name == c.secretName {
+		return errors.New("connection refused")
+	}
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func TestRotateCredentialsRetry(t *testing.T) {
+	genSecret := func(clusterName string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credential-hetzner-" + clusterName, Namespace: resources.KubermaticNamespace},
+			Data:       map[string][]byte{resources.HetznerToken: []byte("old")},
+		}
+	}
+	genCluster := func(name string) *kubermaticv1.Cluster {
+		return &kubermaticv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       kubermaticv1.ClusterSpec{Cloud: kubermaticv1.CloudSpec{Hetzner: &kubermaticv1.HetznerCloudSpec{}}},
+		}
+	}
+	client := &failingSecretClient{
+		Client: fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme,
+			&kubermaticv1.Preset{ObjectMeta: metav1.ObjectMeta{Name: "team"}, Spec: kubermaticv1.PresetSpec{Hetzner: &kubermaticv1.Hetzner{Token: "old"}}},
+			genCluster("a"), genSecret("a"), genCluster("b"), genSecret("b"), genCluster("inline")),
+		secretName: "credential-hetzner-b",
+		fail:       true,
+	}
+	seedsGetter := func() (map[string]*kubermaticv1.Seed, error) {
+		return map[string]*kubermaticv1.Seed{"europe": {ObjectMeta: metav1.ObjectMeta{Name: "europe"}}}, nil
+	}
+	seedClientGetter := func(*kubermaticv1.Seed) (ctrlruntimeclient.Client, error) {
+		return client, nil
+	}
+	rotationProvider := kubernetes.NewCredentialRotationProvider(context.Background(), client, seedsGetter, seedClientGetter)
+	userInfo := &provider.UserInfo{Email: "bob@acme.com", IsAdmin: true}
+	rotation := &provider.CredentialRotation{ProviderName: "hetzner", PresetName: "team", NewCredentials: map[string]string{resources.HetznerToken: "new"}}
+	presetToken := func() string {
+		preset := &kubermaticv1.Preset{}
+		if err := client.Get(context.Background(), types.NamespacedName{Name: "team"}, preset); err != nil {
+			t.Fatal(err)
+		}
+		return preset.Spec.Hetzner.Token
+	}
+	resultErrors := func(results []provider.CredentialRotationResult) map[string]bool {
+		failed := map[string]bool{}
+		for _, result := range results {
+			failed[result.ClusterName] = result.Error != nil
+		}
+		return failed
+	}
+
+	// The cluster b fails, so the preset is kept to find it again on the next attempt
+	results, err := rotationProvider.Rotate(userInfo, rotation)
+	if err != nil {
+		t.Fatalf("failed to rotate credentials: %v", err)
+	}
+	if failed := resultErrors(results); len(failed) != 3 || failed["a"] || !failed["b"] || !failed["inline"] {
+		t.Fatalf("expected a to be rotated and b and inline to be reported as failed, got %+v", results)
+	}
+	if token := presetToken(); token != "old" {
+		t.Fatalf("expected the preset to be kept after a failure, got token %q", token)
+	}
+
+	client.fail = false
+	results, err = rotationProvider.Rotate(userInfo, rotation)
+	if err != nil {
+		t.Fatalf("failed to retry the rotation: %v", err)
+	}
+	// Clusters with inline credentials can not be rotated, they must not block the preset
+	if failed := resultErrors(results); len(failed) != 2 || failed["b"] || !failed["inline"] {
+		t.Fatalf("expected b to be rotated and inline to be reported as failed, got %+v", results)
+	}
+	if token := presetToken(); token != "new" {
+		t.Errorf("expected the preset to be rotated, got token %q", token)
+	}
+	for _, clusterName := range []string{"a", "b"} {
+		secret := &corev1.Secret{}
+		if err := client.Get(context.Background(), types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: "credential-hetzner-" + clusterName}, secret); err != nil {
+			t.Fatal(err)
+		}
+		if string(secret.Data[resources.HetznerToken]) != "new" {
+			t.Errorf("credentials of cluster %s were not rotated: %v", clusterName, secret.Data)
+		}
+	}
+}
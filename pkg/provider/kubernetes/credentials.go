@@ -262,7 +262,30 @@ func createOrUpdateOpenstackSecret(ctx context.Context, seedClient ctrlruntimecl
 	spec := cluster.Spec.Cloud.Openstack
 
 	// already migrated
-	if spec.Username == "" && spec.Password == "" && spec.Tenant == "" && spec.TenantID == "" && spec.Domain == "" {
+	if spec.Username == "" && spec.Password == "" && spec.Tenant == "" && spec.TenantID == "" && spec.Domain == "" &&
+		spec.ApplicationCredentialID == "" && spec.ApplicationCredentialSecret == "" {
+		return nil
+	}
+
+	if spec.ApplicationCredentialID != "" {
+		credentialRef, err := ensureCredentialSecret(ctx, seedClient, cluster, map[string][]byte{
+			resources.OpenstackApplicationCredentialID:     []byte(spec.ApplicationCredentialID),
+			resources.OpenstackApplicationCredentialSecret: []byte(spec.ApplicationCredentialSecret),
+		})
+		if err != nil {
+			return err
+		}
+
+		cluster.Spec.Cloud.Openstack.CredentialsReference = credentialRef
+
+		cluster.Spec.Cloud.Openstack.ApplicationCredentialID = ""
+		cluster.Spec.Cloud.Openstack.ApplicationCredentialSecret = ""
+		cluster.Spec.Cloud.Openstack.Username = ""
+		cluster.Spec.Cloud.Openstack.Password = ""
+		cluster.Spec.Cloud.Openstack.Tenant = ""
+		cluster.Spec.Cloud.Openstack.TenantID = ""
+		cluster.Spec.Cloud.Openstack.Domain = ""
+
 		return nil
 	}
 
@@ -19,6 +19,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"k8c.io/kubermatic/v2/pkg/controller/master-controller-manager/rbac"
@@ -30,6 +31,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -194,6 +196,95 @@ func (p *ProjectMemberProvider) MappingsFor(userEmail string) ([]*kubermaticapiv
 	return memberMappings, nil
 }
 
+// MapGroupsToGroup maps the given groups of the identity provider to a specific group of the given project
+// This function is unsafe in a sense that it uses privileged account to list all group bindings in the system
+func (p *ProjectMemberProvider) MapGroupsToGroup(groups []string, projectID string) (string, error) {
+	roles, err := p.mapGroupsToRoles(groups)
+	if err != nil {
+		return "", err
+	}
+
+	if role, ok := roles[projectID]; ok {
+		return rbac.GenerateActualGroupNameFor(projectID, role), nil
+	}
+
+	return "", kerrors.NewForbidden(schema.GroupResource{}, projectID, fmt.Errorf("none of the groups %v belongs to the given project = %s", groups, projectID))
+}
+
+// GroupMappingsFor returns the list of projects (bindings) the given user belongs to through the given groups of the identity provider
+// Note that the returned bindings are not persisted, they only reflect the GroupProjectBindings for the given groups
+// This function is unsafe in a sense that it uses privileged account to list all group bindings in the system
+func (p *ProjectMemberProvider) GroupMappingsFor(userEmail string, groups []string) ([]*kubermaticapiv1.UserProjectBinding, error) {
+	roles, err := p.mapGroupsToRoles(groups)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIDs := make([]string, 0, len(roles))
+	for projectID := range roles {
+		projectIDs = append(projectIDs, projectID)
+	}
+	sort.Strings(projectIDs)
+
+	memberMappings := []*kubermaticapiv1.UserProjectBinding{}
+	for _, projectID := range projectIDs {
+		memberMappings = append(memberMappings, &kubermaticapiv1.UserProjectBinding{
+			Spec: kubermaticapiv1.UserProjectBindingSpec{
+				UserEmail: userEmail,
+				ProjectID: projectID,
+				Group:     rbac.GenerateActualGroupNameFor(projectID, roles[projectID]),
+			},
+		})
+	}
+
+	return memberMappings, nil
+}
+
+// mapGroupsToRoles returns the most privileged role per project the given groups are bound to
+func (p *ProjectMemberProvider) mapGroupsToRoles(groups []string) (map[string]string, error) {
+	roles := map[string]string{}
+	if len(groups) == 0 {
+		return roles, nil
+	}
+
+	allGroupBindings := &kubermaticapiv1.GroupProjectBindingList{}
+	if err := p.clientPrivileged.List(context.Background(), allGroupBindings); err != nil {
+		return nil, err
+	}
+
+	userGroups := sets.NewString(groups...)
+	for _, groupBinding := range allGroupBindings.Items {
+		if !userGroups.Has(groupBinding.Spec.Group) {
+			continue
+		}
+		current, ok := roles[groupBinding.Spec.ProjectID]
+		if !ok || isMorePrivilegedRole(groupBinding.Spec.Role, current) {
+			roles[groupBinding.Spec.ProjectID] = groupBinding.Spec.Role
+		}
+	}
+
+	return roles, nil
+}
+
+// isMorePrivilegedRole tells if the role a takes precedence over the role b
+// the built-in groups are ordered as in rbac.AllGroupsPrefixes and take precedence over custom project roles,
+// custom project roles are ordered alphabetically to keep the mapping stable
+func isMorePrivilegedRole(a, b string) bool {
+	rolePriority := func(role string) int {
+		for i, prefix := range rbac.AllGroupsPrefixes {
+			if prefix == role {
+				return i
+			}
+		}
+		return len(rbac.AllGroupsPrefixes)
+	}
+
+	if rolePriority(a) != rolePriority(b) {
+		return rolePriority(a) < rolePriority(b)
+	}
+	return a < b
+}
+
 // CreateUnsecured creates a binding for the given member and the given project
 // This function is unsafe in a sense that it uses privileged account to create the resource
 func (p *ProjectMemberProvider) CreateUnsecured(project *kubermaticapiv1.Project, memberEmail, group string) (*kubermaticapiv1.UserProjectBinding, error) {
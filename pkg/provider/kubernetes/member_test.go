@@ -152,3 +152,116 @@ func TestListBinding(t *testing.T) {
 		})
 	}
 }
+
+func TestMapGroupsToGroup(t *testing.T) {
+	// test data
+	testcases := []struct {
+		name                  string
+		groups                []string
+		projectID             string
+		existingGroupBindings []*kubermaticv1.GroupProjectBinding
+		expectedGroup         string
+		expectedError         string
+	}{
+		{
+			name:      "scenario 1: the group of the identity provider is mapped to the role within the project",
+			groups:    []string{"developers"},
+			projectID: "my-first-project-ID",
+			existingGroupBindings: []*kubermaticv1.GroupProjectBinding{
+				createGroupBinding("abcdBinding", "my-first-project-ID", "developers", "editors"),
+				createGroupBinding("differentProjectBinding", "abcd", "developers", "owners"),
+			},
+			expectedGroup: "editors-my-first-project-ID",
+		},
+		{
+			name:      "scenario 2: the most privileged role wins when the user belongs to many bound groups",
+			groups:    []string{"developers", "auditors", "admins"},
+			projectID: "my-first-project-ID",
+			existingGroupBindings: []*kubermaticv1.GroupProjectBinding{
+				createGroupBinding("abcdBinding", "my-first-project-ID", "auditors", "clusteroperator"),
+				createGroupBinding("cdBinding", "my-first-project-ID", "developers", "viewers"),
+				createGroupBinding("efBinding", "my-first-project-ID", "admins", "owners"),
+			},
+			expectedGroup: "owners-my-first-project-ID",
+		},
+		{
+			name:      "scenario 3: none of the groups is bound to the project",
+			groups:    []string{"developers"},
+			projectID: "my-first-project-ID",
+			existingGroupBindings: []*kubermaticv1.GroupProjectBinding{
+				createGroupBinding("abcdBinding", "my-first-project-ID", "auditors", "viewers"),
+			},
+			expectedError: "forbidden: none of the groups [developers] belongs to the given project = my-first-project-ID",
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			kubermaticObjects := []runtime.Object{}
+			for _, binding := range tc.existingGroupBindings {
+				kubermaticObjects = append(kubermaticObjects, binding)
+			}
+			fakeClient := fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme, kubermaticObjects...)
+			fakeImpersonationClient := func(impCfg restclient.ImpersonationConfig) (ctrlruntimeclient.Client, error) {
+				return fakeClient, nil
+			}
+			// act
+			target := kubernetes.NewProjectMemberProvider(fakeImpersonationClient, fakeClient, kubernetes.IsServiceAccount)
+			group, err := target.MapGroupsToGroup(tc.groups, tc.projectID)
+
+			// validate
+			if len(tc.expectedError) > 0 {
+				if err == nil || err.Error() != tc.expectedError {
+					t.Fatalf("expected error %q, got %v", tc.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if group != tc.expectedGroup {
+				t.Fatalf("unexpected group returned, expected %s, got %s", tc.expectedGroup, group)
+			}
+		})
+	}
+}
+
+func TestGroupMappingsFor(t *testing.T) {
+	// test data
+	existingGroupBindings := []runtime.Object{
+		createGroupBinding("abcdBinding", "my-first-project-ID", "developers", "editors"),
+		createGroupBinding("cdBinding", "my-second-project-ID", "auditors", "viewers"),
+		createGroupBinding("efBinding", "my-third-project-ID", "admins", "owners"),
+	}
+	fakeClient := fakectrlruntimeclient.NewFakeClientWithScheme(scheme.Scheme, existingGroupBindings...)
+	fakeImpersonationClient := func(impCfg restclient.ImpersonationConfig) (ctrlruntimeclient.Client, error) {
+		return fakeClient, nil
+	}
+	expectedBindings := []*kubermaticv1.UserProjectBinding{
+		{
+			Spec: kubermaticv1.UserProjectBindingSpec{
+				UserEmail: "bob@acme.com",
+				ProjectID: "my-first-project-ID",
+				Group:     "editors-my-first-project-ID",
+			},
+		},
+		{
+			Spec: kubermaticv1.UserProjectBindingSpec{
+				UserEmail: "bob@acme.com",
+				ProjectID: "my-second-project-ID",
+				Group:     "viewers-my-second-project-ID",
+			},
+		},
+	}
+
+	// act
+	target := kubernetes.NewProjectMemberProvider(fakeImpersonationClient, fakeClient, kubernetes.IsServiceAccount)
+	result, err := target.GroupMappingsFor("bob@acme.com", []string{"developers", "auditors"})
+
+	// validate
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := deep.Equal(result, expectedBindings); diff != nil {
+		t.Fatalf("unexpected bindings returned, diff = %v", diff)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OperationProvider is a object to track asynchronous actions on clusters
+type OperationProvider struct {
+	client ctrlruntimeclient.Client
+	// apiReader reads the operations directly from the API server, clients poll an operation right
+	// after starting it and must not miss it or its latest status because the cache is not up to date yet
+	apiReader ctrlruntimeclient.Reader
+	ctx       context.Context
+}
+
+var _ provider.OperationProvider = &OperationProvider{}
+
+// NewOperationProvider returns an operation provider
+func NewOperationProvider(ctx context.Context, client ctrlruntimeclient.Client, apiReader ctrlruntimeclient.Reader) *OperationProvider {
+	return &OperationProvider{client: client, apiReader: apiReader, ctx: ctx}
+}
+
+// CreateUnsecured starts tracking the given action on the cluster
+func (p *OperationProvider) CreateUnsecured(project *kubermaticv1.Project, clusterID string, operationType kubermaticv1.OperationType, version, userEmail string) (*kubermaticv1.Operation, error) {
+	operation := &kubermaticv1.Operation{
+		ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.ProjectKindName,
+					UID:        project.GetUID(),
+					Name:       project.Name,
+				},
+			},
+			Name: rand.String(10),
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey:        project.Name,
+				kubermaticv1.OperationClusterLabelKey: clusterID,
+			},
+		},
+		Spec: kubermaticv1.OperationSpec{
+			Type:      operationType,
+			ProjectID: project.Name,
+			ClusterID: clusterID,
+			Version:   version,
+			UserEmail: userEmail,
+		},
+		Status: kubermaticv1.OperationStatus{
+			Phase: kubermaticv1.OperationPhaseRunning,
+		},
+	}
+	if err := p.client.Create(p.ctx, operation); err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// GetUnsecured returns the given operation
+func (p *OperationProvider) GetUnsecured(operationID string) (*kubermaticv1.Operation, error) {
+	operation := &kubermaticv1.Operation{}
+	if err := p.apiReader.Get(p.ctx, ctrlruntimeclient.ObjectKey{Name: operationID}, operation); err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// ListUnsecured returns the operations of the given project, newest first
+func (p *OperationProvider) ListUnsecured(projectID, clusterID string) ([]kubermaticv1.Operation, error) {
+	labels := ctrlruntimeclient.MatchingLabels{kubermaticv1.ProjectIDLabelKey: projectID}
+	if clusterID != "" {
+		labels[kubermaticv1.OperationClusterLabelKey] = clusterID
+	}
+	operationList := &kubermaticv1.OperationList{}
+	if err := p.apiReader.List(p.ctx, operationList, labels); err != nil {
+		return nil, fmt.Errorf("failed to list operations: %v", err)
+	}
+
+	operations := operationList.Items
+	sort.SliceStable(operations, func(i, j int) bool {
+		return operations[j].CreationTimestamp.Before(&operations[i].CreationTimestamp)
+	})
+	return operations, nil
+}
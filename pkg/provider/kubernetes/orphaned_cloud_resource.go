@@ -0,0 +1,54 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OrphanedCloudResourceProvider is a object to read the orphaned cloud resources
+type OrphanedCloudResourceProvider struct {
+	client ctrlruntimeclient.Client
+	ctx    context.Context
+}
+
+var _ provider.OrphanedCloudResourceProvider = &OrphanedCloudResourceProvider{}
+
+// NewOrphanedCloudResourceProvider returns an orphaned cloud resource provider
+func NewOrphanedCloudResourceProvider(ctx context.Context, client ctrlruntimeclient.Client) *OrphanedCloudResourceProvider {
+	return &OrphanedCloudResourceProvider{client: client, ctx: ctx}
+}
+
+// List gets all orphaned cloud resources
+func (p *OrphanedCloudResourceProvider) List(userInfo *provider.UserInfo) ([]kubermaticv1.OrphanedCloudResource, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	resourceList := &kubermaticv1.OrphanedCloudResourceList{}
+	if err := p.client.List(p.ctx, resourceList); err != nil {
+		return nil, fmt.Errorf("failed to list orphaned cloud resources: %v", err)
+	}
+	return resourceList.Items, nil
+}
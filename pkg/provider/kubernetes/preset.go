@@ -349,6 +349,8 @@ func (m *PresetsProvider) setOpenStackCredentials(userInfo *provider.UserInfo, p
 	cloud.Openstack.Domain = credentials.Domain
 	cloud.Openstack.Tenant = credentials.Tenant
 	cloud.Openstack.TenantID = credentials.TenantID
+	cloud.Openstack.ApplicationCredentialID = credentials.ApplicationCredentialID
+	cloud.Openstack.ApplicationCredentialSecret = credentials.ApplicationCredentialSecret
 
 	cloud.Openstack.SubnetID = credentials.SubnetID
 	cloud.Openstack.Network = credentials.Network
@@ -533,6 +533,27 @@ func TestCredentialEndpoint(t *testing.T) {
 			cloudSpec:         kubermaticv1.CloudSpec{Openstack: &kubermaticv1.OpenstackCloudSpec{}},
 			expectedCloudSpec: &kubermaticv1.CloudSpec{Openstack: &kubermaticv1.OpenstackCloudSpec{Tenant: "a", Domain: "b", Password: "c", Username: "d"}},
 		},
+		{
+			name:       "test 7b: set application credentials for OpenStack provider",
+			presetName: "test",
+			userInfo:   provider.UserInfo{Email: "test@example.com"},
+			presets: []runtime.Object{
+				&kubermaticv1.Preset{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test",
+					},
+					Spec: kubermaticv1.PresetSpec{
+						RequiredEmailDomain: "example.com",
+						Openstack: &kubermaticv1.Openstack{
+							ApplicationCredentialID: "a", ApplicationCredentialSecret: "b",
+						},
+					},
+				},
+			},
+			dc:                &kubermaticv1.Datacenter{Spec: kubermaticv1.DatacenterSpec{Openstack: &kubermaticv1.DatacenterSpecOpenstack{EnforceFloatingIP: false}}},
+			cloudSpec:         kubermaticv1.CloudSpec{Openstack: &kubermaticv1.OpenstackCloudSpec{}},
+			expectedCloudSpec: &kubermaticv1.CloudSpec{Openstack: &kubermaticv1.OpenstackCloudSpec{ApplicationCredentialID: "a", ApplicationCredentialSecret: "b"}},
+		},
 		{
 			name:       "test 8: set credentials for Vsphere provider",
 			presetName: "test",
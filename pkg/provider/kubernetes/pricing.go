@@ -0,0 +1,127 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PriceListProvider is a object to handle the price lists of the datacenters
+type PriceListProvider struct {
+	client ctrlruntimeclient.Client
+	ctx    context.Context
+}
+
+var _ provider.PriceListProvider = &PriceListProvider{}
+
+// NewPriceListProvider returns a price list provider
+func NewPriceListProvider(ctx context.Context, client ctrlruntimeclient.Client) *PriceListProvider {
+	return &PriceListProvider{client: client, ctx: ctx}
+}
+
+// GetUnsecured returns the price list of the given datacenter
+func (p *PriceListProvider) GetUnsecured(datacenter string) (*kubermaticv1.PriceList, error) {
+	priceList := &kubermaticv1.PriceList{}
+	if err := p.client.Get(p.ctx, ctrlruntimeclient.ObjectKey{Name: datacenter}, priceList); err != nil {
+		return nil, err
+	}
+	return priceList, nil
+}
+
+// List gets all price lists
+func (p *PriceListProvider) List(userInfo *provider.UserInfo) ([]kubermaticv1.PriceList, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	priceListList := &kubermaticv1.PriceListList{}
+	if err := p.client.List(p.ctx, priceListList); err != nil {
+		return nil, fmt.Errorf("failed to list price lists: %v", err)
+	}
+	return priceListList.Items, nil
+}
+
+// CreateOrUpdate stores the given price list
+func (p *PriceListProvider) CreateOrUpdate(userInfo *provider.UserInfo, priceList *kubermaticv1.PriceList) (*kubermaticv1.PriceList, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	existing, err := p.GetUnsecured(priceList.Name)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err := p.client.Create(p.ctx, priceList); err != nil {
+			return nil, err
+		}
+		return priceList, nil
+	}
+	existing.Spec = priceList.Spec
+	if err := p.client.Update(p.ctx, existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+// Delete deletes the price list of the given datacenter
+func (p *PriceListProvider) Delete(userInfo *provider.UserInfo, datacenter string) error {
+	if !userInfo.IsAdmin {
+		return kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	return p.client.Delete(p.ctx, &kubermaticv1.PriceList{ObjectMeta: metav1.ObjectMeta{Name: datacenter}})
+}
+
+// UsageReportProvider is a object to read the usage reports of the projects
+type UsageReportProvider struct {
+	client ctrlruntimeclient.Client
+	ctx    context.Context
+}
+
+var _ provider.UsageReportProvider = &UsageReportProvider{}
+
+// NewUsageReportProvider returns a usage report provider
+func NewUsageReportProvider(ctx context.Context, client ctrlruntimeclient.Client) *UsageReportProvider {
+	return &UsageReportProvider{client: client, ctx: ctx}
+}
+
+// ListUnsecured returns the usage reports of the given project and period
+func (p *UsageReportProvider) ListUnsecured(projectID, period string) ([]kubermaticv1.UsageReport, error) {
+	reportList := &kubermaticv1.UsageReportList{}
+	if err := p.client.List(p.ctx, reportList); err != nil {
+		return nil, fmt.Errorf("failed to list usage reports: %v", err)
+	}
+
+	reports := []kubermaticv1.UsageReport{}
+	for _, report := range reportList.Items {
+		if projectID != "" && report.Spec.ProjectID != projectID {
+			continue
+		}
+		if period != "" && report.Spec.Period != period {
+			continue
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NewProjectInvitationProvider returns a project invitation provider
+func NewProjectInvitationProvider(createMasterImpersonatedClient impersonationClient, clientPrivileged ctrlruntimeclient.Client) *ProjectInvitationProvider {
+	return &ProjectInvitationProvider{
+		createMasterImpersonatedClient: createMasterImpersonatedClient,
+		clientPrivileged:               clientPrivileged,
+	}
+}
+
+var _ provider.ProjectInvitationProvider = &ProjectInvitationProvider{}
+var _ provider.PrivilegedProjectInvitationProvider = &ProjectInvitationProvider{}
+
+// ProjectInvitationProvider manages the pending invitations of users to projects
+type ProjectInvitationProvider struct {
+	// createMasterImpersonatedClient is used as a ground for impersonation
+	createMasterImpersonatedClient impersonationClient
+
+	// treat clientPrivileged as a privileged user and use wisely
+	clientPrivileged ctrlruntimeclient.Client
+}
+
+// List gets all invitations of the given project
+func (p *ProjectInvitationProvider) List(userInfo *provider.UserInfo, project *kubermaticapiv1.Project) ([]*kubermaticapiv1.ProjectInvitation, error) {
+	invitations, err := p.ListUnsecured(project)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note:
+	// After we get the list of invitations we try to get at least one item using unprivileged account to see if the user have read access
+	if len(invitations) > 0 {
+		masterImpersonatedClient, err := createImpersonationClientWrapperFromUserInfo(userInfo, p.createMasterImpersonatedClient)
+		if err != nil {
+			return nil, err
+		}
+
+		invitationToGet := invitations[0]
+		if err := masterImpersonatedClient.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: invitationToGet.Name}, &kubermaticapiv1.ProjectInvitation{}); err != nil {
+			return nil, err
+		}
+	}
+
+	return invitations, nil
+}
+
+// Create creates an invitation of the given user to the given project
+func (p *ProjectInvitationProvider) Create(userInfo *provider.UserInfo, project *kubermaticapiv1.Project, email, group string, expiresAt time.Time) (*kubermaticapiv1.ProjectInvitation, error) {
+	invitation := genProjectInvitation(project, email, group, userInfo.Email, expiresAt)
+
+	masterImpersonatedClient, err := createImpersonationClientWrapperFromUserInfo(userInfo, p.createMasterImpersonatedClient)
+	if err != nil {
+		return nil, err
+	}
+	if err := masterImpersonatedClient.Create(context.Background(), invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// Delete revokes the given invitation
+func (p *ProjectInvitationProvider) Delete(userInfo *provider.UserInfo, invitationName string) error {
+	masterImpersonatedClient, err := createImpersonationClientWrapperFromUserInfo(userInfo, p.createMasterImpersonatedClient)
+	if err != nil {
+		return err
+	}
+	return masterImpersonatedClient.Delete(context.Background(), &kubermaticapiv1.ProjectInvitation{ObjectMeta: metav1.ObjectMeta{Name: invitationName}})
+}
+
+// GetUnsecured gets the given invitation
+// This function is unsafe in a sense that it uses privileged account to get the resource
+func (p *ProjectInvitationProvider) GetUnsecured(invitationName string) (*kubermaticapiv1.ProjectInvitation, error) {
+	invitation := &kubermaticapiv1.ProjectInvitation{}
+	if err := p.clientPrivileged.Get(context.Background(), ctrlruntimeclient.ObjectKey{Name: invitationName}, invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// ListUnsecured gets all invitations of the given project
+// This function is unsafe in a sense that it uses privileged account to get the resources
+func (p *ProjectInvitationProvider) ListUnsecured(project *kubermaticapiv1.Project) ([]*kubermaticapiv1.ProjectInvitation, error) {
+	return p.list(func(invitation *kubermaticapiv1.ProjectInvitation) bool {
+		return invitation.Spec.ProjectID == project.Name
+	})
+}
+
+// ListForEmailUnsecured gets all invitations of the user with the given email
+// This function is unsafe in a sense that it uses privileged account to get the resources
+func (p *ProjectInvitationProvider) ListForEmailUnsecured(email string) ([]*kubermaticapiv1.ProjectInvitation, error) {
+	return p.list(func(invitation *kubermaticapiv1.ProjectInvitation) bool {
+		return strings.EqualFold(invitation.Spec.UserEmail, email)
+	})
+}
+
+// CreateUnsecured creates an invitation of the given user to the given project
+// This function is unsafe in a sense that it uses privileged account to create the resource
+func (p *ProjectInvitationProvider) CreateUnsecured(project *kubermaticapiv1.Project, email, group, invitedBy string, expiresAt time.Time) (*kubermaticapiv1.ProjectInvitation, error) {
+	invitation := genProjectInvitation(project, email, group, invitedBy, expiresAt)
+
+	if err := p.clientPrivileged.Create(context.Background(), invitation); err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// DeleteUnsecured deletes the given invitation
+// This function is unsafe in a sense that it uses privileged account to delete the resource
+func (p *ProjectInvitationProvider) DeleteUnsecured(invitationName string) error {
+	return p.clientPrivileged.Delete(context.Background(), &kubermaticapiv1.ProjectInvitation{ObjectMeta: metav1.ObjectMeta{Name: invitationName}})
+}
+
+func (p *ProjectInvitationProvider) list(filter func(*kubermaticapiv1.ProjectInvitation) bool) ([]*kubermaticapiv1.ProjectInvitation, error) {
+	allInvitations := &kubermaticapiv1.ProjectInvitationList{}
+	if err := p.clientPrivileged.List(context.Background(), allInvitations); err != nil {
+		return nil, err
+	}
+
+	invitations := []*kubermaticapiv1.ProjectInvitation{}
+	for i := range allInvitations.Items {
+		if filter(&allInvitations.Items[i]) {
+			invitations = append(invitations, allInvitations.Items[i].DeepCopy())
+		}
+	}
+
+	return invitations, nil
+}
+
+func genProjectInvitation(project *kubermaticapiv1.Project, email, group, invitedBy string, expiresAt time.Time) *kubermaticapiv1.ProjectInvitation {
+	return &kubermaticapiv1.ProjectInvitation{
+		ObjectMeta: metav1.ObjectMeta{
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticapiv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticapiv1.ProjectKindName,
+					UID:        project.GetUID(),
+					Name:       project.Name,
+				},
+			},
+			Name: rand.String(10),
+		},
+		Spec: kubermaticapiv1.ProjectInvitationSpec{
+			ProjectID: project.Name,
+			UserEmail: email,
+			Group:     group,
+			InvitedBy: invitedBy,
+			ExpiresAt: metav1.NewTime(expiresAt),
+		},
+	}
+}
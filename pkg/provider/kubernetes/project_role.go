@@ -0,0 +1,100 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ProjectRoleProvider is a object to handle custom project roles
+type ProjectRoleProvider struct {
+	client ctrlruntimeclient.Client
+	ctx    context.Context
+}
+
+var _ provider.ProjectRoleProvider = &ProjectRoleProvider{}
+
+// NewProjectRoleProvider returns a project role provider
+func NewProjectRoleProvider(ctx context.Context, client ctrlruntimeclient.Client) *ProjectRoleProvider {
+	return &ProjectRoleProvider{client: client, ctx: ctx}
+}
+
+// List gets all project roles
+func (p *ProjectRoleProvider) List(userInfo *provider.UserInfo) ([]kubermaticv1.ProjectRole, error) {
+	projectRoleList := &kubermaticv1.ProjectRoleList{}
+	if err := p.client.List(p.ctx, projectRoleList); err != nil {
+		return nil, fmt.Errorf("failed to list project roles: %v", err)
+	}
+	return projectRoleList.Items, nil
+}
+
+// Get gets the given project role
+func (p *ProjectRoleProvider) Get(userInfo *provider.UserInfo, name string) (*kubermaticv1.ProjectRole, error) {
+	projectRole := &kubermaticv1.ProjectRole{}
+	if err := p.client.Get(p.ctx, ctrlruntimeclient.ObjectKey{Name: name}, projectRole); err != nil {
+		return nil, err
+	}
+	return projectRole, nil
+}
+
+// Create creates the given project role
+func (p *ProjectRoleProvider) Create(userInfo *provider.UserInfo, projectRole *kubermaticv1.ProjectRole) (*kubermaticv1.ProjectRole, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	if err := p.client.Create(p.ctx, projectRole); err != nil {
+		return nil, err
+	}
+	return projectRole, nil
+}
+
+// Update updates the given project role
+func (p *ProjectRoleProvider) Update(userInfo *provider.UserInfo, projectRole *kubermaticv1.ProjectRole) (*kubermaticv1.ProjectRole, error) {
+	if !userInfo.IsAdmin {
+		return nil, kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	oldProjectRole, err := p.Get(userInfo, projectRole.Name)
+	if err != nil {
+		return nil, err
+	}
+	updatedProjectRole := oldProjectRole.DeepCopy()
+	updatedProjectRole.Spec = projectRole.Spec
+	if err := p.client.Patch(p.ctx, updatedProjectRole, ctrlruntimeclient.MergeFrom(oldProjectRole)); err != nil {
+		return nil, fmt.Errorf("failed to update project role: %v", err)
+	}
+	return updatedProjectRole, nil
+}
+
+// Delete deletes the given project role
+func (p *ProjectRoleProvider) Delete(userInfo *provider.UserInfo, name string) error {
+	if !userInfo.IsAdmin {
+		return kerrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%q doesn't have admin rights", userInfo.Email))
+	}
+	projectRole, err := p.Get(userInfo, name)
+	if err != nil {
+		return err
+	}
+	return p.client.Delete(p.ctx, projectRole)
+}
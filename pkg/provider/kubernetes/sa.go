@@ -33,6 +33,9 @@ import (
 const (
 	ServiceAccountLabelGroup = "initialGroup"
 	saPrefix                 = "serviceaccount-"
+
+	// ServiceAccountAnnotationCreator holds the email address of the user who created the service account
+	ServiceAccountAnnotationCreator = "kubermatic.io/creator"
 )
 
 // NewServiceAccountProvider returns a service account provider
@@ -66,6 +69,7 @@ func (p *ServiceAccountProvider) Create(userInfo *provider.UserInfo, project *ku
 	}
 
 	sa := genServiceAccount(project, name, group, p.domain)
+	sa.Annotations = map[string]string{ServiceAccountAnnotationCreator: userInfo.Email}
 
 	masterImpersonatedClient, err := createImpersonationClientWrapperFromUserInfo(userInfo, p.createMasterImpersonatedClient)
 	if err != nil {
@@ -57,6 +57,7 @@ func TestCreateServiceAccount(t *testing.T) {
 			},
 			expectedSA: func() *kubermaticv1.User {
 				sa := createSANoPrefix("test", "my-first-project-ID", "editors", "1")
+				sa.Annotations = map[string]string{kubernetes.ServiceAccountAnnotationCreator: "john@acme.com"}
 				sa.ResourceVersion = "1"
 				return sa
 			}(),
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/provider"
@@ -33,8 +34,14 @@ import (
 )
 
 const (
-	labelTokenName = "token"
-	tokenPrefix    = "sa-token-"
+	labelTokenName         = "token"
+	labelPreviousTokenName = "previous-token"
+	tokenPrefix            = "sa-token-"
+
+	// TokenLastUsedAnnotation holds the time when the token was last used to authenticate
+	TokenLastUsedAnnotation = "kubermatic.io/token-last-used"
+	// PreviousTokenExpiryAnnotation holds the time until the token replaced by a rotation is accepted
+	PreviousTokenExpiryAnnotation = "kubermatic.io/previous-token-expiry"
 )
 
 // NewServiceAccountProvider returns a service account provider
@@ -140,7 +147,7 @@ func (p *ServiceAccountTokenProvider) List(userInfo *provider.UserInfo, project
 
 	resultList := make([]*v1.Secret, 0)
 	for _, secret := range allSecrets.Items {
-		if isToken(&secret) {
+		if IsToken(&secret) {
 			for _, owner := range secret.GetOwnerReferences() {
 				if owner.APIVersion == kubermaticv1.SchemeGroupVersion.String() && owner.Kind == kubermaticv1.UserKindName &&
 					owner.Name == sa.Name && owner.UID == sa.UID {
@@ -205,7 +212,7 @@ func (p *ServiceAccountTokenProvider) ListUnsecured(options *provider.ServiceAcc
 	}
 	allTokens := []*v1.Secret{}
 	for _, secret := range allSecrets.Items {
-		if isToken(&secret) {
+		if IsToken(&secret) {
 			sCpy := secret.DeepCopy()
 			sCpy.Name = removeTokenPrefix(sCpy.Name)
 			allTokens = append(allTokens, sCpy)
@@ -239,7 +246,8 @@ func (p *ServiceAccountTokenProvider) ListUnsecured(options *provider.ServiceAcc
 	return allTokens, nil
 }
 
-func isToken(secret *v1.Secret) bool {
+// IsToken checks if the given secret holds a service account token
+func IsToken(secret *v1.Secret) bool {
 	if secret == nil {
 		return false
 	}
@@ -366,6 +374,75 @@ func (p *ServiceAccountTokenProvider) DeleteUnsecured(name string) error {
 	return p.kubernetesClientPrivileged.Delete(context.Background(), secret)
 }
 
+// RotateToken replaces the token data of the given secret with the new token.
+// The replaced token is kept and stays valid until the overlap period has passed.
+func RotateToken(secret *v1.Secret, newToken string, overlap time.Duration, now time.Time) {
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	delete(secret.Data, labelPreviousTokenName)
+	delete(secret.Annotations, PreviousTokenExpiryAnnotation)
+
+	if oldToken, ok := secret.Data[labelTokenName]; ok && overlap > 0 {
+		secret.Data[labelPreviousTokenName] = oldToken
+		secret.Annotations[PreviousTokenExpiryAnnotation] = now.Add(overlap).UTC().Format(time.RFC3339)
+	}
+	secret.Data[labelTokenName] = []byte(newToken)
+}
+
+// IsTokenAccepted checks if the given token matches the current token stored in the secret
+// or the previous one if the rotation overlap period hasn't passed yet
+func IsTokenAccepted(secret *v1.Secret, token string, now time.Time) bool {
+	if current, ok := secret.Data[labelTokenName]; ok && string(current) == token {
+		return true
+	}
+	previous, ok := secret.Data[labelPreviousTokenName]
+	if !ok || string(previous) != token {
+		return false
+	}
+	expiry, ok := GetPreviousTokenExpiry(secret)
+	return ok && now.Before(expiry)
+}
+
+// GetPreviousTokenExpiry returns the time until the token replaced by the last rotation is accepted
+func GetPreviousTokenExpiry(secret *v1.Secret) (time.Time, bool) {
+	return getTimeAnnotation(secret, PreviousTokenExpiryAnnotation)
+}
+
+// RemovePreviousToken removes the token replaced by the last rotation from the secret
+func RemovePreviousToken(secret *v1.Secret) {
+	delete(secret.Data, labelPreviousTokenName)
+	delete(secret.Annotations, PreviousTokenExpiryAnnotation)
+}
+
+// GetTokenLastUsed returns the time when the token was last used
+func GetTokenLastUsed(secret *v1.Secret) (time.Time, bool) {
+	return getTimeAnnotation(secret, TokenLastUsedAnnotation)
+}
+
+// SetTokenLastUsed records the time when the token was last used
+func SetTokenLastUsed(secret *v1.Secret, lastUsed time.Time) {
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[TokenLastUsedAnnotation] = lastUsed.UTC().Format(time.RFC3339)
+}
+
+func getTimeAnnotation(secret *v1.Secret, annotation string) (time.Time, bool) {
+	value, ok := secret.Annotations[annotation]
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
 // removeTokenPrefix removes "sa-token-" from a token's ID
 // for example given "sa-token-gmtzqz692d" it returns "gmtzqz692d"
 func removeTokenPrefix(id string) string {
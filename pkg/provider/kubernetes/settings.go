@@ -92,6 +92,7 @@ func (s *SettingsProvider) createDefaultGlobalSettings() (*kubermaticv1.Kubermat
 			UserProjectsLimit:           0,
 			RestrictProjectCreation:     false,
 			EnableExternalClusterImport: true,
+			EnableWebTerminal:           false,
 		},
 	}
 	if err := s.runtimeClient.Create(context.Background(), defaultSettings); err != nil {
@@ -77,6 +77,7 @@ func (p *SSHKeyProvider) Create(userInfo *provider.UserInfo, project *kubermatic
 	if err != nil {
 		return nil, err
 	}
+	sshKey.Spec.Owner = userInfo.Email
 
 	masterImpersonatedClient, err := createImpersonationClientWrapperFromUserInfo(userInfo, p.createMasterImpersonatedClient)
 	if err != nil {
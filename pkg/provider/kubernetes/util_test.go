@@ -75,6 +75,30 @@ func createBinding(name, projectID, email, group string) *kubermaticv1.UserProje
 	return binding
 }
 
+func createGroupBinding(name, projectID, group, role string) *kubermaticv1.GroupProjectBinding {
+	return &kubermaticv1.GroupProjectBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       kubermaticv1.GroupProjectBindingKind,
+			APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.ProjectKindName,
+					Name:       projectID,
+				},
+			},
+		},
+		Spec: kubermaticv1.GroupProjectBindingSpec{
+			Group:     group,
+			ProjectID: projectID,
+			Role:      role,
+		},
+	}
+}
+
 func createSA(name, projectName, group, id string) *kubermaticv1.User {
 	sa := genServiceAccount(id, name, group, projectName)
 	// remove autogenerated values
@@ -0,0 +1,42 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import "fmt"
+
+// quotaWarningThreshold is the share of a quota above which a PreflightChecker warns about the usage
+const quotaWarningThreshold = 0.9
+
+// CheckQuota compares the usage of a quota after a request with its limit. A negative limit means
+// that the quota is unlimited. It returns nil if the request fits well into the quota.
+func CheckQuota(name string, limit, used, required int64) *PreflightCheckResult {
+	if limit < 0 || required <= 0 {
+		return nil
+	}
+	if used+required > limit {
+		return &PreflightCheckResult{
+			Error:   true,
+			Message: fmt.Sprintf("the quota of %s is exceeded: %d of %d are in use and %d more are required", name, used, limit, required),
+		}
+	}
+	if float64(used+required) > quotaWarningThreshold*float64(limit) {
+		return &PreflightCheckResult{
+			Message: fmt.Sprintf("the quota of %s is almost exhausted: %d of %d are going to be in use", name, used+required, limit),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestCheckQuota(t *testing.T) {
+	testCases := []struct {
+		name           string
+		limit          int64
+		used           int64
+		required       int64
+		expectedResult *PreflightCheckResult
+	}{
+		{
+			name:     "request fits into the quota",
+			limit:    20,
+			used:     4,
+			required: 4,
+		},
+		{
+			name:     "unlimited quota",
+			limit:    -1,
+			used:     100,
+			required: 4,
+		},
+		{
+			name:     "nothing is required",
+			limit:    2,
+			used:     4,
+			required: 0,
+		},
+		{
+			name:     "quota is almost exhausted",
+			limit:    20,
+			used:     16,
+			required: 3,
+			expectedResult: &PreflightCheckResult{
+				Message: "the quota of vCPUs is almost exhausted: 19 of 20 are going to be in use",
+			},
+		},
+		{
+			name:     "quota is exceeded",
+			limit:    20,
+			used:     16,
+			required: 8,
+			expectedResult: &PreflightCheckResult{
+				Error:   true,
+				Message: "the quota of vCPUs is exceeded: 16 of 20 are in use and 8 more are required",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := CheckQuota("vCPUs", tc.limit, tc.used, tc.required)
+			if diff := deep.Equal(result, tc.expectedResult); diff != nil {
+				t.Errorf("unexpected result: %v", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	providerconfig "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
 
@@ -75,6 +76,57 @@ type CloudProvider interface {
 	ValidateCloudSpecUpdate(oldSpec kubermaticv1.CloudSpec, newSpec kubermaticv1.CloudSpec) error
 }
 
+// CloudResource is a resource which was created at the cloud provider for a cluster
+type CloudResource struct {
+	Kind string
+	Name string
+}
+
+// CloudResourceLister is implemented by cloud providers which create resources in InitializeCloudProvider,
+// it returns the resources CleanUpCloudProvider is going to remove
+type CloudResourceLister interface {
+	ListCloudResources(cluster *kubermaticv1.Cluster) []CloudResource
+}
+
+// ClusterCloudResource is a resource at the cloud provider which was created for a cluster
+type ClusterCloudResource struct {
+	CloudResource
+	// ID identifies the resource at the cloud provider, it equals the name if the provider identifies resources by name
+	ID string
+	// ClusterName is the name of the cluster the resource was created for. Resources without it are never deleted.
+	ClusterName string
+	// ProjectID is the project of the cluster if the provider records it. Resources whose project does not exist
+	// belong to another installation or to a deleted project and are never deleted.
+	ProjectID string
+}
+
+// CloudResourceSweeper is implemented by cloud providers which are able to find the resources of clusters
+// that were not removed by CleanUpCloudProvider, like after a failed cluster deletion
+type CloudResourceSweeper interface {
+	// ListClusterResources returns all resources which carry the tag or the name of a cluster
+	// and are accessible with the credentials of the given spec
+	ListClusterResources(spec kubermaticv1.CloudSpec) ([]ClusterCloudResource, error)
+	// DeleteClusterResource deletes a resource returned by ListClusterResources, it succeeds if the resource is already gone
+	DeleteClusterResource(spec kubermaticv1.CloudSpec, resource ClusterCloudResource) error
+}
+
+// PreflightCheckResult is a finding of a PreflightChecker
+type PreflightCheckResult struct {
+	// Error is set if the request is going to fail, otherwise the result is a warning
+	Error   bool
+	Message string
+}
+
+// PreflightChecker is implemented by cloud providers which are able to tell upfront whether the quotas and
+// limits of the account suffice for a cluster and its nodes. An error returned by the methods means that
+// the checks could not be run, it does not mean that the checked request is going to fail.
+type PreflightChecker interface {
+	// PreflightCheckCluster checks the resources InitializeCloudProvider is going to create for the given spec
+	PreflightCheckCluster(spec kubermaticv1.CloudSpec) ([]PreflightCheckResult, error)
+	// PreflightCheckNodes checks the resources the given number of additional nodes are going to use
+	PreflightCheckNodes(spec kubermaticv1.CloudSpec, nodeSpec apiv1.NodeCloudSpec, replicas int) ([]PreflightCheckResult, error)
+}
+
 // ClusterUpdater defines a function to persist an update to a cluster
 type ClusterUpdater func(string, func(*kubermaticv1.Cluster)) (*kubermaticv1.Cluster, error)
 
@@ -224,6 +276,11 @@ type PrivilegedClusterProvider interface {
 	// Note that the admin privileges are used to delete cluster
 	DeleteUnsecured(cluster *kubermaticv1.Cluster) error
 
+	// WatchUnsecured watches all clusters in the seed.
+	//
+	// Note that the admin privileges are used to watch clusters
+	WatchUnsecured() (watch.Interface, error)
+
 	// NewUnsecured creates a brand new cluster that is bound to the given project.
 	//
 	// Note that the admin privileges are used to create cluster
@@ -391,6 +448,84 @@ type ProjectMemberMapper interface {
 	// MappingsFor returns the list of projects (bindings) for the given user
 	// This function is unsafe in a sense that it uses privileged account to list all members in the system
 	MappingsFor(userEmail string) ([]*kubermaticv1.UserProjectBinding, error)
+
+	// MapGroupsToGroup maps the given groups of the identity provider to a specific group of the given project
+	// This function is unsafe in a sense that it uses privileged account to list all group bindings in the system
+	MapGroupsToGroup(groups []string, projectID string) (string, error)
+
+	// GroupMappingsFor returns the list of projects (bindings) the given user belongs to through the given groups of the identity provider
+	// Note that the returned bindings are not persisted, they only reflect the GroupProjectBindings for the given groups
+	// This function is unsafe in a sense that it uses privileged account to list all group bindings in the system
+	GroupMappingsFor(userEmail string, groups []string) ([]*kubermaticv1.UserProjectBinding, error)
+}
+
+// GroupProjectBindingProvider declares the set of methods for interacting with the group bindings of a project
+type GroupProjectBindingProvider interface {
+	// List gets all group bindings of the given project
+	List(userInfo *UserInfo, project *kubermaticv1.Project) ([]*kubermaticv1.GroupProjectBinding, error)
+
+	// Create binds the given group of the identity provider to the given role within the given project
+	Create(userInfo *UserInfo, project *kubermaticv1.Project, group, role string) (*kubermaticv1.GroupProjectBinding, error)
+
+	// Delete deletes the given binding
+	Delete(userInfo *UserInfo, bindingName string) error
+}
+
+// PrivilegedGroupProjectBindingProvider declares the set of methods for interacting with the group bindings of a project
+// using a privileged client
+type PrivilegedGroupProjectBindingProvider interface {
+	// ListUnsecured gets all group bindings of the given project
+	// This function is unsafe in a sense that it uses privileged account to get the resources
+	ListUnsecured(project *kubermaticv1.Project) ([]*kubermaticv1.GroupProjectBinding, error)
+
+	// CreateUnsecured binds the given group of the identity provider to the given role within the given project
+	// This function is unsafe in a sense that it uses privileged account to create the resource
+	CreateUnsecured(project *kubermaticv1.Project, group, role string) (*kubermaticv1.GroupProjectBinding, error)
+
+	// DeleteUnsecured deletes the given binding
+	// This function is unsafe in a sense that it uses privileged account to delete the resource
+	DeleteUnsecured(bindingName string) error
+}
+
+// ProjectInvitationProvider declares the set of methods for interacting with the invitations of a project
+type ProjectInvitationProvider interface {
+	// List gets all invitations of the given project
+	List(userInfo *UserInfo, project *kubermaticv1.Project) ([]*kubermaticv1.ProjectInvitation, error)
+
+	// Create creates an invitation of the given user to the given project
+	Create(userInfo *UserInfo, project *kubermaticv1.Project, email, group string, expiresAt time.Time) (*kubermaticv1.ProjectInvitation, error)
+
+	// Delete revokes the given invitation
+	Delete(userInfo *UserInfo, invitationName string) error
+}
+
+// PrivilegedProjectInvitationProvider declares the set of methods for interacting with the invitations of a project
+// using a privileged client
+type PrivilegedProjectInvitationProvider interface {
+	// GetUnsecured gets the given invitation
+	// This function is unsafe in a sense that it uses privileged account to get the resource
+	GetUnsecured(invitationName string) (*kubermaticv1.ProjectInvitation, error)
+
+	// ListUnsecured gets all invitations of the given project
+	// This function is unsafe in a sense that it uses privileged account to get the resources
+	ListUnsecured(project *kubermaticv1.Project) ([]*kubermaticv1.ProjectInvitation, error)
+
+	// ListForEmailUnsecured gets all invitations of the user with the given email
+	// This function is unsafe in a sense that it uses privileged account to get the resources
+	ListForEmailUnsecured(email string) ([]*kubermaticv1.ProjectInvitation, error)
+
+	// CreateUnsecured creates an invitation of the given user to the given project
+	// This function is unsafe in a sense that it uses privileged account to create the resource
+	CreateUnsecured(project *kubermaticv1.Project, email, group, invitedBy string, expiresAt time.Time) (*kubermaticv1.ProjectInvitation, error)
+
+	// DeleteUnsecured deletes the given invitation
+	// This function is unsafe in a sense that it uses privileged account to delete the resource
+	DeleteUnsecured(invitationName string) error
+}
+
+// InvitationNotifier informs users about the invitations they received
+type InvitationNotifier interface {
+	NotifyInvitation(invitation *kubermaticv1.ProjectInvitation, project *kubermaticv1.Project) error
 }
 
 // ClusterCloudProviderName returns the provider name for the given CloudSpec.
@@ -699,6 +834,25 @@ type SettingsProvider interface {
 type AdminProvider interface {
 	SetAdmin(userInfo *UserInfo, email string, isAdmin bool) (*kubermaticv1.User, error)
 	GetAdmins(userInfo *UserInfo) ([]kubermaticv1.User, error)
+
+	// SuspendUser suspends or reactivates the given user
+	// Suspending a user also revokes the tokens of the service accounts the user created
+	SuspendUser(userInfo *UserInfo, email string, suspended bool) (*kubermaticv1.User, error)
+
+	// OffboardUser suspends the given user, transfers the projects the user exclusively owns
+	// to the given admin and removes the user from all projects
+	OffboardUser(userInfo *UserInfo, email, newOwnerEmail string) (*UserOffboarding, error)
+}
+
+// UserOffboarding summarizes the changes made while offboarding a user
+type UserOffboarding struct {
+	User *kubermaticv1.User
+	// TransferredProjects are the projects the user exclusively owned
+	TransferredProjects []string
+	// RemovedBindings are the bindings of the user to the projects
+	RemovedBindings []string
+	// RevokedTokens are the tokens of the service accounts the user created
+	RevokedTokens []string
 }
 
 // PresetProvider declares the set of methods for interacting with presets
@@ -790,3 +944,108 @@ type ConstraintTemplateProvider interface {
 	// Delete a Constraint Template
 	Delete(ct *kubermaticv1.ConstraintTemplate) error
 }
+
+// ProjectRoleProvider declares the set of methods for interacting with custom project roles
+type ProjectRoleProvider interface {
+	// List gets all project roles, they can be listed by every user so that they can be assigned to the project members
+	List(userInfo *UserInfo) ([]kubermaticv1.ProjectRole, error)
+
+	// Get gets the given project role
+	Get(userInfo *UserInfo, name string) (*kubermaticv1.ProjectRole, error)
+
+	// Create creates the given project role, only admins can create project roles
+	Create(userInfo *UserInfo, projectRole *kubermaticv1.ProjectRole) (*kubermaticv1.ProjectRole, error)
+
+	// Update updates the given project role, only admins can update project roles
+	Update(userInfo *UserInfo, projectRole *kubermaticv1.ProjectRole) (*kubermaticv1.ProjectRole, error)
+
+	// Delete deletes the given project role, only admins can delete project roles
+	Delete(userInfo *UserInfo, name string) error
+}
+
+// PriceListProvider declares the set of methods for interacting with the price lists of the datacenters
+type PriceListProvider interface {
+	// GetUnsecured returns the price list of the given datacenter
+	//
+	// Note that this function:
+	// is unsafe in a sense that it doesn't check whether the user is allowed to read the price list
+	GetUnsecured(datacenter string) (*kubermaticv1.PriceList, error)
+
+	// List gets all price lists, only admins can list price lists
+	List(userInfo *UserInfo) ([]kubermaticv1.PriceList, error)
+
+	// CreateOrUpdate stores the given price list, only admins can change price lists
+	CreateOrUpdate(userInfo *UserInfo, priceList *kubermaticv1.PriceList) (*kubermaticv1.PriceList, error)
+
+	// Delete deletes the price list of the given datacenter, only admins can delete price lists
+	Delete(userInfo *UserInfo, datacenter string) error
+}
+
+// UsageReportProvider declares the set of methods for reading the usage reports of the projects
+type UsageReportProvider interface {
+	// ListUnsecured returns the usage reports of the given project, the reports of all projects are
+	// returned if the project is empty and all periods are returned if the period is empty
+	//
+	// Note that this function:
+	// is unsafe in a sense that it doesn't check whether the user is allowed to read the reports
+	ListUnsecured(projectID, period string) ([]kubermaticv1.UsageReport, error)
+}
+
+// CredentialRotation describes the replacement of the cloud credentials of a preset or of clusters
+type CredentialRotation struct {
+	// ProviderName is the name of the cloud provider of the credentials, e.g. aws
+	ProviderName string
+	// PresetName is the name of the preset whose credentials are replaced. The clusters which use the
+	// current credentials of the preset are updated as well.
+	PresetName string
+	// Credentials are the current credentials of the clusters to update, keyed like in the credential secrets.
+	// They are taken from the preset if a preset is given.
+	Credentials map[string]string
+	// NewCredentials replace the current credentials, keyed like in the credential secrets
+	NewCredentials map[string]string
+}
+
+// CredentialRotationResult is the outcome of a CredentialRotation for a cluster. The cluster fields are
+// empty if the clusters of the seed could not be listed.
+type CredentialRotationResult struct {
+	SeedName          string
+	ClusterName       string
+	HumanReadableName string
+	ProjectID         string
+	Error             error
+}
+
+// CredentialRotationProvider declares the set of methods for rotating the cloud credentials of clusters
+type CredentialRotationProvider interface {
+	// Rotate replaces the credentials and returns the results of the clusters which used the current credentials,
+	// only admins can rotate credentials
+	Rotate(userInfo *UserInfo, rotation *CredentialRotation) ([]CredentialRotationResult, error)
+}
+
+// OrphanedCloudResourceProvider declares the set of methods for reading the orphaned cloud resources
+type OrphanedCloudResourceProvider interface {
+	// List gets all orphaned cloud resources, only admins can list them
+	List(userInfo *UserInfo) ([]kubermaticv1.OrphanedCloudResource, error)
+}
+
+// OperationProvider declares the set of methods for tracking asynchronous actions on clusters
+type OperationProvider interface {
+	// CreateUnsecured starts tracking the given action on the cluster, the version is the target version of upgrades
+	//
+	// Note that this function:
+	// is unsafe in a sense that it doesn't check whether the user is allowed to access the cluster
+	CreateUnsecured(project *kubermaticv1.Project, clusterID string, operationType kubermaticv1.OperationType, version, userEmail string) (*kubermaticv1.Operation, error)
+
+	// GetUnsecured returns the given operation
+	//
+	// Note that this function:
+	// is unsafe in a sense that it doesn't check whether the user is allowed to read the operation
+	GetUnsecured(operationID string) (*kubermaticv1.Operation, error)
+
+	// ListUnsecured returns the operations of the given project, only the operations of the given cluster
+	// are returned if the cluster is not empty
+	//
+	// Note that this function:
+	// is unsafe in a sense that it doesn't check whether the user is allowed to read the operations
+	ListUnsecured(projectID, clusterID string) ([]kubermaticv1.Operation, error)
+}
@@ -22,6 +22,8 @@ import (
 
 	kubermaticapiv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // UserInfoGetter is a function to retrieve a UserInfo
@@ -41,7 +43,14 @@ func UserInfoGetterFactory(userProjectMapper ProjectMemberMapper) (UserInfoGette
 			var err error
 			group, err = userProjectMapper.MapUserToGroup(user.Spec.Email, projectID)
 			if err != nil {
-				return nil, err
+				// the user might belong to the project through the groups of the identity provider
+				groups, _ := ctx.Value(kubermaticcontext.TokenGroupsContextKey).([]string)
+				if !kerrors.IsForbidden(err) || len(groups) == 0 {
+					return nil, err
+				}
+				if group, err = userProjectMapper.MapGroupsToGroup(groups, projectID); err != nil {
+					return nil, err
+				}
 			}
 		}
 
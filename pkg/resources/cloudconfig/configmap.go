@@ -128,27 +128,31 @@ func CloudConfig(
 	case cloud.Openstack != nil:
 		manageSecurityGroups := dc.Spec.Openstack.ManageSecurityGroups
 		trustDevicePath := dc.Spec.Openstack.TrustDevicePath
-		openstackCloudConfig := &openstack.CloudConfig{
-			Global: openstack.GlobalOpts{
-				AuthURL:    dc.Spec.Openstack.AuthURL,
-				Username:   credentials.Openstack.Username,
-				Password:   credentials.Openstack.Password,
-				DomainName: credentials.Openstack.Domain,
-				TenantName: credentials.Openstack.Tenant,
-				TenantID:   credentials.Openstack.TenantID,
-				Region:     dc.Spec.Openstack.Region,
+		openstackConfig := &openstackCloudConfig{
+			CloudConfig: openstack.CloudConfig{
+				Global: openstack.GlobalOpts{
+					AuthURL:    dc.Spec.Openstack.AuthURL,
+					Username:   credentials.Openstack.Username,
+					Password:   credentials.Openstack.Password,
+					DomainName: credentials.Openstack.Domain,
+					TenantName: credentials.Openstack.Tenant,
+					TenantID:   credentials.Openstack.TenantID,
+					Region:     dc.Spec.Openstack.Region,
+				},
+				BlockStorage: openstack.BlockStorageOpts{
+					BSVersion:       "auto",
+					TrustDevicePath: trustDevicePath != nil && *trustDevicePath,
+					IgnoreVolumeAZ:  dc.Spec.Openstack.IgnoreVolumeAZ,
+				},
+				LoadBalancer: openstack.LoadBalancerOpts{
+					ManageSecurityGroups: manageSecurityGroups == nil || *manageSecurityGroups,
+				},
+				Version: cluster.Spec.Version.String(),
 			},
-			BlockStorage: openstack.BlockStorageOpts{
-				BSVersion:       "auto",
-				TrustDevicePath: trustDevicePath != nil && *trustDevicePath,
-				IgnoreVolumeAZ:  dc.Spec.Openstack.IgnoreVolumeAZ,
-			},
-			LoadBalancer: openstack.LoadBalancerOpts{
-				ManageSecurityGroups: manageSecurityGroups == nil || *manageSecurityGroups,
-			},
-			Version: cluster.Spec.Version.String(),
+			ApplicationCredentialID:     credentials.Openstack.ApplicationCredentialID,
+			ApplicationCredentialSecret: credentials.Openstack.ApplicationCredentialSecret,
 		}
-		cloudConfig, err = openstack.CloudConfigToString(openstackCloudConfig)
+		cloudConfig, err = openstackCloudConfigToString(openstackConfig)
 		if err != nil {
 			return cloudConfig, err
 		}
@@ -271,6 +275,7 @@ func getVsphereCloudConfig(
 			Datacenter:       dc.Spec.VSphere.Datacenter,
 			Folder:           cluster.Spec.Cloud.VSphere.Folder,
 			DefaultDatastore: datastore,
+			ResourcePoolPath: cluster.Spec.Cloud.VSphere.ResourcePool,
 		},
 		Disk: vsphere.DiskOpts{
 			SCSIControllerType: "pvscsi",
@@ -18,8 +18,10 @@ package cloudconfig
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	openstack "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/openstack/types"
 	vsphere "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/vsphere/types"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -120,3 +122,42 @@ func TestGetVsphereCloudConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenstackCloudConfigToString(t *testing.T) {
+	config := openstack.CloudConfig{
+		Global: openstack.GlobalOpts{
+			AuthURL:    "https://keystone.example.com/v3",
+			Username:   "user",
+			Password:   "pass",
+			TenantName: "tenant",
+			Region:     "RegionOne",
+		},
+		Version: "1.18.0",
+	}
+
+	// Without application credentials the cloud config has to match the one of the machine-controller
+	expected, err := openstack.CloudConfigToString(&config)
+	if err != nil {
+		t.Fatalf("failed to render cloud config: %v", err)
+	}
+	cloudConfig, err := openstackCloudConfigToString(&openstackCloudConfig{CloudConfig: config})
+	if err != nil {
+		t.Fatalf("failed to render cloud config: %v", err)
+	}
+	if cloudConfig != expected {
+		t.Errorf("expected cloud config\n%s\ngot:\n%s", expected, cloudConfig)
+	}
+
+	cloudConfig, err = openstackCloudConfigToString(&openstackCloudConfig{
+		CloudConfig:                 config,
+		ApplicationCredentialID:     "id",
+		ApplicationCredentialSecret: "sec\"ret",
+	})
+	if err != nil {
+		t.Fatalf("failed to render cloud config: %v", err)
+	}
+	expectedGlobal := "[Global]\nauth-url    = \"https://keystone.example.com/v3\"\napplication-credential-id     = \"id\"\napplication-credential-secret = \"sec\\\"ret\"\nregion      = \"RegionOne\"\n\n"
+	if !strings.HasPrefix(cloudConfig, expectedGlobal) {
+		t.Errorf("expected the cloud config to start with\n%s\ngot:\n%s", expectedGlobal, cloudConfig)
+	}
+}
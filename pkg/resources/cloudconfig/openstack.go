@@ -0,0 +1,97 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudconfig
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/Masterminds/sprig"
+	openstack "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/openstack/types"
+	"github.com/kubermatic/machine-controller/pkg/ini"
+)
+
+// openstackCloudConfigTpl is the cloud config template of the machine-controller, which does not
+// support application credentials yet. Application credentials are bound to a project, the
+// username, tenant and domain are left out when they are used.
+const openstackCloudConfigTpl = `[Global]
+auth-url    = {{ .Global.AuthURL | iniEscape }}
+{{- if .ApplicationCredentialID }}
+application-credential-id     = {{ .ApplicationCredentialID | iniEscape }}
+application-credential-secret = {{ .ApplicationCredentialSecret | iniEscape }}
+{{- else }}
+username    = {{ .Global.Username | iniEscape }}
+password    = {{ .Global.Password | iniEscape }}
+tenant-name = {{ .Global.TenantName | iniEscape }}
+tenant-id   = {{ .Global.TenantID | iniEscape }}
+domain-name = {{ .Global.DomainName | iniEscape }}
+{{- end }}
+region      = {{ .Global.Region | iniEscape }}
+
+[LoadBalancer]
+lb-version = {{ default "v2" .LoadBalancer.LBVersion | iniEscape }}
+subnet-id = {{ .LoadBalancer.SubnetID | iniEscape }}
+floating-network-id = {{ .LoadBalancer.FloatingNetworkID | iniEscape }}
+lb-method = {{ default "ROUND_ROBIN" .LoadBalancer.LBMethod | iniEscape }}
+lb-provider = {{ .LoadBalancer.LBProvider | iniEscape }}
+
+{{- if .LoadBalancer.CreateMonitor }}
+create-monitor = {{ .LoadBalancer.CreateMonitor }}
+monitor-delay = {{ .LoadBalancer.MonitorDelay }}
+monitor-timeout = {{ .LoadBalancer.MonitorTimeout }}
+monitor-max-retries = {{ .LoadBalancer.MonitorMaxRetries }}
+{{- end}}
+{{- if semverCompare "~1.9.10 || ~1.10.6 || ~1.11.1 || >=1.12.*" .Version }}
+manage-security-groups = {{ .LoadBalancer.ManageSecurityGroups }}
+{{- end }}
+
+[BlockStorage]
+{{- if semverCompare ">=1.9" .Version }}
+ignore-volume-az  = {{ .BlockStorage.IgnoreVolumeAZ }}
+{{- end }}
+trust-device-path = {{ .BlockStorage.TrustDevicePath }}
+bs-version        = {{ default "auto" .BlockStorage.BSVersion | iniEscape }}
+{{- if .BlockStorage.NodeVolumeAttachLimit }}
+node-volume-attach-limit = {{ .BlockStorage.NodeVolumeAttachLimit }}
+{{- end }}
+`
+
+// openstackCloudConfig is the OpenStack cloud config of the machine-controller with application credentials
+type openstackCloudConfig struct {
+	openstack.CloudConfig
+
+	ApplicationCredentialID     string
+	ApplicationCredentialSecret string
+}
+
+func openstackCloudConfigToString(c *openstackCloudConfig) (string, error) {
+	funcMap := sprig.TxtFuncMap()
+	funcMap["iniEscape"] = ini.Escape
+
+	tpl, err := template.New("cloud-config").Funcs(funcMap).Parse(openstackCloudConfigTpl)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the cloud config template: %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := tpl.Execute(buf, c); err != nil {
+		return "", fmt.Errorf("failed to execute cloud config template: %v", err)
+	}
+
+	return buf.String(), nil
+}
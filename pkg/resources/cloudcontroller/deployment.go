@@ -29,6 +29,9 @@ func DeploymentCreator(data *resources.TemplateData) reconciling.NamedDeployment
 	if data.Cluster().Spec.Cloud.Openstack != nil {
 		return openStackDeploymentCreator(data)
 	}
+	if data.Cluster().Spec.Cloud.Hetzner != nil {
+		return hetznerDeploymentCreator(data)
+	}
 
 	return func() (name string, create reconciling.DeploymentCreator) {
 		return osName, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
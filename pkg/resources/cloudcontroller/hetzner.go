@@ -0,0 +1,134 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudcontroller
+
+import (
+	"fmt"
+
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/resources/reconciling"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	hetznerName = "hcloud-cloud-controller-manager"
+	hetznerTag  = "v1.7.0"
+)
+
+var (
+	hetznerResourceRequirements = corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("50Mi"),
+			corev1.ResourceCPU:    resource.MustParse("100m"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+			corev1.ResourceCPU:    resource.MustParse("500m"),
+		},
+	}
+)
+
+// hetznerDeploymentCreator returns the deployment of the Hetzner Cloud controller manager. The controller manager
+// uses the private network of the cluster for the node addresses and as target of the load balancers.
+func hetznerDeploymentCreator(data *resources.TemplateData) reconciling.NamedDeploymentCreatorGetter {
+	return func() (string, reconciling.DeploymentCreator) {
+		return hetznerName, func(dep *appsv1.Deployment) (*appsv1.Deployment, error) {
+			dep.Name = hetznerName
+			dep.Labels = resources.BaseAppLabels(hetznerName, nil)
+
+			dep.Spec.Replicas = resources.Int32(1)
+
+			dep.Spec.Selector = &metav1.LabelSelector{
+				MatchLabels: resources.BaseAppLabels(hetznerName, nil),
+			}
+
+			dep.Spec.Template.Spec.Volumes = []corev1.Volume{
+				{
+					Name: resources.CloudControllerManagerKubeconfigSecretName,
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{
+							SecretName: resources.CloudControllerManagerKubeconfigSecretName,
+						},
+					},
+				},
+			}
+
+			podLabels, err := data.GetPodTemplateLabels(hetznerName, dep.Spec.Template.Spec.Volumes, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			dep.Spec.Template.ObjectMeta = metav1.ObjectMeta{
+				Labels: podLabels,
+			}
+
+			f := false
+			dep.Spec.Template.Spec.AutomountServiceAccountToken = &f
+
+			credentials, err := resources.GetHetznerCredentials(data)
+			if err != nil {
+				return nil, err
+			}
+
+			dep.Spec.Template.Spec.Containers = []corev1.Container{
+				{
+					Name:    hetznerName,
+					Image:   data.ImageRegistry(resources.RegistryDocker) + "/hetznercloud/hcloud-cloud-controller-manager:" + hetznerTag,
+					Command: []string{"/bin/hcloud-cloud-controller-manager"},
+					Args: []string{
+						"--kubeconfig=/etc/kubernetes/kubeconfig/kubeconfig",
+						"--cloud-provider=hcloud",
+						"--allow-untagged-cloud",
+						// The pod network is provided by the CNI, the routes of the network are not used
+						"--configure-cloud-routes=false",
+						"--v=1",
+					},
+					Env: []corev1.EnvVar{
+						{
+							Name:  "HCLOUD_TOKEN",
+							Value: credentials.Token,
+						},
+						{
+							Name:  "HCLOUD_NETWORK",
+							Value: data.Cluster().Spec.Cloud.Hetzner.Network,
+						},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      resources.CloudControllerManagerKubeconfigSecretName,
+							MountPath: "/etc/kubernetes/kubeconfig",
+							ReadOnly:  true,
+						},
+					},
+				},
+			}
+			defResourceRequirements := map[string]*corev1.ResourceRequirements{
+				hetznerName: hetznerResourceRequirements.DeepCopy(),
+			}
+			err = resources.SetResourceRequirements(dep.Spec.Template.Spec.Containers, defResourceRequirements, nil, dep.Annotations)
+			if err != nil {
+				return nil, fmt.Errorf("failed to set resource requirements: %v", err)
+			}
+
+			return dep, nil
+		}
+	}
+}
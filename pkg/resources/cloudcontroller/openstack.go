@@ -183,6 +183,10 @@ func getOSVersion(version semver.Semver) (string, error) {
 
 // ExternalCloudControllerFeatureSupported checks if the
 func ExternalCloudControllerFeatureSupported(dc *kubermaticv1.Datacenter, cluster *kubermaticv1.Cluster) bool {
+	// The Hetzner Cloud controller manager is needed to use the private network of the cluster
+	if cluster.Spec.Cloud.Hetzner != nil {
+		return true
+	}
 	if cluster.Spec.Cloud.Openstack == nil {
 		return false
 	}
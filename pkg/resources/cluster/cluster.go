@@ -43,6 +43,7 @@ func Spec(apiCluster apiv1.Cluster, dc *kubermaticv1.Datacenter, secretKeyGetter
 		Openshift:                           apiCluster.Spec.Openshift,
 		AdmissionPlugins:                    apiCluster.Spec.AdmissionPlugins,
 		OPAIntegration:                      apiCluster.Spec.OPAIntegration,
+		DeletionProtection:                  apiCluster.Spec.DeletionProtection,
 	}
 
 	providerName, err := provider.ClusterCloudProviderName(spec.Cloud)
@@ -64,11 +64,13 @@ type HetznerCredentials struct {
 }
 
 type OpenstackCredentials struct {
-	Username string
-	Password string
-	Tenant   string
-	TenantID string
-	Domain   string
+	Username                    string
+	Password                    string
+	Tenant                      string
+	TenantID                    string
+	Domain                      string
+	ApplicationCredentialID     string
+	ApplicationCredentialSecret string
 }
 
 type PacketCredentials struct {
@@ -272,6 +274,22 @@ func GetOpenstackCredentials(data CredentialsData) (OpenstackCredentials, error)
 	openstackCredentials := OpenstackCredentials{}
 	var err error
 
+	// Application credentials are used instead of the username and password when they are configured.
+	// Credential secrets created before they were supported have no key for them.
+	if spec.ApplicationCredentialID != "" {
+		openstackCredentials.ApplicationCredentialID = spec.ApplicationCredentialID
+	} else if spec.CredentialsReference != nil && spec.CredentialsReference.Name != "" {
+		openstackCredentials.ApplicationCredentialID, _ = data.GetGlobalSecretKeySelectorValue(spec.CredentialsReference, OpenstackApplicationCredentialID)
+	}
+	if openstackCredentials.ApplicationCredentialID != "" {
+		if spec.ApplicationCredentialSecret != "" {
+			openstackCredentials.ApplicationCredentialSecret = spec.ApplicationCredentialSecret
+		} else if openstackCredentials.ApplicationCredentialSecret, err = data.GetGlobalSecretKeySelectorValue(spec.CredentialsReference, OpenstackApplicationCredentialSecret); err != nil {
+			return OpenstackCredentials{}, err
+		}
+		return openstackCredentials, nil
+	}
+
 	if spec.Username != "" {
 		openstackCredentials.Username = spec.Username
 	} else if openstackCredentials.Username, err = data.GetGlobalSecretKeySelectorValue(spec.CredentialsReference, OpenstackUsername); err != nil {
@@ -347,6 +347,11 @@ func GetKubernetesCloudProviderName(cluster *kubermaticv1.Cluster) string {
 			return "external"
 		}
 		return "openstack"
+	case cluster.Spec.Cloud.Hetzner != nil:
+		if cluster.Spec.Features[kubermaticv1.ClusterFeatureExternalCloudProvider] {
+			return "external"
+		}
+		return ""
 	default:
 		return ""
 	}
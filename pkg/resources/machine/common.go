@@ -179,6 +179,7 @@ func getVSphereProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc
 		DatastoreCluster: providerconfig.ConfigVarString{Value: c.Spec.Cloud.VSphere.DatastoreCluster},
 		Cluster:          providerconfig.ConfigVarString{Value: dc.Spec.VSphere.Cluster},
 		Folder:           providerconfig.ConfigVarString{Value: c.Spec.Cloud.VSphere.Folder},
+		ResourcePool:     providerconfig.ConfigVarString{Value: c.Spec.Cloud.VSphere.ResourcePool},
 		AllowInsecure:    providerconfig.ConfigVarBool{Value: dc.Spec.VSphere.AllowInsecure},
 	}
 
@@ -247,6 +248,9 @@ func getHetznerProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc
 		Location:   providerconfig.ConfigVarString{Value: dc.Spec.Hetzner.Location},
 		ServerType: providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Hetzner.Type},
 	}
+	if c.Spec.Cloud.Hetzner.Network != "" {
+		config.Networks = []providerconfig.ConfigVarString{{Value: c.Spec.Cloud.Hetzner.Network}}
+	}
 
 	ext := &runtime.RawExtension{}
 	b, err := json.Marshal(config)
@@ -358,13 +362,20 @@ func getGCPProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc *ku
 	return ext, nil
 }
 
-func getKubevirtProviderSpec(nodeSpec apiv1.NodeSpec) (*runtime.RawExtension, error) {
+func getKubevirtProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec) (*runtime.RawExtension, error) {
+	// The virtual machines are always created in the namespace of the cluster, it is isolated from the
+	// other namespaces of the KubeVirt infra cluster
+	namespace := kubevirtNamespace(c)
+	if namespace == "" {
+		return nil, errors.New("the cluster has no namespace in the KubeVirt infra cluster yet")
+	}
+
 	config := kubevirt.RawConfig{
 		CPUs:             providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Kubevirt.CPUs},
 		PVCSize:          providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Kubevirt.PVCSize},
 		StorageClassName: providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Kubevirt.StorageClassName},
 		SourceURL:        providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Kubevirt.SourceURL},
-		Namespace:        providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Kubevirt.Namespace},
+		Namespace:        providerconfig.ConfigVarString{Value: namespace},
 		Memory:           providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Kubevirt.Memory},
 	}
 
@@ -378,6 +389,14 @@ func getKubevirtProviderSpec(nodeSpec apiv1.NodeSpec) (*runtime.RawExtension, er
 	return ext, nil
 }
 
+// kubevirtNamespace returns the namespace of the cluster in the KubeVirt infra cluster
+func kubevirtNamespace(c *kubermaticv1.Cluster) string {
+	if c.Spec.Cloud.Kubevirt == nil {
+		return ""
+	}
+	return c.Spec.Cloud.Kubevirt.Namespace
+}
+
 func getAlibabaProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc *kubermaticv1.Datacenter) (*runtime.RawExtension, error) {
 	config := alibaba.RawConfig{
 		InstanceType:            providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Alibaba.InstanceType},
@@ -389,6 +408,14 @@ func getAlibabaProviderSpec(c *kubermaticv1.Cluster, nodeSpec apiv1.NodeSpec, dc
 		ZoneID:                  providerconfig.ConfigVarString{Value: nodeSpec.Cloud.Alibaba.ZoneID},
 	}
 
+	// Nodes without a vSwitch are created in the vSwitch of the cluster, and in its zone unless the node has one
+	if nodeSpec.Cloud.Alibaba.VSwitchID == "" && c.Spec.Cloud.Alibaba != nil && c.Spec.Cloud.Alibaba.VSwitchID != "" {
+		config.VSwitchID.Value = c.Spec.Cloud.Alibaba.VSwitchID
+		if config.ZoneID.Value == "" {
+			config.ZoneID.Value = c.Spec.Cloud.Alibaba.ZoneID
+		}
+	}
+
 	config.Labels = map[string]string{}
 	for key, value := range nodeSpec.Cloud.Alibaba.Labels {
 		config.Labels[key] = value
@@ -20,10 +20,12 @@ import (
 	"encoding/json"
 	"testing"
 
+	kubevirt "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/kubevirt/types"
 	vsphere "github.com/kubermatic/machine-controller/pkg/cloudprovider/provider/vsphere/types"
 	providerconfigtypes "github.com/kubermatic/machine-controller/pkg/providerconfig/types"
 	apiv1 "k8c.io/kubermatic/v2/pkg/api/v1"
 	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/semver"
 )
 
 func TestGetVSphereProviderSpec(t *testing.T) {
@@ -133,3 +135,126 @@ func TestGetVSphereProviderSpec(t *testing.T) {
 		})
 	}
 }
+
+func genKubevirtCluster(namespace string) *kubermaticv1.Cluster {
+	return &kubermaticv1.Cluster{
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{
+				Kubevirt: &kubermaticv1.KubevirtCloudSpec{
+					Namespace: namespace,
+				},
+			},
+			Version: *semver.NewSemverOrDie("1.18.10"),
+		},
+	}
+}
+
+func TestGetKubevirtProviderSpec(t *testing.T) {
+	tests := []struct {
+		name          string
+		cluster       *kubermaticv1.Cluster
+		nodeSpec      apiv1.NodeSpec
+		wantNamespace string
+		wantErr       bool
+	}{
+		{
+			name: "Namespace of the cluster",
+			nodeSpec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{
+					Kubevirt: &apiv1.KubevirtNodeSpec{},
+				},
+			},
+			cluster:       genKubevirtCluster("kubevirt-cluster-abcd"),
+			wantNamespace: "kubevirt-cluster-abcd",
+		},
+		{
+			name: "Namespace of the node is ignored",
+			nodeSpec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{
+					Kubevirt: &apiv1.KubevirtNodeSpec{
+						Namespace: "my-namespace",
+					},
+				},
+			},
+			cluster:       genKubevirtCluster("kubevirt-cluster-abcd"),
+			wantNamespace: "kubevirt-cluster-abcd",
+		},
+		{
+			name: "Cluster without namespace",
+			nodeSpec: apiv1.NodeSpec{
+				Cloud: apiv1.NodeCloudSpec{
+					Kubevirt: &apiv1.KubevirtNodeSpec{
+						Namespace: "my-namespace",
+					},
+				},
+			},
+			cluster: genKubevirtCluster(""),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getKubevirtProviderSpec(tt.cluster, tt.nodeSpec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getKubevirtProviderSpec() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			gotRawConf := kubevirt.RawConfig{}
+			if err := json.Unmarshal(got.Raw, &gotRawConf); err != nil {
+				t.Fatalf("error occurred whil unmarshaling raw config: %v", err)
+			}
+			if gotRawConf.Namespace.Value != tt.wantNamespace {
+				t.Errorf("getKubevirtProviderSpec() namespace = %q, want %q", gotRawConf.Namespace.Value, tt.wantNamespace)
+			}
+		})
+	}
+}
+
+func TestValidateKubevirtNamespace(t *testing.T) {
+	tests := []struct {
+		name      string
+		cluster   *kubermaticv1.Cluster
+		namespace string
+		wantErr   bool
+	}{
+		{
+			name:    "No namespace",
+			cluster: genKubevirtCluster("kubevirt-cluster-abcd"),
+		},
+		{
+			name:      "Namespace of the cluster",
+			cluster:   genKubevirtCluster("kubevirt-cluster-abcd"),
+			namespace: "kubevirt-cluster-abcd",
+		},
+		{
+			name:      "Other namespace",
+			cluster:   genKubevirtCluster("kubevirt-cluster-abcd"),
+			namespace: "my-namespace",
+			wantErr:   true,
+		},
+		{
+			name:      "Cluster without namespace",
+			cluster:   genKubevirtCluster(""),
+			namespace: "my-namespace",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nd := &apiv1.NodeDeployment{
+				Spec: apiv1.NodeDeploymentSpec{
+					Template: apiv1.NodeSpec{
+						Cloud: apiv1.NodeCloudSpec{
+							Kubevirt: &apiv1.KubevirtNodeSpec{Namespace: tt.namespace},
+						},
+					},
+				},
+			}
+			if _, err := Validate(nd, tt.cluster); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
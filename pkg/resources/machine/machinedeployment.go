@@ -181,6 +181,11 @@ func getProviderConfig(c *kubermaticv1.Cluster, nd *apiv1.NodeDeployment, dc *ku
 		if err := validation.ValidateCreateNodeSpec(c, &nd.Spec.Template, dc); err != nil {
 			return nil, err
 		}
+		// The application credentials are passed to the machine-controller, but the version in use
+		// only authenticates with a username and password
+		if credentials.Openstack.ApplicationCredentialID != "" {
+			return nil, errors.New("nodes can not be provisioned with OpenStack application credentials, the machine-controller does not support them")
+		}
 
 		cloudExt, err = getOpenstackProviderSpec(c, nd.Spec.Template, dc)
 		if err != nil {
@@ -212,7 +217,7 @@ func getProviderConfig(c *kubermaticv1.Cluster, nd *apiv1.NodeDeployment, dc *ku
 		}
 	case nd.Spec.Template.Cloud.Kubevirt != nil:
 		config.CloudProvider = providerconfig.CloudProviderKubeVirt
-		cloudExt, err = getKubevirtProviderSpec(nd.Spec.Template)
+		cloudExt, err = getKubevirtProviderSpec(c, nd.Spec.Template)
 		if err != nil {
 			return nil, err
 		}
@@ -282,9 +287,9 @@ func getProviderOS(config *providerconfig.Config, nd *apiv1.NodeDeployment) erro
 	return nil
 }
 
-// Validate if the node deployment structure fulfills certain requirements. It returns node deployment with updated
-// kubelet version if it wasn't specified.
-func Validate(nd *apiv1.NodeDeployment, controlPlaneVersion *semver.Version) (*apiv1.NodeDeployment, error) {
+// Validate if the node deployment structure fulfills certain requirements for the given cluster. It returns node
+// deployment with updated kubelet version if it wasn't specified.
+func Validate(nd *apiv1.NodeDeployment, cluster *kubermaticv1.Cluster) (*apiv1.NodeDeployment, error) {
 	if nd.Spec.Template.Cloud.Openstack == nil &&
 		nd.Spec.Template.Cloud.Digitalocean == nil &&
 		nd.Spec.Template.Cloud.AWS == nil &&
@@ -298,6 +303,12 @@ func Validate(nd *apiv1.NodeDeployment, controlPlaneVersion *semver.Version) (*a
 		return nil, fmt.Errorf("node deployment needs to have cloud provider data")
 	}
 
+	if kubevirtSpec := nd.Spec.Template.Cloud.Kubevirt; kubevirtSpec != nil && kubevirtSpec.Namespace != "" && kubevirtSpec.Namespace != kubevirtNamespace(cluster) {
+		return nil, fmt.Errorf("nodes can only be created in the namespace %q of the cluster in the KubeVirt infra cluster", kubevirtNamespace(cluster))
+	}
+
+	controlPlaneVersion := cluster.Spec.Version.Semver()
+
 	if nd.Spec.Template.Versions.Kubelet != "" {
 		kubeletVersion, err := semver.NewVersion(nd.Spec.Template.Versions.Kubelet)
 		if err != nil {
@@ -16,6 +16,7 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
@@ -208,6 +209,43 @@ func ReconcileServiceAccounts(ctx context.Context, namedGetters []NamedServiceAc
 	return nil
 }
 
+// ResourceQuotaCreator defines an interface to create/update ResourceQuotas
+type ResourceQuotaCreator = func(existing *corev1.ResourceQuota) (*corev1.ResourceQuota, error)
+
+// NamedResourceQuotaCreatorGetter returns the name of the resource and the corresponding creator function
+type NamedResourceQuotaCreatorGetter = func() (name string, create ResourceQuotaCreator)
+
+// ResourceQuotaObjectWrapper adds a wrapper so the ResourceQuotaCreator matches ObjectCreator.
+// This is needed as Go does not support function interface matching.
+func ResourceQuotaObjectWrapper(create ResourceQuotaCreator) ObjectCreator {
+	return func(existing runtime.Object) (runtime.Object, error) {
+		if existing != nil {
+			return create(existing.(*corev1.ResourceQuota))
+		}
+		return create(&corev1.ResourceQuota{})
+	}
+}
+
+// ReconcileResourceQuotas will create and update the ResourceQuotas coming from the passed ResourceQuotaCreator slice
+func ReconcileResourceQuotas(ctx context.Context, namedGetters []NamedResourceQuotaCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	for _, get := range namedGetters {
+		name, create := get()
+		createObject := ResourceQuotaObjectWrapper(create)
+		createObject = createWithNamespace(createObject, namespace)
+		createObject = createWithName(createObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			createObject = objectModifier(createObject)
+		}
+
+		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &corev1.ResourceQuota{}, false); err != nil {
+			return fmt.Errorf("failed to ensure ResourceQuota %s/%s: %v", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
 // StatefulSetCreator defines an interface to create/update StatefulSets
 type StatefulSetCreator = func(existing *appsv1.StatefulSet) (*appsv1.StatefulSet, error)
 
@@ -767,6 +805,43 @@ func ReconcileIngresses(ctx context.Context, namedGetters []NamedIngressCreatorG
 	return nil
 }
 
+// NetworkPolicyCreator defines an interface to create/update NetworkPolicys
+type NetworkPolicyCreator = func(existing *networkingv1.NetworkPolicy) (*networkingv1.NetworkPolicy, error)
+
+// NamedNetworkPolicyCreatorGetter returns the name of the resource and the corresponding creator function
+type NamedNetworkPolicyCreatorGetter = func() (name string, create NetworkPolicyCreator)
+
+// NetworkPolicyObjectWrapper adds a wrapper so the NetworkPolicyCreator matches ObjectCreator.
+// This is needed as Go does not support function interface matching.
+func NetworkPolicyObjectWrapper(create NetworkPolicyCreator) ObjectCreator {
+	return func(existing runtime.Object) (runtime.Object, error) {
+		if existing != nil {
+			return create(existing.(*networkingv1.NetworkPolicy))
+		}
+		return create(&networkingv1.NetworkPolicy{})
+	}
+}
+
+// ReconcileNetworkPolicies will create and update the NetworkPolicies coming from the passed NetworkPolicyCreator slice
+func ReconcileNetworkPolicies(ctx context.Context, namedGetters []NamedNetworkPolicyCreatorGetter, namespace string, client ctrlruntimeclient.Client, objectModifiers ...ObjectModifier) error {
+	for _, get := range namedGetters {
+		name, create := get()
+		createObject := NetworkPolicyObjectWrapper(create)
+		createObject = createWithNamespace(createObject, namespace)
+		createObject = createWithName(createObject, name)
+
+		for _, objectModifier := range objectModifiers {
+			createObject = objectModifier(createObject)
+		}
+
+		if err := EnsureNamedObject(ctx, types.NamespacedName{Namespace: namespace, Name: name}, createObject, client, &networkingv1.NetworkPolicy{}, false); err != nil {
+			return fmt.Errorf("failed to ensure NetworkPolicy %s/%s: %v", namespace, name, err)
+		}
+	}
+
+	return nil
+}
+
 // SeedCreator defines an interface to create/update Seeds
 type SeedCreator = func(existing *kubermaticv1.Seed) (*kubermaticv1.Seed, error)
 
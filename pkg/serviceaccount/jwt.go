@@ -42,6 +42,9 @@ type TokenGenerator interface {
 type TokenAuthenticator interface {
 	// Authenticate checks given token and transform it to custom claim object
 	Authenticate(tokenData string) (*jwt.Claims, *CustomTokenClaim, error)
+	// ParseClaims checks the signature of the given token and transforms it to custom claim object,
+	// unlike Authenticate it doesn't reject expired tokens
+	ParseClaims(tokenData string) (*jwt.Claims, *CustomTokenClaim, error)
 }
 
 // CustomTokenClaim represents authenticated user
@@ -51,12 +54,23 @@ type CustomTokenClaim struct {
 	TokenID   string `json:"token_id,omitempty"`
 }
 
+// DefaultExpiry returns the expiry of a token that is issued now without an explicit expiry
+func DefaultExpiry() time.Time {
+	return Now().AddDate(3, 0, 0)
+}
+
+// Claims returns the claims for a token that expires after the default lifetime
 func Claims(email, projectID, tokenID string) (*jwt.Claims, *CustomTokenClaim) {
+	return ClaimsWithExpiry(email, projectID, tokenID, DefaultExpiry())
+}
+
+// ClaimsWithExpiry returns the claims for a token that expires at the given time
+func ClaimsWithExpiry(email, projectID, tokenID string, expiry time.Time) (*jwt.Claims, *CustomTokenClaim) {
 
 	sc := &jwt.Claims{
 		IssuedAt:  jwt.NewNumericDate(Now()),
 		NotBefore: jwt.NewNumericDate(Now()),
-		Expiry:    jwt.NewNumericDate(Now().AddDate(3, 0, 0)),
+		Expiry:    jwt.NewNumericDate(expiry),
 	}
 	pc := &CustomTokenClaim{
 		Email:     email,
@@ -107,19 +121,11 @@ func JWTTokenAuthenticator(privateKey []byte) TokenAuthenticator {
 
 // Authenticate decrypts signed token data to CustomTokenClaim object and checks if token expired
 func (a *jwtTokenAuthenticator) Authenticate(tokenData string) (*jwt.Claims, *CustomTokenClaim, error) {
-
-	tok, err := jwt.ParseSigned(tokenData)
+	public, customClaims, err := a.ParseClaims(tokenData)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	public := &jwt.Claims{}
-	customClaims := &CustomTokenClaim{}
-
-	if err := tok.Claims(a.key, customClaims, public); err != nil {
-		return nil, nil, err
-	}
-
 	err = public.Validate(jwt.Expected{
 		Time: Now(),
 	})
@@ -134,6 +140,23 @@ func (a *jwtTokenAuthenticator) Authenticate(tokenData string) (*jwt.Claims, *Cu
 	return public, customClaims, nil
 }
 
+// ParseClaims decrypts signed token data to CustomTokenClaim object without checking if token expired
+func (a *jwtTokenAuthenticator) ParseClaims(tokenData string) (*jwt.Claims, *CustomTokenClaim, error) {
+	tok, err := jwt.ParseSigned(tokenData)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	public := &jwt.Claims{}
+	customClaims := &CustomTokenClaim{}
+
+	if err := tok.Claims(a.key, customClaims, public); err != nil {
+		return nil, nil, err
+	}
+
+	return public, customClaims, nil
+}
+
 func ValidateKey(privateKey []byte) error {
 	if len(privateKey) == 0 {
 		return fmt.Errorf("the signing key can not be empty")
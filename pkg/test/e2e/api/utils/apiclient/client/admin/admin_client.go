@@ -25,8 +25,14 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	CreateProjectRole(params *CreateProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*CreateProjectRoleCreated, error)
+
 	DeleteAdmissionPlugin(params *DeleteAdmissionPluginParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteAdmissionPluginOK, error)
 
+	DeletePriceList(params *DeletePriceListParams, authInfo runtime.ClientAuthInfoWriter) (*DeletePriceListOK, error)
+
+	DeleteProjectRole(params *DeleteProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteProjectRoleOK, error)
+
 	DeleteSeed(params *DeleteSeedParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteSeedOK, error)
 
 	GetAdmins(params *GetAdminsParams, authInfo runtime.ClientAuthInfoWriter) (*GetAdminsOK, error)
@@ -37,23 +43,75 @@ type ClientService interface {
 
 	GetKubermaticSettings(params *GetKubermaticSettingsParams, authInfo runtime.ClientAuthInfoWriter) (*GetKubermaticSettingsOK, error)
 
+	GetProjectRole(params *GetProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*GetProjectRoleOK, error)
+
 	GetSeed(params *GetSeedParams, authInfo runtime.ClientAuthInfoWriter) (*GetSeedOK, error)
 
 	ListAdmissionPlugins(params *ListAdmissionPluginsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAdmissionPluginsOK, error)
 
+	ListAllUsageReports(params *ListAllUsageReportsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAllUsageReportsOK, error)
+
+	ListOrphanedCloudResources(params *ListOrphanedCloudResourcesParams, authInfo runtime.ClientAuthInfoWriter) (*ListOrphanedCloudResourcesOK, error)
+
+	ListPriceLists(params *ListPriceListsParams, authInfo runtime.ClientAuthInfoWriter) (*ListPriceListsOK, error)
+
 	ListSeeds(params *ListSeedsParams, authInfo runtime.ClientAuthInfoWriter) (*ListSeedsOK, error)
 
+	OffboardUser(params *OffboardUserParams, authInfo runtime.ClientAuthInfoWriter) (*OffboardUserOK, error)
+
 	PatchKubermaticSettings(params *PatchKubermaticSettingsParams, authInfo runtime.ClientAuthInfoWriter) (*PatchKubermaticSettingsOK, error)
 
+	RotateCredentials(params *RotateCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*RotateCredentialsOK, error)
+
 	SetAdmin(params *SetAdminParams, authInfo runtime.ClientAuthInfoWriter) (*SetAdminOK, error)
 
+	SetUserSuspension(params *SetUserSuspensionParams, authInfo runtime.ClientAuthInfoWriter) (*SetUserSuspensionOK, error)
+
 	UpdateAdmissionPlugin(params *UpdateAdmissionPluginParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateAdmissionPluginOK, error)
 
+	UpdatePriceList(params *UpdatePriceListParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePriceListOK, error)
+
+	UpdateProjectRole(params *UpdateProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateProjectRoleOK, error)
+
 	UpdateSeed(params *UpdateSeedParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateSeedOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  CreateProjectRole creates a custom project role
+*/
+func (a *Client) CreateProjectRole(params *CreateProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*CreateProjectRoleCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateProjectRoleParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "createProjectRole",
+		Method:             "POST",
+		PathPattern:        "/api/v1/admin/projectroles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateProjectRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateProjectRoleCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateProjectRoleDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   DeleteAdmissionPlugin deletes the admission plugin
 */
@@ -88,6 +146,74 @@ func (a *Client) DeleteAdmissionPlugin(params *DeleteAdmissionPluginParams, auth
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  DeletePriceList deletes the price list of the datacenter
+*/
+func (a *Client) DeletePriceList(params *DeletePriceListParams, authInfo runtime.ClientAuthInfoWriter) (*DeletePriceListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeletePriceListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deletePriceList",
+		Method:             "DELETE",
+		PathPattern:        "/api/v1/admin/pricelists/{datacenter}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeletePriceListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeletePriceListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeletePriceListDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  DeleteProjectRole deletes the custom project role
+*/
+func (a *Client) DeleteProjectRole(params *DeleteProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteProjectRoleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteProjectRoleParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deleteProjectRole",
+		Method:             "DELETE",
+		PathPattern:        "/api/v1/admin/projectroles/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeleteProjectRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteProjectRoleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteProjectRoleDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   DeleteSeed deletes the seed c r d object from the kubermatic
 */
@@ -258,6 +384,40 @@ func (a *Client) GetKubermaticSettings(params *GetKubermaticSettingsParams, auth
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetProjectRole gets the custom project role
+*/
+func (a *Client) GetProjectRole(params *GetProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*GetProjectRoleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProjectRoleParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getProjectRole",
+		Method:             "GET",
+		PathPattern:        "/api/v1/admin/projectroles/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetProjectRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProjectRoleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetProjectRoleDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetSeed returns the seed object
 */
@@ -326,6 +486,108 @@ func (a *Client) ListAdmissionPlugins(params *ListAdmissionPluginsParams, authIn
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListAllUsageReports returns the monthly usage reports of all projects the c s v format is meant for chargeback
+*/
+func (a *Client) ListAllUsageReports(params *ListAllUsageReportsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAllUsageReportsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAllUsageReportsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listAllUsageReports",
+		Method:             "GET",
+		PathPattern:        "/api/v1/admin/usagereports",
+		ProducesMediaTypes: []string{"application/json", "text/csv"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListAllUsageReportsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAllUsageReportsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAllUsageReportsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListOrphanedCloudResources returns the resources at the cloud providers whose clusters do not exist anymore
+*/
+func (a *Client) ListOrphanedCloudResources(params *ListOrphanedCloudResourcesParams, authInfo runtime.ClientAuthInfoWriter) (*ListOrphanedCloudResourcesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListOrphanedCloudResourcesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listOrphanedCloudResources",
+		Method:             "GET",
+		PathPattern:        "/api/v1/admin/orphanedcloudresources",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListOrphanedCloudResourcesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListOrphanedCloudResourcesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListOrphanedCloudResourcesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListPriceLists returns the price lists of all datacenters
+*/
+func (a *Client) ListPriceLists(params *ListPriceListsParams, authInfo runtime.ClientAuthInfoWriter) (*ListPriceListsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListPriceListsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listPriceLists",
+		Method:             "GET",
+		PathPattern:        "/api/v1/admin/pricelists",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListPriceListsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListPriceListsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListPriceListsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   ListSeeds returns all seeds from the c r ds
 */
@@ -360,6 +622,42 @@ func (a *Client) ListSeeds(params *ListSeedsParams, authInfo runtime.ClientAuthI
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  	OffboardUser Suspends the given user, transfers the projects they exclusively own to the given admin
+
+  and removes them from all projects.
+*/
+func (a *Client) OffboardUser(params *OffboardUserParams, authInfo runtime.ClientAuthInfoWriter) (*OffboardUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewOffboardUserParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "offboardUser",
+		Method:             "POST",
+		PathPattern:        "/api/v1/admin/users/offboarding",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &OffboardUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*OffboardUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*OffboardUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   PatchKubermaticSettings patches the global settings
 */
@@ -394,6 +692,40 @@ func (a *Client) PatchKubermaticSettings(params *PatchKubermaticSettingsParams,
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  RotateCredentials replaces the cloud credentials of a preset or of clusters and updates the clusters using them
+*/
+func (a *Client) RotateCredentials(params *RotateCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*RotateCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRotateCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "rotateCredentials",
+		Method:             "POST",
+		PathPattern:        "/api/v1/admin/credentialrotations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RotateCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RotateCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RotateCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   SetAdmin allows setting and clearing admin role for users
 */
@@ -428,6 +760,42 @@ func (a *Client) SetAdmin(params *SetAdminParams, authInfo runtime.ClientAuthInf
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  SetUserSuspension suspends or reactivates the given user suspending a user rejects their tokens
+
+  revokes the tokens of the service accounts they created and removes their SSH keys from the clusters.
+*/
+func (a *Client) SetUserSuspension(params *SetUserSuspensionParams, authInfo runtime.ClientAuthInfoWriter) (*SetUserSuspensionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetUserSuspensionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "setUserSuspension",
+		Method:             "PUT",
+		PathPattern:        "/api/v1/admin/users/suspension",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SetUserSuspensionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetUserSuspensionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetUserSuspensionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   UpdateAdmissionPlugin updates the admission plugin
 */
@@ -462,6 +830,74 @@ func (a *Client) UpdateAdmissionPlugin(params *UpdateAdmissionPluginParams, auth
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  UpdatePriceList creates or replaces the price list of the datacenter
+*/
+func (a *Client) UpdatePriceList(params *UpdatePriceListParams, authInfo runtime.ClientAuthInfoWriter) (*UpdatePriceListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdatePriceListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updatePriceList",
+		Method:             "PUT",
+		PathPattern:        "/api/v1/admin/pricelists/{datacenter}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UpdatePriceListReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdatePriceListOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdatePriceListDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  UpdateProjectRole updates the custom project role
+*/
+func (a *Client) UpdateProjectRole(params *UpdateProjectRoleParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateProjectRoleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateProjectRoleParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateProjectRole",
+		Method:             "PATCH",
+		PathPattern:        "/api/v1/admin/projectroles/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UpdateProjectRoleReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateProjectRoleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateProjectRoleDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   UpdateSeed updates the seed
 */
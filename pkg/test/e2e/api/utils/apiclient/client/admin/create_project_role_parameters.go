@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewCreateProjectRoleParams creates a new CreateProjectRoleParams object
+// with the default values initialized.
+func NewCreateProjectRoleParams() *CreateProjectRoleParams {
+	var ()
+	return &CreateProjectRoleParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateProjectRoleParamsWithTimeout creates a new CreateProjectRoleParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCreateProjectRoleParamsWithTimeout(timeout time.Duration) *CreateProjectRoleParams {
+	var ()
+	return &CreateProjectRoleParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewCreateProjectRoleParamsWithContext creates a new CreateProjectRoleParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewCreateProjectRoleParamsWithContext(ctx context.Context) *CreateProjectRoleParams {
+	var ()
+	return &CreateProjectRoleParams{
+
+		Context: ctx,
+	}
+}
+
+// NewCreateProjectRoleParamsWithHTTPClient creates a new CreateProjectRoleParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCreateProjectRoleParamsWithHTTPClient(client *http.Client) *CreateProjectRoleParams {
+	var ()
+	return &CreateProjectRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*CreateProjectRoleParams contains all the parameters to send to the API endpoint
+for the create project role operation typically these are written to a http.Request
+*/
+type CreateProjectRoleParams struct {
+
+	/*Body*/
+	Body *models.ProjectRole
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the create project role params
+func (o *CreateProjectRoleParams) WithTimeout(timeout time.Duration) *CreateProjectRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create project role params
+func (o *CreateProjectRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create project role params
+func (o *CreateProjectRoleParams) WithContext(ctx context.Context) *CreateProjectRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create project role params
+func (o *CreateProjectRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create project role params
+func (o *CreateProjectRoleParams) WithHTTPClient(client *http.Client) *CreateProjectRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create project role params
+func (o *CreateProjectRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create project role params
+func (o *CreateProjectRoleParams) WithBody(body *models.ProjectRole) *CreateProjectRoleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create project role params
+func (o *CreateProjectRoleParams) SetBody(body *models.ProjectRole) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateProjectRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// CreateProjectRoleReader is a Reader for the CreateProjectRole structure.
+type CreateProjectRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateProjectRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateProjectRoleCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewCreateProjectRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewCreateProjectRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewCreateProjectRoleDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateProjectRoleCreated creates a CreateProjectRoleCreated with default headers values
+func NewCreateProjectRoleCreated() *CreateProjectRoleCreated {
+	return &CreateProjectRoleCreated{}
+}
+
+/*CreateProjectRoleCreated handles this case with default header values.
+
+ProjectRole
+*/
+type CreateProjectRoleCreated struct {
+	Payload *models.ProjectRole
+}
+
+func (o *CreateProjectRoleCreated) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/projectroles][%d] createProjectRoleCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateProjectRoleCreated) GetPayload() *models.ProjectRole {
+	return o.Payload
+}
+
+func (o *CreateProjectRoleCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProjectRole)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateProjectRoleUnauthorized creates a CreateProjectRoleUnauthorized with default headers values
+func NewCreateProjectRoleUnauthorized() *CreateProjectRoleUnauthorized {
+	return &CreateProjectRoleUnauthorized{}
+}
+
+/*CreateProjectRoleUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type CreateProjectRoleUnauthorized struct {
+}
+
+func (o *CreateProjectRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/projectroles][%d] createProjectRoleUnauthorized ", 401)
+}
+
+func (o *CreateProjectRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCreateProjectRoleForbidden creates a CreateProjectRoleForbidden with default headers values
+func NewCreateProjectRoleForbidden() *CreateProjectRoleForbidden {
+	return &CreateProjectRoleForbidden{}
+}
+
+/*CreateProjectRoleForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type CreateProjectRoleForbidden struct {
+}
+
+func (o *CreateProjectRoleForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/projectroles][%d] createProjectRoleForbidden ", 403)
+}
+
+func (o *CreateProjectRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCreateProjectRoleDefault creates a CreateProjectRoleDefault with default headers values
+func NewCreateProjectRoleDefault(code int) *CreateProjectRoleDefault {
+	return &CreateProjectRoleDefault{
+		_statusCode: code,
+	}
+}
+
+/*CreateProjectRoleDefault handles this case with default header values.
+
+errorResponse
+*/
+type CreateProjectRoleDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the create project role default response
+func (o *CreateProjectRoleDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateProjectRoleDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/projectroles][%d] createProjectRole default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateProjectRoleDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateProjectRoleDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeletePriceListParams creates a new DeletePriceListParams object
+// with the default values initialized.
+func NewDeletePriceListParams() *DeletePriceListParams {
+	var ()
+	return &DeletePriceListParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeletePriceListParamsWithTimeout creates a new DeletePriceListParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeletePriceListParamsWithTimeout(timeout time.Duration) *DeletePriceListParams {
+	var ()
+	return &DeletePriceListParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeletePriceListParamsWithContext creates a new DeletePriceListParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeletePriceListParamsWithContext(ctx context.Context) *DeletePriceListParams {
+	var ()
+	return &DeletePriceListParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeletePriceListParamsWithHTTPClient creates a new DeletePriceListParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeletePriceListParamsWithHTTPClient(client *http.Client) *DeletePriceListParams {
+	var ()
+	return &DeletePriceListParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeletePriceListParams contains all the parameters to send to the API endpoint
+for the delete price list operation typically these are written to a http.Request
+*/
+type DeletePriceListParams struct {
+
+	/*Datacenter*/
+	Datacenter string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete price list params
+func (o *DeletePriceListParams) WithTimeout(timeout time.Duration) *DeletePriceListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete price list params
+func (o *DeletePriceListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete price list params
+func (o *DeletePriceListParams) WithContext(ctx context.Context) *DeletePriceListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete price list params
+func (o *DeletePriceListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete price list params
+func (o *DeletePriceListParams) WithHTTPClient(client *http.Client) *DeletePriceListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete price list params
+func (o *DeletePriceListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDatacenter adds the datacenter to the delete price list params
+func (o *DeletePriceListParams) WithDatacenter(datacenter string) *DeletePriceListParams {
+	o.SetDatacenter(datacenter)
+	return o
+}
+
+// SetDatacenter adds the datacenter to the delete price list params
+func (o *DeletePriceListParams) SetDatacenter(datacenter string) {
+	o.Datacenter = datacenter
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeletePriceListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param datacenter
+	if err := r.SetPathParam("datacenter", o.Datacenter); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// DeletePriceListReader is a Reader for the DeletePriceList structure.
+type DeletePriceListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeletePriceListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeletePriceListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDeletePriceListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeletePriceListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeletePriceListDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeletePriceListOK creates a DeletePriceListOK with default headers values
+func NewDeletePriceListOK() *DeletePriceListOK {
+	return &DeletePriceListOK{}
+}
+
+/*DeletePriceListOK handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeletePriceListOK struct {
+}
+
+func (o *DeletePriceListOK) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/pricelists/{datacenter}][%d] deletePriceListOK ", 200)
+}
+
+func (o *DeletePriceListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeletePriceListUnauthorized creates a DeletePriceListUnauthorized with default headers values
+func NewDeletePriceListUnauthorized() *DeletePriceListUnauthorized {
+	return &DeletePriceListUnauthorized{}
+}
+
+/*DeletePriceListUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeletePriceListUnauthorized struct {
+}
+
+func (o *DeletePriceListUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/pricelists/{datacenter}][%d] deletePriceListUnauthorized ", 401)
+}
+
+func (o *DeletePriceListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeletePriceListForbidden creates a DeletePriceListForbidden with default headers values
+func NewDeletePriceListForbidden() *DeletePriceListForbidden {
+	return &DeletePriceListForbidden{}
+}
+
+/*DeletePriceListForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeletePriceListForbidden struct {
+}
+
+func (o *DeletePriceListForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/pricelists/{datacenter}][%d] deletePriceListForbidden ", 403)
+}
+
+func (o *DeletePriceListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeletePriceListDefault creates a DeletePriceListDefault with default headers values
+func NewDeletePriceListDefault(code int) *DeletePriceListDefault {
+	return &DeletePriceListDefault{
+		_statusCode: code,
+	}
+}
+
+/*DeletePriceListDefault handles this case with default header values.
+
+errorResponse
+*/
+type DeletePriceListDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the delete price list default response
+func (o *DeletePriceListDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeletePriceListDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/pricelists/{datacenter}][%d] deletePriceList default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeletePriceListDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeletePriceListDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteProjectRoleParams creates a new DeleteProjectRoleParams object
+// with the default values initialized.
+func NewDeleteProjectRoleParams() *DeleteProjectRoleParams {
+	var ()
+	return &DeleteProjectRoleParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteProjectRoleParamsWithTimeout creates a new DeleteProjectRoleParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteProjectRoleParamsWithTimeout(timeout time.Duration) *DeleteProjectRoleParams {
+	var ()
+	return &DeleteProjectRoleParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteProjectRoleParamsWithContext creates a new DeleteProjectRoleParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteProjectRoleParamsWithContext(ctx context.Context) *DeleteProjectRoleParams {
+	var ()
+	return &DeleteProjectRoleParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteProjectRoleParamsWithHTTPClient creates a new DeleteProjectRoleParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteProjectRoleParamsWithHTTPClient(client *http.Client) *DeleteProjectRoleParams {
+	var ()
+	return &DeleteProjectRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteProjectRoleParams contains all the parameters to send to the API endpoint
+for the delete project role operation typically these are written to a http.Request
+*/
+type DeleteProjectRoleParams struct {
+
+	/*Name*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete project role params
+func (o *DeleteProjectRoleParams) WithTimeout(timeout time.Duration) *DeleteProjectRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete project role params
+func (o *DeleteProjectRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete project role params
+func (o *DeleteProjectRoleParams) WithContext(ctx context.Context) *DeleteProjectRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete project role params
+func (o *DeleteProjectRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete project role params
+func (o *DeleteProjectRoleParams) WithHTTPClient(client *http.Client) *DeleteProjectRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete project role params
+func (o *DeleteProjectRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the delete project role params
+func (o *DeleteProjectRoleParams) WithName(name string) *DeleteProjectRoleParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the delete project role params
+func (o *DeleteProjectRoleParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteProjectRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
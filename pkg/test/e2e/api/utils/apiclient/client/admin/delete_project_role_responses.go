@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// DeleteProjectRoleReader is a Reader for the DeleteProjectRole structure.
+type DeleteProjectRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteProjectRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteProjectRoleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDeleteProjectRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeleteProjectRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteProjectRoleDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteProjectRoleOK creates a DeleteProjectRoleOK with default headers values
+func NewDeleteProjectRoleOK() *DeleteProjectRoleOK {
+	return &DeleteProjectRoleOK{}
+}
+
+/*DeleteProjectRoleOK handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteProjectRoleOK struct {
+}
+
+func (o *DeleteProjectRoleOK) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/projectroles/{name}][%d] deleteProjectRoleOK ", 200)
+}
+
+func (o *DeleteProjectRoleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteProjectRoleUnauthorized creates a DeleteProjectRoleUnauthorized with default headers values
+func NewDeleteProjectRoleUnauthorized() *DeleteProjectRoleUnauthorized {
+	return &DeleteProjectRoleUnauthorized{}
+}
+
+/*DeleteProjectRoleUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteProjectRoleUnauthorized struct {
+}
+
+func (o *DeleteProjectRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/projectroles/{name}][%d] deleteProjectRoleUnauthorized ", 401)
+}
+
+func (o *DeleteProjectRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteProjectRoleForbidden creates a DeleteProjectRoleForbidden with default headers values
+func NewDeleteProjectRoleForbidden() *DeleteProjectRoleForbidden {
+	return &DeleteProjectRoleForbidden{}
+}
+
+/*DeleteProjectRoleForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteProjectRoleForbidden struct {
+}
+
+func (o *DeleteProjectRoleForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/projectroles/{name}][%d] deleteProjectRoleForbidden ", 403)
+}
+
+func (o *DeleteProjectRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteProjectRoleDefault creates a DeleteProjectRoleDefault with default headers values
+func NewDeleteProjectRoleDefault(code int) *DeleteProjectRoleDefault {
+	return &DeleteProjectRoleDefault{
+		_statusCode: code,
+	}
+}
+
+/*DeleteProjectRoleDefault handles this case with default header values.
+
+errorResponse
+*/
+type DeleteProjectRoleDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the delete project role default response
+func (o *DeleteProjectRoleDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteProjectRoleDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/admin/projectroles/{name}][%d] deleteProjectRole default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteProjectRoleDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteProjectRoleDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetProjectRoleParams creates a new GetProjectRoleParams object
+// with the default values initialized.
+func NewGetProjectRoleParams() *GetProjectRoleParams {
+	var ()
+	return &GetProjectRoleParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetProjectRoleParamsWithTimeout creates a new GetProjectRoleParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetProjectRoleParamsWithTimeout(timeout time.Duration) *GetProjectRoleParams {
+	var ()
+	return &GetProjectRoleParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetProjectRoleParamsWithContext creates a new GetProjectRoleParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetProjectRoleParamsWithContext(ctx context.Context) *GetProjectRoleParams {
+	var ()
+	return &GetProjectRoleParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetProjectRoleParamsWithHTTPClient creates a new GetProjectRoleParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetProjectRoleParamsWithHTTPClient(client *http.Client) *GetProjectRoleParams {
+	var ()
+	return &GetProjectRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetProjectRoleParams contains all the parameters to send to the API endpoint
+for the get project role operation typically these are written to a http.Request
+*/
+type GetProjectRoleParams struct {
+
+	/*Name*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get project role params
+func (o *GetProjectRoleParams) WithTimeout(timeout time.Duration) *GetProjectRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get project role params
+func (o *GetProjectRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get project role params
+func (o *GetProjectRoleParams) WithContext(ctx context.Context) *GetProjectRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get project role params
+func (o *GetProjectRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get project role params
+func (o *GetProjectRoleParams) WithHTTPClient(client *http.Client) *GetProjectRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get project role params
+func (o *GetProjectRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the get project role params
+func (o *GetProjectRoleParams) WithName(name string) *GetProjectRoleParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the get project role params
+func (o *GetProjectRoleParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetProjectRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// GetProjectRoleReader is a Reader for the GetProjectRole structure.
+type GetProjectRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetProjectRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetProjectRoleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetProjectRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetProjectRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetProjectRoleDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetProjectRoleOK creates a GetProjectRoleOK with default headers values
+func NewGetProjectRoleOK() *GetProjectRoleOK {
+	return &GetProjectRoleOK{}
+}
+
+/*GetProjectRoleOK handles this case with default header values.
+
+ProjectRole
+*/
+type GetProjectRoleOK struct {
+	Payload *models.ProjectRole
+}
+
+func (o *GetProjectRoleOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/projectroles/{name}][%d] getProjectRoleOK  %+v", 200, o.Payload)
+}
+
+func (o *GetProjectRoleOK) GetPayload() *models.ProjectRole {
+	return o.Payload
+}
+
+func (o *GetProjectRoleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProjectRole)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProjectRoleUnauthorized creates a GetProjectRoleUnauthorized with default headers values
+func NewGetProjectRoleUnauthorized() *GetProjectRoleUnauthorized {
+	return &GetProjectRoleUnauthorized{}
+}
+
+/*GetProjectRoleUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type GetProjectRoleUnauthorized struct {
+}
+
+func (o *GetProjectRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/projectroles/{name}][%d] getProjectRoleUnauthorized ", 401)
+}
+
+func (o *GetProjectRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetProjectRoleForbidden creates a GetProjectRoleForbidden with default headers values
+func NewGetProjectRoleForbidden() *GetProjectRoleForbidden {
+	return &GetProjectRoleForbidden{}
+}
+
+/*GetProjectRoleForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type GetProjectRoleForbidden struct {
+}
+
+func (o *GetProjectRoleForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/projectroles/{name}][%d] getProjectRoleForbidden ", 403)
+}
+
+func (o *GetProjectRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetProjectRoleDefault creates a GetProjectRoleDefault with default headers values
+func NewGetProjectRoleDefault(code int) *GetProjectRoleDefault {
+	return &GetProjectRoleDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetProjectRoleDefault handles this case with default header values.
+
+errorResponse
+*/
+type GetProjectRoleDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the get project role default response
+func (o *GetProjectRoleDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetProjectRoleDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/projectroles/{name}][%d] getProjectRole default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetProjectRoleDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetProjectRoleDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAllUsageReportsParams creates a new ListAllUsageReportsParams object
+// with the default values initialized.
+func NewListAllUsageReportsParams() *ListAllUsageReportsParams {
+	var ()
+	return &ListAllUsageReportsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAllUsageReportsParamsWithTimeout creates a new ListAllUsageReportsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListAllUsageReportsParamsWithTimeout(timeout time.Duration) *ListAllUsageReportsParams {
+	var ()
+	return &ListAllUsageReportsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListAllUsageReportsParamsWithContext creates a new ListAllUsageReportsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListAllUsageReportsParamsWithContext(ctx context.Context) *ListAllUsageReportsParams {
+	var ()
+	return &ListAllUsageReportsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListAllUsageReportsParamsWithHTTPClient creates a new ListAllUsageReportsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListAllUsageReportsParamsWithHTTPClient(client *http.Client) *ListAllUsageReportsParams {
+	var ()
+	return &ListAllUsageReportsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListAllUsageReportsParams contains all the parameters to send to the API endpoint
+for the list all usage reports operation typically these are written to a http.Request
+*/
+type ListAllUsageReportsParams struct {
+
+	/*Format
+	  Format of the response, either "json" or "csv", defaults to "json"
+
+	*/
+	Format *string
+	/*Period
+	  Period restricts the reports to a month, for example "2020-10"
+
+	*/
+	Period *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list all usage reports params
+func (o *ListAllUsageReportsParams) WithTimeout(timeout time.Duration) *ListAllUsageReportsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list all usage reports params
+func (o *ListAllUsageReportsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list all usage reports params
+func (o *ListAllUsageReportsParams) WithContext(ctx context.Context) *ListAllUsageReportsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list all usage reports params
+func (o *ListAllUsageReportsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list all usage reports params
+func (o *ListAllUsageReportsParams) WithHTTPClient(client *http.Client) *ListAllUsageReportsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list all usage reports params
+func (o *ListAllUsageReportsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFormat adds the format to the list all usage reports params
+func (o *ListAllUsageReportsParams) WithFormat(format *string) *ListAllUsageReportsParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the list all usage reports params
+func (o *ListAllUsageReportsParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithPeriod adds the period to the list all usage reports params
+func (o *ListAllUsageReportsParams) WithPeriod(period *string) *ListAllUsageReportsParams {
+	o.SetPeriod(period)
+	return o
+}
+
+// SetPeriod adds the period to the list all usage reports params
+func (o *ListAllUsageReportsParams) SetPeriod(period *string) {
+	o.Period = period
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAllUsageReportsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Period != nil {
+
+		// query param period
+		var qrPeriod string
+		if o.Period != nil {
+			qrPeriod = *o.Period
+		}
+		qPeriod := qrPeriod
+		if qPeriod != "" {
+			if err := r.SetQueryParam("period", qPeriod); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListAllUsageReportsReader is a Reader for the ListAllUsageReports structure.
+type ListAllUsageReportsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAllUsageReportsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAllUsageReportsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListAllUsageReportsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListAllUsageReportsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListAllUsageReportsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAllUsageReportsOK creates a ListAllUsageReportsOK with default headers values
+func NewListAllUsageReportsOK() *ListAllUsageReportsOK {
+	return &ListAllUsageReportsOK{}
+}
+
+/*ListAllUsageReportsOK handles this case with default header values.
+
+UsageReport
+*/
+type ListAllUsageReportsOK struct {
+	Payload []*models.UsageReport
+}
+
+func (o *ListAllUsageReportsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/usagereports][%d] listAllUsageReportsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAllUsageReportsOK) GetPayload() []*models.UsageReport {
+	return o.Payload
+}
+
+func (o *ListAllUsageReportsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAllUsageReportsUnauthorized creates a ListAllUsageReportsUnauthorized with default headers values
+func NewListAllUsageReportsUnauthorized() *ListAllUsageReportsUnauthorized {
+	return &ListAllUsageReportsUnauthorized{}
+}
+
+/*ListAllUsageReportsUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListAllUsageReportsUnauthorized struct {
+}
+
+func (o *ListAllUsageReportsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/usagereports][%d] listAllUsageReportsUnauthorized ", 401)
+}
+
+func (o *ListAllUsageReportsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListAllUsageReportsForbidden creates a ListAllUsageReportsForbidden with default headers values
+func NewListAllUsageReportsForbidden() *ListAllUsageReportsForbidden {
+	return &ListAllUsageReportsForbidden{}
+}
+
+/*ListAllUsageReportsForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListAllUsageReportsForbidden struct {
+}
+
+func (o *ListAllUsageReportsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/usagereports][%d] listAllUsageReportsForbidden ", 403)
+}
+
+func (o *ListAllUsageReportsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListAllUsageReportsDefault creates a ListAllUsageReportsDefault with default headers values
+func NewListAllUsageReportsDefault(code int) *ListAllUsageReportsDefault {
+	return &ListAllUsageReportsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListAllUsageReportsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListAllUsageReportsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list all usage reports default response
+func (o *ListAllUsageReportsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAllUsageReportsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/usagereports][%d] listAllUsageReports default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAllUsageReportsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListAllUsageReportsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListPriceListsParams creates a new ListPriceListsParams object
+// with the default values initialized.
+func NewListPriceListsParams() *ListPriceListsParams {
+
+	return &ListPriceListsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListPriceListsParamsWithTimeout creates a new ListPriceListsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListPriceListsParamsWithTimeout(timeout time.Duration) *ListPriceListsParams {
+
+	return &ListPriceListsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListPriceListsParamsWithContext creates a new ListPriceListsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListPriceListsParamsWithContext(ctx context.Context) *ListPriceListsParams {
+
+	return &ListPriceListsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListPriceListsParamsWithHTTPClient creates a new ListPriceListsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListPriceListsParamsWithHTTPClient(client *http.Client) *ListPriceListsParams {
+
+	return &ListPriceListsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListPriceListsParams contains all the parameters to send to the API endpoint
+for the list price lists operation typically these are written to a http.Request
+*/
+type ListPriceListsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list price lists params
+func (o *ListPriceListsParams) WithTimeout(timeout time.Duration) *ListPriceListsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list price lists params
+func (o *ListPriceListsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list price lists params
+func (o *ListPriceListsParams) WithContext(ctx context.Context) *ListPriceListsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list price lists params
+func (o *ListPriceListsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list price lists params
+func (o *ListPriceListsParams) WithHTTPClient(client *http.Client) *ListPriceListsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list price lists params
+func (o *ListPriceListsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListPriceListsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
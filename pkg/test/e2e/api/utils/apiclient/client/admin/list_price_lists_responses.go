@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListPriceListsReader is a Reader for the ListPriceLists structure.
+type ListPriceListsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListPriceListsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListPriceListsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListPriceListsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListPriceListsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListPriceListsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListPriceListsOK creates a ListPriceListsOK with default headers values
+func NewListPriceListsOK() *ListPriceListsOK {
+	return &ListPriceListsOK{}
+}
+
+/*ListPriceListsOK handles this case with default header values.
+
+PriceList
+*/
+type ListPriceListsOK struct {
+	Payload []*models.PriceList
+}
+
+func (o *ListPriceListsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/pricelists][%d] listPriceListsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListPriceListsOK) GetPayload() []*models.PriceList {
+	return o.Payload
+}
+
+func (o *ListPriceListsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListPriceListsUnauthorized creates a ListPriceListsUnauthorized with default headers values
+func NewListPriceListsUnauthorized() *ListPriceListsUnauthorized {
+	return &ListPriceListsUnauthorized{}
+}
+
+/*ListPriceListsUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListPriceListsUnauthorized struct {
+}
+
+func (o *ListPriceListsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/pricelists][%d] listPriceListsUnauthorized ", 401)
+}
+
+func (o *ListPriceListsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListPriceListsForbidden creates a ListPriceListsForbidden with default headers values
+func NewListPriceListsForbidden() *ListPriceListsForbidden {
+	return &ListPriceListsForbidden{}
+}
+
+/*ListPriceListsForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListPriceListsForbidden struct {
+}
+
+func (o *ListPriceListsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/pricelists][%d] listPriceListsForbidden ", 403)
+}
+
+func (o *ListPriceListsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListPriceListsDefault creates a ListPriceListsDefault with default headers values
+func NewListPriceListsDefault(code int) *ListPriceListsDefault {
+	return &ListPriceListsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListPriceListsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListPriceListsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list price lists default response
+func (o *ListPriceListsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListPriceListsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/admin/pricelists][%d] listPriceLists default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListPriceListsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListPriceListsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewOffboardUserParams creates a new OffboardUserParams object
+// with the default values initialized.
+func NewOffboardUserParams() *OffboardUserParams {
+	var ()
+	return &OffboardUserParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewOffboardUserParamsWithTimeout creates a new OffboardUserParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewOffboardUserParamsWithTimeout(timeout time.Duration) *OffboardUserParams {
+	var ()
+	return &OffboardUserParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewOffboardUserParamsWithContext creates a new OffboardUserParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewOffboardUserParamsWithContext(ctx context.Context) *OffboardUserParams {
+	var ()
+	return &OffboardUserParams{
+
+		Context: ctx,
+	}
+}
+
+// NewOffboardUserParamsWithHTTPClient creates a new OffboardUserParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewOffboardUserParamsWithHTTPClient(client *http.Client) *OffboardUserParams {
+	var ()
+	return &OffboardUserParams{
+		HTTPClient: client,
+	}
+}
+
+/*OffboardUserParams contains all the parameters to send to the API endpoint
+for the offboard user operation typically these are written to a http.Request
+*/
+type OffboardUserParams struct {
+
+	/*Body*/
+	Body *models.UserOffboarding
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the offboard user params
+func (o *OffboardUserParams) WithTimeout(timeout time.Duration) *OffboardUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the offboard user params
+func (o *OffboardUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the offboard user params
+func (o *OffboardUserParams) WithContext(ctx context.Context) *OffboardUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the offboard user params
+func (o *OffboardUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the offboard user params
+func (o *OffboardUserParams) WithHTTPClient(client *http.Client) *OffboardUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the offboard user params
+func (o *OffboardUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the offboard user params
+func (o *OffboardUserParams) WithBody(body *models.UserOffboarding) *OffboardUserParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the offboard user params
+func (o *OffboardUserParams) SetBody(body *models.UserOffboarding) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *OffboardUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
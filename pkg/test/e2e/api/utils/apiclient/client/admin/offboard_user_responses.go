@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// OffboardUserReader is a Reader for the OffboardUser structure.
+type OffboardUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *OffboardUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewOffboardUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewOffboardUserUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewOffboardUserForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewOffboardUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewOffboardUserOK creates a OffboardUserOK with default headers values
+func NewOffboardUserOK() *OffboardUserOK {
+	return &OffboardUserOK{}
+}
+
+/*OffboardUserOK handles this case with default header values.
+
+UserOffboarding
+*/
+type OffboardUserOK struct {
+	Payload *models.UserOffboarding
+}
+
+func (o *OffboardUserOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/users/offboarding][%d] offboardUserOK  %+v", 200, o.Payload)
+}
+
+func (o *OffboardUserOK) GetPayload() *models.UserOffboarding {
+	return o.Payload
+}
+
+func (o *OffboardUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UserOffboarding)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewOffboardUserUnauthorized creates a OffboardUserUnauthorized with default headers values
+func NewOffboardUserUnauthorized() *OffboardUserUnauthorized {
+	return &OffboardUserUnauthorized{}
+}
+
+/*OffboardUserUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type OffboardUserUnauthorized struct {
+}
+
+func (o *OffboardUserUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/users/offboarding][%d] offboardUserUnauthorized ", 401)
+}
+
+func (o *OffboardUserUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewOffboardUserForbidden creates a OffboardUserForbidden with default headers values
+func NewOffboardUserForbidden() *OffboardUserForbidden {
+	return &OffboardUserForbidden{}
+}
+
+/*OffboardUserForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type OffboardUserForbidden struct {
+}
+
+func (o *OffboardUserForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/users/offboarding][%d] offboardUserForbidden ", 403)
+}
+
+func (o *OffboardUserForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewOffboardUserDefault creates a OffboardUserDefault with default headers values
+func NewOffboardUserDefault(code int) *OffboardUserDefault {
+	return &OffboardUserDefault{
+		_statusCode: code,
+	}
+}
+
+/*OffboardUserDefault handles this case with default header values.
+
+errorResponse
+*/
+type OffboardUserDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the offboard user default response
+func (o *OffboardUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *OffboardUserDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/admin/users/offboarding][%d] offboardUser default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *OffboardUserDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *OffboardUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewSetUserSuspensionParams creates a new SetUserSuspensionParams object
+// with the default values initialized.
+func NewSetUserSuspensionParams() *SetUserSuspensionParams {
+	var ()
+	return &SetUserSuspensionParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSetUserSuspensionParamsWithTimeout creates a new SetUserSuspensionParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSetUserSuspensionParamsWithTimeout(timeout time.Duration) *SetUserSuspensionParams {
+	var ()
+	return &SetUserSuspensionParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSetUserSuspensionParamsWithContext creates a new SetUserSuspensionParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSetUserSuspensionParamsWithContext(ctx context.Context) *SetUserSuspensionParams {
+	var ()
+	return &SetUserSuspensionParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSetUserSuspensionParamsWithHTTPClient creates a new SetUserSuspensionParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSetUserSuspensionParamsWithHTTPClient(client *http.Client) *SetUserSuspensionParams {
+	var ()
+	return &SetUserSuspensionParams{
+		HTTPClient: client,
+	}
+}
+
+/*SetUserSuspensionParams contains all the parameters to send to the API endpoint
+for the set user suspension operation typically these are written to a http.Request
+*/
+type SetUserSuspensionParams struct {
+
+	/*Body*/
+	Body *models.UserSuspension
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the set user suspension params
+func (o *SetUserSuspensionParams) WithTimeout(timeout time.Duration) *SetUserSuspensionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the set user suspension params
+func (o *SetUserSuspensionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the set user suspension params
+func (o *SetUserSuspensionParams) WithContext(ctx context.Context) *SetUserSuspensionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the set user suspension params
+func (o *SetUserSuspensionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the set user suspension params
+func (o *SetUserSuspensionParams) WithHTTPClient(client *http.Client) *SetUserSuspensionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the set user suspension params
+func (o *SetUserSuspensionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the set user suspension params
+func (o *SetUserSuspensionParams) WithBody(body *models.UserSuspension) *SetUserSuspensionParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the set user suspension params
+func (o *SetUserSuspensionParams) SetBody(body *models.UserSuspension) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SetUserSuspensionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// SetUserSuspensionReader is a Reader for the SetUserSuspension structure.
+type SetUserSuspensionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetUserSuspensionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetUserSuspensionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewSetUserSuspensionUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewSetUserSuspensionForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewSetUserSuspensionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetUserSuspensionOK creates a SetUserSuspensionOK with default headers values
+func NewSetUserSuspensionOK() *SetUserSuspensionOK {
+	return &SetUserSuspensionOK{}
+}
+
+/*SetUserSuspensionOK handles this case with default header values.
+
+UserSuspension
+*/
+type SetUserSuspensionOK struct {
+	Payload *models.UserSuspension
+}
+
+func (o *SetUserSuspensionOK) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/users/suspension][%d] setUserSuspensionOK  %+v", 200, o.Payload)
+}
+
+func (o *SetUserSuspensionOK) GetPayload() *models.UserSuspension {
+	return o.Payload
+}
+
+func (o *SetUserSuspensionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.UserSuspension)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetUserSuspensionUnauthorized creates a SetUserSuspensionUnauthorized with default headers values
+func NewSetUserSuspensionUnauthorized() *SetUserSuspensionUnauthorized {
+	return &SetUserSuspensionUnauthorized{}
+}
+
+/*SetUserSuspensionUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type SetUserSuspensionUnauthorized struct {
+}
+
+func (o *SetUserSuspensionUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/users/suspension][%d] setUserSuspensionUnauthorized ", 401)
+}
+
+func (o *SetUserSuspensionUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSetUserSuspensionForbidden creates a SetUserSuspensionForbidden with default headers values
+func NewSetUserSuspensionForbidden() *SetUserSuspensionForbidden {
+	return &SetUserSuspensionForbidden{}
+}
+
+/*SetUserSuspensionForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type SetUserSuspensionForbidden struct {
+}
+
+func (o *SetUserSuspensionForbidden) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/users/suspension][%d] setUserSuspensionForbidden ", 403)
+}
+
+func (o *SetUserSuspensionForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSetUserSuspensionDefault creates a SetUserSuspensionDefault with default headers values
+func NewSetUserSuspensionDefault(code int) *SetUserSuspensionDefault {
+	return &SetUserSuspensionDefault{
+		_statusCode: code,
+	}
+}
+
+/*SetUserSuspensionDefault handles this case with default header values.
+
+errorResponse
+*/
+type SetUserSuspensionDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the set user suspension default response
+func (o *SetUserSuspensionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetUserSuspensionDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/users/suspension][%d] setUserSuspension default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *SetUserSuspensionDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *SetUserSuspensionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewUpdatePriceListParams creates a new UpdatePriceListParams object
+// with the default values initialized.
+func NewUpdatePriceListParams() *UpdatePriceListParams {
+	var ()
+	return &UpdatePriceListParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdatePriceListParamsWithTimeout creates a new UpdatePriceListParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdatePriceListParamsWithTimeout(timeout time.Duration) *UpdatePriceListParams {
+	var ()
+	return &UpdatePriceListParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdatePriceListParamsWithContext creates a new UpdatePriceListParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdatePriceListParamsWithContext(ctx context.Context) *UpdatePriceListParams {
+	var ()
+	return &UpdatePriceListParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdatePriceListParamsWithHTTPClient creates a new UpdatePriceListParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdatePriceListParamsWithHTTPClient(client *http.Client) *UpdatePriceListParams {
+	var ()
+	return &UpdatePriceListParams{
+		HTTPClient: client,
+	}
+}
+
+/*UpdatePriceListParams contains all the parameters to send to the API endpoint
+for the update price list operation typically these are written to a http.Request
+*/
+type UpdatePriceListParams struct {
+
+	/*Body*/
+	Body *models.PriceList
+	/*Datacenter*/
+	Datacenter string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update price list params
+func (o *UpdatePriceListParams) WithTimeout(timeout time.Duration) *UpdatePriceListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update price list params
+func (o *UpdatePriceListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update price list params
+func (o *UpdatePriceListParams) WithContext(ctx context.Context) *UpdatePriceListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update price list params
+func (o *UpdatePriceListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update price list params
+func (o *UpdatePriceListParams) WithHTTPClient(client *http.Client) *UpdatePriceListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update price list params
+func (o *UpdatePriceListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update price list params
+func (o *UpdatePriceListParams) WithBody(body *models.PriceList) *UpdatePriceListParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update price list params
+func (o *UpdatePriceListParams) SetBody(body *models.PriceList) {
+	o.Body = body
+}
+
+// WithDatacenter adds the datacenter to the update price list params
+func (o *UpdatePriceListParams) WithDatacenter(datacenter string) *UpdatePriceListParams {
+	o.SetDatacenter(datacenter)
+	return o
+}
+
+// SetDatacenter adds the datacenter to the update price list params
+func (o *UpdatePriceListParams) SetDatacenter(datacenter string) {
+	o.Datacenter = datacenter
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdatePriceListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param datacenter
+	if err := r.SetPathParam("datacenter", o.Datacenter); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
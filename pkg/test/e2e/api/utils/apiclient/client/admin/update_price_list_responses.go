@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// UpdatePriceListReader is a Reader for the UpdatePriceList structure.
+type UpdatePriceListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdatePriceListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdatePriceListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewUpdatePriceListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewUpdatePriceListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUpdatePriceListDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdatePriceListOK creates a UpdatePriceListOK with default headers values
+func NewUpdatePriceListOK() *UpdatePriceListOK {
+	return &UpdatePriceListOK{}
+}
+
+/*UpdatePriceListOK handles this case with default header values.
+
+PriceList
+*/
+type UpdatePriceListOK struct {
+	Payload *models.PriceList
+}
+
+func (o *UpdatePriceListOK) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/pricelists/{datacenter}][%d] updatePriceListOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdatePriceListOK) GetPayload() *models.PriceList {
+	return o.Payload
+}
+
+func (o *UpdatePriceListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PriceList)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdatePriceListUnauthorized creates a UpdatePriceListUnauthorized with default headers values
+func NewUpdatePriceListUnauthorized() *UpdatePriceListUnauthorized {
+	return &UpdatePriceListUnauthorized{}
+}
+
+/*UpdatePriceListUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UpdatePriceListUnauthorized struct {
+}
+
+func (o *UpdatePriceListUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/pricelists/{datacenter}][%d] updatePriceListUnauthorized ", 401)
+}
+
+func (o *UpdatePriceListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUpdatePriceListForbidden creates a UpdatePriceListForbidden with default headers values
+func NewUpdatePriceListForbidden() *UpdatePriceListForbidden {
+	return &UpdatePriceListForbidden{}
+}
+
+/*UpdatePriceListForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UpdatePriceListForbidden struct {
+}
+
+func (o *UpdatePriceListForbidden) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/pricelists/{datacenter}][%d] updatePriceListForbidden ", 403)
+}
+
+func (o *UpdatePriceListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUpdatePriceListDefault creates a UpdatePriceListDefault with default headers values
+func NewUpdatePriceListDefault(code int) *UpdatePriceListDefault {
+	return &UpdatePriceListDefault{
+		_statusCode: code,
+	}
+}
+
+/*UpdatePriceListDefault handles this case with default header values.
+
+errorResponse
+*/
+type UpdatePriceListDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the update price list default response
+func (o *UpdatePriceListDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdatePriceListDefault) Error() string {
+	return fmt.Sprintf("[PUT /api/v1/admin/pricelists/{datacenter}][%d] updatePriceList default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdatePriceListDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdatePriceListDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewUpdateProjectRoleParams creates a new UpdateProjectRoleParams object
+// with the default values initialized.
+func NewUpdateProjectRoleParams() *UpdateProjectRoleParams {
+	var ()
+	return &UpdateProjectRoleParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateProjectRoleParamsWithTimeout creates a new UpdateProjectRoleParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateProjectRoleParamsWithTimeout(timeout time.Duration) *UpdateProjectRoleParams {
+	var ()
+	return &UpdateProjectRoleParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateProjectRoleParamsWithContext creates a new UpdateProjectRoleParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateProjectRoleParamsWithContext(ctx context.Context) *UpdateProjectRoleParams {
+	var ()
+	return &UpdateProjectRoleParams{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateProjectRoleParamsWithHTTPClient creates a new UpdateProjectRoleParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateProjectRoleParamsWithHTTPClient(client *http.Client) *UpdateProjectRoleParams {
+	var ()
+	return &UpdateProjectRoleParams{
+		HTTPClient: client,
+	}
+}
+
+/*UpdateProjectRoleParams contains all the parameters to send to the API endpoint
+for the update project role operation typically these are written to a http.Request
+*/
+type UpdateProjectRoleParams struct {
+
+	/*Body*/
+	Body *models.ProjectRole
+	/*Name*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update project role params
+func (o *UpdateProjectRoleParams) WithTimeout(timeout time.Duration) *UpdateProjectRoleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update project role params
+func (o *UpdateProjectRoleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update project role params
+func (o *UpdateProjectRoleParams) WithContext(ctx context.Context) *UpdateProjectRoleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update project role params
+func (o *UpdateProjectRoleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update project role params
+func (o *UpdateProjectRoleParams) WithHTTPClient(client *http.Client) *UpdateProjectRoleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update project role params
+func (o *UpdateProjectRoleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update project role params
+func (o *UpdateProjectRoleParams) WithBody(body *models.ProjectRole) *UpdateProjectRoleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update project role params
+func (o *UpdateProjectRoleParams) SetBody(body *models.ProjectRole) {
+	o.Body = body
+}
+
+// WithName adds the name to the update project role params
+func (o *UpdateProjectRoleParams) WithName(name string) *UpdateProjectRoleParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the update project role params
+func (o *UpdateProjectRoleParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateProjectRoleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
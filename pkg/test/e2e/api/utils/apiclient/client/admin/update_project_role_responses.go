@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// UpdateProjectRoleReader is a Reader for the UpdateProjectRole structure.
+type UpdateProjectRoleReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateProjectRoleReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateProjectRoleOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewUpdateProjectRoleUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewUpdateProjectRoleForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUpdateProjectRoleDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateProjectRoleOK creates a UpdateProjectRoleOK with default headers values
+func NewUpdateProjectRoleOK() *UpdateProjectRoleOK {
+	return &UpdateProjectRoleOK{}
+}
+
+/*UpdateProjectRoleOK handles this case with default header values.
+
+ProjectRole
+*/
+type UpdateProjectRoleOK struct {
+	Payload *models.ProjectRole
+}
+
+func (o *UpdateProjectRoleOK) Error() string {
+	return fmt.Sprintf("[PATCH /api/v1/admin/projectroles/{name}][%d] updateProjectRoleOK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateProjectRoleOK) GetPayload() *models.ProjectRole {
+	return o.Payload
+}
+
+func (o *UpdateProjectRoleOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProjectRole)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateProjectRoleUnauthorized creates a UpdateProjectRoleUnauthorized with default headers values
+func NewUpdateProjectRoleUnauthorized() *UpdateProjectRoleUnauthorized {
+	return &UpdateProjectRoleUnauthorized{}
+}
+
+/*UpdateProjectRoleUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UpdateProjectRoleUnauthorized struct {
+}
+
+func (o *UpdateProjectRoleUnauthorized) Error() string {
+	return fmt.Sprintf("[PATCH /api/v1/admin/projectroles/{name}][%d] updateProjectRoleUnauthorized ", 401)
+}
+
+func (o *UpdateProjectRoleUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUpdateProjectRoleForbidden creates a UpdateProjectRoleForbidden with default headers values
+func NewUpdateProjectRoleForbidden() *UpdateProjectRoleForbidden {
+	return &UpdateProjectRoleForbidden{}
+}
+
+/*UpdateProjectRoleForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UpdateProjectRoleForbidden struct {
+}
+
+func (o *UpdateProjectRoleForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /api/v1/admin/projectroles/{name}][%d] updateProjectRoleForbidden ", 403)
+}
+
+func (o *UpdateProjectRoleForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUpdateProjectRoleDefault creates a UpdateProjectRoleDefault with default headers values
+func NewUpdateProjectRoleDefault(code int) *UpdateProjectRoleDefault {
+	return &UpdateProjectRoleDefault{
+		_statusCode: code,
+	}
+}
+
+/*UpdateProjectRoleDefault handles this case with default header values.
+
+errorResponse
+*/
+type UpdateProjectRoleDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the update project role default response
+func (o *UpdateProjectRoleDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateProjectRoleDefault) Error() string {
+	return fmt.Sprintf("[PATCH /api/v1/admin/projectroles/{name}][%d] updateProjectRole default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UpdateProjectRoleDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateProjectRoleDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -29,6 +29,14 @@ type ClientService interface {
 
 	ListAlibabaInstanceTypesNoCredentials(params *ListAlibabaInstanceTypesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaInstanceTypesNoCredentialsOK, error)
 
+	ListAlibabaVPCs(params *ListAlibabaVPCsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVPCsOK, error)
+
+	ListAlibabaVPCsNoCredentials(params *ListAlibabaVPCsNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVPCsNoCredentialsOK, error)
+
+	ListAlibabaVSwitches(params *ListAlibabaVSwitchesParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVSwitchesOK, error)
+
+	ListAlibabaVSwitchesNoCredentials(params *ListAlibabaVSwitchesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVSwitchesNoCredentialsOK, error)
+
 	ListAlibabaZones(params *ListAlibabaZonesParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaZonesOK, error)
 
 	ListAlibabaZonesNoCredentials(params *ListAlibabaZonesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaZonesNoCredentialsOK, error)
@@ -104,6 +112,142 @@ func (a *Client) ListAlibabaInstanceTypesNoCredentials(params *ListAlibabaInstan
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListAlibabaVPCs lists available alibaba v p cs
+*/
+func (a *Client) ListAlibabaVPCs(params *ListAlibabaVPCsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVPCsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAlibabaVPCsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listAlibabaVPCs",
+		Method:             "GET",
+		PathPattern:        "/api/v1/providers/alibaba/vpcs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListAlibabaVPCsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAlibabaVPCsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAlibabaVPCsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListAlibabaVPCsNoCredentials Lists available Alibaba VPCs
+*/
+func (a *Client) ListAlibabaVPCsNoCredentials(params *ListAlibabaVPCsNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVPCsNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAlibabaVPCsNoCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listAlibabaVPCsNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vpcs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListAlibabaVPCsNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAlibabaVPCsNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAlibabaVPCsNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListAlibabaVSwitches lists available alibaba v switches optionally filtered by v p c
+*/
+func (a *Client) ListAlibabaVSwitches(params *ListAlibabaVSwitchesParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVSwitchesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAlibabaVSwitchesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listAlibabaVSwitches",
+		Method:             "GET",
+		PathPattern:        "/api/v1/providers/alibaba/vswitches",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListAlibabaVSwitchesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAlibabaVSwitchesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAlibabaVSwitchesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListAlibabaVSwitchesNoCredentials Lists available Alibaba vSwitches
+*/
+func (a *Client) ListAlibabaVSwitchesNoCredentials(params *ListAlibabaVSwitchesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListAlibabaVSwitchesNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAlibabaVSwitchesNoCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listAlibabaVSwitchesNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vswitches",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListAlibabaVSwitchesNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAlibabaVSwitchesNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAlibabaVSwitchesNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   ListAlibabaZones lists available alibaba zones
 */
@@ -0,0 +1,190 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAlibabaVPCsNoCredentialsParams creates a new ListAlibabaVPCsNoCredentialsParams object
+// with the default values initialized.
+func NewListAlibabaVPCsNoCredentialsParams() *ListAlibabaVPCsNoCredentialsParams {
+	var ()
+	return &ListAlibabaVPCsNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAlibabaVPCsNoCredentialsParamsWithTimeout creates a new ListAlibabaVPCsNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListAlibabaVPCsNoCredentialsParamsWithTimeout(timeout time.Duration) *ListAlibabaVPCsNoCredentialsParams {
+	var ()
+	return &ListAlibabaVPCsNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListAlibabaVPCsNoCredentialsParamsWithContext creates a new ListAlibabaVPCsNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListAlibabaVPCsNoCredentialsParamsWithContext(ctx context.Context) *ListAlibabaVPCsNoCredentialsParams {
+	var ()
+	return &ListAlibabaVPCsNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListAlibabaVPCsNoCredentialsParamsWithHTTPClient creates a new ListAlibabaVPCsNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListAlibabaVPCsNoCredentialsParamsWithHTTPClient(client *http.Client) *ListAlibabaVPCsNoCredentialsParams {
+	var ()
+	return &ListAlibabaVPCsNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListAlibabaVPCsNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list alibaba v p cs no credentials operation typically these are written to a http.Request
+*/
+type ListAlibabaVPCsNoCredentialsParams struct {
+
+	/*Region*/
+	Region *string
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithTimeout(timeout time.Duration) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithContext(ctx context.Context) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithHTTPClient(client *http.Client) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRegion adds the region to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithRegion(region *string) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetRegion(region *string) {
+	o.Region = region
+}
+
+// WithClusterID adds the clusterID to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithClusterID(clusterID string) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithDC(dc string) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) WithProjectID(projectID string) *ListAlibabaVPCsNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list alibaba v p cs no credentials params
+func (o *ListAlibabaVPCsNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAlibabaVPCsNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Region != nil {
+
+		// header param Region
+		if err := r.SetHeaderParam("Region", *o.Region); err != nil {
+			return err
+		}
+
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
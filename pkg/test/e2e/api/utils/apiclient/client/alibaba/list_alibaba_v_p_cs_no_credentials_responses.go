@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListAlibabaVPCsNoCredentialsReader is a Reader for the ListAlibabaVPCsNoCredentials structure.
+type ListAlibabaVPCsNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAlibabaVPCsNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAlibabaVPCsNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListAlibabaVPCsNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAlibabaVPCsNoCredentialsOK creates a ListAlibabaVPCsNoCredentialsOK with default headers values
+func NewListAlibabaVPCsNoCredentialsOK() *ListAlibabaVPCsNoCredentialsOK {
+	return &ListAlibabaVPCsNoCredentialsOK{}
+}
+
+/*ListAlibabaVPCsNoCredentialsOK handles this case with default header values.
+
+AlibabaVPCList
+*/
+type ListAlibabaVPCsNoCredentialsOK struct {
+	Payload models.AlibabaVPCList
+}
+
+func (o *ListAlibabaVPCsNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vpcs][%d] listAlibabaVPCsNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAlibabaVPCsNoCredentialsOK) GetPayload() models.AlibabaVPCList {
+	return o.Payload
+}
+
+func (o *ListAlibabaVPCsNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAlibabaVPCsNoCredentialsDefault creates a ListAlibabaVPCsNoCredentialsDefault with default headers values
+func NewListAlibabaVPCsNoCredentialsDefault(code int) *ListAlibabaVPCsNoCredentialsDefault {
+	return &ListAlibabaVPCsNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListAlibabaVPCsNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListAlibabaVPCsNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list alibaba v p cs no credentials default response
+func (o *ListAlibabaVPCsNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAlibabaVPCsNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vpcs][%d] listAlibabaVPCsNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAlibabaVPCsNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListAlibabaVPCsNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
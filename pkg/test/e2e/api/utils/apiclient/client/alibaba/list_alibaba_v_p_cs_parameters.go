@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAlibabaVPCsParams creates a new ListAlibabaVPCsParams object
+// with the default values initialized.
+func NewListAlibabaVPCsParams() *ListAlibabaVPCsParams {
+	var ()
+	return &ListAlibabaVPCsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAlibabaVPCsParamsWithTimeout creates a new ListAlibabaVPCsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListAlibabaVPCsParamsWithTimeout(timeout time.Duration) *ListAlibabaVPCsParams {
+	var ()
+	return &ListAlibabaVPCsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListAlibabaVPCsParamsWithContext creates a new ListAlibabaVPCsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListAlibabaVPCsParamsWithContext(ctx context.Context) *ListAlibabaVPCsParams {
+	var ()
+	return &ListAlibabaVPCsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListAlibabaVPCsParamsWithHTTPClient creates a new ListAlibabaVPCsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListAlibabaVPCsParamsWithHTTPClient(client *http.Client) *ListAlibabaVPCsParams {
+	var ()
+	return &ListAlibabaVPCsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListAlibabaVPCsParams contains all the parameters to send to the API endpoint
+for the list alibaba v p cs operation typically these are written to a http.Request
+*/
+type ListAlibabaVPCsParams struct {
+
+	/*AccessKeyID*/
+	AccessKeyID *string
+	/*AccessKeySecret*/
+	AccessKeySecret *string
+	/*Credential*/
+	Credential *string
+	/*Region*/
+	Region *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithTimeout(timeout time.Duration) *ListAlibabaVPCsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithContext(ctx context.Context) *ListAlibabaVPCsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithHTTPClient(client *http.Client) *ListAlibabaVPCsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccessKeyID adds the accessKeyID to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithAccessKeyID(accessKeyID *string) *ListAlibabaVPCsParams {
+	o.SetAccessKeyID(accessKeyID)
+	return o
+}
+
+// SetAccessKeyID adds the accessKeyId to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetAccessKeyID(accessKeyID *string) {
+	o.AccessKeyID = accessKeyID
+}
+
+// WithAccessKeySecret adds the accessKeySecret to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithAccessKeySecret(accessKeySecret *string) *ListAlibabaVPCsParams {
+	o.SetAccessKeySecret(accessKeySecret)
+	return o
+}
+
+// SetAccessKeySecret adds the accessKeySecret to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetAccessKeySecret(accessKeySecret *string) {
+	o.AccessKeySecret = accessKeySecret
+}
+
+// WithCredential adds the credential to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithCredential(credential *string) *ListAlibabaVPCsParams {
+	o.SetCredential(credential)
+	return o
+}
+
+// SetCredential adds the credential to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetCredential(credential *string) {
+	o.Credential = credential
+}
+
+// WithRegion adds the region to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) WithRegion(region *string) *ListAlibabaVPCsParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the list alibaba v p cs params
+func (o *ListAlibabaVPCsParams) SetRegion(region *string) {
+	o.Region = region
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAlibabaVPCsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AccessKeyID != nil {
+
+		// header param AccessKeyID
+		if err := r.SetHeaderParam("AccessKeyID", *o.AccessKeyID); err != nil {
+			return err
+		}
+
+	}
+
+	if o.AccessKeySecret != nil {
+
+		// header param AccessKeySecret
+		if err := r.SetHeaderParam("AccessKeySecret", *o.AccessKeySecret); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Credential != nil {
+
+		// header param Credential
+		if err := r.SetHeaderParam("Credential", *o.Credential); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Region != nil {
+
+		// header param Region
+		if err := r.SetHeaderParam("Region", *o.Region); err != nil {
+			return err
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
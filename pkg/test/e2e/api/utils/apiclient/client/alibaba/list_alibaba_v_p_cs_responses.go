@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListAlibabaVPCsReader is a Reader for the ListAlibabaVPCs structure.
+type ListAlibabaVPCsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAlibabaVPCsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAlibabaVPCsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListAlibabaVPCsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAlibabaVPCsOK creates a ListAlibabaVPCsOK with default headers values
+func NewListAlibabaVPCsOK() *ListAlibabaVPCsOK {
+	return &ListAlibabaVPCsOK{}
+}
+
+/*ListAlibabaVPCsOK handles this case with default header values.
+
+AlibabaVPCList
+*/
+type ListAlibabaVPCsOK struct {
+	Payload models.AlibabaVPCList
+}
+
+func (o *ListAlibabaVPCsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/alibaba/vpcs][%d] listAlibabaVPCsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAlibabaVPCsOK) GetPayload() models.AlibabaVPCList {
+	return o.Payload
+}
+
+func (o *ListAlibabaVPCsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAlibabaVPCsDefault creates a ListAlibabaVPCsDefault with default headers values
+func NewListAlibabaVPCsDefault(code int) *ListAlibabaVPCsDefault {
+	return &ListAlibabaVPCsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListAlibabaVPCsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListAlibabaVPCsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list alibaba v p cs default response
+func (o *ListAlibabaVPCsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAlibabaVPCsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/alibaba/vpcs][%d] listAlibabaVPCs default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAlibabaVPCsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListAlibabaVPCsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
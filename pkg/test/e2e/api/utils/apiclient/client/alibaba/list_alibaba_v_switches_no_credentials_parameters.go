@@ -0,0 +1,219 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAlibabaVSwitchesNoCredentialsParams creates a new ListAlibabaVSwitchesNoCredentialsParams object
+// with the default values initialized.
+func NewListAlibabaVSwitchesNoCredentialsParams() *ListAlibabaVSwitchesNoCredentialsParams {
+	var ()
+	return &ListAlibabaVSwitchesNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAlibabaVSwitchesNoCredentialsParamsWithTimeout creates a new ListAlibabaVSwitchesNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListAlibabaVSwitchesNoCredentialsParamsWithTimeout(timeout time.Duration) *ListAlibabaVSwitchesNoCredentialsParams {
+	var ()
+	return &ListAlibabaVSwitchesNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListAlibabaVSwitchesNoCredentialsParamsWithContext creates a new ListAlibabaVSwitchesNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListAlibabaVSwitchesNoCredentialsParamsWithContext(ctx context.Context) *ListAlibabaVSwitchesNoCredentialsParams {
+	var ()
+	return &ListAlibabaVSwitchesNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListAlibabaVSwitchesNoCredentialsParamsWithHTTPClient creates a new ListAlibabaVSwitchesNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListAlibabaVSwitchesNoCredentialsParamsWithHTTPClient(client *http.Client) *ListAlibabaVSwitchesNoCredentialsParams {
+	var ()
+	return &ListAlibabaVSwitchesNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListAlibabaVSwitchesNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list alibaba v switches no credentials operation typically these are written to a http.Request
+*/
+type ListAlibabaVSwitchesNoCredentialsParams struct {
+
+	/*Region*/
+	Region *string
+	/*VPCID*/
+	VPCID *string
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithTimeout(timeout time.Duration) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithContext(ctx context.Context) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithHTTPClient(client *http.Client) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithRegion adds the region to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithRegion(region *string) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetRegion(region *string) {
+	o.Region = region
+}
+
+// WithVPCID adds the vPCID to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithVPCID(vPCID *string) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetVPCID(vPCID)
+	return o
+}
+
+// SetVPCID adds the vPCId to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetVPCID(vPCID *string) {
+	o.VPCID = vPCID
+}
+
+// WithClusterID adds the clusterID to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithClusterID(clusterID string) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithDC(dc string) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WithProjectID(projectID string) *ListAlibabaVSwitchesNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list alibaba v switches no credentials params
+func (o *ListAlibabaVSwitchesNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAlibabaVSwitchesNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Region != nil {
+
+		// header param Region
+		if err := r.SetHeaderParam("Region", *o.Region); err != nil {
+			return err
+		}
+
+	}
+
+	if o.VPCID != nil {
+
+		// query param VPCID
+		var qrVPCID string
+		if o.VPCID != nil {
+			qrVPCID = *o.VPCID
+		}
+		qVPCID := qrVPCID
+		if qVPCID != "" {
+			if err := r.SetQueryParam("VPCID", qVPCID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
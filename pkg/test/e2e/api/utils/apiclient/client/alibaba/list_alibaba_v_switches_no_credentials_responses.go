@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListAlibabaVSwitchesNoCredentialsReader is a Reader for the ListAlibabaVSwitchesNoCredentials structure.
+type ListAlibabaVSwitchesNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAlibabaVSwitchesNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAlibabaVSwitchesNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListAlibabaVSwitchesNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAlibabaVSwitchesNoCredentialsOK creates a ListAlibabaVSwitchesNoCredentialsOK with default headers values
+func NewListAlibabaVSwitchesNoCredentialsOK() *ListAlibabaVSwitchesNoCredentialsOK {
+	return &ListAlibabaVSwitchesNoCredentialsOK{}
+}
+
+/*ListAlibabaVSwitchesNoCredentialsOK handles this case with default header values.
+
+AlibabaVSwitchList
+*/
+type ListAlibabaVSwitchesNoCredentialsOK struct {
+	Payload models.AlibabaVSwitchList
+}
+
+func (o *ListAlibabaVSwitchesNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vswitches][%d] listAlibabaVSwitchesNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListAlibabaVSwitchesNoCredentialsOK) GetPayload() models.AlibabaVSwitchList {
+	return o.Payload
+}
+
+func (o *ListAlibabaVSwitchesNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAlibabaVSwitchesNoCredentialsDefault creates a ListAlibabaVSwitchesNoCredentialsDefault with default headers values
+func NewListAlibabaVSwitchesNoCredentialsDefault(code int) *ListAlibabaVSwitchesNoCredentialsDefault {
+	return &ListAlibabaVSwitchesNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListAlibabaVSwitchesNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListAlibabaVSwitchesNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list alibaba v switches no credentials default response
+func (o *ListAlibabaVSwitchesNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAlibabaVSwitchesNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/alibaba/vswitches][%d] listAlibabaVSwitchesNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListAlibabaVSwitchesNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListAlibabaVSwitchesNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package alibaba
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAlibabaVSwitchesParams creates a new ListAlibabaVSwitchesParams object
+// with the default values initialized.
+func NewListAlibabaVSwitchesParams() *ListAlibabaVSwitchesParams {
+	var ()
+	return &ListAlibabaVSwitchesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAlibabaVSwitchesParamsWithTimeout creates a new ListAlibabaVSwitchesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListAlibabaVSwitchesParamsWithTimeout(timeout time.Duration) *ListAlibabaVSwitchesParams {
+	var ()
+	return &ListAlibabaVSwitchesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListAlibabaVSwitchesParamsWithContext creates a new ListAlibabaVSwitchesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListAlibabaVSwitchesParamsWithContext(ctx context.Context) *ListAlibabaVSwitchesParams {
+	var ()
+	return &ListAlibabaVSwitchesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListAlibabaVSwitchesParamsWithHTTPClient creates a new ListAlibabaVSwitchesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListAlibabaVSwitchesParamsWithHTTPClient(client *http.Client) *ListAlibabaVSwitchesParams {
+	var ()
+	return &ListAlibabaVSwitchesParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListAlibabaVSwitchesParams contains all the parameters to send to the API endpoint
+for the list alibaba v switches operation typically these are written to a http.Request
+*/
+type ListAlibabaVSwitchesParams struct {
+
+	/*AccessKeyID*/
+	AccessKeyID *string
+	/*AccessKeySecret*/
+	AccessKeySecret *string
+	/*Credential*/
+	Credential *string
+	/*Region*/
+	Region *string
+	/*VPCID*/
+	VPCID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithTimeout(timeout time.Duration) *ListAlibabaVSwitchesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithContext(ctx context.Context) *ListAlibabaVSwitchesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithHTTPClient(client *http.Client) *ListAlibabaVSwitchesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAccessKeyID adds the accessKeyID to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithAccessKeyID(accessKeyID *string) *ListAlibabaVSwitchesParams {
+	o.SetAccessKeyID(accessKeyID)
+	return o
+}
+
+// SetAccessKeyID adds the accessKeyId to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetAccessKeyID(accessKeyID *string) {
+	o.AccessKeyID = accessKeyID
+}
+
+// WithAccessKeySecret adds the accessKeySecret to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithAccessKeySecret(accessKeySecret *string) *ListAlibabaVSwitchesParams {
+	o.SetAccessKeySecret(accessKeySecret)
+	return o
+}
+
+// SetAccessKeySecret adds the accessKeySecret to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetAccessKeySecret(accessKeySecret *string) {
+	o.AccessKeySecret = accessKeySecret
+}
+
+// WithCredential adds the credential to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithCredential(credential *string) *ListAlibabaVSwitchesParams {
+	o.SetCredential(credential)
+	return o
+}
+
+// SetCredential adds the credential to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetCredential(credential *string) {
+	o.Credential = credential
+}
+
+// WithRegion adds the region to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithRegion(region *string) *ListAlibabaVSwitchesParams {
+	o.SetRegion(region)
+	return o
+}
+
+// SetRegion adds the region to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetRegion(region *string) {
+	o.Region = region
+}
+
+// WithVPCID adds the vPCID to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) WithVPCID(vPCID *string) *ListAlibabaVSwitchesParams {
+	o.SetVPCID(vPCID)
+	return o
+}
+
+// SetVPCID adds the vPCId to the list alibaba v switches params
+func (o *ListAlibabaVSwitchesParams) SetVPCID(vPCID *string) {
+	o.VPCID = vPCID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAlibabaVSwitchesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.AccessKeyID != nil {
+
+		// header param AccessKeyID
+		if err := r.SetHeaderParam("AccessKeyID", *o.AccessKeyID); err != nil {
+			return err
+		}
+
+	}
+
+	if o.AccessKeySecret != nil {
+
+		// header param AccessKeySecret
+		if err := r.SetHeaderParam("AccessKeySecret", *o.AccessKeySecret); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Credential != nil {
+
+		// header param Credential
+		if err := r.SetHeaderParam("Credential", *o.Credential); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Region != nil {
+
+		// header param Region
+		if err := r.SetHeaderParam("Region", *o.Region); err != nil {
+			return err
+		}
+
+	}
+
+	if o.VPCID != nil {
+
+		// query param VPCID
+		var qrVPCID string
+		if o.VPCID != nil {
+			qrVPCID = *o.VPCID
+		}
+		qVPCID := qrVPCID
+		if qVPCID != "" {
+			if err := r.SetQueryParam("VPCID", qVPCID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
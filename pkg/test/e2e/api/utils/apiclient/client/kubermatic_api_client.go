@@ -21,6 +21,7 @@ import (
 	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/digitalocean"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/gcp"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/hetzner"
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/kubevirt"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/metric"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/openstack"
 	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/client/operations"
@@ -88,6 +89,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Kubermatic
 	cli.Digitalocean = digitalocean.New(transport, formats)
 	cli.Gcp = gcp.New(transport, formats)
 	cli.Hetzner = hetzner.New(transport, formats)
+	cli.Kubevirt = kubevirt.New(transport, formats)
 	cli.Metric = metric.New(transport, formats)
 	cli.Openstack = openstack.New(transport, formats)
 	cli.Operations = operations.New(transport, formats)
@@ -166,6 +168,8 @@ type KubermaticAPI struct {
 
 	Hetzner hetzner.ClientService
 
+	Kubevirt kubevirt.ClientService
+
 	Metric metric.ClientService
 
 	Openstack openstack.ClientService
@@ -207,6 +211,7 @@ func (c *KubermaticAPI) SetTransport(transport runtime.ClientTransport) {
 	c.Digitalocean.SetTransport(transport)
 	c.Gcp.SetTransport(transport)
 	c.Hetzner.SetTransport(transport)
+	c.Kubevirt.SetTransport(transport)
 	c.Metric.SetTransport(transport)
 	c.Openstack.SetTransport(transport)
 	c.Operations.SetTransport(transport)
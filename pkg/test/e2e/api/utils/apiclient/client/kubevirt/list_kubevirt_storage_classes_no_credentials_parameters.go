@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package kubevirt
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListKubevirtStorageClassesNoCredentialsParams creates a new ListKubevirtStorageClassesNoCredentialsParams object
+// with the default values initialized.
+func NewListKubevirtStorageClassesNoCredentialsParams() *ListKubevirtStorageClassesNoCredentialsParams {
+	var ()
+	return &ListKubevirtStorageClassesNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListKubevirtStorageClassesNoCredentialsParamsWithTimeout creates a new ListKubevirtStorageClassesNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListKubevirtStorageClassesNoCredentialsParamsWithTimeout(timeout time.Duration) *ListKubevirtStorageClassesNoCredentialsParams {
+	var ()
+	return &ListKubevirtStorageClassesNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListKubevirtStorageClassesNoCredentialsParamsWithContext creates a new ListKubevirtStorageClassesNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListKubevirtStorageClassesNoCredentialsParamsWithContext(ctx context.Context) *ListKubevirtStorageClassesNoCredentialsParams {
+	var ()
+	return &ListKubevirtStorageClassesNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListKubevirtStorageClassesNoCredentialsParamsWithHTTPClient creates a new ListKubevirtStorageClassesNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListKubevirtStorageClassesNoCredentialsParamsWithHTTPClient(client *http.Client) *ListKubevirtStorageClassesNoCredentialsParams {
+	var ()
+	return &ListKubevirtStorageClassesNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListKubevirtStorageClassesNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list kubevirt storage classes no credentials operation typically these are written to a http.Request
+*/
+type ListKubevirtStorageClassesNoCredentialsParams struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WithTimeout(timeout time.Duration) *ListKubevirtStorageClassesNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WithContext(ctx context.Context) *ListKubevirtStorageClassesNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WithHTTPClient(client *http.Client) *ListKubevirtStorageClassesNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WithClusterID(clusterID string) *ListKubevirtStorageClassesNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WithDC(dc string) *ListKubevirtStorageClassesNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WithProjectID(projectID string) *ListKubevirtStorageClassesNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list kubevirt storage classes no credentials params
+func (o *ListKubevirtStorageClassesNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListKubevirtStorageClassesNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
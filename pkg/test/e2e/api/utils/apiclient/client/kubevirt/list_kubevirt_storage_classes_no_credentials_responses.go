@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package kubevirt
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListKubevirtStorageClassesNoCredentialsReader is a Reader for the ListKubevirtStorageClassesNoCredentials structure.
+type ListKubevirtStorageClassesNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListKubevirtStorageClassesNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListKubevirtStorageClassesNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListKubevirtStorageClassesNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListKubevirtStorageClassesNoCredentialsOK creates a ListKubevirtStorageClassesNoCredentialsOK with default headers values
+func NewListKubevirtStorageClassesNoCredentialsOK() *ListKubevirtStorageClassesNoCredentialsOK {
+	return &ListKubevirtStorageClassesNoCredentialsOK{}
+}
+
+/*ListKubevirtStorageClassesNoCredentialsOK handles this case with default header values.
+
+KubevirtStorageClassList
+*/
+type ListKubevirtStorageClassesNoCredentialsOK struct {
+	Payload models.KubevirtStorageClassList
+}
+
+func (o *ListKubevirtStorageClassesNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/storageclasses][%d] listKubevirtStorageClassesNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListKubevirtStorageClassesNoCredentialsOK) GetPayload() models.KubevirtStorageClassList {
+	return o.Payload
+}
+
+func (o *ListKubevirtStorageClassesNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListKubevirtStorageClassesNoCredentialsDefault creates a ListKubevirtStorageClassesNoCredentialsDefault with default headers values
+func NewListKubevirtStorageClassesNoCredentialsDefault(code int) *ListKubevirtStorageClassesNoCredentialsDefault {
+	return &ListKubevirtStorageClassesNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListKubevirtStorageClassesNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListKubevirtStorageClassesNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list kubevirt storage classes no credentials default response
+func (o *ListKubevirtStorageClassesNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListKubevirtStorageClassesNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/storageclasses][%d] listKubevirtStorageClassesNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListKubevirtStorageClassesNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListKubevirtStorageClassesNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
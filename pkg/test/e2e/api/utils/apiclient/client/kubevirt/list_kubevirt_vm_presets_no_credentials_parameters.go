@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package kubevirt
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListKubevirtVMPresetsNoCredentialsParams creates a new ListKubevirtVMPresetsNoCredentialsParams object
+// with the default values initialized.
+func NewListKubevirtVMPresetsNoCredentialsParams() *ListKubevirtVMPresetsNoCredentialsParams {
+	var ()
+	return &ListKubevirtVMPresetsNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListKubevirtVMPresetsNoCredentialsParamsWithTimeout creates a new ListKubevirtVMPresetsNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListKubevirtVMPresetsNoCredentialsParamsWithTimeout(timeout time.Duration) *ListKubevirtVMPresetsNoCredentialsParams {
+	var ()
+	return &ListKubevirtVMPresetsNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListKubevirtVMPresetsNoCredentialsParamsWithContext creates a new ListKubevirtVMPresetsNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListKubevirtVMPresetsNoCredentialsParamsWithContext(ctx context.Context) *ListKubevirtVMPresetsNoCredentialsParams {
+	var ()
+	return &ListKubevirtVMPresetsNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListKubevirtVMPresetsNoCredentialsParamsWithHTTPClient creates a new ListKubevirtVMPresetsNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListKubevirtVMPresetsNoCredentialsParamsWithHTTPClient(client *http.Client) *ListKubevirtVMPresetsNoCredentialsParams {
+	var ()
+	return &ListKubevirtVMPresetsNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListKubevirtVMPresetsNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list kubevirt VM presets no credentials operation typically these are written to a http.Request
+*/
+type ListKubevirtVMPresetsNoCredentialsParams struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WithTimeout(timeout time.Duration) *ListKubevirtVMPresetsNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WithContext(ctx context.Context) *ListKubevirtVMPresetsNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WithHTTPClient(client *http.Client) *ListKubevirtVMPresetsNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WithClusterID(clusterID string) *ListKubevirtVMPresetsNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WithDC(dc string) *ListKubevirtVMPresetsNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WithProjectID(projectID string) *ListKubevirtVMPresetsNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list kubevirt VM presets no credentials params
+func (o *ListKubevirtVMPresetsNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListKubevirtVMPresetsNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
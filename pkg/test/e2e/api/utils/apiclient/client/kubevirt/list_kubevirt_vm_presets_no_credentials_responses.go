@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package kubevirt
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListKubevirtVMPresetsNoCredentialsReader is a Reader for the ListKubevirtVMPresetsNoCredentials structure.
+type ListKubevirtVMPresetsNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListKubevirtVMPresetsNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListKubevirtVMPresetsNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListKubevirtVMPresetsNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListKubevirtVMPresetsNoCredentialsOK creates a ListKubevirtVMPresetsNoCredentialsOK with default headers values
+func NewListKubevirtVMPresetsNoCredentialsOK() *ListKubevirtVMPresetsNoCredentialsOK {
+	return &ListKubevirtVMPresetsNoCredentialsOK{}
+}
+
+/*ListKubevirtVMPresetsNoCredentialsOK handles this case with default header values.
+
+KubevirtVMPresetList
+*/
+type ListKubevirtVMPresetsNoCredentialsOK struct {
+	Payload models.KubevirtVMPresetList
+}
+
+func (o *ListKubevirtVMPresetsNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/vmpresets][%d] listKubevirtVmPresetsNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListKubevirtVMPresetsNoCredentialsOK) GetPayload() models.KubevirtVMPresetList {
+	return o.Payload
+}
+
+func (o *ListKubevirtVMPresetsNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListKubevirtVMPresetsNoCredentialsDefault creates a ListKubevirtVMPresetsNoCredentialsDefault with default headers values
+func NewListKubevirtVMPresetsNoCredentialsDefault(code int) *ListKubevirtVMPresetsNoCredentialsDefault {
+	return &ListKubevirtVMPresetsNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListKubevirtVMPresetsNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListKubevirtVMPresetsNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list kubevirt VM presets no credentials default response
+func (o *ListKubevirtVMPresetsNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListKubevirtVMPresetsNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/kubevirt/vmpresets][%d] listKubevirtVMPresetsNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListKubevirtVMPresetsNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListKubevirtVMPresetsNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
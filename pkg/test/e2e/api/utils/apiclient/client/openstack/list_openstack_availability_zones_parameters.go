@@ -60,6 +60,10 @@ for the list openstack availability zones operation typically these are written
 */
 type ListOpenstackAvailabilityZonesParams struct {
 
+	/*ApplicationCredentialID*/
+	ApplicationCredentialID *string
+	/*ApplicationCredentialSecret*/
+	ApplicationCredentialSecret *string
 	/*Credential*/
 	Credential *string
 	/*DatacenterName*/
@@ -113,6 +117,28 @@ func (o *ListOpenstackAvailabilityZonesParams) SetHTTPClient(client *http.Client
 	o.HTTPClient = client
 }
 
+// WithApplicationCredentialID adds the applicationCredentialID to the list openstack availability zones params
+func (o *ListOpenstackAvailabilityZonesParams) WithApplicationCredentialID(applicationCredentialID *string) *ListOpenstackAvailabilityZonesParams {
+	o.SetApplicationCredentialID(applicationCredentialID)
+	return o
+}
+
+// SetApplicationCredentialID adds the applicationCredentialId to the list openstack availability zones params
+func (o *ListOpenstackAvailabilityZonesParams) SetApplicationCredentialID(applicationCredentialID *string) {
+	o.ApplicationCredentialID = applicationCredentialID
+}
+
+// WithApplicationCredentialSecret adds the applicationCredentialSecret to the list openstack availability zones params
+func (o *ListOpenstackAvailabilityZonesParams) WithApplicationCredentialSecret(applicationCredentialSecret *string) *ListOpenstackAvailabilityZonesParams {
+	o.SetApplicationCredentialSecret(applicationCredentialSecret)
+	return o
+}
+
+// SetApplicationCredentialSecret adds the applicationCredentialSecret to the list openstack availability zones params
+func (o *ListOpenstackAvailabilityZonesParams) SetApplicationCredentialSecret(applicationCredentialSecret *string) {
+	o.ApplicationCredentialSecret = applicationCredentialSecret
+}
+
 // WithCredential adds the credential to the list openstack availability zones params
 func (o *ListOpenstackAvailabilityZonesParams) WithCredential(credential *string) *ListOpenstackAvailabilityZonesParams {
 	o.SetCredential(credential)
@@ -198,6 +224,24 @@ func (o *ListOpenstackAvailabilityZonesParams) WriteToRequest(r runtime.ClientRe
 	}
 	var res []error
 
+	if o.ApplicationCredentialID != nil {
+
+		// header param ApplicationCredentialID
+		if err := r.SetHeaderParam("ApplicationCredentialID", *o.ApplicationCredentialID); err != nil {
+			return err
+		}
+
+	}
+
+	if o.ApplicationCredentialSecret != nil {
+
+		// header param ApplicationCredentialSecret
+		if err := r.SetHeaderParam("ApplicationCredentialSecret", *o.ApplicationCredentialSecret); err != nil {
+			return err
+		}
+
+	}
+
 	if o.Credential != nil {
 
 		// header param Credential
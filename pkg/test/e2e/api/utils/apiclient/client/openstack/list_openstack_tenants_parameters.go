@@ -60,6 +60,10 @@ for the list openstack tenants operation typically these are written to a http.R
 */
 type ListOpenstackTenantsParams struct {
 
+	/*ApplicationCredentialID*/
+	ApplicationCredentialID *string
+	/*ApplicationCredentialSecret*/
+	ApplicationCredentialSecret *string
 	/*Credential*/
 	Credential *string
 	/*DatacenterName*/
@@ -109,6 +113,28 @@ func (o *ListOpenstackTenantsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithApplicationCredentialID adds the applicationCredentialID to the list openstack tenants params
+func (o *ListOpenstackTenantsParams) WithApplicationCredentialID(applicationCredentialID *string) *ListOpenstackTenantsParams {
+	o.SetApplicationCredentialID(applicationCredentialID)
+	return o
+}
+
+// SetApplicationCredentialID adds the applicationCredentialId to the list openstack tenants params
+func (o *ListOpenstackTenantsParams) SetApplicationCredentialID(applicationCredentialID *string) {
+	o.ApplicationCredentialID = applicationCredentialID
+}
+
+// WithApplicationCredentialSecret adds the applicationCredentialSecret to the list openstack tenants params
+func (o *ListOpenstackTenantsParams) WithApplicationCredentialSecret(applicationCredentialSecret *string) *ListOpenstackTenantsParams {
+	o.SetApplicationCredentialSecret(applicationCredentialSecret)
+	return o
+}
+
+// SetApplicationCredentialSecret adds the applicationCredentialSecret to the list openstack tenants params
+func (o *ListOpenstackTenantsParams) SetApplicationCredentialSecret(applicationCredentialSecret *string) {
+	o.ApplicationCredentialSecret = applicationCredentialSecret
+}
+
 // WithCredential adds the credential to the list openstack tenants params
 func (o *ListOpenstackTenantsParams) WithCredential(credential *string) *ListOpenstackTenantsParams {
 	o.SetCredential(credential)
@@ -172,6 +198,24 @@ func (o *ListOpenstackTenantsParams) WriteToRequest(r runtime.ClientRequest, reg
 	}
 	var res []error
 
+	if o.ApplicationCredentialID != nil {
+
+		// header param ApplicationCredentialID
+		if err := r.SetHeaderParam("ApplicationCredentialID", *o.ApplicationCredentialID); err != nil {
+			return err
+		}
+
+	}
+
+	if o.ApplicationCredentialSecret != nil {
+
+		// header param ApplicationCredentialSecret
+		if err := r.SetHeaderParam("ApplicationCredentialSecret", *o.ApplicationCredentialSecret); err != nil {
+			return err
+		}
+
+	}
+
 	if o.Credential != nil {
 
 		// header param Credential
@@ -65,6 +65,14 @@ type DeleteClusterParams struct {
 	DeleteLoadBalancers *bool
 	/*DeleteVolumes*/
 	DeleteVolumes *bool
+	/*RetainLoadBalancers*/
+	RetainLoadBalancers *string
+	/*RetainMachines*/
+	RetainMachines *string
+	/*RetainVolumes*/
+	RetainVolumes *string
+	/*SkipGracePeriod*/
+	SkipGracePeriod *bool
 	/*ClusterID*/
 	ClusterID string
 	/*Dc*/
@@ -132,6 +140,50 @@ func (o *DeleteClusterParams) SetDeleteVolumes(deleteVolumes *bool) {
 	o.DeleteVolumes = deleteVolumes
 }
 
+// WithRetainLoadBalancers adds the retainLoadBalancers to the delete cluster params
+func (o *DeleteClusterParams) WithRetainLoadBalancers(retainLoadBalancers *string) *DeleteClusterParams {
+	o.SetRetainLoadBalancers(retainLoadBalancers)
+	return o
+}
+
+// SetRetainLoadBalancers adds the retainLoadBalancers to the delete cluster params
+func (o *DeleteClusterParams) SetRetainLoadBalancers(retainLoadBalancers *string) {
+	o.RetainLoadBalancers = retainLoadBalancers
+}
+
+// WithRetainMachines adds the retainMachines to the delete cluster params
+func (o *DeleteClusterParams) WithRetainMachines(retainMachines *string) *DeleteClusterParams {
+	o.SetRetainMachines(retainMachines)
+	return o
+}
+
+// SetRetainMachines adds the retainMachines to the delete cluster params
+func (o *DeleteClusterParams) SetRetainMachines(retainMachines *string) {
+	o.RetainMachines = retainMachines
+}
+
+// WithRetainVolumes adds the retainVolumes to the delete cluster params
+func (o *DeleteClusterParams) WithRetainVolumes(retainVolumes *string) *DeleteClusterParams {
+	o.SetRetainVolumes(retainVolumes)
+	return o
+}
+
+// SetRetainVolumes adds the retainVolumes to the delete cluster params
+func (o *DeleteClusterParams) SetRetainVolumes(retainVolumes *string) {
+	o.RetainVolumes = retainVolumes
+}
+
+// WithSkipGracePeriod adds the skipGracePeriod to the delete cluster params
+func (o *DeleteClusterParams) WithSkipGracePeriod(skipGracePeriod *bool) *DeleteClusterParams {
+	o.SetSkipGracePeriod(skipGracePeriod)
+	return o
+}
+
+// SetSkipGracePeriod adds the skipGracePeriod to the delete cluster params
+func (o *DeleteClusterParams) SetSkipGracePeriod(skipGracePeriod *bool) {
+	o.SkipGracePeriod = skipGracePeriod
+}
+
 // WithClusterID adds the clusterID to the delete cluster params
 func (o *DeleteClusterParams) WithClusterID(clusterID string) *DeleteClusterParams {
 	o.SetClusterID(clusterID)
@@ -191,6 +243,42 @@ func (o *DeleteClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 
 	}
 
+	if o.RetainLoadBalancers != nil {
+
+		// header param RetainLoadBalancers
+		if err := r.SetHeaderParam("RetainLoadBalancers", *o.RetainLoadBalancers); err != nil {
+			return err
+		}
+
+	}
+
+	if o.RetainMachines != nil {
+
+		// header param RetainMachines
+		if err := r.SetHeaderParam("RetainMachines", *o.RetainMachines); err != nil {
+			return err
+		}
+
+	}
+
+	if o.RetainVolumes != nil {
+
+		// header param RetainVolumes
+		if err := r.SetHeaderParam("RetainVolumes", *o.RetainVolumes); err != nil {
+			return err
+		}
+
+	}
+
+	if o.SkipGracePeriod != nil {
+
+		// header param SkipGracePeriod
+		if err := r.SetHeaderParam("SkipGracePeriod", swag.FormatBool(*o.SkipGracePeriod)); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
 		return err
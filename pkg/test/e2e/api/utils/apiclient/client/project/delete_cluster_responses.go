@@ -41,6 +41,12 @@ func (o *DeleteClusterReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewDeleteClusterConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		result := NewDeleteClusterDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -116,6 +122,27 @@ func (o *DeleteClusterForbidden) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewDeleteClusterConflict creates a DeleteClusterConflict with default headers values
+func NewDeleteClusterConflict() *DeleteClusterConflict {
+	return &DeleteClusterConflict{}
+}
+
+/*DeleteClusterConflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteClusterConflict struct {
+}
+
+func (o *DeleteClusterConflict) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}][%d] deleteClusterConflict ", 409)
+}
+
+func (o *DeleteClusterConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
 // NewDeleteClusterDefault creates a DeleteClusterDefault with default headers values
 func NewDeleteClusterDefault(code int) *DeleteClusterDefault {
 	return &DeleteClusterDefault{
@@ -65,6 +65,14 @@ type DeleteClusterV2Params struct {
 	DeleteLoadBalancers *bool
 	/*DeleteVolumes*/
 	DeleteVolumes *bool
+	/*RetainLoadBalancers*/
+	RetainLoadBalancers *string
+	/*RetainMachines*/
+	RetainMachines *string
+	/*RetainVolumes*/
+	RetainVolumes *string
+	/*SkipGracePeriod*/
+	SkipGracePeriod *bool
 	/*ClusterID*/
 	ClusterID string
 	/*ProjectID*/
@@ -130,6 +138,50 @@ func (o *DeleteClusterV2Params) SetDeleteVolumes(deleteVolumes *bool) {
 	o.DeleteVolumes = deleteVolumes
 }
 
+// WithRetainLoadBalancers adds the retainLoadBalancers to the delete cluster v2 params
+func (o *DeleteClusterV2Params) WithRetainLoadBalancers(retainLoadBalancers *string) *DeleteClusterV2Params {
+	o.SetRetainLoadBalancers(retainLoadBalancers)
+	return o
+}
+
+// SetRetainLoadBalancers adds the retainLoadBalancers to the delete cluster v2 params
+func (o *DeleteClusterV2Params) SetRetainLoadBalancers(retainLoadBalancers *string) {
+	o.RetainLoadBalancers = retainLoadBalancers
+}
+
+// WithRetainMachines adds the retainMachines to the delete cluster v2 params
+func (o *DeleteClusterV2Params) WithRetainMachines(retainMachines *string) *DeleteClusterV2Params {
+	o.SetRetainMachines(retainMachines)
+	return o
+}
+
+// SetRetainMachines adds the retainMachines to the delete cluster v2 params
+func (o *DeleteClusterV2Params) SetRetainMachines(retainMachines *string) {
+	o.RetainMachines = retainMachines
+}
+
+// WithRetainVolumes adds the retainVolumes to the delete cluster v2 params
+func (o *DeleteClusterV2Params) WithRetainVolumes(retainVolumes *string) *DeleteClusterV2Params {
+	o.SetRetainVolumes(retainVolumes)
+	return o
+}
+
+// SetRetainVolumes adds the retainVolumes to the delete cluster v2 params
+func (o *DeleteClusterV2Params) SetRetainVolumes(retainVolumes *string) {
+	o.RetainVolumes = retainVolumes
+}
+
+// WithSkipGracePeriod adds the skipGracePeriod to the delete cluster v2 params
+func (o *DeleteClusterV2Params) WithSkipGracePeriod(skipGracePeriod *bool) *DeleteClusterV2Params {
+	o.SetSkipGracePeriod(skipGracePeriod)
+	return o
+}
+
+// SetSkipGracePeriod adds the skipGracePeriod to the delete cluster v2 params
+func (o *DeleteClusterV2Params) SetSkipGracePeriod(skipGracePeriod *bool) {
+	o.SkipGracePeriod = skipGracePeriod
+}
+
 // WithClusterID adds the clusterID to the delete cluster v2 params
 func (o *DeleteClusterV2Params) WithClusterID(clusterID string) *DeleteClusterV2Params {
 	o.SetClusterID(clusterID)
@@ -178,6 +230,42 @@ func (o *DeleteClusterV2Params) WriteToRequest(r runtime.ClientRequest, reg strf
 
 	}
 
+	if o.RetainLoadBalancers != nil {
+
+		// header param RetainLoadBalancers
+		if err := r.SetHeaderParam("RetainLoadBalancers", *o.RetainLoadBalancers); err != nil {
+			return err
+		}
+
+	}
+
+	if o.RetainMachines != nil {
+
+		// header param RetainMachines
+		if err := r.SetHeaderParam("RetainMachines", *o.RetainMachines); err != nil {
+			return err
+		}
+
+	}
+
+	if o.RetainVolumes != nil {
+
+		// header param RetainVolumes
+		if err := r.SetHeaderParam("RetainVolumes", *o.RetainVolumes); err != nil {
+			return err
+		}
+
+	}
+
+	if o.SkipGracePeriod != nil {
+
+		// header param SkipGracePeriod
+		if err := r.SetHeaderParam("SkipGracePeriod", swag.FormatBool(*o.SkipGracePeriod)); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
 		return err
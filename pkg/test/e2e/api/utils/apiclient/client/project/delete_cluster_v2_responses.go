@@ -41,6 +41,12 @@ func (o *DeleteClusterV2Reader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewDeleteClusterV2Conflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		result := NewDeleteClusterV2Default(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -116,6 +122,27 @@ func (o *DeleteClusterV2Forbidden) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewDeleteClusterV2Conflict creates a DeleteClusterV2Conflict with default headers values
+func NewDeleteClusterV2Conflict() *DeleteClusterV2Conflict {
+	return &DeleteClusterV2Conflict{}
+}
+
+/*DeleteClusterV2Conflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteClusterV2Conflict struct {
+}
+
+func (o *DeleteClusterV2Conflict) Error() string {
+	return fmt.Sprintf("[DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}][%d] deleteClusterV2Conflict ", 409)
+}
+
+func (o *DeleteClusterV2Conflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
 // NewDeleteClusterV2Default creates a DeleteClusterV2Default with default headers values
 func NewDeleteClusterV2Default(code int) *DeleteClusterV2Default {
 	return &DeleteClusterV2Default{
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewEstimateClusterCostParams creates a new EstimateClusterCostParams object
+// with the default values initialized.
+func NewEstimateClusterCostParams() *EstimateClusterCostParams {
+	var ()
+	return &EstimateClusterCostParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewEstimateClusterCostParamsWithTimeout creates a new EstimateClusterCostParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewEstimateClusterCostParamsWithTimeout(timeout time.Duration) *EstimateClusterCostParams {
+	var ()
+	return &EstimateClusterCostParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewEstimateClusterCostParamsWithContext creates a new EstimateClusterCostParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewEstimateClusterCostParamsWithContext(ctx context.Context) *EstimateClusterCostParams {
+	var ()
+	return &EstimateClusterCostParams{
+
+		Context: ctx,
+	}
+}
+
+// NewEstimateClusterCostParamsWithHTTPClient creates a new EstimateClusterCostParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewEstimateClusterCostParamsWithHTTPClient(client *http.Client) *EstimateClusterCostParams {
+	var ()
+	return &EstimateClusterCostParams{
+		HTTPClient: client,
+	}
+}
+
+/*EstimateClusterCostParams contains all the parameters to send to the API endpoint
+for the estimate cluster cost operation typically these are written to a http.Request
+*/
+type EstimateClusterCostParams struct {
+
+	/*Body*/
+	Body *models.CreateClusterSpec
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the estimate cluster cost params
+func (o *EstimateClusterCostParams) WithTimeout(timeout time.Duration) *EstimateClusterCostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the estimate cluster cost params
+func (o *EstimateClusterCostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the estimate cluster cost params
+func (o *EstimateClusterCostParams) WithContext(ctx context.Context) *EstimateClusterCostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the estimate cluster cost params
+func (o *EstimateClusterCostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the estimate cluster cost params
+func (o *EstimateClusterCostParams) WithHTTPClient(client *http.Client) *EstimateClusterCostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the estimate cluster cost params
+func (o *EstimateClusterCostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the estimate cluster cost params
+func (o *EstimateClusterCostParams) WithBody(body *models.CreateClusterSpec) *EstimateClusterCostParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the estimate cluster cost params
+func (o *EstimateClusterCostParams) SetBody(body *models.CreateClusterSpec) {
+	o.Body = body
+}
+
+// WithProjectID adds the projectID to the estimate cluster cost params
+func (o *EstimateClusterCostParams) WithProjectID(projectID string) *EstimateClusterCostParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the estimate cluster cost params
+func (o *EstimateClusterCostParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *EstimateClusterCostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
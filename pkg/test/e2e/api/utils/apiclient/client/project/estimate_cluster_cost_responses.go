@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// EstimateClusterCostReader is a Reader for the EstimateClusterCost structure.
+type EstimateClusterCostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *EstimateClusterCostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewEstimateClusterCostOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewEstimateClusterCostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewEstimateClusterCostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewEstimateClusterCostDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewEstimateClusterCostOK creates a EstimateClusterCostOK with default headers values
+func NewEstimateClusterCostOK() *EstimateClusterCostOK {
+	return &EstimateClusterCostOK{}
+}
+
+/*EstimateClusterCostOK handles this case with default header values.
+
+CostEstimate
+*/
+type EstimateClusterCostOK struct {
+	Payload *models.CostEstimate
+}
+
+func (o *EstimateClusterCostOK) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/costestimate][%d] estimateClusterCostOK  %+v", 200, o.Payload)
+}
+
+func (o *EstimateClusterCostOK) GetPayload() *models.CostEstimate {
+	return o.Payload
+}
+
+func (o *EstimateClusterCostOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CostEstimate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateClusterCostUnauthorized creates a EstimateClusterCostUnauthorized with default headers values
+func NewEstimateClusterCostUnauthorized() *EstimateClusterCostUnauthorized {
+	return &EstimateClusterCostUnauthorized{}
+}
+
+/*EstimateClusterCostUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type EstimateClusterCostUnauthorized struct {
+}
+
+func (o *EstimateClusterCostUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/costestimate][%d] estimateClusterCostUnauthorized ", 401)
+}
+
+func (o *EstimateClusterCostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewEstimateClusterCostForbidden creates a EstimateClusterCostForbidden with default headers values
+func NewEstimateClusterCostForbidden() *EstimateClusterCostForbidden {
+	return &EstimateClusterCostForbidden{}
+}
+
+/*EstimateClusterCostForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type EstimateClusterCostForbidden struct {
+}
+
+func (o *EstimateClusterCostForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/costestimate][%d] estimateClusterCostForbidden ", 403)
+}
+
+func (o *EstimateClusterCostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewEstimateClusterCostDefault creates a EstimateClusterCostDefault with default headers values
+func NewEstimateClusterCostDefault(code int) *EstimateClusterCostDefault {
+	return &EstimateClusterCostDefault{
+		_statusCode: code,
+	}
+}
+
+/*EstimateClusterCostDefault handles this case with default header values.
+
+errorResponse
+*/
+type EstimateClusterCostDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the estimate cluster cost default response
+func (o *EstimateClusterCostDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *EstimateClusterCostDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/costestimate][%d] estimateClusterCost default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *EstimateClusterCostDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *EstimateClusterCostDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewEstimateMachineDeploymentCostParams creates a new EstimateMachineDeploymentCostParams object
+// with the default values initialized.
+func NewEstimateMachineDeploymentCostParams() *EstimateMachineDeploymentCostParams {
+	var ()
+	return &EstimateMachineDeploymentCostParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewEstimateMachineDeploymentCostParamsWithTimeout creates a new EstimateMachineDeploymentCostParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewEstimateMachineDeploymentCostParamsWithTimeout(timeout time.Duration) *EstimateMachineDeploymentCostParams {
+	var ()
+	return &EstimateMachineDeploymentCostParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewEstimateMachineDeploymentCostParamsWithContext creates a new EstimateMachineDeploymentCostParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewEstimateMachineDeploymentCostParamsWithContext(ctx context.Context) *EstimateMachineDeploymentCostParams {
+	var ()
+	return &EstimateMachineDeploymentCostParams{
+
+		Context: ctx,
+	}
+}
+
+// NewEstimateMachineDeploymentCostParamsWithHTTPClient creates a new EstimateMachineDeploymentCostParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewEstimateMachineDeploymentCostParamsWithHTTPClient(client *http.Client) *EstimateMachineDeploymentCostParams {
+	var ()
+	return &EstimateMachineDeploymentCostParams{
+		HTTPClient: client,
+	}
+}
+
+/*EstimateMachineDeploymentCostParams contains all the parameters to send to the API endpoint
+for the estimate machine deployment cost operation typically these are written to a http.Request
+*/
+type EstimateMachineDeploymentCostParams struct {
+
+	/*Body*/
+	Body *models.NodeDeployment
+	/*ClusterID*/
+	ClusterID string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) WithTimeout(timeout time.Duration) *EstimateMachineDeploymentCostParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) WithContext(ctx context.Context) *EstimateMachineDeploymentCostParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) WithHTTPClient(client *http.Client) *EstimateMachineDeploymentCostParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) WithBody(body *models.NodeDeployment) *EstimateMachineDeploymentCostParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) SetBody(body *models.NodeDeployment) {
+	o.Body = body
+}
+
+// WithClusterID adds the clusterID to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) WithClusterID(clusterID string) *EstimateMachineDeploymentCostParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithProjectID adds the projectID to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) WithProjectID(projectID string) *EstimateMachineDeploymentCostParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the estimate machine deployment cost params
+func (o *EstimateMachineDeploymentCostParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *EstimateMachineDeploymentCostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// EstimateMachineDeploymentCostReader is a Reader for the EstimateMachineDeploymentCost structure.
+type EstimateMachineDeploymentCostReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *EstimateMachineDeploymentCostReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewEstimateMachineDeploymentCostOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewEstimateMachineDeploymentCostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewEstimateMachineDeploymentCostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewEstimateMachineDeploymentCostDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewEstimateMachineDeploymentCostOK creates a EstimateMachineDeploymentCostOK with default headers values
+func NewEstimateMachineDeploymentCostOK() *EstimateMachineDeploymentCostOK {
+	return &EstimateMachineDeploymentCostOK{}
+}
+
+/*EstimateMachineDeploymentCostOK handles this case with default header values.
+
+CostEstimate
+*/
+type EstimateMachineDeploymentCostOK struct {
+	Payload *models.CostEstimate
+}
+
+func (o *EstimateMachineDeploymentCostOK) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate][%d] estimateMachineDeploymentCostOK  %+v", 200, o.Payload)
+}
+
+func (o *EstimateMachineDeploymentCostOK) GetPayload() *models.CostEstimate {
+	return o.Payload
+}
+
+func (o *EstimateMachineDeploymentCostOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.CostEstimate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewEstimateMachineDeploymentCostUnauthorized creates a EstimateMachineDeploymentCostUnauthorized with default headers values
+func NewEstimateMachineDeploymentCostUnauthorized() *EstimateMachineDeploymentCostUnauthorized {
+	return &EstimateMachineDeploymentCostUnauthorized{}
+}
+
+/*EstimateMachineDeploymentCostUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type EstimateMachineDeploymentCostUnauthorized struct {
+}
+
+func (o *EstimateMachineDeploymentCostUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate][%d] estimateMachineDeploymentCostUnauthorized ", 401)
+}
+
+func (o *EstimateMachineDeploymentCostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewEstimateMachineDeploymentCostForbidden creates a EstimateMachineDeploymentCostForbidden with default headers values
+func NewEstimateMachineDeploymentCostForbidden() *EstimateMachineDeploymentCostForbidden {
+	return &EstimateMachineDeploymentCostForbidden{}
+}
+
+/*EstimateMachineDeploymentCostForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type EstimateMachineDeploymentCostForbidden struct {
+}
+
+func (o *EstimateMachineDeploymentCostForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate][%d] estimateMachineDeploymentCostForbidden ", 403)
+}
+
+func (o *EstimateMachineDeploymentCostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewEstimateMachineDeploymentCostDefault creates a EstimateMachineDeploymentCostDefault with default headers values
+func NewEstimateMachineDeploymentCostDefault(code int) *EstimateMachineDeploymentCostDefault {
+	return &EstimateMachineDeploymentCostDefault{
+		_statusCode: code,
+	}
+}
+
+/*EstimateMachineDeploymentCostDefault handles this case with default header values.
+
+errorResponse
+*/
+type EstimateMachineDeploymentCostDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the estimate machine deployment cost default response
+func (o *EstimateMachineDeploymentCostDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *EstimateMachineDeploymentCostDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate][%d] estimateMachineDeploymentCost default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *EstimateMachineDeploymentCostDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *EstimateMachineDeploymentCostDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// GetOperationReader is a Reader for the GetOperation structure.
+type GetOperationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetOperationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetOperationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetOperationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetOperationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetOperationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetOperationOK creates a GetOperationOK with default headers values
+func NewGetOperationOK() *GetOperationOK {
+	return &GetOperationOK{}
+}
+
+/*GetOperationOK handles this case with default header values.
+
+Operation
+*/
+type GetOperationOK struct {
+	Payload *models.Operation
+}
+
+func (o *GetOperationOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations/{operation_id}][%d] getOperationOK  %+v", 200, o.Payload)
+}
+
+func (o *GetOperationOK) GetPayload() *models.Operation {
+	return o.Payload
+}
+
+func (o *GetOperationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Operation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOperationUnauthorized creates a GetOperationUnauthorized with default headers values
+func NewGetOperationUnauthorized() *GetOperationUnauthorized {
+	return &GetOperationUnauthorized{}
+}
+
+/*GetOperationUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type GetOperationUnauthorized struct {
+}
+
+func (o *GetOperationUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations/{operation_id}][%d] getOperationUnauthorized ", 401)
+}
+
+func (o *GetOperationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetOperationForbidden creates a GetOperationForbidden with default headers values
+func NewGetOperationForbidden() *GetOperationForbidden {
+	return &GetOperationForbidden{}
+}
+
+/*GetOperationForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type GetOperationForbidden struct {
+}
+
+func (o *GetOperationForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations/{operation_id}][%d] getOperationForbidden ", 403)
+}
+
+func (o *GetOperationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetOperationDefault creates a GetOperationDefault with default headers values
+func NewGetOperationDefault(code int) *GetOperationDefault {
+	return &GetOperationDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetOperationDefault handles this case with default header values.
+
+errorResponse
+*/
+type GetOperationDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the get operation default response
+func (o *GetOperationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetOperationDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations/{operation_id}][%d] getOperation default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetOperationDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetOperationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
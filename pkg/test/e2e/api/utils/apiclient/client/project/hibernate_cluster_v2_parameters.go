@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewHibernateClusterV2Params creates a new HibernateClusterV2Params object
+// with the default values initialized.
+func NewHibernateClusterV2Params() *HibernateClusterV2Params {
+	var ()
+	return &HibernateClusterV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewHibernateClusterV2ParamsWithTimeout creates a new HibernateClusterV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewHibernateClusterV2ParamsWithTimeout(timeout time.Duration) *HibernateClusterV2Params {
+	var ()
+	return &HibernateClusterV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewHibernateClusterV2ParamsWithContext creates a new HibernateClusterV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewHibernateClusterV2ParamsWithContext(ctx context.Context) *HibernateClusterV2Params {
+	var ()
+	return &HibernateClusterV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewHibernateClusterV2ParamsWithHTTPClient creates a new HibernateClusterV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewHibernateClusterV2ParamsWithHTTPClient(client *http.Client) *HibernateClusterV2Params {
+	var ()
+	return &HibernateClusterV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*HibernateClusterV2Params contains all the parameters to send to the API endpoint
+for the hibernate cluster v2 operation typically these are written to a http.Request
+*/
+type HibernateClusterV2Params struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) WithTimeout(timeout time.Duration) *HibernateClusterV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) WithContext(ctx context.Context) *HibernateClusterV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) WithHTTPClient(client *http.Client) *HibernateClusterV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) WithClusterID(clusterID string) *HibernateClusterV2Params {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithProjectID adds the projectID to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) WithProjectID(projectID string) *HibernateClusterV2Params {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the hibernate cluster v2 params
+func (o *HibernateClusterV2Params) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *HibernateClusterV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
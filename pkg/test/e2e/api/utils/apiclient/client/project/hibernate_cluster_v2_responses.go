@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// HibernateClusterV2Reader is a Reader for the HibernateClusterV2 structure.
+type HibernateClusterV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *HibernateClusterV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewHibernateClusterV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewHibernateClusterV2Unauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewHibernateClusterV2Forbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewHibernateClusterV2Conflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewHibernateClusterV2Default(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewHibernateClusterV2OK creates a HibernateClusterV2OK with default headers values
+func NewHibernateClusterV2OK() *HibernateClusterV2OK {
+	return &HibernateClusterV2OK{}
+}
+
+/*HibernateClusterV2OK handles this case with default header values.
+
+Cluster
+*/
+type HibernateClusterV2OK struct {
+	Payload *models.Cluster
+}
+
+func (o *HibernateClusterV2OK) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate][%d] hibernateClusterV2OK  %+v", 200, o.Payload)
+}
+
+func (o *HibernateClusterV2OK) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *HibernateClusterV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewHibernateClusterV2Unauthorized creates a HibernateClusterV2Unauthorized with default headers values
+func NewHibernateClusterV2Unauthorized() *HibernateClusterV2Unauthorized {
+	return &HibernateClusterV2Unauthorized{}
+}
+
+/*HibernateClusterV2Unauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type HibernateClusterV2Unauthorized struct {
+}
+
+func (o *HibernateClusterV2Unauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate][%d] hibernateClusterV2Unauthorized ", 401)
+}
+
+func (o *HibernateClusterV2Unauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewHibernateClusterV2Forbidden creates a HibernateClusterV2Forbidden with default headers values
+func NewHibernateClusterV2Forbidden() *HibernateClusterV2Forbidden {
+	return &HibernateClusterV2Forbidden{}
+}
+
+/*HibernateClusterV2Forbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type HibernateClusterV2Forbidden struct {
+}
+
+func (o *HibernateClusterV2Forbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate][%d] hibernateClusterV2Forbidden ", 403)
+}
+
+func (o *HibernateClusterV2Forbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewHibernateClusterV2Conflict creates a HibernateClusterV2Conflict with default headers values
+func NewHibernateClusterV2Conflict() *HibernateClusterV2Conflict {
+	return &HibernateClusterV2Conflict{}
+}
+
+/*HibernateClusterV2Conflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type HibernateClusterV2Conflict struct {
+}
+
+func (o *HibernateClusterV2Conflict) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate][%d] hibernateClusterV2Conflict ", 409)
+}
+
+func (o *HibernateClusterV2Conflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewHibernateClusterV2Default creates a HibernateClusterV2Default with default headers values
+func NewHibernateClusterV2Default(code int) *HibernateClusterV2Default {
+	return &HibernateClusterV2Default{
+		_statusCode: code,
+	}
+}
+
+/*HibernateClusterV2Default handles this case with default header values.
+
+errorResponse
+*/
+type HibernateClusterV2Default struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the hibernate cluster v2 default response
+func (o *HibernateClusterV2Default) Code() int {
+	return o._statusCode
+}
+
+func (o *HibernateClusterV2Default) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate][%d] hibernateClusterV2 default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *HibernateClusterV2Default) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *HibernateClusterV2Default) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
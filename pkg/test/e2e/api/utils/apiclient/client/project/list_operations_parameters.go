@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOperationsParams creates a new ListOperationsParams object
+// with the default values initialized.
+func NewListOperationsParams() *ListOperationsParams {
+	var ()
+	return &ListOperationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListOperationsParamsWithTimeout creates a new ListOperationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListOperationsParamsWithTimeout(timeout time.Duration) *ListOperationsParams {
+	var ()
+	return &ListOperationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListOperationsParamsWithContext creates a new ListOperationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListOperationsParamsWithContext(ctx context.Context) *ListOperationsParams {
+	var ()
+	return &ListOperationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListOperationsParamsWithHTTPClient creates a new ListOperationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListOperationsParamsWithHTTPClient(client *http.Client) *ListOperationsParams {
+	var ()
+	return &ListOperationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListOperationsParams contains all the parameters to send to the API endpoint
+for the list operations operation typically these are written to a http.Request
+*/
+type ListOperationsParams struct {
+
+	/*ClusterID
+	  ClusterID restricts the operations to a single cluster
+
+	*/
+	ClusterID *string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list operations params
+func (o *ListOperationsParams) WithTimeout(timeout time.Duration) *ListOperationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list operations params
+func (o *ListOperationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list operations params
+func (o *ListOperationsParams) WithContext(ctx context.Context) *ListOperationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list operations params
+func (o *ListOperationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list operations params
+func (o *ListOperationsParams) WithHTTPClient(client *http.Client) *ListOperationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list operations params
+func (o *ListOperationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list operations params
+func (o *ListOperationsParams) WithClusterID(clusterID *string) *ListOperationsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list operations params
+func (o *ListOperationsParams) SetClusterID(clusterID *string) {
+	o.ClusterID = clusterID
+}
+
+// WithProjectID adds the projectID to the list operations params
+func (o *ListOperationsParams) WithProjectID(projectID string) *ListOperationsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list operations params
+func (o *ListOperationsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListOperationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.ClusterID != nil {
+
+		// query param cluster_id
+		var qrClusterID string
+		if o.ClusterID != nil {
+			qrClusterID = *o.ClusterID
+		}
+		qClusterID := qrClusterID
+		if qClusterID != "" {
+			if err := r.SetQueryParam("cluster_id", qClusterID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListOperationsReader is a Reader for the ListOperations structure.
+type ListOperationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListOperationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListOperationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListOperationsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListOperationsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListOperationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListOperationsOK creates a ListOperationsOK with default headers values
+func NewListOperationsOK() *ListOperationsOK {
+	return &ListOperationsOK{}
+}
+
+/*ListOperationsOK handles this case with default header values.
+
+Operation
+*/
+type ListOperationsOK struct {
+	Payload []*models.Operation
+}
+
+func (o *ListOperationsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations][%d] listOperationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListOperationsOK) GetPayload() []*models.Operation {
+	return o.Payload
+}
+
+func (o *ListOperationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListOperationsUnauthorized creates a ListOperationsUnauthorized with default headers values
+func NewListOperationsUnauthorized() *ListOperationsUnauthorized {
+	return &ListOperationsUnauthorized{}
+}
+
+/*ListOperationsUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListOperationsUnauthorized struct {
+}
+
+func (o *ListOperationsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations][%d] listOperationsUnauthorized ", 401)
+}
+
+func (o *ListOperationsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListOperationsForbidden creates a ListOperationsForbidden with default headers values
+func NewListOperationsForbidden() *ListOperationsForbidden {
+	return &ListOperationsForbidden{}
+}
+
+/*ListOperationsForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListOperationsForbidden struct {
+}
+
+func (o *ListOperationsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations][%d] listOperationsForbidden ", 403)
+}
+
+func (o *ListOperationsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListOperationsDefault creates a ListOperationsDefault with default headers values
+func NewListOperationsDefault(code int) *ListOperationsDefault {
+	return &ListOperationsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListOperationsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListOperationsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list operations default response
+func (o *ListOperationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListOperationsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/operations][%d] listOperations default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListOperationsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListOperationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
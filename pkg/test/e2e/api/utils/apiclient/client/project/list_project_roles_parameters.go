@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListProjectRolesParams creates a new ListProjectRolesParams object
+// with the default values initialized.
+func NewListProjectRolesParams() *ListProjectRolesParams {
+
+	return &ListProjectRolesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListProjectRolesParamsWithTimeout creates a new ListProjectRolesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListProjectRolesParamsWithTimeout(timeout time.Duration) *ListProjectRolesParams {
+
+	return &ListProjectRolesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListProjectRolesParamsWithContext creates a new ListProjectRolesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListProjectRolesParamsWithContext(ctx context.Context) *ListProjectRolesParams {
+
+	return &ListProjectRolesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListProjectRolesParamsWithHTTPClient creates a new ListProjectRolesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListProjectRolesParamsWithHTTPClient(client *http.Client) *ListProjectRolesParams {
+
+	return &ListProjectRolesParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListProjectRolesParams contains all the parameters to send to the API endpoint
+for the list project roles operation typically these are written to a http.Request
+*/
+type ListProjectRolesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list project roles params
+func (o *ListProjectRolesParams) WithTimeout(timeout time.Duration) *ListProjectRolesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list project roles params
+func (o *ListProjectRolesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list project roles params
+func (o *ListProjectRolesParams) WithContext(ctx context.Context) *ListProjectRolesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list project roles params
+func (o *ListProjectRolesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list project roles params
+func (o *ListProjectRolesParams) WithHTTPClient(client *http.Client) *ListProjectRolesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list project roles params
+func (o *ListProjectRolesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListProjectRolesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
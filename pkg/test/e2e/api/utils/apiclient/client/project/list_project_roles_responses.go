@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListProjectRolesReader is a Reader for the ListProjectRoles structure.
+type ListProjectRolesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListProjectRolesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListProjectRolesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListProjectRolesUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListProjectRolesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListProjectRolesOK creates a ListProjectRolesOK with default headers values
+func NewListProjectRolesOK() *ListProjectRolesOK {
+	return &ListProjectRolesOK{}
+}
+
+/*ListProjectRolesOK handles this case with default header values.
+
+ProjectRole
+*/
+type ListProjectRolesOK struct {
+	Payload []*models.ProjectRole
+}
+
+func (o *ListProjectRolesOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projectroles][%d] listProjectRolesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListProjectRolesOK) GetPayload() []*models.ProjectRole {
+	return o.Payload
+}
+
+func (o *ListProjectRolesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListProjectRolesUnauthorized creates a ListProjectRolesUnauthorized with default headers values
+func NewListProjectRolesUnauthorized() *ListProjectRolesUnauthorized {
+	return &ListProjectRolesUnauthorized{}
+}
+
+/*ListProjectRolesUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListProjectRolesUnauthorized struct {
+}
+
+func (o *ListProjectRolesUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projectroles][%d] listProjectRolesUnauthorized ", 401)
+}
+
+func (o *ListProjectRolesUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListProjectRolesDefault creates a ListProjectRolesDefault with default headers values
+func NewListProjectRolesDefault(code int) *ListProjectRolesDefault {
+	return &ListProjectRolesDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListProjectRolesDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListProjectRolesDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list project roles default response
+func (o *ListProjectRolesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListProjectRolesDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projectroles][%d] listProjectRoles default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListProjectRolesDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListProjectRolesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
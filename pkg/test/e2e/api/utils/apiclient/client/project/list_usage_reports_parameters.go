@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListUsageReportsParams creates a new ListUsageReportsParams object
+// with the default values initialized.
+func NewListUsageReportsParams() *ListUsageReportsParams {
+	var ()
+	return &ListUsageReportsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListUsageReportsParamsWithTimeout creates a new ListUsageReportsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListUsageReportsParamsWithTimeout(timeout time.Duration) *ListUsageReportsParams {
+	var ()
+	return &ListUsageReportsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListUsageReportsParamsWithContext creates a new ListUsageReportsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListUsageReportsParamsWithContext(ctx context.Context) *ListUsageReportsParams {
+	var ()
+	return &ListUsageReportsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListUsageReportsParamsWithHTTPClient creates a new ListUsageReportsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListUsageReportsParamsWithHTTPClient(client *http.Client) *ListUsageReportsParams {
+	var ()
+	return &ListUsageReportsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListUsageReportsParams contains all the parameters to send to the API endpoint
+for the list usage reports operation typically these are written to a http.Request
+*/
+type ListUsageReportsParams struct {
+
+	/*Format
+	  Format of the response, either "json" or "csv", defaults to "json"
+
+	*/
+	Format *string
+	/*Period
+	  Period restricts the reports to a month, for example "2020-10"
+
+	*/
+	Period *string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list usage reports params
+func (o *ListUsageReportsParams) WithTimeout(timeout time.Duration) *ListUsageReportsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list usage reports params
+func (o *ListUsageReportsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list usage reports params
+func (o *ListUsageReportsParams) WithContext(ctx context.Context) *ListUsageReportsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list usage reports params
+func (o *ListUsageReportsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list usage reports params
+func (o *ListUsageReportsParams) WithHTTPClient(client *http.Client) *ListUsageReportsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list usage reports params
+func (o *ListUsageReportsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFormat adds the format to the list usage reports params
+func (o *ListUsageReportsParams) WithFormat(format *string) *ListUsageReportsParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the list usage reports params
+func (o *ListUsageReportsParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithPeriod adds the period to the list usage reports params
+func (o *ListUsageReportsParams) WithPeriod(period *string) *ListUsageReportsParams {
+	o.SetPeriod(period)
+	return o
+}
+
+// SetPeriod adds the period to the list usage reports params
+func (o *ListUsageReportsParams) SetPeriod(period *string) {
+	o.Period = period
+}
+
+// WithProjectID adds the projectID to the list usage reports params
+func (o *ListUsageReportsParams) WithProjectID(projectID string) *ListUsageReportsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list usage reports params
+func (o *ListUsageReportsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListUsageReportsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Period != nil {
+
+		// query param period
+		var qrPeriod string
+		if o.Period != nil {
+			qrPeriod = *o.Period
+		}
+		qPeriod := qrPeriod
+		if qPeriod != "" {
+			if err := r.SetQueryParam("period", qPeriod); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListUsageReportsReader is a Reader for the ListUsageReports structure.
+type ListUsageReportsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListUsageReportsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListUsageReportsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListUsageReportsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListUsageReportsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListUsageReportsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListUsageReportsOK creates a ListUsageReportsOK with default headers values
+func NewListUsageReportsOK() *ListUsageReportsOK {
+	return &ListUsageReportsOK{}
+}
+
+/*ListUsageReportsOK handles this case with default header values.
+
+UsageReport
+*/
+type ListUsageReportsOK struct {
+	Payload []*models.UsageReport
+}
+
+func (o *ListUsageReportsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v2/projects/{project_id}/usagereports][%d] listUsageReportsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListUsageReportsOK) GetPayload() []*models.UsageReport {
+	return o.Payload
+}
+
+func (o *ListUsageReportsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListUsageReportsUnauthorized creates a ListUsageReportsUnauthorized with default headers values
+func NewListUsageReportsUnauthorized() *ListUsageReportsUnauthorized {
+	return &ListUsageReportsUnauthorized{}
+}
+
+/*ListUsageReportsUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListUsageReportsUnauthorized struct {
+}
+
+func (o *ListUsageReportsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v2/projects/{project_id}/usagereports][%d] listUsageReportsUnauthorized ", 401)
+}
+
+func (o *ListUsageReportsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListUsageReportsForbidden creates a ListUsageReportsForbidden with default headers values
+func NewListUsageReportsForbidden() *ListUsageReportsForbidden {
+	return &ListUsageReportsForbidden{}
+}
+
+/*ListUsageReportsForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListUsageReportsForbidden struct {
+}
+
+func (o *ListUsageReportsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v2/projects/{project_id}/usagereports][%d] listUsageReportsForbidden ", 403)
+}
+
+func (o *ListUsageReportsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListUsageReportsDefault creates a ListUsageReportsDefault with default headers values
+func NewListUsageReportsDefault(code int) *ListUsageReportsDefault {
+	return &ListUsageReportsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListUsageReportsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListUsageReportsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list usage reports default response
+func (o *ListUsageReportsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListUsageReportsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v2/projects/{project_id}/usagereports][%d] listUsageReports default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListUsageReportsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListUsageReportsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewMoveClusterV2Params creates a new MoveClusterV2Params object
+// with the default values initialized.
+func NewMoveClusterV2Params() *MoveClusterV2Params {
+	var ()
+	return &MoveClusterV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewMoveClusterV2ParamsWithTimeout creates a new MoveClusterV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewMoveClusterV2ParamsWithTimeout(timeout time.Duration) *MoveClusterV2Params {
+	var ()
+	return &MoveClusterV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewMoveClusterV2ParamsWithContext creates a new MoveClusterV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewMoveClusterV2ParamsWithContext(ctx context.Context) *MoveClusterV2Params {
+	var ()
+	return &MoveClusterV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewMoveClusterV2ParamsWithHTTPClient creates a new MoveClusterV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewMoveClusterV2ParamsWithHTTPClient(client *http.Client) *MoveClusterV2Params {
+	var ()
+	return &MoveClusterV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*MoveClusterV2Params contains all the parameters to send to the API endpoint
+for the move cluster v2 operation typically these are written to a http.Request
+*/
+type MoveClusterV2Params struct {
+
+	/*Body*/
+	Body *models.ClusterMove
+	/*ClusterID*/
+	ClusterID string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the move cluster v2 params
+func (o *MoveClusterV2Params) WithTimeout(timeout time.Duration) *MoveClusterV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the move cluster v2 params
+func (o *MoveClusterV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the move cluster v2 params
+func (o *MoveClusterV2Params) WithContext(ctx context.Context) *MoveClusterV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the move cluster v2 params
+func (o *MoveClusterV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the move cluster v2 params
+func (o *MoveClusterV2Params) WithHTTPClient(client *http.Client) *MoveClusterV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the move cluster v2 params
+func (o *MoveClusterV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the move cluster v2 params
+func (o *MoveClusterV2Params) WithBody(body *models.ClusterMove) *MoveClusterV2Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the move cluster v2 params
+func (o *MoveClusterV2Params) SetBody(body *models.ClusterMove) {
+	o.Body = body
+}
+
+// WithClusterID adds the clusterID to the move cluster v2 params
+func (o *MoveClusterV2Params) WithClusterID(clusterID string) *MoveClusterV2Params {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the move cluster v2 params
+func (o *MoveClusterV2Params) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithProjectID adds the projectID to the move cluster v2 params
+func (o *MoveClusterV2Params) WithProjectID(projectID string) *MoveClusterV2Params {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the move cluster v2 params
+func (o *MoveClusterV2Params) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *MoveClusterV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
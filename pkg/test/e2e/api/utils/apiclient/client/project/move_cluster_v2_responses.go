@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// MoveClusterV2Reader is a Reader for the MoveClusterV2 structure.
+type MoveClusterV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *MoveClusterV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewMoveClusterV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewMoveClusterV2Unauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewMoveClusterV2Forbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewMoveClusterV2Conflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewMoveClusterV2Default(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewMoveClusterV2OK creates a MoveClusterV2OK with default headers values
+func NewMoveClusterV2OK() *MoveClusterV2OK {
+	return &MoveClusterV2OK{}
+}
+
+/*MoveClusterV2OK handles this case with default header values.
+
+Cluster
+*/
+type MoveClusterV2OK struct {
+	Payload *models.Cluster
+}
+
+func (o *MoveClusterV2OK) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/move][%d] moveClusterV2OK  %+v", 200, o.Payload)
+}
+
+func (o *MoveClusterV2OK) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *MoveClusterV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewMoveClusterV2Unauthorized creates a MoveClusterV2Unauthorized with default headers values
+func NewMoveClusterV2Unauthorized() *MoveClusterV2Unauthorized {
+	return &MoveClusterV2Unauthorized{}
+}
+
+/*MoveClusterV2Unauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type MoveClusterV2Unauthorized struct {
+}
+
+func (o *MoveClusterV2Unauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/move][%d] moveClusterV2Unauthorized ", 401)
+}
+
+func (o *MoveClusterV2Unauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewMoveClusterV2Forbidden creates a MoveClusterV2Forbidden with default headers values
+func NewMoveClusterV2Forbidden() *MoveClusterV2Forbidden {
+	return &MoveClusterV2Forbidden{}
+}
+
+/*MoveClusterV2Forbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type MoveClusterV2Forbidden struct {
+}
+
+func (o *MoveClusterV2Forbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/move][%d] moveClusterV2Forbidden ", 403)
+}
+
+func (o *MoveClusterV2Forbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewMoveClusterV2Conflict creates a MoveClusterV2Conflict with default headers values
+func NewMoveClusterV2Conflict() *MoveClusterV2Conflict {
+	return &MoveClusterV2Conflict{}
+}
+
+/*MoveClusterV2Conflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type MoveClusterV2Conflict struct {
+}
+
+func (o *MoveClusterV2Conflict) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/move][%d] moveClusterV2Conflict ", 409)
+}
+
+func (o *MoveClusterV2Conflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewMoveClusterV2Default creates a MoveClusterV2Default with default headers values
+func NewMoveClusterV2Default(code int) *MoveClusterV2Default {
+	return &MoveClusterV2Default{
+		_statusCode: code,
+	}
+}
+
+/*MoveClusterV2Default handles this case with default header values.
+
+errorResponse
+*/
+type MoveClusterV2Default struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the move cluster v2 default response
+func (o *MoveClusterV2Default) Code() int {
+	return o._statusCode
+}
+
+func (o *MoveClusterV2Default) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/move][%d] moveClusterV2 default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *MoveClusterV2Default) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *MoveClusterV2Default) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
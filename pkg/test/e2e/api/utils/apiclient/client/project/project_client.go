@@ -71,8 +71,14 @@ type ClientService interface {
 
 	DetachSSHKeyFromClusterV2(params *DetachSSHKeyFromClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*DetachSSHKeyFromClusterV2OK, error)
 
+	EstimateClusterCost(params *EstimateClusterCostParams, authInfo runtime.ClientAuthInfoWriter) (*EstimateClusterCostOK, error)
+
+	EstimateMachineDeploymentCost(params *EstimateMachineDeploymentCostParams, authInfo runtime.ClientAuthInfoWriter) (*EstimateMachineDeploymentCostOK, error)
+
 	GetCluster(params *GetClusterParams, authInfo runtime.ClientAuthInfoWriter) (*GetClusterOK, error)
 
+	GetClusterDeletionPreviewV2(params *GetClusterDeletionPreviewV2Params, authInfo runtime.ClientAuthInfoWriter) (*GetClusterDeletionPreviewV2OK, error)
+
 	GetClusterEvents(params *GetClusterEventsParams, authInfo runtime.ClientAuthInfoWriter) (*GetClusterEventsOK, error)
 
 	GetClusterEventsV2(params *GetClusterEventsV2Params, authInfo runtime.ClientAuthInfoWriter) (*GetClusterEventsV2OK, error)
@@ -109,10 +115,16 @@ type ClientService interface {
 
 	GetOidcClusterKubeconfigV2(params *GetOidcClusterKubeconfigV2Params, authInfo runtime.ClientAuthInfoWriter) (*GetOidcClusterKubeconfigV2OK, error)
 
+	GetOperation(params *GetOperationParams, authInfo runtime.ClientAuthInfoWriter) (*GetOperationOK, error)
+
 	GetProject(params *GetProjectParams, authInfo runtime.ClientAuthInfoWriter) (*GetProjectOK, error)
 
 	GetRole(params *GetRoleParams, authInfo runtime.ClientAuthInfoWriter) (*GetRoleOK, error)
 
+	HibernateCluster(params *HibernateClusterParams, authInfo runtime.ClientAuthInfoWriter) (*HibernateClusterOK, error)
+
+	HibernateClusterV2(params *HibernateClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*HibernateClusterV2OK, error)
+
 	ListClusterRole(params *ListClusterRoleParams, authInfo runtime.ClientAuthInfoWriter) (*ListClusterRoleOK, error)
 
 	ListClusterRoleBinding(params *ListClusterRoleBindingParams, authInfo runtime.ClientAuthInfoWriter) (*ListClusterRoleBindingOK, error)
@@ -143,6 +155,10 @@ type ClientService interface {
 
 	ListNodeDeployments(params *ListNodeDeploymentsParams, authInfo runtime.ClientAuthInfoWriter) (*ListNodeDeploymentsOK, error)
 
+	ListOperations(params *ListOperationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListOperationsOK, error)
+
+	ListProjectRoles(params *ListProjectRolesParams, authInfo runtime.ClientAuthInfoWriter) (*ListProjectRolesOK, error)
+
 	ListProjects(params *ListProjectsParams, authInfo runtime.ClientAuthInfoWriter) (*ListProjectsOK, error)
 
 	ListRole(params *ListRoleParams, authInfo runtime.ClientAuthInfoWriter) (*ListRoleOK, error)
@@ -157,6 +173,10 @@ type ClientService interface {
 
 	ListSSHKeysAssignedToClusterV2(params *ListSSHKeysAssignedToClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*ListSSHKeysAssignedToClusterV2OK, error)
 
+	ListUsageReports(params *ListUsageReportsParams, authInfo runtime.ClientAuthInfoWriter) (*ListUsageReportsOK, error)
+
+	MoveClusterV2(params *MoveClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*MoveClusterV2OK, error)
+
 	PatchCluster(params *PatchClusterParams, authInfo runtime.ClientAuthInfoWriter) (*PatchClusterOK, error)
 
 	PatchClusterRole(params *PatchClusterRoleParams, authInfo runtime.ClientAuthInfoWriter) (*PatchClusterRoleOK, error)
@@ -167,6 +187,10 @@ type ClientService interface {
 
 	PatchRole(params *PatchRoleParams, authInfo runtime.ClientAuthInfoWriter) (*PatchRoleOK, error)
 
+	ResumeCluster(params *ResumeClusterParams, authInfo runtime.ClientAuthInfoWriter) (*ResumeClusterOK, error)
+
+	ResumeClusterV2(params *ResumeClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*ResumeClusterV2OK, error)
+
 	RevokeClusterAdminToken(params *RevokeClusterAdminTokenParams, authInfo runtime.ClientAuthInfoWriter) (*RevokeClusterAdminTokenOK, error)
 
 	RevokeClusterViewerToken(params *RevokeClusterViewerTokenParams, authInfo runtime.ClientAuthInfoWriter) (*RevokeClusterViewerTokenOK, error)
@@ -175,6 +199,10 @@ type ClientService interface {
 
 	UnbindUserFromRoleBinding(params *UnbindUserFromRoleBindingParams, authInfo runtime.ClientAuthInfoWriter) (*UnbindUserFromRoleBindingOK, error)
 
+	UndeleteCluster(params *UndeleteClusterParams, authInfo runtime.ClientAuthInfoWriter) (*UndeleteClusterOK, error)
+
+	UndeleteClusterV2(params *UndeleteClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*UndeleteClusterV2OK, error)
+
 	UpdateExternalCluster(params *UpdateExternalClusterParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateExternalClusterOK, error)
 
 	UpdateProject(params *UpdateProjectParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateProjectOK, error)
@@ -459,7 +487,10 @@ func (a *Client) CreateExternalCluster(params *CreateExternalClusterParams, auth
 }
 
 /*
-  CreateNodeDeployment Creates a node deployment that will belong to the given cluster
+  	CreateNodeDeployment Creates a node deployment that will belong to the given cluster. AWS node deployments without
+
+  subnet and availability zone are spread across the availability zones of the cluster VPC,
+  one node deployment per zone. All of them are returned in zoneNodeDeployments.
 */
 func (a *Client) CreateNodeDeployment(params *CreateNodeDeploymentParams, authInfo runtime.ClientAuthInfoWriter) (*CreateNodeDeploymentCreated, error) {
 	// TODO: Validate the params before sending
@@ -597,7 +628,9 @@ func (a *Client) CreateSSHKey(params *CreateSSHKeyParams, authInfo runtime.Clien
 }
 
 /*
-  DeleteCluster Deletes the specified cluster
+  	DeleteCluster Deletes the specified cluster. If a deletion grace period is configured, the cluster is hibernated
+
+  and can be undeleted until the grace period is over.
 */
 func (a *Client) DeleteCluster(params *DeleteClusterParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteClusterOK, error) {
 	// TODO: Validate the params before sending
@@ -665,7 +698,9 @@ func (a *Client) DeleteClusterRole(params *DeleteClusterRoleParams, authInfo run
 }
 
 /*
-  DeleteClusterV2 Deletes the specified cluster
+  	DeleteClusterV2 Deletes the specified cluster. If a deletion grace period is configured, the cluster is hibernated
+
+  and can be undeleted until the grace period is over.
 */
 func (a *Client) DeleteClusterV2(params *DeleteClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*DeleteClusterV2OK, error) {
 	// TODO: Validate the params before sending
@@ -972,6 +1007,76 @@ func (a *Client) DetachSSHKeyFromClusterV2(params *DetachSSHKeyFromClusterV2Para
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  EstimateClusterCost estimates the monthly cost of a cluster and its initial node deployment before it gets created
+
+  The prices are taken from the price list of the datacenter of the cluster.
+*/
+func (a *Client) EstimateClusterCost(params *EstimateClusterCostParams, authInfo runtime.ClientAuthInfoWriter) (*EstimateClusterCostOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEstimateClusterCostParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "estimateClusterCost",
+		Method:             "POST",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/costestimate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &EstimateClusterCostReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EstimateClusterCostOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*EstimateClusterCostDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  EstimateMachineDeploymentCost estimates the monthly cost of a machine deployment of the cluster before it gets created
+*/
+func (a *Client) EstimateMachineDeploymentCost(params *EstimateMachineDeploymentCostParams, authInfo runtime.ClientAuthInfoWriter) (*EstimateMachineDeploymentCostOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEstimateMachineDeploymentCostParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "estimateMachineDeploymentCost",
+		Method:             "POST",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/costestimate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &EstimateMachineDeploymentCostReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EstimateMachineDeploymentCostOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*EstimateMachineDeploymentCostDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetCluster Gets the cluster with the given name
 */
@@ -1006,6 +1111,40 @@ func (a *Client) GetCluster(params *GetClusterParams, authInfo runtime.ClientAut
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetClusterDeletionPreviewV2 lists the resources which are removed when the cluster gets deleted
+*/
+func (a *Client) GetClusterDeletionPreviewV2(params *GetClusterDeletionPreviewV2Params, authInfo runtime.ClientAuthInfoWriter) (*GetClusterDeletionPreviewV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetClusterDeletionPreviewV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getClusterDeletionPreviewV2",
+		Method:             "GET",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/deletionpreview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetClusterDeletionPreviewV2Reader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetClusterDeletionPreviewV2OK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetClusterDeletionPreviewV2Default)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetClusterEvents gets the events related to the specified cluster
 */
@@ -1618,6 +1757,40 @@ func (a *Client) GetOidcClusterKubeconfigV2(params *GetOidcClusterKubeconfigV2Pa
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetOperation Gets the status of the given operation
+*/
+func (a *Client) GetOperation(params *GetOperationParams, authInfo runtime.ClientAuthInfoWriter) (*GetOperationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetOperationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getOperation",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/operations/{operation_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetOperationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetOperationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetOperationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetProject Gets the project with the given ID
 */
@@ -1686,6 +1859,78 @@ func (a *Client) GetRole(params *GetRoleParams, authInfo runtime.ClientAuthInfoW
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  HibernateCluster scales the worker nodes and the control plane of the cluster to zero
+
+  The progress is reported in the phase of the cluster status.
+*/
+func (a *Client) HibernateCluster(params *HibernateClusterParams, authInfo runtime.ClientAuthInfoWriter) (*HibernateClusterOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewHibernateClusterParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "hibernateCluster",
+		Method:             "POST",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/hibernate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &HibernateClusterReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*HibernateClusterOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*HibernateClusterDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  HibernateClusterV2 scales the worker nodes and the control plane of the cluster to zero
+
+  The progress is reported in the phase of the cluster status.
+*/
+func (a *Client) HibernateClusterV2(params *HibernateClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*HibernateClusterV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewHibernateClusterV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "hibernateClusterV2",
+		Method:             "POST",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/hibernate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &HibernateClusterV2Reader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*HibernateClusterV2OK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*HibernateClusterV2Default)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   ListClusterRole Lists all ClusterRoles
 */
@@ -2198,6 +2443,74 @@ func (a *Client) ListNodeDeployments(params *ListNodeDeploymentsParams, authInfo
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListOperations Lists the operations of the given project, the newest first
+*/
+func (a *Client) ListOperations(params *ListOperationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListOperationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListOperationsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listOperations",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/operations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListOperationsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListOperationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListOperationsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListProjectRoles lists the custom project roles that can be assigned to the members of a project
+*/
+func (a *Client) ListProjectRoles(params *ListProjectRolesParams, authInfo runtime.ClientAuthInfoWriter) (*ListProjectRolesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListProjectRolesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listProjectRoles",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projectroles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListProjectRolesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListProjectRolesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListProjectRolesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   ListProjects lists projects that an authenticated user is a member of
 */
@@ -2440,6 +2753,74 @@ func (a *Client) ListSSHKeysAssignedToClusterV2(params *ListSSHKeysAssignedToClu
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListUsageReports lists the monthly usage reports of the project they contain the node hours and the costs of every cluster
+*/
+func (a *Client) ListUsageReports(params *ListUsageReportsParams, authInfo runtime.ClientAuthInfoWriter) (*ListUsageReportsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListUsageReportsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listUsageReports",
+		Method:             "GET",
+		PathPattern:        "/api/v2/projects/{project_id}/usagereports",
+		ProducesMediaTypes: []string{"application/json", "text/csv"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListUsageReportsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListUsageReportsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListUsageReportsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  MoveClusterV2 moves the cluster to another project the caller has to be an owner of both projects
+*/
+func (a *Client) MoveClusterV2(params *MoveClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*MoveClusterV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMoveClusterV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "moveClusterV2",
+		Method:             "POST",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/move",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &MoveClusterV2Reader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*MoveClusterV2OK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*MoveClusterV2Default)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   PatchCluster patches the given cluster using JSON merge patch method https tools ietf org html rfc7396
 */
@@ -2611,6 +2992,78 @@ func (a *Client) PatchRole(params *PatchRoleParams, authInfo runtime.ClientAuthI
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ResumeCluster scales the control plane and the worker nodes of a hibernated cluster up again
+
+  The progress is reported in the phase of the cluster status.
+*/
+func (a *Client) ResumeCluster(params *ResumeClusterParams, authInfo runtime.ClientAuthInfoWriter) (*ResumeClusterOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewResumeClusterParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "resumeCluster",
+		Method:             "POST",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ResumeClusterReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ResumeClusterOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ResumeClusterDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ResumeClusterV2 scales the control plane and the worker nodes of a hibernated cluster up again
+
+  The progress is reported in the phase of the cluster status.
+*/
+func (a *Client) ResumeClusterV2(params *ResumeClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*ResumeClusterV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewResumeClusterV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "resumeClusterV2",
+		Method:             "POST",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ResumeClusterV2Reader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ResumeClusterV2OK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ResumeClusterV2Default)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   RevokeClusterAdminToken Revokes the current admin token
 */
@@ -2747,6 +3200,78 @@ func (a *Client) UnbindUserFromRoleBinding(params *UnbindUserFromRoleBindingPara
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  UndeleteCluster cancels the deletion of a cluster which is kept for the deletion grace period
+
+  The cluster is resumed unless it was hibernated before it got deleted.
+*/
+func (a *Client) UndeleteCluster(params *UndeleteClusterParams, authInfo runtime.ClientAuthInfoWriter) (*UndeleteClusterOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeleteClusterParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "undeleteCluster",
+		Method:             "POST",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UndeleteClusterReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UndeleteClusterOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UndeleteClusterDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  UndeleteClusterV2 cancels the deletion of a cluster which is kept for the deletion grace period
+
+  The cluster is resumed unless it was hibernated before it got deleted.
+*/
+func (a *Client) UndeleteClusterV2(params *UndeleteClusterV2Params, authInfo runtime.ClientAuthInfoWriter) (*UndeleteClusterV2OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUndeleteClusterV2Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "undeleteClusterV2",
+		Method:             "POST",
+		PathPattern:        "/api/v2/projects/{project_id}/clusters/{cluster_id}/undelete",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &UndeleteClusterV2Reader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UndeleteClusterV2OK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UndeleteClusterV2Default)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   UpdateExternalCluster updates an external cluster for the given project
 */
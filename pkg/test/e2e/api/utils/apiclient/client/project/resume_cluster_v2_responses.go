@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ResumeClusterV2Reader is a Reader for the ResumeClusterV2 structure.
+type ResumeClusterV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ResumeClusterV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewResumeClusterV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewResumeClusterV2Unauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewResumeClusterV2Forbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewResumeClusterV2Conflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewResumeClusterV2Default(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewResumeClusterV2OK creates a ResumeClusterV2OK with default headers values
+func NewResumeClusterV2OK() *ResumeClusterV2OK {
+	return &ResumeClusterV2OK{}
+}
+
+/*ResumeClusterV2OK handles this case with default header values.
+
+Cluster
+*/
+type ResumeClusterV2OK struct {
+	Payload *models.Cluster
+}
+
+func (o *ResumeClusterV2OK) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/resume][%d] resumeClusterV2OK  %+v", 200, o.Payload)
+}
+
+func (o *ResumeClusterV2OK) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *ResumeClusterV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewResumeClusterV2Unauthorized creates a ResumeClusterV2Unauthorized with default headers values
+func NewResumeClusterV2Unauthorized() *ResumeClusterV2Unauthorized {
+	return &ResumeClusterV2Unauthorized{}
+}
+
+/*ResumeClusterV2Unauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ResumeClusterV2Unauthorized struct {
+}
+
+func (o *ResumeClusterV2Unauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/resume][%d] resumeClusterV2Unauthorized ", 401)
+}
+
+func (o *ResumeClusterV2Unauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewResumeClusterV2Forbidden creates a ResumeClusterV2Forbidden with default headers values
+func NewResumeClusterV2Forbidden() *ResumeClusterV2Forbidden {
+	return &ResumeClusterV2Forbidden{}
+}
+
+/*ResumeClusterV2Forbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ResumeClusterV2Forbidden struct {
+}
+
+func (o *ResumeClusterV2Forbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/resume][%d] resumeClusterV2Forbidden ", 403)
+}
+
+func (o *ResumeClusterV2Forbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewResumeClusterV2Conflict creates a ResumeClusterV2Conflict with default headers values
+func NewResumeClusterV2Conflict() *ResumeClusterV2Conflict {
+	return &ResumeClusterV2Conflict{}
+}
+
+/*ResumeClusterV2Conflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ResumeClusterV2Conflict struct {
+}
+
+func (o *ResumeClusterV2Conflict) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/resume][%d] resumeClusterV2Conflict ", 409)
+}
+
+func (o *ResumeClusterV2Conflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewResumeClusterV2Default creates a ResumeClusterV2Default with default headers values
+func NewResumeClusterV2Default(code int) *ResumeClusterV2Default {
+	return &ResumeClusterV2Default{
+		_statusCode: code,
+	}
+}
+
+/*ResumeClusterV2Default handles this case with default header values.
+
+errorResponse
+*/
+type ResumeClusterV2Default struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the resume cluster v2 default response
+func (o *ResumeClusterV2Default) Code() int {
+	return o._statusCode
+}
+
+func (o *ResumeClusterV2Default) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/resume][%d] resumeClusterV2 default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ResumeClusterV2Default) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ResumeClusterV2Default) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
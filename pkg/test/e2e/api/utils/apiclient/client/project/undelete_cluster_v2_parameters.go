@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewUndeleteClusterV2Params creates a new UndeleteClusterV2Params object
+// with the default values initialized.
+func NewUndeleteClusterV2Params() *UndeleteClusterV2Params {
+	var ()
+	return &UndeleteClusterV2Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUndeleteClusterV2ParamsWithTimeout creates a new UndeleteClusterV2Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUndeleteClusterV2ParamsWithTimeout(timeout time.Duration) *UndeleteClusterV2Params {
+	var ()
+	return &UndeleteClusterV2Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewUndeleteClusterV2ParamsWithContext creates a new UndeleteClusterV2Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewUndeleteClusterV2ParamsWithContext(ctx context.Context) *UndeleteClusterV2Params {
+	var ()
+	return &UndeleteClusterV2Params{
+
+		Context: ctx,
+	}
+}
+
+// NewUndeleteClusterV2ParamsWithHTTPClient creates a new UndeleteClusterV2Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUndeleteClusterV2ParamsWithHTTPClient(client *http.Client) *UndeleteClusterV2Params {
+	var ()
+	return &UndeleteClusterV2Params{
+		HTTPClient: client,
+	}
+}
+
+/*UndeleteClusterV2Params contains all the parameters to send to the API endpoint
+for the undelete cluster v2 operation typically these are written to a http.Request
+*/
+type UndeleteClusterV2Params struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) WithTimeout(timeout time.Duration) *UndeleteClusterV2Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) WithContext(ctx context.Context) *UndeleteClusterV2Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) WithHTTPClient(client *http.Client) *UndeleteClusterV2Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) WithClusterID(clusterID string) *UndeleteClusterV2Params {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithProjectID adds the projectID to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) WithProjectID(projectID string) *UndeleteClusterV2Params {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the undelete cluster v2 params
+func (o *UndeleteClusterV2Params) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UndeleteClusterV2Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package project
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// UndeleteClusterV2Reader is a Reader for the UndeleteClusterV2 structure.
+type UndeleteClusterV2Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UndeleteClusterV2Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUndeleteClusterV2OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewUndeleteClusterV2Unauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewUndeleteClusterV2Forbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewUndeleteClusterV2Conflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewUndeleteClusterV2Default(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUndeleteClusterV2OK creates a UndeleteClusterV2OK with default headers values
+func NewUndeleteClusterV2OK() *UndeleteClusterV2OK {
+	return &UndeleteClusterV2OK{}
+}
+
+/*UndeleteClusterV2OK handles this case with default header values.
+
+Cluster
+*/
+type UndeleteClusterV2OK struct {
+	Payload *models.Cluster
+}
+
+func (o *UndeleteClusterV2OK) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/undelete][%d] undeleteClusterV2OK  %+v", 200, o.Payload)
+}
+
+func (o *UndeleteClusterV2OK) GetPayload() *models.Cluster {
+	return o.Payload
+}
+
+func (o *UndeleteClusterV2OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Cluster)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUndeleteClusterV2Unauthorized creates a UndeleteClusterV2Unauthorized with default headers values
+func NewUndeleteClusterV2Unauthorized() *UndeleteClusterV2Unauthorized {
+	return &UndeleteClusterV2Unauthorized{}
+}
+
+/*UndeleteClusterV2Unauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UndeleteClusterV2Unauthorized struct {
+}
+
+func (o *UndeleteClusterV2Unauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/undelete][%d] undeleteClusterV2Unauthorized ", 401)
+}
+
+func (o *UndeleteClusterV2Unauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUndeleteClusterV2Forbidden creates a UndeleteClusterV2Forbidden with default headers values
+func NewUndeleteClusterV2Forbidden() *UndeleteClusterV2Forbidden {
+	return &UndeleteClusterV2Forbidden{}
+}
+
+/*UndeleteClusterV2Forbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UndeleteClusterV2Forbidden struct {
+}
+
+func (o *UndeleteClusterV2Forbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/undelete][%d] undeleteClusterV2Forbidden ", 403)
+}
+
+func (o *UndeleteClusterV2Forbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUndeleteClusterV2Conflict creates a UndeleteClusterV2Conflict with default headers values
+func NewUndeleteClusterV2Conflict() *UndeleteClusterV2Conflict {
+	return &UndeleteClusterV2Conflict{}
+}
+
+/*UndeleteClusterV2Conflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type UndeleteClusterV2Conflict struct {
+}
+
+func (o *UndeleteClusterV2Conflict) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/undelete][%d] undeleteClusterV2Conflict ", 409)
+}
+
+func (o *UndeleteClusterV2Conflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUndeleteClusterV2Default creates a UndeleteClusterV2Default with default headers values
+func NewUndeleteClusterV2Default(code int) *UndeleteClusterV2Default {
+	return &UndeleteClusterV2Default{
+		_statusCode: code,
+	}
+}
+
+/*UndeleteClusterV2Default handles this case with default header values.
+
+errorResponse
+*/
+type UndeleteClusterV2Default struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the undelete cluster v2 default response
+func (o *UndeleteClusterV2Default) Code() int {
+	return o._statusCode
+}
+
+func (o *UndeleteClusterV2Default) Error() string {
+	return fmt.Sprintf("[POST /api/v2/projects/{project_id}/clusters/{cluster_id}/undelete][%d] undeleteClusterV2 default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *UndeleteClusterV2Default) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UndeleteClusterV2Default) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tokens
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRotateServiceAccountTokenParams creates a new RotateServiceAccountTokenParams object
+// with the default values initialized.
+func NewRotateServiceAccountTokenParams() *RotateServiceAccountTokenParams {
+	var ()
+	return &RotateServiceAccountTokenParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRotateServiceAccountTokenParamsWithTimeout creates a new RotateServiceAccountTokenParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRotateServiceAccountTokenParamsWithTimeout(timeout time.Duration) *RotateServiceAccountTokenParams {
+	var ()
+	return &RotateServiceAccountTokenParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRotateServiceAccountTokenParamsWithContext creates a new RotateServiceAccountTokenParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRotateServiceAccountTokenParamsWithContext(ctx context.Context) *RotateServiceAccountTokenParams {
+	var ()
+	return &RotateServiceAccountTokenParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRotateServiceAccountTokenParamsWithHTTPClient creates a new RotateServiceAccountTokenParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRotateServiceAccountTokenParamsWithHTTPClient(client *http.Client) *RotateServiceAccountTokenParams {
+	var ()
+	return &RotateServiceAccountTokenParams{
+		HTTPClient: client,
+	}
+}
+
+/*RotateServiceAccountTokenParams contains all the parameters to send to the API endpoint
+for the rotate service account token operation typically these are written to a http.Request
+*/
+type RotateServiceAccountTokenParams struct {
+
+	/*ProjectID*/
+	ProjectID string
+	/*ServiceaccountID*/
+	ServiceAccountID string
+	/*TokenID*/
+	TokenID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) WithTimeout(timeout time.Duration) *RotateServiceAccountTokenParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) WithContext(ctx context.Context) *RotateServiceAccountTokenParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) WithHTTPClient(client *http.Client) *RotateServiceAccountTokenParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithProjectID adds the projectID to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) WithProjectID(projectID string) *RotateServiceAccountTokenParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WithServiceAccountID adds the serviceaccountID to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) WithServiceAccountID(serviceaccountID string) *RotateServiceAccountTokenParams {
+	o.SetServiceAccountID(serviceaccountID)
+	return o
+}
+
+// SetServiceAccountID adds the serviceaccountId to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) SetServiceAccountID(serviceaccountID string) {
+	o.ServiceAccountID = serviceaccountID
+}
+
+// WithTokenID adds the tokenID to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) WithTokenID(tokenID string) *RotateServiceAccountTokenParams {
+	o.SetTokenID(tokenID)
+	return o
+}
+
+// SetTokenID adds the tokenId to the rotate service account token params
+func (o *RotateServiceAccountTokenParams) SetTokenID(tokenID string) {
+	o.TokenID = tokenID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RotateServiceAccountTokenParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	// path param serviceaccount_id
+	if err := r.SetPathParam("serviceaccount_id", o.ServiceAccountID); err != nil {
+		return err
+	}
+
+	// path param token_id
+	if err := r.SetPathParam("token_id", o.TokenID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package tokens
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// RotateServiceAccountTokenReader is a Reader for the RotateServiceAccountToken structure.
+type RotateServiceAccountTokenReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RotateServiceAccountTokenReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRotateServiceAccountTokenOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRotateServiceAccountTokenUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRotateServiceAccountTokenForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewRotateServiceAccountTokenDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRotateServiceAccountTokenOK creates a RotateServiceAccountTokenOK with default headers values
+func NewRotateServiceAccountTokenOK() *RotateServiceAccountTokenOK {
+	return &RotateServiceAccountTokenOK{}
+}
+
+/*RotateServiceAccountTokenOK handles this case with default header values.
+
+ServiceAccountToken
+*/
+type RotateServiceAccountTokenOK struct {
+	Payload *models.ServiceAccountToken
+}
+
+func (o *RotateServiceAccountTokenOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}/rotate][%d] rotateServiceAccountTokenOK  %+v", 200, o.Payload)
+}
+
+func (o *RotateServiceAccountTokenOK) GetPayload() *models.ServiceAccountToken {
+	return o.Payload
+}
+
+func (o *RotateServiceAccountTokenOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ServiceAccountToken)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRotateServiceAccountTokenUnauthorized creates a RotateServiceAccountTokenUnauthorized with default headers values
+func NewRotateServiceAccountTokenUnauthorized() *RotateServiceAccountTokenUnauthorized {
+	return &RotateServiceAccountTokenUnauthorized{}
+}
+
+/*RotateServiceAccountTokenUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type RotateServiceAccountTokenUnauthorized struct {
+}
+
+func (o *RotateServiceAccountTokenUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}/rotate][%d] rotateServiceAccountTokenUnauthorized ", 401)
+}
+
+func (o *RotateServiceAccountTokenUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRotateServiceAccountTokenForbidden creates a RotateServiceAccountTokenForbidden with default headers values
+func NewRotateServiceAccountTokenForbidden() *RotateServiceAccountTokenForbidden {
+	return &RotateServiceAccountTokenForbidden{}
+}
+
+/*RotateServiceAccountTokenForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type RotateServiceAccountTokenForbidden struct {
+}
+
+func (o *RotateServiceAccountTokenForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}/rotate][%d] rotateServiceAccountTokenForbidden ", 403)
+}
+
+func (o *RotateServiceAccountTokenForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRotateServiceAccountTokenDefault creates a RotateServiceAccountTokenDefault with default headers values
+func NewRotateServiceAccountTokenDefault(code int) *RotateServiceAccountTokenDefault {
+	return &RotateServiceAccountTokenDefault{
+		_statusCode: code,
+	}
+}
+
+/*RotateServiceAccountTokenDefault handles this case with default header values.
+
+errorResponse
+*/
+type RotateServiceAccountTokenDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the rotate service account token default response
+func (o *RotateServiceAccountTokenDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RotateServiceAccountTokenDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens/{token_id}/rotate][%d] rotateServiceAccountToken default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RotateServiceAccountTokenDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RotateServiceAccountTokenDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
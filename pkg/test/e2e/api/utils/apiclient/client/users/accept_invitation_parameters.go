@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewAcceptInvitationParams creates a new AcceptInvitationParams object
+// with the default values initialized.
+func NewAcceptInvitationParams() *AcceptInvitationParams {
+	var ()
+	return &AcceptInvitationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAcceptInvitationParamsWithTimeout creates a new AcceptInvitationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAcceptInvitationParamsWithTimeout(timeout time.Duration) *AcceptInvitationParams {
+	var ()
+	return &AcceptInvitationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewAcceptInvitationParamsWithContext creates a new AcceptInvitationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewAcceptInvitationParamsWithContext(ctx context.Context) *AcceptInvitationParams {
+	var ()
+	return &AcceptInvitationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewAcceptInvitationParamsWithHTTPClient creates a new AcceptInvitationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAcceptInvitationParamsWithHTTPClient(client *http.Client) *AcceptInvitationParams {
+	var ()
+	return &AcceptInvitationParams{
+		HTTPClient: client,
+	}
+}
+
+/*AcceptInvitationParams contains all the parameters to send to the API endpoint
+for the accept invitation operation typically these are written to a http.Request
+*/
+type AcceptInvitationParams struct {
+
+	/*InvitationID*/
+	InvitationID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the accept invitation params
+func (o *AcceptInvitationParams) WithTimeout(timeout time.Duration) *AcceptInvitationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the accept invitation params
+func (o *AcceptInvitationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the accept invitation params
+func (o *AcceptInvitationParams) WithContext(ctx context.Context) *AcceptInvitationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the accept invitation params
+func (o *AcceptInvitationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the accept invitation params
+func (o *AcceptInvitationParams) WithHTTPClient(client *http.Client) *AcceptInvitationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the accept invitation params
+func (o *AcceptInvitationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInvitationID adds the invitationID to the accept invitation params
+func (o *AcceptInvitationParams) WithInvitationID(invitationID string) *AcceptInvitationParams {
+	o.SetInvitationID(invitationID)
+	return o
+}
+
+// SetInvitationID adds the invitationId to the accept invitation params
+func (o *AcceptInvitationParams) SetInvitationID(invitationID string) {
+	o.InvitationID = invitationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AcceptInvitationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param invitation_id
+	if err := r.SetPathParam("invitation_id", o.InvitationID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// AcceptInvitationReader is a Reader for the AcceptInvitation structure.
+type AcceptInvitationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AcceptInvitationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewAcceptInvitationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAcceptInvitationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAcceptInvitationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewAcceptInvitationConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 410:
+		result := NewAcceptInvitationGone()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAcceptInvitationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAcceptInvitationOK creates a AcceptInvitationOK with default headers values
+func NewAcceptInvitationOK() *AcceptInvitationOK {
+	return &AcceptInvitationOK{}
+}
+
+/*AcceptInvitationOK handles this case with default header values.
+
+User
+*/
+type AcceptInvitationOK struct {
+	Payload *models.User
+}
+
+func (o *AcceptInvitationOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/accept][%d] acceptInvitationOK  %+v", 200, o.Payload)
+}
+
+func (o *AcceptInvitationOK) GetPayload() *models.User {
+	return o.Payload
+}
+
+func (o *AcceptInvitationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.User)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAcceptInvitationUnauthorized creates a AcceptInvitationUnauthorized with default headers values
+func NewAcceptInvitationUnauthorized() *AcceptInvitationUnauthorized {
+	return &AcceptInvitationUnauthorized{}
+}
+
+/*AcceptInvitationUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type AcceptInvitationUnauthorized struct {
+}
+
+func (o *AcceptInvitationUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/accept][%d] acceptInvitationUnauthorized ", 401)
+}
+
+func (o *AcceptInvitationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAcceptInvitationForbidden creates a AcceptInvitationForbidden with default headers values
+func NewAcceptInvitationForbidden() *AcceptInvitationForbidden {
+	return &AcceptInvitationForbidden{}
+}
+
+/*AcceptInvitationForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type AcceptInvitationForbidden struct {
+}
+
+func (o *AcceptInvitationForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/accept][%d] acceptInvitationForbidden ", 403)
+}
+
+func (o *AcceptInvitationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAcceptInvitationConflict creates a AcceptInvitationConflict with default headers values
+func NewAcceptInvitationConflict() *AcceptInvitationConflict {
+	return &AcceptInvitationConflict{}
+}
+
+/*AcceptInvitationConflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type AcceptInvitationConflict struct {
+}
+
+func (o *AcceptInvitationConflict) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/accept][%d] acceptInvitationConflict ", 409)
+}
+
+func (o *AcceptInvitationConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAcceptInvitationGone creates a AcceptInvitationGone with default headers values
+func NewAcceptInvitationGone() *AcceptInvitationGone {
+	return &AcceptInvitationGone{}
+}
+
+/*AcceptInvitationGone handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type AcceptInvitationGone struct {
+}
+
+func (o *AcceptInvitationGone) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/accept][%d] acceptInvitationGone ", 410)
+}
+
+func (o *AcceptInvitationGone) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAcceptInvitationDefault creates a AcceptInvitationDefault with default headers values
+func NewAcceptInvitationDefault(code int) *AcceptInvitationDefault {
+	return &AcceptInvitationDefault{
+		_statusCode: code,
+	}
+}
+
+/*AcceptInvitationDefault handles this case with default header values.
+
+errorResponse
+*/
+type AcceptInvitationDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the accept invitation default response
+func (o *AcceptInvitationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AcceptInvitationDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/accept][%d] acceptInvitation default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AcceptInvitationDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AcceptInvitationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
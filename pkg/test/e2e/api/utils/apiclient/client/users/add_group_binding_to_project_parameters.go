@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// NewAddGroupBindingToProjectParams creates a new AddGroupBindingToProjectParams object
+// with the default values initialized.
+func NewAddGroupBindingToProjectParams() *AddGroupBindingToProjectParams {
+	var ()
+	return &AddGroupBindingToProjectParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddGroupBindingToProjectParamsWithTimeout creates a new AddGroupBindingToProjectParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAddGroupBindingToProjectParamsWithTimeout(timeout time.Duration) *AddGroupBindingToProjectParams {
+	var ()
+	return &AddGroupBindingToProjectParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewAddGroupBindingToProjectParamsWithContext creates a new AddGroupBindingToProjectParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewAddGroupBindingToProjectParamsWithContext(ctx context.Context) *AddGroupBindingToProjectParams {
+	var ()
+	return &AddGroupBindingToProjectParams{
+
+		Context: ctx,
+	}
+}
+
+// NewAddGroupBindingToProjectParamsWithHTTPClient creates a new AddGroupBindingToProjectParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAddGroupBindingToProjectParamsWithHTTPClient(client *http.Client) *AddGroupBindingToProjectParams {
+	var ()
+	return &AddGroupBindingToProjectParams{
+		HTTPClient: client,
+	}
+}
+
+/*AddGroupBindingToProjectParams contains all the parameters to send to the API endpoint
+for the add group binding to project operation typically these are written to a http.Request
+*/
+type AddGroupBindingToProjectParams struct {
+
+	/*Body*/
+	Body *models.GroupProjectBinding
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) WithTimeout(timeout time.Duration) *AddGroupBindingToProjectParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) WithContext(ctx context.Context) *AddGroupBindingToProjectParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) WithHTTPClient(client *http.Client) *AddGroupBindingToProjectParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) WithBody(body *models.GroupProjectBinding) *AddGroupBindingToProjectParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) SetBody(body *models.GroupProjectBinding) {
+	o.Body = body
+}
+
+// WithProjectID adds the projectID to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) WithProjectID(projectID string) *AddGroupBindingToProjectParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the add group binding to project params
+func (o *AddGroupBindingToProjectParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddGroupBindingToProjectParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
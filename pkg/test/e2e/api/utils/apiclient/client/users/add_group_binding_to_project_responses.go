@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// AddGroupBindingToProjectReader is a Reader for the AddGroupBindingToProject structure.
+type AddGroupBindingToProjectReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *AddGroupBindingToProjectReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewAddGroupBindingToProjectCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewAddGroupBindingToProjectUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewAddGroupBindingToProjectForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewAddGroupBindingToProjectDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewAddGroupBindingToProjectCreated creates a AddGroupBindingToProjectCreated with default headers values
+func NewAddGroupBindingToProjectCreated() *AddGroupBindingToProjectCreated {
+	return &AddGroupBindingToProjectCreated{}
+}
+
+/*AddGroupBindingToProjectCreated handles this case with default header values.
+
+GroupProjectBinding
+*/
+type AddGroupBindingToProjectCreated struct {
+	Payload *models.GroupProjectBinding
+}
+
+func (o *AddGroupBindingToProjectCreated) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/groupbindings][%d] addGroupBindingToProjectCreated  %+v", 201, o.Payload)
+}
+
+func (o *AddGroupBindingToProjectCreated) GetPayload() *models.GroupProjectBinding {
+	return o.Payload
+}
+
+func (o *AddGroupBindingToProjectCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GroupProjectBinding)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddGroupBindingToProjectUnauthorized creates a AddGroupBindingToProjectUnauthorized with default headers values
+func NewAddGroupBindingToProjectUnauthorized() *AddGroupBindingToProjectUnauthorized {
+	return &AddGroupBindingToProjectUnauthorized{}
+}
+
+/*AddGroupBindingToProjectUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type AddGroupBindingToProjectUnauthorized struct {
+}
+
+func (o *AddGroupBindingToProjectUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/groupbindings][%d] addGroupBindingToProjectUnauthorized ", 401)
+}
+
+func (o *AddGroupBindingToProjectUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddGroupBindingToProjectForbidden creates a AddGroupBindingToProjectForbidden with default headers values
+func NewAddGroupBindingToProjectForbidden() *AddGroupBindingToProjectForbidden {
+	return &AddGroupBindingToProjectForbidden{}
+}
+
+/*AddGroupBindingToProjectForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type AddGroupBindingToProjectForbidden struct {
+}
+
+func (o *AddGroupBindingToProjectForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/groupbindings][%d] addGroupBindingToProjectForbidden ", 403)
+}
+
+func (o *AddGroupBindingToProjectForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewAddGroupBindingToProjectDefault creates a AddGroupBindingToProjectDefault with default headers values
+func NewAddGroupBindingToProjectDefault(code int) *AddGroupBindingToProjectDefault {
+	return &AddGroupBindingToProjectDefault{
+		_statusCode: code,
+	}
+}
+
+/*AddGroupBindingToProjectDefault handles this case with default header values.
+
+errorResponse
+*/
+type AddGroupBindingToProjectDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the add group binding to project default response
+func (o *AddGroupBindingToProjectDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *AddGroupBindingToProjectDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/groupbindings][%d] addGroupBindingToProject default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *AddGroupBindingToProjectDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *AddGroupBindingToProjectDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// CreateProjectInvitationReader is a Reader for the CreateProjectInvitation structure.
+type CreateProjectInvitationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateProjectInvitationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateProjectInvitationCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewCreateProjectInvitationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewCreateProjectInvitationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 409:
+		result := NewCreateProjectInvitationConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewCreateProjectInvitationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateProjectInvitationCreated creates a CreateProjectInvitationCreated with default headers values
+func NewCreateProjectInvitationCreated() *CreateProjectInvitationCreated {
+	return &CreateProjectInvitationCreated{}
+}
+
+/*CreateProjectInvitationCreated handles this case with default header values.
+
+ProjectInvitation
+*/
+type CreateProjectInvitationCreated struct {
+	Payload *models.ProjectInvitation
+}
+
+func (o *CreateProjectInvitationCreated) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/invitations][%d] createProjectInvitationCreated  %+v", 201, o.Payload)
+}
+
+func (o *CreateProjectInvitationCreated) GetPayload() *models.ProjectInvitation {
+	return o.Payload
+}
+
+func (o *CreateProjectInvitationCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ProjectInvitation)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateProjectInvitationUnauthorized creates a CreateProjectInvitationUnauthorized with default headers values
+func NewCreateProjectInvitationUnauthorized() *CreateProjectInvitationUnauthorized {
+	return &CreateProjectInvitationUnauthorized{}
+}
+
+/*CreateProjectInvitationUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type CreateProjectInvitationUnauthorized struct {
+}
+
+func (o *CreateProjectInvitationUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/invitations][%d] createProjectInvitationUnauthorized ", 401)
+}
+
+func (o *CreateProjectInvitationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCreateProjectInvitationForbidden creates a CreateProjectInvitationForbidden with default headers values
+func NewCreateProjectInvitationForbidden() *CreateProjectInvitationForbidden {
+	return &CreateProjectInvitationForbidden{}
+}
+
+/*CreateProjectInvitationForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type CreateProjectInvitationForbidden struct {
+}
+
+func (o *CreateProjectInvitationForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/invitations][%d] createProjectInvitationForbidden ", 403)
+}
+
+func (o *CreateProjectInvitationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCreateProjectInvitationConflict creates a CreateProjectInvitationConflict with default headers values
+func NewCreateProjectInvitationConflict() *CreateProjectInvitationConflict {
+	return &CreateProjectInvitationConflict{}
+}
+
+/*CreateProjectInvitationConflict handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type CreateProjectInvitationConflict struct {
+}
+
+func (o *CreateProjectInvitationConflict) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/invitations][%d] createProjectInvitationConflict ", 409)
+}
+
+func (o *CreateProjectInvitationConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewCreateProjectInvitationDefault creates a CreateProjectInvitationDefault with default headers values
+func NewCreateProjectInvitationDefault(code int) *CreateProjectInvitationDefault {
+	return &CreateProjectInvitationDefault{
+		_statusCode: code,
+	}
+}
+
+/*CreateProjectInvitationDefault handles this case with default header values.
+
+errorResponse
+*/
+type CreateProjectInvitationDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the create project invitation default response
+func (o *CreateProjectInvitationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateProjectInvitationDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/projects/{project_id}/invitations][%d] createProjectInvitation default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *CreateProjectInvitationDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateProjectInvitationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeclineInvitationParams creates a new DeclineInvitationParams object
+// with the default values initialized.
+func NewDeclineInvitationParams() *DeclineInvitationParams {
+	var ()
+	return &DeclineInvitationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeclineInvitationParamsWithTimeout creates a new DeclineInvitationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeclineInvitationParamsWithTimeout(timeout time.Duration) *DeclineInvitationParams {
+	var ()
+	return &DeclineInvitationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeclineInvitationParamsWithContext creates a new DeclineInvitationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeclineInvitationParamsWithContext(ctx context.Context) *DeclineInvitationParams {
+	var ()
+	return &DeclineInvitationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeclineInvitationParamsWithHTTPClient creates a new DeclineInvitationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeclineInvitationParamsWithHTTPClient(client *http.Client) *DeclineInvitationParams {
+	var ()
+	return &DeclineInvitationParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeclineInvitationParams contains all the parameters to send to the API endpoint
+for the decline invitation operation typically these are written to a http.Request
+*/
+type DeclineInvitationParams struct {
+
+	/*InvitationID*/
+	InvitationID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the decline invitation params
+func (o *DeclineInvitationParams) WithTimeout(timeout time.Duration) *DeclineInvitationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the decline invitation params
+func (o *DeclineInvitationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the decline invitation params
+func (o *DeclineInvitationParams) WithContext(ctx context.Context) *DeclineInvitationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the decline invitation params
+func (o *DeclineInvitationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the decline invitation params
+func (o *DeclineInvitationParams) WithHTTPClient(client *http.Client) *DeclineInvitationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the decline invitation params
+func (o *DeclineInvitationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInvitationID adds the invitationID to the decline invitation params
+func (o *DeclineInvitationParams) WithInvitationID(invitationID string) *DeclineInvitationParams {
+	o.SetInvitationID(invitationID)
+	return o
+}
+
+// SetInvitationID adds the invitationId to the decline invitation params
+func (o *DeclineInvitationParams) SetInvitationID(invitationID string) {
+	o.InvitationID = invitationID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeclineInvitationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param invitation_id
+	if err := r.SetPathParam("invitation_id", o.InvitationID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// DeclineInvitationReader is a Reader for the DeclineInvitation structure.
+type DeclineInvitationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeclineInvitationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeclineInvitationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDeclineInvitationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeclineInvitationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeclineInvitationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeclineInvitationOK creates a DeclineInvitationOK with default headers values
+func NewDeclineInvitationOK() *DeclineInvitationOK {
+	return &DeclineInvitationOK{}
+}
+
+/*DeclineInvitationOK handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeclineInvitationOK struct {
+}
+
+func (o *DeclineInvitationOK) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/decline][%d] declineInvitationOK ", 200)
+}
+
+func (o *DeclineInvitationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeclineInvitationUnauthorized creates a DeclineInvitationUnauthorized with default headers values
+func NewDeclineInvitationUnauthorized() *DeclineInvitationUnauthorized {
+	return &DeclineInvitationUnauthorized{}
+}
+
+/*DeclineInvitationUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeclineInvitationUnauthorized struct {
+}
+
+func (o *DeclineInvitationUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/decline][%d] declineInvitationUnauthorized ", 401)
+}
+
+func (o *DeclineInvitationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeclineInvitationForbidden creates a DeclineInvitationForbidden with default headers values
+func NewDeclineInvitationForbidden() *DeclineInvitationForbidden {
+	return &DeclineInvitationForbidden{}
+}
+
+/*DeclineInvitationForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeclineInvitationForbidden struct {
+}
+
+func (o *DeclineInvitationForbidden) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/decline][%d] declineInvitationForbidden ", 403)
+}
+
+func (o *DeclineInvitationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeclineInvitationDefault creates a DeclineInvitationDefault with default headers values
+func NewDeclineInvitationDefault(code int) *DeclineInvitationDefault {
+	return &DeclineInvitationDefault{
+		_statusCode: code,
+	}
+}
+
+/*DeclineInvitationDefault handles this case with default header values.
+
+errorResponse
+*/
+type DeclineInvitationDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the decline invitation default response
+func (o *DeclineInvitationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeclineInvitationDefault) Error() string {
+	return fmt.Sprintf("[POST /api/v1/me/invitations/{invitation_id}/decline][%d] declineInvitation default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeclineInvitationDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeclineInvitationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteGroupBindingFromProjectParams creates a new DeleteGroupBindingFromProjectParams object
+// with the default values initialized.
+func NewDeleteGroupBindingFromProjectParams() *DeleteGroupBindingFromProjectParams {
+	var ()
+	return &DeleteGroupBindingFromProjectParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteGroupBindingFromProjectParamsWithTimeout creates a new DeleteGroupBindingFromProjectParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteGroupBindingFromProjectParamsWithTimeout(timeout time.Duration) *DeleteGroupBindingFromProjectParams {
+	var ()
+	return &DeleteGroupBindingFromProjectParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteGroupBindingFromProjectParamsWithContext creates a new DeleteGroupBindingFromProjectParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteGroupBindingFromProjectParamsWithContext(ctx context.Context) *DeleteGroupBindingFromProjectParams {
+	var ()
+	return &DeleteGroupBindingFromProjectParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteGroupBindingFromProjectParamsWithHTTPClient creates a new DeleteGroupBindingFromProjectParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteGroupBindingFromProjectParamsWithHTTPClient(client *http.Client) *DeleteGroupBindingFromProjectParams {
+	var ()
+	return &DeleteGroupBindingFromProjectParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteGroupBindingFromProjectParams contains all the parameters to send to the API endpoint
+for the delete group binding from project operation typically these are written to a http.Request
+*/
+type DeleteGroupBindingFromProjectParams struct {
+
+	/*BindingName*/
+	BindingName string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) WithTimeout(timeout time.Duration) *DeleteGroupBindingFromProjectParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) WithContext(ctx context.Context) *DeleteGroupBindingFromProjectParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) WithHTTPClient(client *http.Client) *DeleteGroupBindingFromProjectParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBindingName adds the bindingName to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) WithBindingName(bindingName string) *DeleteGroupBindingFromProjectParams {
+	o.SetBindingName(bindingName)
+	return o
+}
+
+// SetBindingName adds the bindingName to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) SetBindingName(bindingName string) {
+	o.BindingName = bindingName
+}
+
+// WithProjectID adds the projectID to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) WithProjectID(projectID string) *DeleteGroupBindingFromProjectParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the delete group binding from project params
+func (o *DeleteGroupBindingFromProjectParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteGroupBindingFromProjectParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param binding_name
+	if err := r.SetPathParam("binding_name", o.BindingName); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
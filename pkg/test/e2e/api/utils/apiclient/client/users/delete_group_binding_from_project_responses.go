@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// DeleteGroupBindingFromProjectReader is a Reader for the DeleteGroupBindingFromProject structure.
+type DeleteGroupBindingFromProjectReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteGroupBindingFromProjectReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteGroupBindingFromProjectOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewDeleteGroupBindingFromProjectUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewDeleteGroupBindingFromProjectForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteGroupBindingFromProjectDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteGroupBindingFromProjectOK creates a DeleteGroupBindingFromProjectOK with default headers values
+func NewDeleteGroupBindingFromProjectOK() *DeleteGroupBindingFromProjectOK {
+	return &DeleteGroupBindingFromProjectOK{}
+}
+
+/*DeleteGroupBindingFromProjectOK handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteGroupBindingFromProjectOK struct {
+}
+
+func (o *DeleteGroupBindingFromProjectOK) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/groupbindings/{binding_name}][%d] deleteGroupBindingFromProjectOK ", 200)
+}
+
+func (o *DeleteGroupBindingFromProjectOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteGroupBindingFromProjectUnauthorized creates a DeleteGroupBindingFromProjectUnauthorized with default headers values
+func NewDeleteGroupBindingFromProjectUnauthorized() *DeleteGroupBindingFromProjectUnauthorized {
+	return &DeleteGroupBindingFromProjectUnauthorized{}
+}
+
+/*DeleteGroupBindingFromProjectUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteGroupBindingFromProjectUnauthorized struct {
+}
+
+func (o *DeleteGroupBindingFromProjectUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/groupbindings/{binding_name}][%d] deleteGroupBindingFromProjectUnauthorized ", 401)
+}
+
+func (o *DeleteGroupBindingFromProjectUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteGroupBindingFromProjectForbidden creates a DeleteGroupBindingFromProjectForbidden with default headers values
+func NewDeleteGroupBindingFromProjectForbidden() *DeleteGroupBindingFromProjectForbidden {
+	return &DeleteGroupBindingFromProjectForbidden{}
+}
+
+/*DeleteGroupBindingFromProjectForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type DeleteGroupBindingFromProjectForbidden struct {
+}
+
+func (o *DeleteGroupBindingFromProjectForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/groupbindings/{binding_name}][%d] deleteGroupBindingFromProjectForbidden ", 403)
+}
+
+func (o *DeleteGroupBindingFromProjectForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteGroupBindingFromProjectDefault creates a DeleteGroupBindingFromProjectDefault with default headers values
+func NewDeleteGroupBindingFromProjectDefault(code int) *DeleteGroupBindingFromProjectDefault {
+	return &DeleteGroupBindingFromProjectDefault{
+		_statusCode: code,
+	}
+}
+
+/*DeleteGroupBindingFromProjectDefault handles this case with default header values.
+
+errorResponse
+*/
+type DeleteGroupBindingFromProjectDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the delete group binding from project default response
+func (o *DeleteGroupBindingFromProjectDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteGroupBindingFromProjectDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/groupbindings/{binding_name}][%d] deleteGroupBindingFromProject default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *DeleteGroupBindingFromProjectDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *DeleteGroupBindingFromProjectDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
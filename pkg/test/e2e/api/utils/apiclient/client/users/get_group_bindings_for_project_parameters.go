@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetGroupBindingsForProjectParams creates a new GetGroupBindingsForProjectParams object
+// with the default values initialized.
+func NewGetGroupBindingsForProjectParams() *GetGroupBindingsForProjectParams {
+
+	return &GetGroupBindingsForProjectParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetGroupBindingsForProjectParamsWithTimeout creates a new GetGroupBindingsForProjectParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewGetGroupBindingsForProjectParamsWithTimeout(timeout time.Duration) *GetGroupBindingsForProjectParams {
+
+	return &GetGroupBindingsForProjectParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewGetGroupBindingsForProjectParamsWithContext creates a new GetGroupBindingsForProjectParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewGetGroupBindingsForProjectParamsWithContext(ctx context.Context) *GetGroupBindingsForProjectParams {
+
+	return &GetGroupBindingsForProjectParams{
+
+		Context: ctx,
+	}
+}
+
+// NewGetGroupBindingsForProjectParamsWithHTTPClient creates a new GetGroupBindingsForProjectParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewGetGroupBindingsForProjectParamsWithHTTPClient(client *http.Client) *GetGroupBindingsForProjectParams {
+
+	return &GetGroupBindingsForProjectParams{
+		HTTPClient: client,
+	}
+}
+
+/*GetGroupBindingsForProjectParams contains all the parameters to send to the API endpoint
+for the get group bindings for project operation typically these are written to a http.Request
+*/
+type GetGroupBindingsForProjectParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the get group bindings for project params
+func (o *GetGroupBindingsForProjectParams) WithTimeout(timeout time.Duration) *GetGroupBindingsForProjectParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get group bindings for project params
+func (o *GetGroupBindingsForProjectParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get group bindings for project params
+func (o *GetGroupBindingsForProjectParams) WithContext(ctx context.Context) *GetGroupBindingsForProjectParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get group bindings for project params
+func (o *GetGroupBindingsForProjectParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get group bindings for project params
+func (o *GetGroupBindingsForProjectParams) WithHTTPClient(client *http.Client) *GetGroupBindingsForProjectParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get group bindings for project params
+func (o *GetGroupBindingsForProjectParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetGroupBindingsForProjectParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// GetGroupBindingsForProjectReader is a Reader for the GetGroupBindingsForProject structure.
+type GetGroupBindingsForProjectReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetGroupBindingsForProjectReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetGroupBindingsForProjectOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGetGroupBindingsForProjectUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGetGroupBindingsForProjectForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetGroupBindingsForProjectDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetGroupBindingsForProjectOK creates a GetGroupBindingsForProjectOK with default headers values
+func NewGetGroupBindingsForProjectOK() *GetGroupBindingsForProjectOK {
+	return &GetGroupBindingsForProjectOK{}
+}
+
+/*GetGroupBindingsForProjectOK handles this case with default header values.
+
+GroupProjectBinding
+*/
+type GetGroupBindingsForProjectOK struct {
+	Payload []*models.GroupProjectBinding
+}
+
+func (o *GetGroupBindingsForProjectOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/groupbindings][%d] getGroupBindingsForProjectOK  %+v", 200, o.Payload)
+}
+
+func (o *GetGroupBindingsForProjectOK) GetPayload() []*models.GroupProjectBinding {
+	return o.Payload
+}
+
+func (o *GetGroupBindingsForProjectOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetGroupBindingsForProjectUnauthorized creates a GetGroupBindingsForProjectUnauthorized with default headers values
+func NewGetGroupBindingsForProjectUnauthorized() *GetGroupBindingsForProjectUnauthorized {
+	return &GetGroupBindingsForProjectUnauthorized{}
+}
+
+/*GetGroupBindingsForProjectUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type GetGroupBindingsForProjectUnauthorized struct {
+}
+
+func (o *GetGroupBindingsForProjectUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/groupbindings][%d] getGroupBindingsForProjectUnauthorized ", 401)
+}
+
+func (o *GetGroupBindingsForProjectUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetGroupBindingsForProjectForbidden creates a GetGroupBindingsForProjectForbidden with default headers values
+func NewGetGroupBindingsForProjectForbidden() *GetGroupBindingsForProjectForbidden {
+	return &GetGroupBindingsForProjectForbidden{}
+}
+
+/*GetGroupBindingsForProjectForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type GetGroupBindingsForProjectForbidden struct {
+}
+
+func (o *GetGroupBindingsForProjectForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/groupbindings][%d] getGroupBindingsForProjectForbidden ", 403)
+}
+
+func (o *GetGroupBindingsForProjectForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGetGroupBindingsForProjectDefault creates a GetGroupBindingsForProjectDefault with default headers values
+func NewGetGroupBindingsForProjectDefault(code int) *GetGroupBindingsForProjectDefault {
+	return &GetGroupBindingsForProjectDefault{
+		_statusCode: code,
+	}
+}
+
+/*GetGroupBindingsForProjectDefault handles this case with default header values.
+
+errorResponse
+*/
+type GetGroupBindingsForProjectDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the get group bindings for project default response
+func (o *GetGroupBindingsForProjectDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetGroupBindingsForProjectDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/groupbindings][%d] getGroupBindingsForProject default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *GetGroupBindingsForProjectDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetGroupBindingsForProjectDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListCurrentUserInvitationsParams creates a new ListCurrentUserInvitationsParams object
+// with the default values initialized.
+func NewListCurrentUserInvitationsParams() *ListCurrentUserInvitationsParams {
+
+	return &ListCurrentUserInvitationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListCurrentUserInvitationsParamsWithTimeout creates a new ListCurrentUserInvitationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListCurrentUserInvitationsParamsWithTimeout(timeout time.Duration) *ListCurrentUserInvitationsParams {
+
+	return &ListCurrentUserInvitationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListCurrentUserInvitationsParamsWithContext creates a new ListCurrentUserInvitationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListCurrentUserInvitationsParamsWithContext(ctx context.Context) *ListCurrentUserInvitationsParams {
+
+	return &ListCurrentUserInvitationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListCurrentUserInvitationsParamsWithHTTPClient creates a new ListCurrentUserInvitationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListCurrentUserInvitationsParamsWithHTTPClient(client *http.Client) *ListCurrentUserInvitationsParams {
+
+	return &ListCurrentUserInvitationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListCurrentUserInvitationsParams contains all the parameters to send to the API endpoint
+for the list current user invitations operation typically these are written to a http.Request
+*/
+type ListCurrentUserInvitationsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list current user invitations params
+func (o *ListCurrentUserInvitationsParams) WithTimeout(timeout time.Duration) *ListCurrentUserInvitationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list current user invitations params
+func (o *ListCurrentUserInvitationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list current user invitations params
+func (o *ListCurrentUserInvitationsParams) WithContext(ctx context.Context) *ListCurrentUserInvitationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list current user invitations params
+func (o *ListCurrentUserInvitationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list current user invitations params
+func (o *ListCurrentUserInvitationsParams) WithHTTPClient(client *http.Client) *ListCurrentUserInvitationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list current user invitations params
+func (o *ListCurrentUserInvitationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListCurrentUserInvitationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListCurrentUserInvitationsReader is a Reader for the ListCurrentUserInvitations structure.
+type ListCurrentUserInvitationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListCurrentUserInvitationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListCurrentUserInvitationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListCurrentUserInvitationsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListCurrentUserInvitationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListCurrentUserInvitationsOK creates a ListCurrentUserInvitationsOK with default headers values
+func NewListCurrentUserInvitationsOK() *ListCurrentUserInvitationsOK {
+	return &ListCurrentUserInvitationsOK{}
+}
+
+/*ListCurrentUserInvitationsOK handles this case with default header values.
+
+ProjectInvitation
+*/
+type ListCurrentUserInvitationsOK struct {
+	Payload []*models.ProjectInvitation
+}
+
+func (o *ListCurrentUserInvitationsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/me/invitations][%d] listCurrentUserInvitationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListCurrentUserInvitationsOK) GetPayload() []*models.ProjectInvitation {
+	return o.Payload
+}
+
+func (o *ListCurrentUserInvitationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListCurrentUserInvitationsUnauthorized creates a ListCurrentUserInvitationsUnauthorized with default headers values
+func NewListCurrentUserInvitationsUnauthorized() *ListCurrentUserInvitationsUnauthorized {
+	return &ListCurrentUserInvitationsUnauthorized{}
+}
+
+/*ListCurrentUserInvitationsUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListCurrentUserInvitationsUnauthorized struct {
+}
+
+func (o *ListCurrentUserInvitationsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/me/invitations][%d] listCurrentUserInvitationsUnauthorized ", 401)
+}
+
+func (o *ListCurrentUserInvitationsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListCurrentUserInvitationsDefault creates a ListCurrentUserInvitationsDefault with default headers values
+func NewListCurrentUserInvitationsDefault(code int) *ListCurrentUserInvitationsDefault {
+	return &ListCurrentUserInvitationsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListCurrentUserInvitationsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListCurrentUserInvitationsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list current user invitations default response
+func (o *ListCurrentUserInvitationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListCurrentUserInvitationsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/me/invitations][%d] listCurrentUserInvitations default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListCurrentUserInvitationsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListCurrentUserInvitationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListProjectInvitationsParams creates a new ListProjectInvitationsParams object
+// with the default values initialized.
+func NewListProjectInvitationsParams() *ListProjectInvitationsParams {
+
+	return &ListProjectInvitationsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListProjectInvitationsParamsWithTimeout creates a new ListProjectInvitationsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListProjectInvitationsParamsWithTimeout(timeout time.Duration) *ListProjectInvitationsParams {
+
+	return &ListProjectInvitationsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListProjectInvitationsParamsWithContext creates a new ListProjectInvitationsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListProjectInvitationsParamsWithContext(ctx context.Context) *ListProjectInvitationsParams {
+
+	return &ListProjectInvitationsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListProjectInvitationsParamsWithHTTPClient creates a new ListProjectInvitationsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListProjectInvitationsParamsWithHTTPClient(client *http.Client) *ListProjectInvitationsParams {
+
+	return &ListProjectInvitationsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListProjectInvitationsParams contains all the parameters to send to the API endpoint
+for the list project invitations operation typically these are written to a http.Request
+*/
+type ListProjectInvitationsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list project invitations params
+func (o *ListProjectInvitationsParams) WithTimeout(timeout time.Duration) *ListProjectInvitationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list project invitations params
+func (o *ListProjectInvitationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list project invitations params
+func (o *ListProjectInvitationsParams) WithContext(ctx context.Context) *ListProjectInvitationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list project invitations params
+func (o *ListProjectInvitationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list project invitations params
+func (o *ListProjectInvitationsParams) WithHTTPClient(client *http.Client) *ListProjectInvitationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list project invitations params
+func (o *ListProjectInvitationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListProjectInvitationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
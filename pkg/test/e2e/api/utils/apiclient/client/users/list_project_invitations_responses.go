@@ -0,0 +1,169 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListProjectInvitationsReader is a Reader for the ListProjectInvitations structure.
+type ListProjectInvitationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListProjectInvitationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListProjectInvitationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewListProjectInvitationsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewListProjectInvitationsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewListProjectInvitationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListProjectInvitationsOK creates a ListProjectInvitationsOK with default headers values
+func NewListProjectInvitationsOK() *ListProjectInvitationsOK {
+	return &ListProjectInvitationsOK{}
+}
+
+/*ListProjectInvitationsOK handles this case with default header values.
+
+ProjectInvitation
+*/
+type ListProjectInvitationsOK struct {
+	Payload []*models.ProjectInvitation
+}
+
+func (o *ListProjectInvitationsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/invitations][%d] listProjectInvitationsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListProjectInvitationsOK) GetPayload() []*models.ProjectInvitation {
+	return o.Payload
+}
+
+func (o *ListProjectInvitationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListProjectInvitationsUnauthorized creates a ListProjectInvitationsUnauthorized with default headers values
+func NewListProjectInvitationsUnauthorized() *ListProjectInvitationsUnauthorized {
+	return &ListProjectInvitationsUnauthorized{}
+}
+
+/*ListProjectInvitationsUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListProjectInvitationsUnauthorized struct {
+}
+
+func (o *ListProjectInvitationsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/invitations][%d] listProjectInvitationsUnauthorized ", 401)
+}
+
+func (o *ListProjectInvitationsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListProjectInvitationsForbidden creates a ListProjectInvitationsForbidden with default headers values
+func NewListProjectInvitationsForbidden() *ListProjectInvitationsForbidden {
+	return &ListProjectInvitationsForbidden{}
+}
+
+/*ListProjectInvitationsForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type ListProjectInvitationsForbidden struct {
+}
+
+func (o *ListProjectInvitationsForbidden) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/invitations][%d] listProjectInvitationsForbidden ", 403)
+}
+
+func (o *ListProjectInvitationsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewListProjectInvitationsDefault creates a ListProjectInvitationsDefault with default headers values
+func NewListProjectInvitationsDefault(code int) *ListProjectInvitationsDefault {
+	return &ListProjectInvitationsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListProjectInvitationsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListProjectInvitationsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list project invitations default response
+func (o *ListProjectInvitationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListProjectInvitationsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/invitations][%d] listProjectInvitations default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListProjectInvitationsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListProjectInvitationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRevokeProjectInvitationParams creates a new RevokeProjectInvitationParams object
+// with the default values initialized.
+func NewRevokeProjectInvitationParams() *RevokeProjectInvitationParams {
+	var ()
+	return &RevokeProjectInvitationParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRevokeProjectInvitationParamsWithTimeout creates a new RevokeProjectInvitationParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRevokeProjectInvitationParamsWithTimeout(timeout time.Duration) *RevokeProjectInvitationParams {
+	var ()
+	return &RevokeProjectInvitationParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewRevokeProjectInvitationParamsWithContext creates a new RevokeProjectInvitationParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewRevokeProjectInvitationParamsWithContext(ctx context.Context) *RevokeProjectInvitationParams {
+	var ()
+	return &RevokeProjectInvitationParams{
+
+		Context: ctx,
+	}
+}
+
+// NewRevokeProjectInvitationParamsWithHTTPClient creates a new RevokeProjectInvitationParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRevokeProjectInvitationParamsWithHTTPClient(client *http.Client) *RevokeProjectInvitationParams {
+	var ()
+	return &RevokeProjectInvitationParams{
+		HTTPClient: client,
+	}
+}
+
+/*RevokeProjectInvitationParams contains all the parameters to send to the API endpoint
+for the revoke project invitation operation typically these are written to a http.Request
+*/
+type RevokeProjectInvitationParams struct {
+
+	/*InvitationID*/
+	InvitationID string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) WithTimeout(timeout time.Duration) *RevokeProjectInvitationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) WithContext(ctx context.Context) *RevokeProjectInvitationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) WithHTTPClient(client *http.Client) *RevokeProjectInvitationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithInvitationID adds the invitationID to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) WithInvitationID(invitationID string) *RevokeProjectInvitationParams {
+	o.SetInvitationID(invitationID)
+	return o
+}
+
+// SetInvitationID adds the invitationId to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) SetInvitationID(invitationID string) {
+	o.InvitationID = invitationID
+}
+
+// WithProjectID adds the projectID to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) WithProjectID(projectID string) *RevokeProjectInvitationParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the revoke project invitation params
+func (o *RevokeProjectInvitationParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RevokeProjectInvitationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param invitation_id
+	if err := r.SetPathParam("invitation_id", o.InvitationID); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
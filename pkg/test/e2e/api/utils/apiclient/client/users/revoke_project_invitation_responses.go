@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package users
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// RevokeProjectInvitationReader is a Reader for the RevokeProjectInvitation structure.
+type RevokeProjectInvitationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RevokeProjectInvitationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRevokeProjectInvitationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewRevokeProjectInvitationUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewRevokeProjectInvitationForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewRevokeProjectInvitationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRevokeProjectInvitationOK creates a RevokeProjectInvitationOK with default headers values
+func NewRevokeProjectInvitationOK() *RevokeProjectInvitationOK {
+	return &RevokeProjectInvitationOK{}
+}
+
+/*RevokeProjectInvitationOK handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type RevokeProjectInvitationOK struct {
+}
+
+func (o *RevokeProjectInvitationOK) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/invitations/{invitation_id}][%d] revokeProjectInvitationOK ", 200)
+}
+
+func (o *RevokeProjectInvitationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRevokeProjectInvitationUnauthorized creates a RevokeProjectInvitationUnauthorized with default headers values
+func NewRevokeProjectInvitationUnauthorized() *RevokeProjectInvitationUnauthorized {
+	return &RevokeProjectInvitationUnauthorized{}
+}
+
+/*RevokeProjectInvitationUnauthorized handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type RevokeProjectInvitationUnauthorized struct {
+}
+
+func (o *RevokeProjectInvitationUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/invitations/{invitation_id}][%d] revokeProjectInvitationUnauthorized ", 401)
+}
+
+func (o *RevokeProjectInvitationUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRevokeProjectInvitationForbidden creates a RevokeProjectInvitationForbidden with default headers values
+func NewRevokeProjectInvitationForbidden() *RevokeProjectInvitationForbidden {
+	return &RevokeProjectInvitationForbidden{}
+}
+
+/*RevokeProjectInvitationForbidden handles this case with default header values.
+
+EmptyResponse is a empty response
+*/
+type RevokeProjectInvitationForbidden struct {
+}
+
+func (o *RevokeProjectInvitationForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/invitations/{invitation_id}][%d] revokeProjectInvitationForbidden ", 403)
+}
+
+func (o *RevokeProjectInvitationForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRevokeProjectInvitationDefault creates a RevokeProjectInvitationDefault with default headers values
+func NewRevokeProjectInvitationDefault(code int) *RevokeProjectInvitationDefault {
+	return &RevokeProjectInvitationDefault{
+		_statusCode: code,
+	}
+}
+
+/*RevokeProjectInvitationDefault handles this case with default header values.
+
+errorResponse
+*/
+type RevokeProjectInvitationDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the revoke project invitation default response
+func (o *RevokeProjectInvitationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RevokeProjectInvitationDefault) Error() string {
+	return fmt.Sprintf("[DELETE /api/v1/projects/{project_id}/invitations/{invitation_id}][%d] revokeProjectInvitation default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *RevokeProjectInvitationDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *RevokeProjectInvitationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
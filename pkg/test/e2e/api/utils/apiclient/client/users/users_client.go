@@ -25,21 +25,107 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	AcceptInvitation(params *AcceptInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*AcceptInvitationOK, error)
+
+	AddGroupBindingToProject(params *AddGroupBindingToProjectParams, authInfo runtime.ClientAuthInfoWriter) (*AddGroupBindingToProjectCreated, error)
+
 	AddUserToProject(params *AddUserToProjectParams, authInfo runtime.ClientAuthInfoWriter) (*AddUserToProjectCreated, error)
 
+	CreateProjectInvitation(params *CreateProjectInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*CreateProjectInvitationCreated, error)
+
+	DeclineInvitation(params *DeclineInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*DeclineInvitationOK, error)
+
+	DeleteGroupBindingFromProject(params *DeleteGroupBindingFromProjectParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteGroupBindingFromProjectOK, error)
+
 	DeleteUserFromProject(params *DeleteUserFromProjectParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteUserFromProjectOK, error)
 
 	EditUserInProject(params *EditUserInProjectParams, authInfo runtime.ClientAuthInfoWriter) (*EditUserInProjectOK, error)
 
 	GetCurrentUser(params *GetCurrentUserParams, authInfo runtime.ClientAuthInfoWriter) (*GetCurrentUserOK, error)
 
+	GetGroupBindingsForProject(params *GetGroupBindingsForProjectParams, authInfo runtime.ClientAuthInfoWriter) (*GetGroupBindingsForProjectOK, error)
+
 	GetUsersForProject(params *GetUsersForProjectParams, authInfo runtime.ClientAuthInfoWriter) (*GetUsersForProjectOK, error)
 
+	ListCurrentUserInvitations(params *ListCurrentUserInvitationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListCurrentUserInvitationsOK, error)
+
+	ListProjectInvitations(params *ListProjectInvitationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListProjectInvitationsOK, error)
+
 	LogoutCurrentUser(params *LogoutCurrentUserParams, authInfo runtime.ClientAuthInfoWriter) (*LogoutCurrentUserOK, error)
 
+	RevokeProjectInvitation(params *RevokeProjectInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*RevokeProjectInvitationOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  AcceptInvitation Accepts the given invitation and adds the current user to the project
+*/
+func (a *Client) AcceptInvitation(params *AcceptInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*AcceptInvitationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAcceptInvitationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "acceptInvitation",
+		Method:             "POST",
+		PathPattern:        "/api/v1/me/invitations/{invitation_id}/accept",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AcceptInvitationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AcceptInvitationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AcceptInvitationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  AddGroupBindingToProject Binds the given group of the identity provider to the given role within the project
+*/
+func (a *Client) AddGroupBindingToProject(params *AddGroupBindingToProjectParams, authInfo runtime.ClientAuthInfoWriter) (*AddGroupBindingToProjectCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAddGroupBindingToProjectParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "addGroupBindingToProject",
+		Method:             "POST",
+		PathPattern:        "/api/v1/projects/{project_id}/groupbindings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &AddGroupBindingToProjectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*AddGroupBindingToProjectCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*AddGroupBindingToProjectDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   AddUserToProject Adds the given user to the given project
 */
@@ -74,6 +160,108 @@ func (a *Client) AddUserToProject(params *AddUserToProjectParams, authInfo runti
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  CreateProjectInvitation Invites the given user to the given group within the project
+*/
+func (a *Client) CreateProjectInvitation(params *CreateProjectInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*CreateProjectInvitationCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateProjectInvitationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "createProjectInvitation",
+		Method:             "POST",
+		PathPattern:        "/api/v1/projects/{project_id}/invitations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &CreateProjectInvitationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateProjectInvitationCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*CreateProjectInvitationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  DeclineInvitation Declines the given invitation
+*/
+func (a *Client) DeclineInvitation(params *DeclineInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*DeclineInvitationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeclineInvitationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "declineInvitation",
+		Method:             "POST",
+		PathPattern:        "/api/v1/me/invitations/{invitation_id}/decline",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeclineInvitationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeclineInvitationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeclineInvitationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  DeleteGroupBindingFromProject Removes the given group binding from the project
+*/
+func (a *Client) DeleteGroupBindingFromProject(params *DeleteGroupBindingFromProjectParams, authInfo runtime.ClientAuthInfoWriter) (*DeleteGroupBindingFromProjectOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteGroupBindingFromProjectParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deleteGroupBindingFromProject",
+		Method:             "DELETE",
+		PathPattern:        "/api/v1/projects/{project_id}/groupbindings/{binding_name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &DeleteGroupBindingFromProjectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteGroupBindingFromProjectOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteGroupBindingFromProjectDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   DeleteUserFromProject Removes the given member from the project
 */
@@ -176,6 +364,40 @@ func (a *Client) GetCurrentUser(params *GetCurrentUserParams, authInfo runtime.C
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetGroupBindingsForProject Get list of the groups of the identity provider bound to the given project
+*/
+func (a *Client) GetGroupBindingsForProject(params *GetGroupBindingsForProjectParams, authInfo runtime.ClientAuthInfoWriter) (*GetGroupBindingsForProjectOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetGroupBindingsForProjectParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getGroupBindingsForProject",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/groupbindings",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &GetGroupBindingsForProjectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetGroupBindingsForProjectOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetGroupBindingsForProjectDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   GetUsersForProject Get list of users for the given project
 */
@@ -210,6 +432,74 @@ func (a *Client) GetUsersForProject(params *GetUsersForProjectParams, authInfo r
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListCurrentUserInvitations Get list of the pending invitations of the current user
+*/
+func (a *Client) ListCurrentUserInvitations(params *ListCurrentUserInvitationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListCurrentUserInvitationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListCurrentUserInvitationsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listCurrentUserInvitations",
+		Method:             "GET",
+		PathPattern:        "/api/v1/me/invitations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListCurrentUserInvitationsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListCurrentUserInvitationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListCurrentUserInvitationsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListProjectInvitations Get list of the pending invitations of the given project
+*/
+func (a *Client) ListProjectInvitations(params *ListProjectInvitationsParams, authInfo runtime.ClientAuthInfoWriter) (*ListProjectInvitationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListProjectInvitationsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listProjectInvitations",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/invitations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListProjectInvitationsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListProjectInvitationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListProjectInvitationsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   LogoutCurrentUser adds current authorization bearer token to the blacklist
 
@@ -246,6 +536,40 @@ func (a *Client) LogoutCurrentUser(params *LogoutCurrentUserParams, authInfo run
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  RevokeProjectInvitation Revokes the given invitation
+*/
+func (a *Client) RevokeProjectInvitation(params *RevokeProjectInvitationParams, authInfo runtime.ClientAuthInfoWriter) (*RevokeProjectInvitationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeProjectInvitationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "revokeProjectInvitation",
+		Method:             "DELETE",
+		PathPattern:        "/api/v1/projects/{project_id}/invitations/{invitation_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &RevokeProjectInvitationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RevokeProjectInvitationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RevokeProjectInvitationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
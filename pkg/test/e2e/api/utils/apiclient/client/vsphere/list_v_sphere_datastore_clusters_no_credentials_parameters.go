@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereDatastoreClustersNoCredentialsParams creates a new ListVSphereDatastoreClustersNoCredentialsParams object
+// with the default values initialized.
+func NewListVSphereDatastoreClustersNoCredentialsParams() *ListVSphereDatastoreClustersNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoreClustersNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereDatastoreClustersNoCredentialsParamsWithTimeout creates a new ListVSphereDatastoreClustersNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereDatastoreClustersNoCredentialsParamsWithTimeout(timeout time.Duration) *ListVSphereDatastoreClustersNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoreClustersNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereDatastoreClustersNoCredentialsParamsWithContext creates a new ListVSphereDatastoreClustersNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereDatastoreClustersNoCredentialsParamsWithContext(ctx context.Context) *ListVSphereDatastoreClustersNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoreClustersNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereDatastoreClustersNoCredentialsParamsWithHTTPClient creates a new ListVSphereDatastoreClustersNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereDatastoreClustersNoCredentialsParamsWithHTTPClient(client *http.Client) *ListVSphereDatastoreClustersNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoreClustersNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereDatastoreClustersNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list v sphere datastore clusters no credentials operation typically these are written to a http.Request
+*/
+type ListVSphereDatastoreClustersNoCredentialsParams struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WithTimeout(timeout time.Duration) *ListVSphereDatastoreClustersNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WithContext(ctx context.Context) *ListVSphereDatastoreClustersNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WithHTTPClient(client *http.Client) *ListVSphereDatastoreClustersNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WithClusterID(clusterID string) *ListVSphereDatastoreClustersNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WithDC(dc string) *ListVSphereDatastoreClustersNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WithProjectID(projectID string) *ListVSphereDatastoreClustersNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list v sphere datastore clusters no credentials params
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereDatastoreClustersNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
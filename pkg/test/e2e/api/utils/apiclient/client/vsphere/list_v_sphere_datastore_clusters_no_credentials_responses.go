@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereDatastoreClustersNoCredentialsReader is a Reader for the ListVSphereDatastoreClustersNoCredentials structure.
+type ListVSphereDatastoreClustersNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereDatastoreClustersNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereDatastoreClustersNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereDatastoreClustersNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereDatastoreClustersNoCredentialsOK creates a ListVSphereDatastoreClustersNoCredentialsOK with default headers values
+func NewListVSphereDatastoreClustersNoCredentialsOK() *ListVSphereDatastoreClustersNoCredentialsOK {
+	return &ListVSphereDatastoreClustersNoCredentialsOK{}
+}
+
+/*ListVSphereDatastoreClustersNoCredentialsOK handles this case with default header values.
+
+VSphereDatastoreCluster
+*/
+type ListVSphereDatastoreClustersNoCredentialsOK struct {
+	Payload []*models.VSphereDatastoreCluster
+}
+
+func (o *ListVSphereDatastoreClustersNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastoreclusters][%d] listVSphereDatastoreClustersNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereDatastoreClustersNoCredentialsOK) GetPayload() []*models.VSphereDatastoreCluster {
+	return o.Payload
+}
+
+func (o *ListVSphereDatastoreClustersNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereDatastoreClustersNoCredentialsDefault creates a ListVSphereDatastoreClustersNoCredentialsDefault with default headers values
+func NewListVSphereDatastoreClustersNoCredentialsDefault(code int) *ListVSphereDatastoreClustersNoCredentialsDefault {
+	return &ListVSphereDatastoreClustersNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereDatastoreClustersNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereDatastoreClustersNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere datastore clusters no credentials default response
+func (o *ListVSphereDatastoreClustersNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereDatastoreClustersNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastoreclusters][%d] listVSphereDatastoreClustersNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereDatastoreClustersNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereDatastoreClustersNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereDatastoreClustersParams creates a new ListVSphereDatastoreClustersParams object
+// with the default values initialized.
+func NewListVSphereDatastoreClustersParams() *ListVSphereDatastoreClustersParams {
+	var ()
+	return &ListVSphereDatastoreClustersParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereDatastoreClustersParamsWithTimeout creates a new ListVSphereDatastoreClustersParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereDatastoreClustersParamsWithTimeout(timeout time.Duration) *ListVSphereDatastoreClustersParams {
+	var ()
+	return &ListVSphereDatastoreClustersParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereDatastoreClustersParamsWithContext creates a new ListVSphereDatastoreClustersParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereDatastoreClustersParamsWithContext(ctx context.Context) *ListVSphereDatastoreClustersParams {
+	var ()
+	return &ListVSphereDatastoreClustersParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereDatastoreClustersParamsWithHTTPClient creates a new ListVSphereDatastoreClustersParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereDatastoreClustersParamsWithHTTPClient(client *http.Client) *ListVSphereDatastoreClustersParams {
+	var ()
+	return &ListVSphereDatastoreClustersParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereDatastoreClustersParams contains all the parameters to send to the API endpoint
+for the list v sphere datastore clusters operation typically these are written to a http.Request
+*/
+type ListVSphereDatastoreClustersParams struct {
+
+	/*Credential*/
+	Credential *string
+	/*DatacenterName*/
+	DatacenterName *string
+	/*Password*/
+	Password *string
+	/*Username*/
+	Username *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithTimeout(timeout time.Duration) *ListVSphereDatastoreClustersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithContext(ctx context.Context) *ListVSphereDatastoreClustersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithHTTPClient(client *http.Client) *ListVSphereDatastoreClustersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCredential adds the credential to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithCredential(credential *string) *ListVSphereDatastoreClustersParams {
+	o.SetCredential(credential)
+	return o
+}
+
+// SetCredential adds the credential to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetCredential(credential *string) {
+	o.Credential = credential
+}
+
+// WithDatacenterName adds the datacenterName to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithDatacenterName(datacenterName *string) *ListVSphereDatastoreClustersParams {
+	o.SetDatacenterName(datacenterName)
+	return o
+}
+
+// SetDatacenterName adds the datacenterName to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetDatacenterName(datacenterName *string) {
+	o.DatacenterName = datacenterName
+}
+
+// WithPassword adds the password to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithPassword(password *string) *ListVSphereDatastoreClustersParams {
+	o.SetPassword(password)
+	return o
+}
+
+// SetPassword adds the password to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetPassword(password *string) {
+	o.Password = password
+}
+
+// WithUsername adds the username to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) WithUsername(username *string) *ListVSphereDatastoreClustersParams {
+	o.SetUsername(username)
+	return o
+}
+
+// SetUsername adds the username to the list v sphere datastore clusters params
+func (o *ListVSphereDatastoreClustersParams) SetUsername(username *string) {
+	o.Username = username
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereDatastoreClustersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Credential != nil {
+
+		// header param Credential
+		if err := r.SetHeaderParam("Credential", *o.Credential); err != nil {
+			return err
+		}
+
+	}
+
+	if o.DatacenterName != nil {
+
+		// header param DatacenterName
+		if err := r.SetHeaderParam("DatacenterName", *o.DatacenterName); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Password != nil {
+
+		// header param Password
+		if err := r.SetHeaderParam("Password", *o.Password); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Username != nil {
+
+		// header param Username
+		if err := r.SetHeaderParam("Username", *o.Username); err != nil {
+			return err
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereDatastoreClustersReader is a Reader for the ListVSphereDatastoreClusters structure.
+type ListVSphereDatastoreClustersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereDatastoreClustersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereDatastoreClustersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereDatastoreClustersDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereDatastoreClustersOK creates a ListVSphereDatastoreClustersOK with default headers values
+func NewListVSphereDatastoreClustersOK() *ListVSphereDatastoreClustersOK {
+	return &ListVSphereDatastoreClustersOK{}
+}
+
+/*ListVSphereDatastoreClustersOK handles this case with default header values.
+
+VSphereDatastoreCluster
+*/
+type ListVSphereDatastoreClustersOK struct {
+	Payload []*models.VSphereDatastoreCluster
+}
+
+func (o *ListVSphereDatastoreClustersOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/vsphere/datastoreclusters][%d] listVSphereDatastoreClustersOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereDatastoreClustersOK) GetPayload() []*models.VSphereDatastoreCluster {
+	return o.Payload
+}
+
+func (o *ListVSphereDatastoreClustersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereDatastoreClustersDefault creates a ListVSphereDatastoreClustersDefault with default headers values
+func NewListVSphereDatastoreClustersDefault(code int) *ListVSphereDatastoreClustersDefault {
+	return &ListVSphereDatastoreClustersDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereDatastoreClustersDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereDatastoreClustersDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere datastore clusters default response
+func (o *ListVSphereDatastoreClustersDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereDatastoreClustersDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/vsphere/datastoreclusters][%d] listVSphereDatastoreClusters default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereDatastoreClustersDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereDatastoreClustersDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
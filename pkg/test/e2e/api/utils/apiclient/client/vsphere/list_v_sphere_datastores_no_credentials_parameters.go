@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereDatastoresNoCredentialsParams creates a new ListVSphereDatastoresNoCredentialsParams object
+// with the default values initialized.
+func NewListVSphereDatastoresNoCredentialsParams() *ListVSphereDatastoresNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoresNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereDatastoresNoCredentialsParamsWithTimeout creates a new ListVSphereDatastoresNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereDatastoresNoCredentialsParamsWithTimeout(timeout time.Duration) *ListVSphereDatastoresNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoresNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereDatastoresNoCredentialsParamsWithContext creates a new ListVSphereDatastoresNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereDatastoresNoCredentialsParamsWithContext(ctx context.Context) *ListVSphereDatastoresNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoresNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereDatastoresNoCredentialsParamsWithHTTPClient creates a new ListVSphereDatastoresNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereDatastoresNoCredentialsParamsWithHTTPClient(client *http.Client) *ListVSphereDatastoresNoCredentialsParams {
+	var ()
+	return &ListVSphereDatastoresNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereDatastoresNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list v sphere datastores no credentials operation typically these are written to a http.Request
+*/
+type ListVSphereDatastoresNoCredentialsParams struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) WithTimeout(timeout time.Duration) *ListVSphereDatastoresNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) WithContext(ctx context.Context) *ListVSphereDatastoresNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) WithHTTPClient(client *http.Client) *ListVSphereDatastoresNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) WithClusterID(clusterID string) *ListVSphereDatastoresNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) WithDC(dc string) *ListVSphereDatastoresNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) WithProjectID(projectID string) *ListVSphereDatastoresNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list v sphere datastores no credentials params
+func (o *ListVSphereDatastoresNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereDatastoresNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereDatastoresNoCredentialsReader is a Reader for the ListVSphereDatastoresNoCredentials structure.
+type ListVSphereDatastoresNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereDatastoresNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereDatastoresNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereDatastoresNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereDatastoresNoCredentialsOK creates a ListVSphereDatastoresNoCredentialsOK with default headers values
+func NewListVSphereDatastoresNoCredentialsOK() *ListVSphereDatastoresNoCredentialsOK {
+	return &ListVSphereDatastoresNoCredentialsOK{}
+}
+
+/*ListVSphereDatastoresNoCredentialsOK handles this case with default header values.
+
+VSphereDatastore
+*/
+type ListVSphereDatastoresNoCredentialsOK struct {
+	Payload []*models.VSphereDatastore
+}
+
+func (o *ListVSphereDatastoresNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastores][%d] listVSphereDatastoresNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereDatastoresNoCredentialsOK) GetPayload() []*models.VSphereDatastore {
+	return o.Payload
+}
+
+func (o *ListVSphereDatastoresNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereDatastoresNoCredentialsDefault creates a ListVSphereDatastoresNoCredentialsDefault with default headers values
+func NewListVSphereDatastoresNoCredentialsDefault(code int) *ListVSphereDatastoresNoCredentialsDefault {
+	return &ListVSphereDatastoresNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereDatastoresNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereDatastoresNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere datastores no credentials default response
+func (o *ListVSphereDatastoresNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereDatastoresNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastores][%d] listVSphereDatastoresNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereDatastoresNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereDatastoresNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
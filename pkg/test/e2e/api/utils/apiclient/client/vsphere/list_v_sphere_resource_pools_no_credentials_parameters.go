@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereResourcePoolsNoCredentialsParams creates a new ListVSphereResourcePoolsNoCredentialsParams object
+// with the default values initialized.
+func NewListVSphereResourcePoolsNoCredentialsParams() *ListVSphereResourcePoolsNoCredentialsParams {
+	var ()
+	return &ListVSphereResourcePoolsNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereResourcePoolsNoCredentialsParamsWithTimeout creates a new ListVSphereResourcePoolsNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereResourcePoolsNoCredentialsParamsWithTimeout(timeout time.Duration) *ListVSphereResourcePoolsNoCredentialsParams {
+	var ()
+	return &ListVSphereResourcePoolsNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereResourcePoolsNoCredentialsParamsWithContext creates a new ListVSphereResourcePoolsNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereResourcePoolsNoCredentialsParamsWithContext(ctx context.Context) *ListVSphereResourcePoolsNoCredentialsParams {
+	var ()
+	return &ListVSphereResourcePoolsNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereResourcePoolsNoCredentialsParamsWithHTTPClient creates a new ListVSphereResourcePoolsNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereResourcePoolsNoCredentialsParamsWithHTTPClient(client *http.Client) *ListVSphereResourcePoolsNoCredentialsParams {
+	var ()
+	return &ListVSphereResourcePoolsNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereResourcePoolsNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list v sphere resource pools no credentials operation typically these are written to a http.Request
+*/
+type ListVSphereResourcePoolsNoCredentialsParams struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WithTimeout(timeout time.Duration) *ListVSphereResourcePoolsNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WithContext(ctx context.Context) *ListVSphereResourcePoolsNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WithHTTPClient(client *http.Client) *ListVSphereResourcePoolsNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WithClusterID(clusterID string) *ListVSphereResourcePoolsNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WithDC(dc string) *ListVSphereResourcePoolsNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WithProjectID(projectID string) *ListVSphereResourcePoolsNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list v sphere resource pools no credentials params
+func (o *ListVSphereResourcePoolsNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereResourcePoolsNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
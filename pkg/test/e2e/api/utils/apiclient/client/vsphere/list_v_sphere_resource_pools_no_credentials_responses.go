@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereResourcePoolsNoCredentialsReader is a Reader for the ListVSphereResourcePoolsNoCredentials structure.
+type ListVSphereResourcePoolsNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereResourcePoolsNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereResourcePoolsNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereResourcePoolsNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereResourcePoolsNoCredentialsOK creates a ListVSphereResourcePoolsNoCredentialsOK with default headers values
+func NewListVSphereResourcePoolsNoCredentialsOK() *ListVSphereResourcePoolsNoCredentialsOK {
+	return &ListVSphereResourcePoolsNoCredentialsOK{}
+}
+
+/*ListVSphereResourcePoolsNoCredentialsOK handles this case with default header values.
+
+VSphereResourcePool
+*/
+type ListVSphereResourcePoolsNoCredentialsOK struct {
+	Payload []*models.VSphereResourcePool
+}
+
+func (o *ListVSphereResourcePoolsNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/resourcepools][%d] listVSphereResourcePoolsNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereResourcePoolsNoCredentialsOK) GetPayload() []*models.VSphereResourcePool {
+	return o.Payload
+}
+
+func (o *ListVSphereResourcePoolsNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereResourcePoolsNoCredentialsDefault creates a ListVSphereResourcePoolsNoCredentialsDefault with default headers values
+func NewListVSphereResourcePoolsNoCredentialsDefault(code int) *ListVSphereResourcePoolsNoCredentialsDefault {
+	return &ListVSphereResourcePoolsNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereResourcePoolsNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereResourcePoolsNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere resource pools no credentials default response
+func (o *ListVSphereResourcePoolsNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereResourcePoolsNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/resourcepools][%d] listVSphereResourcePoolsNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereResourcePoolsNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereResourcePoolsNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
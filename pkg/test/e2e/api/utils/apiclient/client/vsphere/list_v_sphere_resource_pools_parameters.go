@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereResourcePoolsParams creates a new ListVSphereResourcePoolsParams object
+// with the default values initialized.
+func NewListVSphereResourcePoolsParams() *ListVSphereResourcePoolsParams {
+	var ()
+	return &ListVSphereResourcePoolsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereResourcePoolsParamsWithTimeout creates a new ListVSphereResourcePoolsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereResourcePoolsParamsWithTimeout(timeout time.Duration) *ListVSphereResourcePoolsParams {
+	var ()
+	return &ListVSphereResourcePoolsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereResourcePoolsParamsWithContext creates a new ListVSphereResourcePoolsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereResourcePoolsParamsWithContext(ctx context.Context) *ListVSphereResourcePoolsParams {
+	var ()
+	return &ListVSphereResourcePoolsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereResourcePoolsParamsWithHTTPClient creates a new ListVSphereResourcePoolsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereResourcePoolsParamsWithHTTPClient(client *http.Client) *ListVSphereResourcePoolsParams {
+	var ()
+	return &ListVSphereResourcePoolsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereResourcePoolsParams contains all the parameters to send to the API endpoint
+for the list v sphere resource pools operation typically these are written to a http.Request
+*/
+type ListVSphereResourcePoolsParams struct {
+
+	/*Credential*/
+	Credential *string
+	/*DatacenterName*/
+	DatacenterName *string
+	/*Password*/
+	Password *string
+	/*Username*/
+	Username *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithTimeout(timeout time.Duration) *ListVSphereResourcePoolsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithContext(ctx context.Context) *ListVSphereResourcePoolsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithHTTPClient(client *http.Client) *ListVSphereResourcePoolsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCredential adds the credential to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithCredential(credential *string) *ListVSphereResourcePoolsParams {
+	o.SetCredential(credential)
+	return o
+}
+
+// SetCredential adds the credential to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetCredential(credential *string) {
+	o.Credential = credential
+}
+
+// WithDatacenterName adds the datacenterName to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithDatacenterName(datacenterName *string) *ListVSphereResourcePoolsParams {
+	o.SetDatacenterName(datacenterName)
+	return o
+}
+
+// SetDatacenterName adds the datacenterName to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetDatacenterName(datacenterName *string) {
+	o.DatacenterName = datacenterName
+}
+
+// WithPassword adds the password to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithPassword(password *string) *ListVSphereResourcePoolsParams {
+	o.SetPassword(password)
+	return o
+}
+
+// SetPassword adds the password to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetPassword(password *string) {
+	o.Password = password
+}
+
+// WithUsername adds the username to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) WithUsername(username *string) *ListVSphereResourcePoolsParams {
+	o.SetUsername(username)
+	return o
+}
+
+// SetUsername adds the username to the list v sphere resource pools params
+func (o *ListVSphereResourcePoolsParams) SetUsername(username *string) {
+	o.Username = username
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereResourcePoolsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Credential != nil {
+
+		// header param Credential
+		if err := r.SetHeaderParam("Credential", *o.Credential); err != nil {
+			return err
+		}
+
+	}
+
+	if o.DatacenterName != nil {
+
+		// header param DatacenterName
+		if err := r.SetHeaderParam("DatacenterName", *o.DatacenterName); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Password != nil {
+
+		// header param Password
+		if err := r.SetHeaderParam("Password", *o.Password); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Username != nil {
+
+		// header param Username
+		if err := r.SetHeaderParam("Username", *o.Username); err != nil {
+			return err
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereResourcePoolsReader is a Reader for the ListVSphereResourcePools structure.
+type ListVSphereResourcePoolsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereResourcePoolsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereResourcePoolsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereResourcePoolsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereResourcePoolsOK creates a ListVSphereResourcePoolsOK with default headers values
+func NewListVSphereResourcePoolsOK() *ListVSphereResourcePoolsOK {
+	return &ListVSphereResourcePoolsOK{}
+}
+
+/*ListVSphereResourcePoolsOK handles this case with default header values.
+
+VSphereResourcePool
+*/
+type ListVSphereResourcePoolsOK struct {
+	Payload []*models.VSphereResourcePool
+}
+
+func (o *ListVSphereResourcePoolsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/vsphere/resourcepools][%d] listVSphereResourcePoolsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereResourcePoolsOK) GetPayload() []*models.VSphereResourcePool {
+	return o.Payload
+}
+
+func (o *ListVSphereResourcePoolsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereResourcePoolsDefault creates a ListVSphereResourcePoolsDefault with default headers values
+func NewListVSphereResourcePoolsDefault(code int) *ListVSphereResourcePoolsDefault {
+	return &ListVSphereResourcePoolsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereResourcePoolsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereResourcePoolsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere resource pools default response
+func (o *ListVSphereResourcePoolsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereResourcePoolsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/vsphere/resourcepools][%d] listVSphereResourcePools default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereResourcePoolsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereResourcePoolsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereStoragePoliciesNoCredentialsParams creates a new ListVSphereStoragePoliciesNoCredentialsParams object
+// with the default values initialized.
+func NewListVSphereStoragePoliciesNoCredentialsParams() *ListVSphereStoragePoliciesNoCredentialsParams {
+	var ()
+	return &ListVSphereStoragePoliciesNoCredentialsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereStoragePoliciesNoCredentialsParamsWithTimeout creates a new ListVSphereStoragePoliciesNoCredentialsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereStoragePoliciesNoCredentialsParamsWithTimeout(timeout time.Duration) *ListVSphereStoragePoliciesNoCredentialsParams {
+	var ()
+	return &ListVSphereStoragePoliciesNoCredentialsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereStoragePoliciesNoCredentialsParamsWithContext creates a new ListVSphereStoragePoliciesNoCredentialsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereStoragePoliciesNoCredentialsParamsWithContext(ctx context.Context) *ListVSphereStoragePoliciesNoCredentialsParams {
+	var ()
+	return &ListVSphereStoragePoliciesNoCredentialsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereStoragePoliciesNoCredentialsParamsWithHTTPClient creates a new ListVSphereStoragePoliciesNoCredentialsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereStoragePoliciesNoCredentialsParamsWithHTTPClient(client *http.Client) *ListVSphereStoragePoliciesNoCredentialsParams {
+	var ()
+	return &ListVSphereStoragePoliciesNoCredentialsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereStoragePoliciesNoCredentialsParams contains all the parameters to send to the API endpoint
+for the list v sphere storage policies no credentials operation typically these are written to a http.Request
+*/
+type ListVSphereStoragePoliciesNoCredentialsParams struct {
+
+	/*ClusterID*/
+	ClusterID string
+	/*Dc*/
+	DC string
+	/*ProjectID*/
+	ProjectID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WithTimeout(timeout time.Duration) *ListVSphereStoragePoliciesNoCredentialsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WithContext(ctx context.Context) *ListVSphereStoragePoliciesNoCredentialsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WithHTTPClient(client *http.Client) *ListVSphereStoragePoliciesNoCredentialsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithClusterID adds the clusterID to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WithClusterID(clusterID string) *ListVSphereStoragePoliciesNoCredentialsParams {
+	o.SetClusterID(clusterID)
+	return o
+}
+
+// SetClusterID adds the clusterId to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) SetClusterID(clusterID string) {
+	o.ClusterID = clusterID
+}
+
+// WithDC adds the dc to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WithDC(dc string) *ListVSphereStoragePoliciesNoCredentialsParams {
+	o.SetDC(dc)
+	return o
+}
+
+// SetDC adds the dc to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) SetDC(dc string) {
+	o.DC = dc
+}
+
+// WithProjectID adds the projectID to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WithProjectID(projectID string) *ListVSphereStoragePoliciesNoCredentialsParams {
+	o.SetProjectID(projectID)
+	return o
+}
+
+// SetProjectID adds the projectId to the list v sphere storage policies no credentials params
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) SetProjectID(projectID string) {
+	o.ProjectID = projectID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereStoragePoliciesNoCredentialsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param cluster_id
+	if err := r.SetPathParam("cluster_id", o.ClusterID); err != nil {
+		return err
+	}
+
+	// path param dc
+	if err := r.SetPathParam("dc", o.DC); err != nil {
+		return err
+	}
+
+	// path param project_id
+	if err := r.SetPathParam("project_id", o.ProjectID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
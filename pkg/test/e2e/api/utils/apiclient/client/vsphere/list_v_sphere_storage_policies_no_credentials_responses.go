@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereStoragePoliciesNoCredentialsReader is a Reader for the ListVSphereStoragePoliciesNoCredentials structure.
+type ListVSphereStoragePoliciesNoCredentialsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereStoragePoliciesNoCredentialsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereStoragePoliciesNoCredentialsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereStoragePoliciesNoCredentialsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereStoragePoliciesNoCredentialsOK creates a ListVSphereStoragePoliciesNoCredentialsOK with default headers values
+func NewListVSphereStoragePoliciesNoCredentialsOK() *ListVSphereStoragePoliciesNoCredentialsOK {
+	return &ListVSphereStoragePoliciesNoCredentialsOK{}
+}
+
+/*ListVSphereStoragePoliciesNoCredentialsOK handles this case with default header values.
+
+VSphereStoragePolicy
+*/
+type ListVSphereStoragePoliciesNoCredentialsOK struct {
+	Payload []*models.VSphereStoragePolicy
+}
+
+func (o *ListVSphereStoragePoliciesNoCredentialsOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/storagepolicies][%d] listVSphereStoragePoliciesNoCredentialsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereStoragePoliciesNoCredentialsOK) GetPayload() []*models.VSphereStoragePolicy {
+	return o.Payload
+}
+
+func (o *ListVSphereStoragePoliciesNoCredentialsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereStoragePoliciesNoCredentialsDefault creates a ListVSphereStoragePoliciesNoCredentialsDefault with default headers values
+func NewListVSphereStoragePoliciesNoCredentialsDefault(code int) *ListVSphereStoragePoliciesNoCredentialsDefault {
+	return &ListVSphereStoragePoliciesNoCredentialsDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereStoragePoliciesNoCredentialsDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereStoragePoliciesNoCredentialsDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere storage policies no credentials default response
+func (o *ListVSphereStoragePoliciesNoCredentialsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereStoragePoliciesNoCredentialsDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/storagepolicies][%d] listVSphereStoragePoliciesNoCredentials default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereStoragePoliciesNoCredentialsDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereStoragePoliciesNoCredentialsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListVSphereStoragePoliciesParams creates a new ListVSphereStoragePoliciesParams object
+// with the default values initialized.
+func NewListVSphereStoragePoliciesParams() *ListVSphereStoragePoliciesParams {
+	var ()
+	return &ListVSphereStoragePoliciesParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListVSphereStoragePoliciesParamsWithTimeout creates a new ListVSphereStoragePoliciesParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListVSphereStoragePoliciesParamsWithTimeout(timeout time.Duration) *ListVSphereStoragePoliciesParams {
+	var ()
+	return &ListVSphereStoragePoliciesParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListVSphereStoragePoliciesParamsWithContext creates a new ListVSphereStoragePoliciesParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListVSphereStoragePoliciesParamsWithContext(ctx context.Context) *ListVSphereStoragePoliciesParams {
+	var ()
+	return &ListVSphereStoragePoliciesParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListVSphereStoragePoliciesParamsWithHTTPClient creates a new ListVSphereStoragePoliciesParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListVSphereStoragePoliciesParamsWithHTTPClient(client *http.Client) *ListVSphereStoragePoliciesParams {
+	var ()
+	return &ListVSphereStoragePoliciesParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListVSphereStoragePoliciesParams contains all the parameters to send to the API endpoint
+for the list v sphere storage policies operation typically these are written to a http.Request
+*/
+type ListVSphereStoragePoliciesParams struct {
+
+	/*Credential*/
+	Credential *string
+	/*DatacenterName*/
+	DatacenterName *string
+	/*Password*/
+	Password *string
+	/*Username*/
+	Username *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithTimeout(timeout time.Duration) *ListVSphereStoragePoliciesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithContext(ctx context.Context) *ListVSphereStoragePoliciesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithHTTPClient(client *http.Client) *ListVSphereStoragePoliciesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCredential adds the credential to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithCredential(credential *string) *ListVSphereStoragePoliciesParams {
+	o.SetCredential(credential)
+	return o
+}
+
+// SetCredential adds the credential to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetCredential(credential *string) {
+	o.Credential = credential
+}
+
+// WithDatacenterName adds the datacenterName to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithDatacenterName(datacenterName *string) *ListVSphereStoragePoliciesParams {
+	o.SetDatacenterName(datacenterName)
+	return o
+}
+
+// SetDatacenterName adds the datacenterName to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetDatacenterName(datacenterName *string) {
+	o.DatacenterName = datacenterName
+}
+
+// WithPassword adds the password to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithPassword(password *string) *ListVSphereStoragePoliciesParams {
+	o.SetPassword(password)
+	return o
+}
+
+// SetPassword adds the password to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetPassword(password *string) {
+	o.Password = password
+}
+
+// WithUsername adds the username to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) WithUsername(username *string) *ListVSphereStoragePoliciesParams {
+	o.SetUsername(username)
+	return o
+}
+
+// SetUsername adds the username to the list v sphere storage policies params
+func (o *ListVSphereStoragePoliciesParams) SetUsername(username *string) {
+	o.Username = username
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListVSphereStoragePoliciesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Credential != nil {
+
+		// header param Credential
+		if err := r.SetHeaderParam("Credential", *o.Credential); err != nil {
+			return err
+		}
+
+	}
+
+	if o.DatacenterName != nil {
+
+		// header param DatacenterName
+		if err := r.SetHeaderParam("DatacenterName", *o.DatacenterName); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Password != nil {
+
+		// header param Password
+		if err := r.SetHeaderParam("Password", *o.Password); err != nil {
+			return err
+		}
+
+	}
+
+	if o.Username != nil {
+
+		// header param Username
+		if err := r.SetHeaderParam("Username", *o.Username); err != nil {
+			return err
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package vsphere
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"k8c.io/kubermatic/v2/pkg/test/e2e/api/utils/apiclient/models"
+)
+
+// ListVSphereStoragePoliciesReader is a Reader for the ListVSphereStoragePolicies structure.
+type ListVSphereStoragePoliciesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListVSphereStoragePoliciesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListVSphereStoragePoliciesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListVSphereStoragePoliciesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListVSphereStoragePoliciesOK creates a ListVSphereStoragePoliciesOK with default headers values
+func NewListVSphereStoragePoliciesOK() *ListVSphereStoragePoliciesOK {
+	return &ListVSphereStoragePoliciesOK{}
+}
+
+/*ListVSphereStoragePoliciesOK handles this case with default header values.
+
+VSphereStoragePolicy
+*/
+type ListVSphereStoragePoliciesOK struct {
+	Payload []*models.VSphereStoragePolicy
+}
+
+func (o *ListVSphereStoragePoliciesOK) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/vsphere/storagepolicies][%d] listVSphereStoragePoliciesOK  %+v", 200, o.Payload)
+}
+
+func (o *ListVSphereStoragePoliciesOK) GetPayload() []*models.VSphereStoragePolicy {
+	return o.Payload
+}
+
+func (o *ListVSphereStoragePoliciesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListVSphereStoragePoliciesDefault creates a ListVSphereStoragePoliciesDefault with default headers values
+func NewListVSphereStoragePoliciesDefault(code int) *ListVSphereStoragePoliciesDefault {
+	return &ListVSphereStoragePoliciesDefault{
+		_statusCode: code,
+	}
+}
+
+/*ListVSphereStoragePoliciesDefault handles this case with default header values.
+
+errorResponse
+*/
+type ListVSphereStoragePoliciesDefault struct {
+	_statusCode int
+
+	Payload *models.ErrorResponse
+}
+
+// Code gets the status code for the list v sphere storage policies default response
+func (o *ListVSphereStoragePoliciesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListVSphereStoragePoliciesDefault) Error() string {
+	return fmt.Sprintf("[GET /api/v1/providers/vsphere/storagepolicies][%d] listVSphereStoragePolicies default  %+v", o._statusCode, o.Payload)
+}
+
+func (o *ListVSphereStoragePoliciesDefault) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListVSphereStoragePoliciesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -25,6 +25,14 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ListVSphereDatastoreClusters(params *ListVSphereDatastoreClustersParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoreClustersOK, error)
+
+	ListVSphereDatastoreClustersNoCredentials(params *ListVSphereDatastoreClustersNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoreClustersNoCredentialsOK, error)
+
+	ListVSphereDatastores(params *ListVSphereDatastoresParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoresOK, error)
+
+	ListVSphereDatastoresNoCredentials(params *ListVSphereDatastoresNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoresNoCredentialsOK, error)
+
 	ListVSphereFolders(params *ListVSphereFoldersParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereFoldersOK, error)
 
 	ListVSphereFoldersNoCredentials(params *ListVSphereFoldersNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereFoldersNoCredentialsOK, error)
@@ -33,9 +41,153 @@ type ClientService interface {
 
 	ListVSphereNetworksNoCredentials(params *ListVSphereNetworksNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereNetworksNoCredentialsOK, error)
 
+	ListVSphereResourcePools(params *ListVSphereResourcePoolsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereResourcePoolsOK, error)
+
+	ListVSphereResourcePoolsNoCredentials(params *ListVSphereResourcePoolsNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereResourcePoolsNoCredentialsOK, error)
+
+	ListVSphereStoragePolicies(params *ListVSphereStoragePoliciesParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereStoragePoliciesOK, error)
+
+	ListVSphereStoragePoliciesNoCredentials(params *ListVSphereStoragePoliciesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereStoragePoliciesNoCredentialsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  ListVSphereDatastoreClusters Lists datastore clusters from vsphere datacenter
+*/
+func (a *Client) ListVSphereDatastoreClusters(params *ListVSphereDatastoreClustersParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoreClustersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereDatastoreClustersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereDatastoreClusters",
+		Method:             "GET",
+		PathPattern:        "/api/v1/providers/vsphere/datastoreclusters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereDatastoreClustersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereDatastoreClustersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereDatastoreClustersDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListVSphereDatastoreClustersNoCredentials Lists datastore clusters from vsphere datacenter
+*/
+func (a *Client) ListVSphereDatastoreClustersNoCredentials(params *ListVSphereDatastoreClustersNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoreClustersNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereDatastoreClustersNoCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereDatastoreClustersNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastoreclusters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereDatastoreClustersNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereDatastoreClustersNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereDatastoreClustersNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListVSphereDatastores Lists datastores from vsphere datacenter
+*/
+func (a *Client) ListVSphereDatastores(params *ListVSphereDatastoresParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoresOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereDatastoresParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereDatastores",
+		Method:             "GET",
+		PathPattern:        "/api/v1/providers/vsphere/datastores",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereDatastoresReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereDatastoresOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereDatastoresDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListVSphereDatastoresNoCredentials Lists datastores from vsphere datacenter
+*/
+func (a *Client) ListVSphereDatastoresNoCredentials(params *ListVSphereDatastoresNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereDatastoresNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereDatastoresNoCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereDatastoresNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/datastores",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereDatastoresNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereDatastoresNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereDatastoresNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   ListVSphereFolders Lists folders from vsphere datacenter
 */
@@ -172,6 +324,142 @@ func (a *Client) ListVSphereNetworksNoCredentials(params *ListVSphereNetworksNoC
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  ListVSphereResourcePools Lists resource pools from vsphere datacenter
+*/
+func (a *Client) ListVSphereResourcePools(params *ListVSphereResourcePoolsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereResourcePoolsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereResourcePoolsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereResourcePools",
+		Method:             "GET",
+		PathPattern:        "/api/v1/providers/vsphere/resourcepools",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereResourcePoolsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereResourcePoolsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereResourcePoolsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListVSphereResourcePoolsNoCredentials Lists resource pools from vsphere datacenter
+*/
+func (a *Client) ListVSphereResourcePoolsNoCredentials(params *ListVSphereResourcePoolsNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereResourcePoolsNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereResourcePoolsNoCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereResourcePoolsNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/resourcepools",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereResourcePoolsNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereResourcePoolsNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereResourcePoolsNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListVSphereStoragePolicies Lists storage policies from vsphere datacenter
+*/
+func (a *Client) ListVSphereStoragePolicies(params *ListVSphereStoragePoliciesParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereStoragePoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereStoragePoliciesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereStoragePolicies",
+		Method:             "GET",
+		PathPattern:        "/api/v1/providers/vsphere/storagepolicies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereStoragePoliciesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereStoragePoliciesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereStoragePoliciesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListVSphereStoragePoliciesNoCredentials Lists storage policies from vsphere datacenter
+*/
+func (a *Client) ListVSphereStoragePoliciesNoCredentials(params *ListVSphereStoragePoliciesNoCredentialsParams, authInfo runtime.ClientAuthInfoWriter) (*ListVSphereStoragePoliciesNoCredentialsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListVSphereStoragePoliciesNoCredentialsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listVSphereStoragePoliciesNoCredentials",
+		Method:             "GET",
+		PathPattern:        "/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/providers/vsphere/storagepolicies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ListVSphereStoragePoliciesNoCredentialsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListVSphereStoragePoliciesNoCredentialsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListVSphereStoragePoliciesNoCredentialsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
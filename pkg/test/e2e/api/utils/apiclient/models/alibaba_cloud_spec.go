@@ -22,6 +22,16 @@ type AlibabaCloudSpec struct {
 	// access key secret
 	AccessKeySecret string `json:"accessKeySecret,omitempty"`
 
+	// VPCID and VSwitchID are the IDs of the network resources of the cluster.
+	// Resources which are not set are created for the cluster and deleted together with it.
+	VPCID string `json:"vpcID,omitempty"`
+
+	// v switch ID
+	VSwitchID string `json:"vSwitchID,omitempty"`
+
+	// ZoneID is the zone of the vSwitch, nodes which use the vSwitch of the cluster are created in this zone.
+	ZoneID string `json:"zoneID,omitempty"`
+
 	// credentials reference
 	CredentialsReference GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
 }
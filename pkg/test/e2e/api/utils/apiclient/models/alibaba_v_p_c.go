@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AlibabaVPC AlibabaVPC represents a object of Alibaba VPC.
+//
+// swagger:model AlibabaVPC
+type AlibabaVPC struct {
+
+	// cidr block
+	CidrBlock string `json:"cidrBlock,omitempty"`
+
+	// ID
+	ID string `json:"id,omitempty"`
+
+	// is default
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this alibaba v p c
+func (m *AlibabaVPC) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AlibabaVPC) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AlibabaVPC) UnmarshalBinary(b []byte) error {
+	var res AlibabaVPC
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -44,6 +44,9 @@ type Cluster struct {
 	// Name represents human readable name for the resource
 	Name string `json:"name,omitempty"`
 
+	// PreflightWarnings are the warnings of the cloud provider checks, they are only set in the response to the creation
+	PreflightWarnings []string `json:"preflightWarnings"`
+
 	// type
 	Type string `json:"type,omitempty"`
 
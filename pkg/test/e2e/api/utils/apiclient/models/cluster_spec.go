@@ -21,6 +21,9 @@ type ClusterSpec struct {
 	// Additional Admission Controller plugins
 	AdmissionPlugins []string `json:"admissionPlugins"`
 
+	// DeletionProtection prevents the cluster from being deleted until it is disabled.
+	DeletionProtection bool `json:"deletionProtection,omitempty"`
+
 	// MachineNetworks optionally specifies the parameters for IPAM.
 	MachineNetworks []*MachineNetworkingConfig `json:"machineNetworks"`
 
@@ -6,8 +6,10 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // ClusterStatus ClusterStatus defines the cluster status
@@ -15,15 +17,64 @@ import (
 // swagger:model ClusterStatus
 type ClusterStatus struct {
 
+	// DeleteAt is set for deleted clusters which are kept for a grace period, they can be undeleted until then
+	// Format: date-time
+	DeleteAt strfmt.DateTime `json:"deleteAt,omitempty"`
+
 	// URL specifies the address at which the cluster is available
 	URL string `json:"url,omitempty"`
 
+	// phase
+	Phase ClusterPhase `json:"phase,omitempty"`
+
 	// version
 	Version Semver `json:"version,omitempty"`
 }
 
 // Validate validates this cluster status
 func (m *ClusterStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeleteAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePhase(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ClusterStatus) validateDeleteAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DeleteAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("deleteAt", "body", "date-time", m.DeleteAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ClusterStatus) validatePhase(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Phase) { // not required
+		return nil
+	}
+
+	if err := m.Phase.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("phase")
+		}
+		return err
+	}
+
 	return nil
 }
 
@@ -18,6 +18,11 @@ type DatacenterSpecDigitalocean struct {
 	// Datacenter location, e.g. "ams3". A list of existing datacenters can be found
 	// at https://www.digitalocean.com/docs/platform/availability-matrix/
 	Region string `json:"region,omitempty"`
+
+	// Optional: The addresses in CIDR notation the traffic of the seed to the nodes originates from.
+	// The firewalls of the clusters only allow kubelet and NodePort traffic from these addresses,
+	// NodePorts are reachable from the load balancers of the cluster as well.
+	SeedEgressAddresses []string `json:"seedEgressAddresses"`
 }
 
 // Validate validates this datacenter spec digitalocean
@@ -22,6 +22,10 @@ type DatacenterSpecHetzner struct {
 	// Optional: Detailed location of the datacenter, like "Hamburg" or "Datacenter 7".
 	// For informational purposes only.
 	Location string `json:"location,omitempty"`
+
+	// Optional: The network zone of the private networks created for the clusters,
+	// e.g. "eu-central". Defaults to "eu-central".
+	NetworkZone string `json:"networkZone,omitempty"`
 }
 
 // Validate validates this datacenter spec hetzner
@@ -34,6 +34,11 @@ type DatacenterSpecVSphere struct {
 	// case no `Datastore` or `DatastoreCluster` is provided at Cluster level.
 	DefaultDatastore string `json:"datastore,omitempty"`
 
+	// Optional: The name of the tag category used to tag the objects of a cluster,
+	// in case no `TagCategory` is provided at Cluster level. The category gets
+	// created if it does not exist yet.
+	DefaultTagCategory string `json:"default_tag_category,omitempty"`
+
 	// Endpoint URL to use, including protocol, for example "https://vcenter.example.com".
 	Endpoint string `json:"endpoint,omitempty"`
 
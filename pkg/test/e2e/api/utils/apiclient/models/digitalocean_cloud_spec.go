@@ -16,6 +16,9 @@ import (
 // swagger:model DigitaloceanCloudSpec
 type DigitaloceanCloudSpec struct {
 
+	// FirewallID is the ID of the cloud firewall which is created for the cluster.
+	FirewallID string `json:"firewallID,omitempty"`
+
 	// token
 	Token string `json:"token,omitempty"`
 
@@ -16,6 +16,10 @@ import (
 // swagger:model HetznerCloudSpec
 type HetznerCloudSpec struct {
 
+	// Network is the ID or the name of the private network the nodes are attached to.
+	// If not set, a network is created for the cluster and deleted together with it.
+	Network string `json:"network,omitempty"`
+
 	// token
 	Token string `json:"token,omitempty"`
 
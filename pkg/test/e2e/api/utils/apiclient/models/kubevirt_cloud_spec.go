@@ -19,6 +19,10 @@ type KubevirtCloudSpec struct {
 	// kubeconfig
 	Kubeconfig string `json:"kubeconfig,omitempty"`
 
+	// Namespace is the namespace in the KubeVirt infra cluster the virtual machines of the cluster are
+	// created in. It is created for the cluster and deleted together with it.
+	Namespace string `json:"namespace,omitempty"`
+
 	// credentials reference
 	CredentialsReference GlobalSecretKeySelector `json:"credentialsReference,omitempty"`
 }
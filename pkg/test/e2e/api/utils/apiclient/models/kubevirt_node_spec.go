@@ -26,8 +26,9 @@ type KubevirtNodeSpec struct {
 	Memory *string `json:"memory"`
 
 	// Namespace states in which namespace kubevirt node will be provisioned.
-	// Required: true
-	Namespace *string `json:"namespace"`
+	// Nodes are always provisioned in the dedicated namespace of the cluster in the KubeVirt infra cluster,
+	// if set it has to match it.
+	Namespace string `json:"namespace,omitempty"`
 
 	// PVCSize states the size of the provisioned pvc per node.
 	// Required: true
@@ -54,10 +55,6 @@ func (m *KubevirtNodeSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateNamespace(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if err := m.validatePVCSize(formats); err != nil {
 		res = append(res, err)
 	}
@@ -94,15 +91,6 @@ func (m *KubevirtNodeSpec) validateMemory(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *KubevirtNodeSpec) validateNamespace(formats strfmt.Registry) error {
-
-	if err := validate.Required("namespace", "body", m.Namespace); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (m *KubevirtNodeSpec) validatePVCSize(formats strfmt.Registry) error {
 
 	if err := validate.Required("pvcSize", "body", m.PVCSize); err != nil {
@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -31,6 +33,13 @@ type NodeDeployment struct {
 	// Name represents human readable name for the resource
 	Name string `json:"name,omitempty"`
 
+	// PreflightWarnings are the warnings of the cloud provider checks, they are only set in the response to the creation
+	PreflightWarnings []string `json:"preflightWarnings"`
+
+	// ZoneNodeDeployments are all node deployments created if the replicas were spread across
+	// availability zones, they are only set in the response to the creation
+	ZoneNodeDeployments []*NodeDeployment `json:"zoneNodeDeployments"`
+
 	// spec
 	Spec *NodeDeploymentSpec `json:"spec,omitempty"`
 
@@ -50,6 +59,10 @@ func (m *NodeDeployment) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateZoneNodeDeployments(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSpec(formats); err != nil {
 		res = append(res, err)
 	}
@@ -90,6 +103,31 @@ func (m *NodeDeployment) validateDeletionTimestamp(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *NodeDeployment) validateZoneNodeDeployments(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ZoneNodeDeployments) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.ZoneNodeDeployments); i++ {
+		if swag.IsZero(m.ZoneNodeDeployments[i]) { // not required
+			continue
+		}
+
+		if m.ZoneNodeDeployments[i] != nil {
+			if err := m.ZoneNodeDeployments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("zoneNodeDeployments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *NodeDeployment) validateSpec(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Spec) { // not required
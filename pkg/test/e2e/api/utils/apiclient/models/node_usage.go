@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NodeUsage NodeUsage represents the usage of all nodes of an instance size within a cluster
+//
+// swagger:model NodeUsage
+type NodeUsage struct {
+
+	// cost
+	Cost float64 `json:"cost,omitempty"`
+
+	// node hours
+	NodeHours float64 `json:"nodeHours,omitempty"`
+
+	// size
+	Size string `json:"size,omitempty"`
+}
+
+// Validate validates this node usage
+func (m *NodeUsage) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodeUsage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodeUsage) UnmarshalBinary(b []byte) error {
+	var res NodeUsage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
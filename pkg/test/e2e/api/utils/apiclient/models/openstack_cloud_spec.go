@@ -16,6 +16,14 @@ import (
 // swagger:model OpenstackCloudSpec
 type OpenstackCloudSpec struct {
 
+	// ApplicationCredentialID and ApplicationCredentialSecret are used instead of the username and password
+	// when set. Application credentials are bound to a project, the tenant and domain are not used with them.
+	// They are only used by the control plane, nodes can not be provisioned with them.
+	ApplicationCredentialID string `json:"applicationCredentialID,omitempty"`
+
+	// application credential secret
+	ApplicationCredentialSecret string `json:"applicationCredentialSecret,omitempty"`
+
 	// domain
 	Domain string `json:"domain,omitempty"`
 
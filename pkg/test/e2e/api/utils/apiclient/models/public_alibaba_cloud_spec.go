@@ -5,7 +5,45 @@ package models
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
 
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
 // PublicAlibabaCloudSpec PublicAlibabaCloudSpec is a public counterpart of apiv1.AlibabaCloudSpec.
 //
 // swagger:model PublicAlibabaCloudSpec
-type PublicAlibabaCloudSpec interface{}
+type PublicAlibabaCloudSpec struct {
+
+	// v p c ID
+	VPCID string `json:"vpcID,omitempty"`
+
+	// v switch ID
+	VSwitchID string `json:"vSwitchID,omitempty"`
+
+	// zone ID
+	ZoneID string `json:"zoneID,omitempty"`
+}
+
+// Validate validates this public alibaba cloud spec
+func (m *PublicAlibabaCloudSpec) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PublicAlibabaCloudSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PublicAlibabaCloudSpec) UnmarshalBinary(b []byte) error {
+	var res PublicAlibabaCloudSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
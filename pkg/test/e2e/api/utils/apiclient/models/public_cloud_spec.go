@@ -20,7 +20,7 @@ type PublicCloudSpec struct {
 	DatacenterName string `json:"dc,omitempty"`
 
 	// alibaba
-	Alibaba PublicAlibabaCloudSpec `json:"alibaba,omitempty"`
+	Alibaba *PublicAlibabaCloudSpec `json:"alibaba,omitempty"`
 
 	// aws
 	Aws PublicAWSCloudSpec `json:"aws,omitempty"`
@@ -41,10 +41,10 @@ type PublicCloudSpec struct {
 	Gcp PublicGCPCloudSpec `json:"gcp,omitempty"`
 
 	// hetzner
-	Hetzner PublicHetznerCloudSpec `json:"hetzner,omitempty"`
+	Hetzner *PublicHetznerCloudSpec `json:"hetzner,omitempty"`
 
 	// kubevirt
-	Kubevirt PublicKubevirtCloudSpec `json:"kubevirt,omitempty"`
+	Kubevirt *PublicKubevirtCloudSpec `json:"kubevirt,omitempty"`
 
 	// openstack
 	Openstack *PublicOpenstackCloudSpec `json:"openstack,omitempty"`
@@ -60,6 +60,18 @@ type PublicCloudSpec struct {
 func (m *PublicCloudSpec) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAlibaba(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHetzner(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKubevirt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOpenstack(formats); err != nil {
 		res = append(res, err)
 	}
@@ -70,6 +82,60 @@ func (m *PublicCloudSpec) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PublicCloudSpec) validateAlibaba(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Alibaba) { // not required
+		return nil
+	}
+
+	if m.Alibaba != nil {
+		if err := m.Alibaba.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("alibaba")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PublicCloudSpec) validateHetzner(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Hetzner) { // not required
+		return nil
+	}
+
+	if m.Hetzner != nil {
+		if err := m.Hetzner.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("hetzner")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PublicCloudSpec) validateKubevirt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Kubevirt) { // not required
+		return nil
+	}
+
+	if m.Kubevirt != nil {
+		if err := m.Kubevirt.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("kubevirt")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *PublicCloudSpec) validateOpenstack(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Openstack) { // not required
@@ -5,7 +5,39 @@ package models
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
 
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
 // PublicHetznerCloudSpec PublicHetznerCloudSpec is a public counterpart of apiv1.HetznerCloudSpec.
 //
 // swagger:model PublicHetznerCloudSpec
-type PublicHetznerCloudSpec interface{}
+type PublicHetznerCloudSpec struct {
+
+	// network
+	Network string `json:"network,omitempty"`
+}
+
+// Validate validates this public hetzner cloud spec
+func (m *PublicHetznerCloudSpec) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PublicHetznerCloudSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PublicHetznerCloudSpec) UnmarshalBinary(b []byte) error {
+	var res PublicHetznerCloudSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -5,7 +5,39 @@ package models
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
 
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
 // PublicKubevirtCloudSpec PublicKubevirtCloudSpec is a public counterpart of apiv1.KubevirtCloudSpec.
 //
 // swagger:model PublicKubevirtCloudSpec
-type PublicKubevirtCloudSpec interface{}
+type PublicKubevirtCloudSpec struct {
+
+	// namespace
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Validate validates this public kubevirt cloud spec
+func (m *PublicKubevirtCloudSpec) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PublicKubevirtCloudSpec) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PublicKubevirtCloudSpec) UnmarshalBinary(b []byte) error {
+	var res PublicKubevirtCloudSpec
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
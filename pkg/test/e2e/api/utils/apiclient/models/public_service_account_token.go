@@ -32,6 +32,10 @@ type PublicServiceAccountToken struct {
 	// ID unique value that identifies the resource generated by the server. Read-Only.
 	ID string `json:"id,omitempty"`
 
+	// LastUsed is a timestamp representing the time when this token was last used.
+	// Format: date-time
+	LastUsed strfmt.DateTime `json:"lastUsed,omitempty"`
+
 	// Name represents human readable name for the resource
 	Name string `json:"name,omitempty"`
 }
@@ -52,6 +56,10 @@ func (m *PublicServiceAccountToken) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastUsed(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -97,6 +105,19 @@ func (m *PublicServiceAccountToken) validateExpiry(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *PublicServiceAccountToken) validateLastUsed(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastUsed) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastUsed", "body", "date-time", m.LastUsed.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *PublicServiceAccountToken) MarshalBinary() ([]byte, error) {
 	if m == nil {
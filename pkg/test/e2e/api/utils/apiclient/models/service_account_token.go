@@ -32,6 +32,10 @@ type ServiceAccountToken struct {
 	// ID unique value that identifies the resource generated by the server. Read-Only.
 	ID string `json:"id,omitempty"`
 
+	// LastUsed is a timestamp representing the time when this token was last used.
+	// Format: date-time
+	LastUsed strfmt.DateTime `json:"lastUsed,omitempty"`
+
 	// Name represents human readable name for the resource
 	Name string `json:"name,omitempty"`
 
@@ -55,6 +59,10 @@ func (m *ServiceAccountToken) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastUsed(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -100,6 +108,19 @@ func (m *ServiceAccountToken) validateExpiry(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ServiceAccountToken) validateLastUsed(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastUsed) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastUsed", "body", "date-time", m.LastUsed.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *ServiceAccountToken) MarshalBinary() ([]byte, error) {
 	if m == nil {
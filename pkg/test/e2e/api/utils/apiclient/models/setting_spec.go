@@ -6,6 +6,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -16,6 +18,9 @@ import (
 // swagger:model SettingSpec
 type SettingSpec struct {
 
+	// Announcements are displayed to all users while they are active
+	Announcements []*Announcement `json:"announcements"`
+
 	// default node count
 	DefaultNodeCount int8 `json:"defaultNodeCount,omitempty"`
 
@@ -37,6 +42,9 @@ type SettingSpec struct {
 	// enable o ID c kubeconfig
 	EnableOIDCKubeconfig bool `json:"enableOIDCKubeconfig,omitempty"`
 
+	// EnableWebTerminal allows project owners and editors to open an interactive kubectl shell for their clusters in the browser
+	EnableWebTerminal bool `json:"enableWebTerminal,omitempty"`
+
 	// restrict project creation
 	RestrictProjectCreation bool `json:"restrictProjectCreation,omitempty"`
 
@@ -46,17 +54,30 @@ type SettingSpec struct {
 	// cleanup options
 	CleanupOptions *CleanupOptions `json:"cleanupOptions,omitempty"`
 
+	// cluster deletion grace period
+	ClusterDeletionGracePeriod Duration `json:"clusterDeletionGracePeriod,omitempty"`
+
 	// cluster type options
 	ClusterTypeOptions ClusterType `json:"clusterTypeOptions,omitempty"`
 
 	// custom links
 	CustomLinks CustomLinks `json:"customLinks,omitempty"`
+
+	// maintenance mode
+	MaintenanceMode *MaintenanceMode `json:"maintenanceMode,omitempty"`
+
+	// service account token options
+	ServiceAccountTokenOptions *ServiceAccountTokenOptions `json:"serviceAccountTokenOptions,omitempty"`
 }
 
 // Validate validates this setting spec
 func (m *SettingSpec) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAnnouncements(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCleanupOptions(formats); err != nil {
 		res = append(res, err)
 	}
@@ -69,12 +90,45 @@ func (m *SettingSpec) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMaintenanceMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceAccountTokenOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *SettingSpec) validateAnnouncements(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Announcements) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Announcements); i++ {
+		if swag.IsZero(m.Announcements[i]) { // not required
+			continue
+		}
+
+		if m.Announcements[i] != nil {
+			if err := m.Announcements[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("announcements" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *SettingSpec) validateCleanupOptions(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.CleanupOptions) { // not required
@@ -125,6 +179,42 @@ func (m *SettingSpec) validateCustomLinks(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *SettingSpec) validateMaintenanceMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MaintenanceMode) { // not required
+		return nil
+	}
+
+	if m.MaintenanceMode != nil {
+		if err := m.MaintenanceMode.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("maintenanceMode")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *SettingSpec) validateServiceAccountTokenOptions(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.ServiceAccountTokenOptions) { // not required
+		return nil
+	}
+
+	if m.ServiceAccountTokenOptions != nil {
+		if err := m.ServiceAccountTokenOptions.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("serviceAccountTokenOptions")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *SettingSpec) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -36,6 +36,20 @@ type VSphereCloudSpec struct {
 	// +optional
 	Password string `json:"password,omitempty"`
 
+	// ResourcePool is used to manage resources such as cpu and memory for
+	// the virtual machines. The resource pool must be defined on vSphere
+	// cluster level.
+	// +optional
+	ResourcePool string `json:"resourcePool,omitempty"`
+
+	// TagCategory is the name of the tag category in which a tag named after
+	// the cluster gets created. The tag is attached to the folder created for
+	// the cluster and the virtual machines in it. Virtual machines still carrying
+	// the tag get removed together with the cluster. Defaults to
+	// the `DefaultTagCategory` of the Datacenter.
+	// +optional
+	TagCategory string `json:"tagCategory,omitempty"`
+
 	// Username is the vSphere user name.
 	// +optional
 	Username string `json:"username,omitempty"`
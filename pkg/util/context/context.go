@@ -21,3 +21,12 @@ type Key string
 
 // UserCRContextKey key under which the current User (from the database) is kept in the ctx
 const UserCRContextKey Key = "user-cr"
+
+// ProjectRoleContextKey key under which the ProjectRole that authorized the current request is kept in the ctx
+const ProjectRoleContextKey Key = "project-role"
+
+// ProjectRoleVerbContextKey key under which the verb the ProjectRole granted for the current request is kept in the ctx
+const ProjectRoleVerbContextKey Key = "project-role-verb"
+
+// TokenGroupsContextKey key under which the groups claim of the verified token is kept in the ctx
+const TokenGroupsContextKey Key = "auth-token-groups"
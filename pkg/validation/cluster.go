@@ -26,6 +26,7 @@ import (
 	kuberneteshelper "k8c.io/kubermatic/v2/pkg/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/provider"
 	"k8c.io/kubermatic/v2/pkg/provider/cloud"
+	"k8c.io/kubermatic/v2/pkg/provider/cloud/hetzner"
 	kubernetesprovider "k8c.io/kubermatic/v2/pkg/provider/kubernetes"
 	"k8c.io/kubermatic/v2/pkg/resources"
 
@@ -61,6 +62,13 @@ func ValidateCreateClusterSpec(spec *kubermaticv1.ClusterSpec, dc *kubermaticv1.
 		return fmt.Errorf("machine network validation failed, see: %v", err)
 	}
 
+	// A network is created for Hetzner clusters which don't use an existing one
+	if spec.Cloud.Hetzner != nil && spec.Cloud.Hetzner.Network == "" {
+		if err := hetzner.ValidateClusterNetwork(spec.ClusterNetwork); err != nil {
+			return fmt.Errorf("invalid cluster network: %v", err)
+		}
+	}
+
 	return nil
 }
 
@@ -289,6 +297,23 @@ func ValidateCloudSpec(spec kubermaticv1.CloudSpec, dc *kubermaticv1.Datacenter)
 }
 
 func validateOpenStackCloudSpec(spec *kubermaticv1.OpenstackCloudSpec, dc *kubermaticv1.Datacenter) error {
+	if spec.FloatingIPPool == "" && dc.Spec.Openstack != nil && dc.Spec.Openstack.EnforceFloatingIP {
+		return errors.New("no floating ip pool specified")
+	}
+
+	// Application credentials are bound to a project, no user, tenant or domain is required for them
+	if spec.ApplicationCredentialID != "" {
+		if spec.ApplicationCredentialSecret == "" {
+			if spec.CredentialsReference == nil {
+				return errors.New("no application credential secret specified")
+			}
+			if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.OpenstackApplicationCredentialSecret); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	if spec.Domain == "" {
 		if err := kuberneteshelper.ValidateSecretKeySelector(spec.CredentialsReference, resources.OpenstackDomain); err != nil {
 			return err
@@ -315,10 +340,6 @@ func validateOpenStackCloudSpec(spec *kubermaticv1.OpenstackCloudSpec, dc *kuber
 	if utilerror.NewAggregate(errs) != nil {
 		return errors.New("no tenant name or ID specified")
 	}
-
-	if spec.FloatingIPPool == "" && dc.Spec.Openstack != nil && dc.Spec.Openstack.EnforceFloatingIP {
-		return errors.New("no floating ip pool specified")
-	}
 	return nil
 }
 
@@ -72,6 +72,31 @@ func TestValidateCloudSpec(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid openstack spec - application credentials",
+			err:  nil,
+			spec: kubermaticv1.CloudSpec{
+				DatacenterName: "some-datacenter",
+				Openstack: &kubermaticv1.OpenstackCloudSpec{
+					ApplicationCredentialID:     "some-id",
+					ApplicationCredentialSecret: "some-secret",
+					// Required due to the above defined DC
+					FloatingIPPool: "some-network",
+				},
+			},
+		},
+		{
+			name: "invalid openstack spec - application credential without secret",
+			err:  errors.New("no application credential secret specified"),
+			spec: kubermaticv1.CloudSpec{
+				DatacenterName: "some-datacenter",
+				Openstack: &kubermaticv1.OpenstackCloudSpec{
+					ApplicationCredentialID: "some-id",
+					// Required due to the above defined DC
+					FloatingIPPool: "some-network",
+				},
+			},
+		},
 		{
 			name: "invalid openstack spec - no datacenter specified",
 			err:  errors.New("no node datacenter specified"),
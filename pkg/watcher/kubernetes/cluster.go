@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"hash/fnv"
+	"reflect"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/go-pubsub"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+
+	v1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/log"
+	"k8c.io/kubermatic/v2/pkg/provider"
+)
+
+// clusterWatcherSyncPeriod is the interval in which new seeds are picked up and broken seed watches are recreated.
+const clusterWatcherSyncPeriod = 30 * time.Second
+
+// ClusterWatcher watches clusters in all seeds and notifies its subscribers about any changes.
+// Changes are published under the project and cluster hash path, this way subscribers can listen
+// to all clusters of a project or to a single cluster.
+type ClusterWatcher struct {
+	seedsGetter           provider.SeedsGetter
+	clusterProviderGetter provider.ClusterProviderGetter
+	publisher             *pubsub.PubSub
+
+	startOnce    sync.Once
+	lock         sync.Mutex
+	seedWatchers map[string]watch.Interface
+}
+
+// NewClusterWatcher returns a new resource watcher. Seeds are watched only after the first subscription.
+func NewClusterWatcher(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) *ClusterWatcher {
+	return &ClusterWatcher{
+		seedsGetter:           seedsGetter,
+		clusterProviderGetter: clusterProviderGetter,
+		publisher:             pubsub.New(),
+		seedWatchers:          map[string]watch.Interface{},
+	}
+}
+
+// syncSeeds makes sure that there is exactly one cluster watch for every existing seed.
+func (watcher *ClusterWatcher) syncSeeds() {
+	seeds, err := watcher.seedsGetter()
+	if err != nil {
+		log.Logger.Debugf("could not list seeds for cluster watcher: %v", err)
+		return
+	}
+
+	watcher.lock.Lock()
+	defer watcher.lock.Unlock()
+
+	for seedName, seed := range seeds {
+		if _, running := watcher.seedWatchers[seedName]; running {
+			continue
+		}
+
+		clusterProvider, err := watcher.clusterProviderGetter(seed)
+		if err != nil {
+			log.Logger.Debugf("could not get cluster provider for seed %s: %v", seedName, err)
+			continue
+		}
+		privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+		if !ok {
+			log.Logger.Debugf("cluster provider for seed %s cannot watch clusters", seedName)
+			continue
+		}
+		seedWatcher, err := privilegedClusterProvider.WatchUnsecured()
+		if err != nil {
+			log.Logger.Debugf("could not create cluster watcher for seed %s: %v", seedName, err)
+			continue
+		}
+
+		watcher.seedWatchers[seedName] = seedWatcher
+		go watcher.run(seedName, seedWatcher)
+	}
+
+	for seedName, seedWatcher := range watcher.seedWatchers {
+		if _, exists := seeds[seedName]; !exists {
+			seedWatcher.Stop()
+			delete(watcher.seedWatchers, seedName)
+		}
+	}
+}
+
+// run and publish information about cluster updates in the given seed. The watch is recreated on the next sync if any error occurs.
+func (watcher *ClusterWatcher) run(seedName string, seedWatcher watch.Interface) {
+	defer func() {
+		log.Logger.Debugf("restarting cluster watcher for seed %s", seedName)
+		seedWatcher.Stop()
+
+		watcher.lock.Lock()
+		defer watcher.lock.Unlock()
+		if watcher.seedWatchers[seedName] == seedWatcher {
+			delete(watcher.seedWatchers, seedName)
+		}
+	}()
+
+	for event := range seedWatcher.ResultChan() {
+		if event.Type == watch.Error {
+			return
+		}
+
+		cluster, ok := event.Object.(*v1.Cluster)
+		if !ok {
+			log.Logger.Debugf("expected cluster got %s", reflect.TypeOf(event.Object))
+			continue
+		}
+
+		projectHash, err := watcher.CalculateHash(cluster.Labels[v1.ProjectIDLabelKey])
+		if err != nil {
+			log.Logger.Warnf("Error calculating project hash for cluster watch pubsub: %v", err)
+			continue
+		}
+		clusterHash, err := watcher.CalculateHash(cluster.Name)
+		if err != nil {
+			log.Logger.Warnf("Error calculating cluster hash for cluster watch pubsub: %v", err)
+			continue
+		}
+
+		if event.Type == watch.Added || event.Type == watch.Modified {
+			watcher.publisher.Publish(cluster, pubsub.LinearTreeTraverser([]uint64{projectHash, clusterHash}))
+		} else if event.Type == watch.Deleted {
+			watcher.publisher.Publish(nil, pubsub.LinearTreeTraverser([]uint64{projectHash, clusterHash}))
+		}
+	}
+}
+
+func (watcher *ClusterWatcher) CalculateHash(id string) (uint64, error) {
+	h := fnv.New64()
+	_, err := h.Write([]byte(id))
+	if err != nil {
+		return 0, err
+	}
+	return h.Sum64(), err
+}
+
+// Subscribe allows to register subscription handler which will be invoked on each cluster change.
+// Use the project hash as path to get notified about all clusters in the project, the project
+// and cluster hash to get notified about a single cluster.
+func (watcher *ClusterWatcher) Subscribe(subscription pubsub.Subscription, opts ...pubsub.SubscribeOption) pubsub.Unsubscriber {
+	watcher.startOnce.Do(func() {
+		go wait.Forever(watcher.syncSeeds, clusterWatcherSyncPeriod)
+	})
+	return watcher.publisher.Subscribe(subscription, opts...)
+}
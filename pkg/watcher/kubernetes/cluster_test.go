@@ -0,0 +1,110 @@
+/*
+Copyright 2020 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	kubermaticv1 "k8c.io/kubermatic/v2/pkg/crd/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/provider/kubernetes"
+
+	"code.cloudfoundry.org/go-pubsub"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+type fakeClusterProvider struct {
+	*kubernetes.ClusterProvider
+	watcher *watch.FakeWatcher
+}
+
+func (p *fakeClusterProvider) WatchUnsecured() (watch.Interface, error) {
+	return p.watcher, nil
+}
+
+func TestClusterWatcher(t *testing.T) {
+	fakeWatcher := watch.NewFake()
+	seedsGetter := func() (map[string]*kubermaticv1.Seed, error) {
+		return map[string]*kubermaticv1.Seed{"us-central1": {ObjectMeta: metav1.ObjectMeta{Name: "us-central1"}}}, nil
+	}
+	clusterProviderGetter := func(seed *kubermaticv1.Seed) (provider.ClusterProvider, error) {
+		return &fakeClusterProvider{watcher: fakeWatcher}, nil
+	}
+	clusterWatcher := NewClusterWatcher(seedsGetter, clusterProviderGetter)
+
+	projectHash, err := clusterWatcher.CalculateHash("my-project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clusterHash, err := clusterWatcher.CalculateHash("abcd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherClusterHash, err := clusterWatcher.CalculateHash("efgh")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projectEvents := make(chan interface{}, 10)
+	clusterEvents := make(chan interface{}, 10)
+	otherClusterEvents := make(chan interface{}, 10)
+	clusterWatcher.Subscribe(func(d interface{}) {
+		projectEvents <- d
+	}, pubsub.WithPath([]uint64{projectHash}))
+	clusterWatcher.Subscribe(func(d interface{}) {
+		clusterEvents <- d
+	}, pubsub.WithPath([]uint64{projectHash, clusterHash}))
+	clusterWatcher.Subscribe(func(d interface{}) {
+		otherClusterEvents <- d
+	}, pubsub.WithPath([]uint64{projectHash, otherClusterHash}))
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "abcd",
+			Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "my-project"},
+		},
+	}
+	fakeWatcher.Add(cluster)
+
+	for name, events := range map[string]chan interface{}{"project": projectEvents, "cluster": clusterEvents} {
+		select {
+		case data := <-events:
+			if data != cluster {
+				t.Fatalf("%s subscriber should receive the cluster, got %v", name, data)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s subscriber did not receive the cluster", name)
+		}
+	}
+
+	fakeWatcher.Delete(cluster)
+
+	select {
+	case data := <-clusterEvents:
+		if data != nil {
+			t.Fatalf("cluster subscriber should receive nil for the deleted cluster, got %v", data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("cluster subscriber did not receive the cluster deletion")
+	}
+
+	if len(otherClusterEvents) != 0 {
+		t.Fatal("subscriber of another cluster should not receive any data")
+	}
+}
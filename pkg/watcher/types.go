@@ -28,6 +28,7 @@ type Providers struct {
 	UserProvider     provider.UserProvider
 	UserWatcher      UserWatcher
 	MemberMapper     provider.ProjectMemberMapper
+	ClusterWatcher   ClusterWatcher
 }
 
 type SettingsWatcher interface {
@@ -38,3 +39,8 @@ type UserWatcher interface {
 	Subscribe(subscription pubsub.Subscription, opts ...pubsub.SubscribeOption) pubsub.Unsubscriber
 	CalculateHash(id string) (uint64, error)
 }
+
+type ClusterWatcher interface {
+	Subscribe(subscription pubsub.Subscription, opts ...pubsub.SubscribeOption) pubsub.Unsubscriber
+	CalculateHash(id string) (uint64, error)
+}